@@ -25,7 +25,9 @@ import (
 
 	"github.com/elastic/beats/v7/heartbeat/config"
 	"github.com/elastic/beats/v7/heartbeat/hbregistry"
+	"github.com/elastic/beats/v7/heartbeat/inventory"
 	"github.com/elastic/beats/v7/heartbeat/monitors"
+	"github.com/elastic/beats/v7/heartbeat/monitors/slo"
 	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
 	"github.com/elastic/beats/v7/heartbeat/scheduler"
 	"github.com/elastic/beats/v7/libbeat/autodiscover"
@@ -44,10 +46,12 @@ type Heartbeat struct {
 	done chan struct{}
 	// config is used for iterating over elements of the config.
 	config          config.Config
+	location        *time.Location
 	scheduler       *scheduler.Scheduler
 	monitorReloader *cfgfile.Reloader
 	dynamicFactory  *monitors.RunnerFactory
 	autodiscover    *autodiscover.Autodiscover
+	sloStore        *slo.Store
 }
 
 // New creates a new heartbeat.
@@ -56,7 +60,6 @@ func New(b *beat.Beat, rawConfig *common.Config) (beat.Beater, error) {
 	if err := rawConfig.Unpack(&parsedConfig); err != nil {
 		return nil, fmt.Errorf("Error reading config file: %v", err)
 	}
-	limit := parsedConfig.Scheduler.Limit
 	locationName := parsedConfig.Scheduler.Location
 	if locationName == "" {
 		locationName = "Local"
@@ -66,14 +69,10 @@ func New(b *beat.Beat, rawConfig *common.Config) (beat.Beater, error) {
 		return nil, err
 	}
 
-	scheduler := scheduler.NewWithLocation(limit, hbregistry.SchedulerRegistry, location)
-
 	bt := &Heartbeat{
-		done:      make(chan struct{}),
-		config:    parsedConfig,
-		scheduler: scheduler,
-		// dynamicFactory is the factory used for dynamic configs, e.g. autodiscover / reload
-		dynamicFactory: monitors.NewFactory(b.Info, scheduler, false),
+		done:     make(chan struct{}),
+		config:   parsedConfig,
+		location: location,
 	}
 	return bt, nil
 }
@@ -82,6 +81,36 @@ func New(b *beat.Beat, rawConfig *common.Config) (beat.Beater, error) {
 func (bt *Heartbeat) Run(b *beat.Beat) error {
 	logp.Info("heartbeat is running! Hit CTRL-C to stop it.")
 
+	registry, err := openStateRegistry()
+	if err != nil {
+		return err
+	}
+	defer registry.Close()
+
+	store, err := registry.Get(sloStoreName)
+	if err != nil {
+		return errors.Wrap(err, "could not open the SLO store")
+	}
+	bt.sloStore = slo.NewStore(store)
+	defer bt.sloStore.Close()
+
+	schedulerStore, err := registry.Get(schedulerStoreName)
+	if err != nil {
+		return errors.Wrap(err, "could not open the scheduler store")
+	}
+	defer schedulerStore.Close()
+
+	bt.scheduler = scheduler.NewWithSettings(scheduler.Settings{
+		Limit:             bt.config.Scheduler.Limit,
+		MaxConcurrentJobs: bt.config.Scheduler.MaxConcurrentJobs,
+		InitialJitter:     bt.config.Scheduler.InitialJitter,
+		Location:          bt.location,
+		Store:             schedulerStore,
+	}, hbregistry.SchedulerRegistry)
+
+	// dynamicFactory is the factory used for dynamic configs, e.g. autodiscover / reload
+	bt.dynamicFactory = monitors.NewFactory(b.Info, bt.scheduler, bt.sloStore, false)
+
 	stopStaticMonitors, err := bt.RunStaticMonitors(b)
 	if err != nil {
 		return err
@@ -102,6 +131,16 @@ func (bt *Heartbeat) Run(b *beat.Beat) error {
 		}
 	}
 
+	for _, cfg := range bt.config.Inventory {
+		provider, err := inventory.NewProvider(cfg, bt.dynamicFactory, b.Publisher, b.Keystore)
+		if err != nil {
+			return errors.Wrap(err, "could not create monitor inventory")
+		}
+
+		provider.Start()
+		defer provider.Stop()
+	}
+
 	if bt.config.Autodiscover != nil {
 		bt.autodiscover, err = bt.makeAutodiscover(b)
 		if err != nil {
@@ -125,7 +164,7 @@ func (bt *Heartbeat) Run(b *beat.Beat) error {
 
 // RunStaticMonitors runs the `heartbeat.monitors` portion of the yaml config if present.
 func (bt *Heartbeat) RunStaticMonitors(b *beat.Beat) (stop func(), err error) {
-	factory := monitors.NewFactory(b.Info, bt.scheduler, true)
+	factory := monitors.NewFactory(b.Info, bt.scheduler, bt.sloStore, true)
 
 	var runners []cfgfile.Runner
 	for _, cfg := range bt.config.Monitors {
@@ -0,0 +1,47 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package beater
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/paths"
+	"github.com/elastic/beats/v7/libbeat/statestore"
+	"github.com/elastic/beats/v7/libbeat/statestore/backend/memlog"
+)
+
+const (
+	// stateRegistryPath is the directory, relative to the data path, heartbeat
+	// persists its state in.
+	stateRegistryPath = "registry"
+
+	sloStoreName       = "slo"
+	schedulerStoreName = "scheduler"
+)
+
+// openStateRegistry opens the registry heartbeat persists its state in.
+func openStateRegistry() (*statestore.Registry, error) {
+	backend, err := memlog.New(logp.NewLogger("heartbeat.registry"), memlog.Settings{
+		Root: paths.Resolve(paths.Data, stateRegistryPath),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open the heartbeat registry")
+	}
+	return statestore.NewRegistry(backend), nil
+}
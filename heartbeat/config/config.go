@@ -21,6 +21,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/elastic/beats/v7/libbeat/autodiscover"
 	"github.com/elastic/beats/v7/libbeat/common"
 )
@@ -32,13 +34,16 @@ type Config struct {
 	ConfigMonitors  *common.Config       `config:"config.monitors"`
 	Scheduler       Scheduler            `config:"scheduler"`
 	Autodiscover    *autodiscover.Config `config:"autodiscover"`
+	Inventory       []*common.Config     `config:"inventory"`
 	SyntheticSuites []*common.Config     `config:"synthetic_suites"`
 }
 
 // Scheduler defines the syntax of a heartbeat.yml scheduler block.
 type Scheduler struct {
-	Limit    int64  `config:"limit"  validate:"min=0"`
-	Location string `config:"location"`
+	Limit             int64         `config:"limit"  validate:"min=0"`
+	MaxConcurrentJobs int64         `config:"max_concurrent_jobs" validate:"min=0"`
+	InitialJitter     time.Duration `config:"initial_jitter" validate:"min=0"`
+	Location          string        `config:"location"`
 }
 
 // DefaultConfig is the canonical instantiation of Config.
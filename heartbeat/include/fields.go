@@ -32,5 +32,5 @@ func init() {
 // AssetFieldsYml returns asset data.
 // This is the base64 encoded gzipped contents of fields.yml.
 func AssetFieldsYml() string {
-	return "eJzs/XtTIzmyMIz/P59CPzbiRzPHLmxuTfO+G/EwwMwQT1/Yht7ZMzsbIFfJtpayVCOpMJ4T57u/oUxJpboApht39/Sy53l6sF0lpVKpVN7zL+SXw/dvT9/+9P8jx5IIaQjLuCFmyjUZ85yRjCuWmnzRI9yQOdVkwgRT1LCMjBbETBk5OTonhZL/ZqnpffcXMqKaZUQK+P6GKc2lIMPkVTJIvvsLOcsZ1YzccM0NmRpT6IPNzQk303KUpHK2yXKqDU83WaqJkUSXkwnThqRTKiYMvrLDjjnLM518912fXLPFAWGp/o4Qw03ODuwD3xGSMZ0qXhguBXxFfnTvEPf2wXeE9ImgM3ZA1v+P4TOmDZ0V698RQkjOblh+QFKpGHxW7PeSK5YdEKNK/MosCnZAMmrwY22+9WNq2KYdk8ynTACa2A0ThkjFJ1xY9CXfwXuEXFhccw0PZeE9dmsUTS2ax0rOqhF6dmKe0jxfEMUKxTQThosJTORGrKbr3DAtS5WyMP/pOHoBfyNTqomQHtqcBPT0kDRuaF4yADoAU8iizO00blg32ZgrbeD9BliKpYzfVFAVvGA5FxVc7x3Ocb/IWCpC8xxH0AnuE7uls8Ju+vrWYLjXH+z2t7YvBvsHg92D7Z1kf3f71/Vom3M6Yrnu3GDcTTmyVAxf4J+X+P01W8ylyjo2+qjURs7sA5uIk4JypcMajqggI0ZKeySMJDTLyIwZSrgYSzWjdhD7vVsTOZ/KMs/gGKZSGMoFEUzbrUNwgHzt/w7zHPdAE6oY0UZaRFHtIQ0AnHgEXWUyvWbqilCRkavrfX3l0NHC5P+s0aLIeQrQrR2QtbGU/RFVaz2yxsSN/aZQMitT+P1/YwTPmNZ0wu7BsGG3pgONP0pFcjlxiAB6cGO53XfowJ/sk+7nHpGF4TP+R6A7Syc3nM3tmeCCUHjafsFUwIqdThtVpqa0eMvlRJM5N1NZGkJFRfY1GHpEmilTjn2QFLc2lSKlhomI8o20QMwIJdNyRkVfMZrRUc6ILmczqhZERicuPoazMje8yMPaNWG3XNsjP2WLasLZiAuWES6MJFKEp5sb+TPLc0l+kSrPoi0ydHLfCYgpnU+EVOySjuQNOyDDwdZOe+dec23setx7OpC6oRPCaDr1q6zT2D9jEkK62lr7V0xKdMIEUopj64fhi4mSZXFAtjro6GLK8M2wS+4YOeZKCR3ZTUY2ODZze3osAzX2ghu7raBiYXFO7SnMc3vueiRjBv+QisiRZurGbg+Sq7RkNpV2p6Qihl4zTWaM6lKxmX3ADRsea55OTbhI8zJj5AdGLR+AtWoyowtCcy2JKoV9282rdAI3Giw0+d4t1Q2pp5ZJjljFj4GyLfyU59rTHiJJlULYcyIRQRa2aH3KDTmfMhVz7yktCmYp0C4WTmpYKnB2iwDhqHEspRHS2D33iz0gpzhdaiUBOcZFw7m1B7FXwZdYUiBOEhkxapLo/B6evQGZxN2c9QW5HadFsWmXwlOWkIo2Yu6bSeZRB2wXBA3Cx0gtXBN7vxIzVbKcTMnvJSvt+HqhDZtpkvNrRv4vHV/THnnPMo70USiZMq25mPhNcY/rMp1aLv1aTrShekpwHeQc0O1QhgcRiBxRGMSV6nSMSp5niedTbpbmie4603ee6uZJOrk1TGT2erZT1VA2dvuOe+Rp2QkyyK6tRCPcAEaGU0jFomM8OGkUEY7yRxjSnoBCyRuesZ4VSHTBUj7mKcG3QfDhOohnDoMRp5kxo3hqaSfIoi+TvWRAXtBZtrez0SM5H8HP+PU/9+jWNtsf74+3B+PdwWA4ots7O2yH7e5k+9mrdLS/lY6Gg5dpANGux5CtwdagP9jqD3bJ1vbBcHAwHJD/GgwGA/Lh4uhfAcNjWubmEnB0QMY016y2rayYshlTNL/kWX1TmduOJ9hYPwfhmeV8Y84UcgWu3fl4wcdwscDtozeaW8ythKJmIPV5wZymSmq7EdpQZdnkqDTkCimEZ1dwzOwBa+/QPt2xiB7XENFc/tPQ9AfBf7di6+PXHcQoy3mQX8F7c5DXRowAd+IdBOiWl9WWZ/9dxQKdNApsM2b0rR3UhOJTeMuhZDHhNwzEUSrca/i0+3nK8mJc5pY3Wg7gVhgGNnNJfnR8mnChDRWpE08b14y2E8NdY4nESUmkkpJYQRVwhjA210QwlqFeOZ/ydNqeKjDsVM7sZFZtitZ9Orb8w18osFS8afxXcmyYIDkbG8JmhVm0t3IsZW0X7UatYhcvFsU92+cvMTsBofmcLjTRxv4bcGtFfD31pInb6rQsfNcKaUmFGhGu4oDV6lkkcTfRiFWPgGTCx7WNr3asSQC1zZ/RdGpVvTaK43E8nh3jXgGq/+6uhDqyGzDtJYNk0FfpViyd6ppoWhop5EyWmpzDTf+AmHooCK1eQeGAvDg838CD6YROB1gqhWBgCDgVhinBDDlT0shU+nv/xenZBlGyhNuwUGzMb5kmpcgY3tP29lUyt4NZ7iYVmUnFiGBmLtU1kQVT1Ehl5Vivu7Mpzcf2BUqsGJMzQrMZF1wbezJvvMxsx8rkDAVsaogzR+AiZjMpeiTNGVX5oroBQXcJ0MqcpwvQF6YMRAa7wGRpOUiUs1GQU++7KnMZhLHaVrgrAcchNM9lCjKzg6i1TU6MDF8Hgne76AZ6cXj+doOUMHi+qG4cjTpRQD2eidPauiPSG+4O917VFizVhAr+B7DHpH2NfIqYANrnZYzliNV5tZ20NXkCorOa6ViiIfeJO409eBetCeZr4eEnKS0Nvn59FJ3BNOcNFfGo+uYeHfHQvWkPm6dHqh0BcsPtWUDS99vkjqCTfT1wqPspNqEqA53AivxS6F70POoDI45WVC4Fzck4l3OiWGrV5ZpF4uLozI2KN1MFZgs2+4V9PIIMDqBmImiC9pnz/35LCppeM/NCbyQwCxoxCsdCWlOhtdCKdrVJvQqrQNZm2sLhlCyPJaOo0BSASci5nLGg9pQa1UfD1IyseROoVGuVwUSxsedWDhTRWKDGo+d+duo97uyIBfUW1PsIAe5YWrDExG9zNUUMPxoqHBH5CeztVerSIsSNWunVXFjw/l0K3ABQs1Fx9gbqjsEq/AppWkNawQr3qw8n2lsGgz0Rx9v08wQLMBweFNVolhHNZlQYngLvZ7fGSXXsFuX1HgpRniPoINsZSW64XS7/g1U2E7tQpkCD09yU1G3H6ZgsZKnCHGOa5574/I1guelEqkXPPuqFEm14nhMmdKmcBOrMzlZwyZg2ljwsSi3CxjzPA0OjRaFkoTg1LF88Ql+mWaaY1qvSqYDa0TjiaMtN6OSfwGZmIz4pZanzBVIzvBMY5tyiRcsZA3M7ybkGc+TpWc+qx3jPSkWovVhuiZaWThJC/rvCbJAHK+kIz4Gicw+Tp/urxH1xhSirS5mCcBMJkVmJJmG8Gq8SXlxZUK4SBOuqRzJWMJE5MR9ldCkqIMBS43askqKS/7gLnOrk+Q6PLVkLw/QDon2092j3qb9WA+QH+wMa7YLjzJ1JRxLIOttbtb9TAwwJewVKh+PhOH5Sm3PCZJJys7hckYHgyMrsnbvzxuoIzJkSa+BIYbhgwlymMlsFTBdz2c+ZMcxeJBmrOzXD7Ou6G+63h989QKjdi1kRgt9GlpcwWRtoqcyUHM6Y4intALIURi0uuZarwvkRTkFOz98B0lsQHh3eCdaqSNOB1LnLR1TQrI0p4PUPWwYmTF4WkoeLtu7AkmLCTZmh8JFTAx9aEKz/D1nLwR3af7md7A139rcHPbKWU7N2QHZ2k93B7qvhPvnf9RaQT8vgGwZNzVTfCxfRT6i+ePT0iDPooEgpx2SiqChzqrhZxFLCgqRWWgEZOpIGjrwQEMxlSOFcoXiYMnv9OU1inEup3C3aA/PQlFdyenXdIng5KaYLze0f3guXeh6lIxDeShOFGoCPkaMRZQa3/YRJv9q2UWkktZGin6WtvSmkNjRf1SlbP4Phka1RrWXKK38c+rEdyNVC/+6c+pWc69wtwbUSnIIjRq6FnAur1VBilwITSUV+PT0j0ZoIkDYIlzdULcicZ1amgevRnWp00sCfbfy92hnsDB7DZhWbcClWycDewwz38a/+347ugmtFHMzB1MnA/layEWvTn5Xz/6ik5Ce9Vq2+zWeM/AE2v3GN4HrBE3l6+PYweq4TeHdRbR6qCVzLdPOHkgmpLw+5ioSwBwiDFw+sMjxQW8fpWdBb/L2K8tOL07ObHUvtp2c3ext1OWpG01Wc5zeHR93ANAz0QprgKZ1RJ4i+//GIvBzsbIFPGcPaWHZATqw6IVPDDHkBqjDXPbLfH/FKMLey7ga6OZ1o5KKm5pL8sywKplKq2b/IlN3SjKV8RnOS8Qk34OewYpSFFMKFwpgOfJzYMhBBSqH5xAWWsAlTCTkvU/Bj37gHXbAR+mcQBhpGnC6KKevgvoNBfzDo757Av9v9re3aTglqkiZldN6P3dSxfqGo0Gg7OT2zq3KWBIxCfHt4Ecxy5AVLJomzMVuuXBkLCdqgvPm55vAMl05kiSJGUXBKiAnJJc3IiOZUpHAHjrlic5rnaPlTsrRXY0PvtYsupDKPU3u96qON4t26cIwNO/6fBR9o8XqEFlhb9Rm+/VE631YdjtaeLKOK3r0fZ24PYkYRz2fvI22YYtlll7b5dHKiZUpTPpkybaJJPY5w7h4spChY5kHW5cgrqWH/f6w8vyjvRcM5C5WVV9bGUibuuSSVszXLvtbiL5ouaYyedK7mjBmmZiDVFoqlXFt5BcQmilYxiLuBqNFylPOU6HI85rdhRHjmxdSY4mBzEx/BJxKpJhsJuVALYIsSBa1bbqVIFLJGC6L5rMgXxNDral/RipZTbYDtYugkylRCGgLGoDnLc1j9xevjKtZnLZVJeb3WZowRNmpUEdC+SmoIkwDRB5VhXNqj/XtJcz7m1Zaijxxj1CIRPs89qYC8TthtygpThZLBa5UfskXuCfieKSmoMjwysZMWBMA8OM5l/7/7HaWZSq8BBaS0e2JnTqmobOykTle9CAMhtrS1oBHL5bybzLvPRP3cxLhdm8/nCaPaJLOFGwEJA08G1WYt8sgjEG6UKdVVaCisFcSPME0lza3pcrSV6HI0rB2+Xo2IK/BQoXBGXh+jVY2x1sMzJ6Rl8DwHhy1TXHaEudgFLCsJGllcwjI+A9dj47G9pG6YndURilv9C3bx+nijh8pU0KQqvAekIevoeUccMAFLsp5WokOStBlkc94wbBREY3cJ6ODPzRmBK97FFKudWI49wvc1uik1U8lqSSa236HPVir0hNrJMTxjxsBDIMd3XYtUkNfHh2cQ3IkrPg5DxbSy3l4dm1Ger2hxH+wKYAKvxCRtACz37FCQ/5Q+CbvgdV1dCGCOojeU53SUd6i5+YgpQ0640IY5EqvhBlyMX4wAYfbVUyAucmXhp+0QTB9NjOvzUWLgjNsscmqsmN1BqAjnCo2r8U7gZG0gplRPV0UJDlPAd+w8aKJTiln9rhWPTR2DEoQKKRZxQgxqKhGpfNDMxXFewSp4hr5c+GBXdxWEgVSKMe4VzWtzUpF1yFcQV9hBVCsJ570jmhdR1rFZT2f2+2Ic7XxqNUo0wUO2BBftRUcsjQJLa6NCybzpdH0ywj1UikKKExAkzOT9D2BnrCc/NQBe/+faNR9RQS8h3nCtR9YUAylaTC7tgJgkdA/OqugwWSLgITjMf3F3bBjmiBI8YyGGAIYCBUSMFQ15Y9Uy0C6GccfeOADRx+TODJgxeVNlJnAdh0hTQU6OtlCDssdszEw6ZRp8LdHohBvtko4qIO0RrefK1ZKeuA6ht3UQ3LiqFC6bSbGZNCFQl8jSaJ6xaKYmZAgTJS7dxi/Ik46oXnV+onpaHw5aDQR5RW5yb8Cxw3JdgeoQ9pgwoBScHKu73tYvKgThXJBPFQdH8CzkyDnWtSAZH4+Zis1v4A3jkBlmL3zLcPqGCSoMYeKGKylmdbtzRVuHv5yHyXnW84EXQP/k3fufyGmGWWwQCFg2uWhbEt/b23v58uX+/v6rV6860blKF2cboZ790ZxTfQ8uAw4Djj4NlyhCtrCZcV3kdBELVLFejPns/YzdLKseOwmV59wsLtveoadj1NE86P3hPnALOAUwoIo1tXh1qftW6+8P634tH/m/ukN26jM+To/9bQKwetbWBJT3h1vbO7t7L/dfDegozdh40A3xCuk4wBzn5rShjhxY8GU7xeTJIHrjuWuUbXIvGs1WMmMZL+vWSlf54bOwVDdXzKy6Dm3tiJ6Fd3rk8A97bVffdKQLLvpukmVPq1//5+GBHgPop1127ci56qvvZlezBXn8+m94tlQI5ycHVHkUwISJX3VcCIHOdY9Qu9AemaRFZfiUCl2iNJcpo6ItKc91bVkYKrCiRblIgY9ktzU4fXbz5yE/L4X5nLk4xzfj2krpJddT/5xuSIGQAl/dz167x9oLcDn7ze0RNoHL10rCN5q8prNRRnvkp6Mz8tPRCbmpLvXDoiAnYsJFIPG/v7Gv2O9dXnXXQaFFQZh7zf7tQO65lapS9MiYqgk1rEdymL59XPD7JRUSmbFLzSeCWuWhppnIjJHz2i93qygXU6ZZs7pBTTMHWX/EBVULDD0Kk+rlE6swBfYBdXkkZc6o6CKaH/AnMFrQAtQljtlkDhZLPi6aoa0FGlWyB/S86AjwiaWxFaZM2wMQmRm8yGknRlM75vt3ZNm2pFNXlcCXVyEzKsoxdXVIRguLIV+W4oaJTKokGpNV2fWK5eyGooP7sLBc8Pt350SKvCN+K5WzxM7JktsiTQolbxdL49ZQU64sbeIwy7hLimpTMHB8pgy6+ZgDpRvH4zL39SMmEDasFoWRE0WLKU8JU0oqXYXdxaPe0JxncRikVMSoUhs/H3nN6A0jpYjyfsY+oAZerV7x91Q1fhh2blUVkU5Zet1VpuDk/ft37y8/vL14/+H84uT48v27dxdL71GJpYpWFNZ2jsPXBNfAVsK9XwWS81RJS8PkSKpC1hK5H/YMMjpb8Tm2UzzlYYbxpHKn1aXM+iPsitwk1dmtdNHHneGTv/38j1/33+wf/n1pXFqSZMvg8h42vn5upGJoSYqPRQepk3Rad4v/3Z4Panz82V1HBN+DsFasbuQTjXpgY7KyURiy5rC2iKoXuhktiJEy166iCHg+oEYFS6/RioRnuoXdx104cPA/Ea/d9yN6fUBMrd+UN0xhOASdUKuuBozYN8Jdb6Wx2I7RybpoDfkP8KVlEFMJOCCMOJYQZJv4y3uycsOD9cxLlxPZKpoWlXFyJWEckAEK4qIpSVXyzlJfNEhUgS+SqaYsLyJHC5gkMXouDK2dsVMsrJxoeNCklpGsVukLqRbPs7pBgc/oZKVaQqyowWQhBwYBsoSGpXKk6ALN0MmKIKsoy8FFJw3Pd1QX8P7po/qA91QIbJp+YFZXbK827wq3o1p0FdIddFuk2VUptzi6lWzpBJk/1xUhtIR9rEsY8ZEoATjmJMeNr+/hJdGjVbU+ZLK1PHEX2QV1KOsp/wFIzJfexAjVpM4p4IavUrfR/1HLJu+50NYeqTLowerucoURKRZJUXk22plR73lVx9qiHPWHstORDY5DKVTH/e7Kv44mSKXQVjO3ItAU44lzLmq1ZjD52I068pnbcOeI+or1XRN6NNSR6WkyLjBQq1oKIVxhbC/dORWrnjYA8MbV7cBDCT4p0f2cx0JYJebeBEx/lWnotSz6pXLRg9TgSrQ+US56GBZy0p9z0Z9z0f+zc9Hjg+mTE1w95uZ+fa6E9PhKec5Kf85Kf85Kf85Kf85Kf85Kf85Kf85Kf85KXzIrPZbrvo7U9Aii5/z0ryA/nRd2VTGdPJCUzWrZ2IXiN5bxHr/5daMrHxuuH2DiX1VKOuRARyZ4t1IwzFe4MdJulsXEMYN4lqdf4SqSzB+hzH2+TPPauSdfUbp51tIzn3POn3POn3POn3POn3POn3POn3POn3POnwyI55zzJyHA55zz55zz55zz55zz55zze3EWPLQ53qM+Yun1a/h4f7/KZbI5wISe85GiijNNsoWgMzSKeIRKmvmWoC6AGTwV7uc3VCxcn5+4e6FruiHJmp5SKAhXm2fNdY4MCTugoHjBflSa2AGgmcHxoElzpNWMZZ7LOReTAw/N9+QYF9DPubh28y3Ii6sky/OrDdc6yBt8pCC/cJHJua7eP0dw32Fk+ourRMuu9z4IftsH4bS19hYsNTAWOR91DTij6bvz5cN96ik/yZ8op6YB+XOKzepSbJqofs64+eozbppb9u0k4DRW9pyP83T5OE3UPqfnrCg9p4Ho52ydO/BkFbxklu2u6HS/Od7FKR4Fj57S4YoAOv/5cPhxEG3t7q0Opq3dvY+Datf5FFcC1e5w62Og0hljy3jLPwqq8+OTk7PHQbWiK7lmMnOKQ/OCqhr9zWihvXs4vsTHPGdYEUFftw/zNVOC5dtbidcqlynGQc2qbEM/lnmOENtJWmtvAH908JtT2n7DjrjbW7991IJYQlU65YaloTbCCpKlzj6QeBpiqJowE8yEdtmtJd7u7TxiFfbipGKxogWchiL+OE2LzHq+skZGqIGneM76kLn4pPJjwZIIsFWvthFb+hGLPaNxAO7Di7PDX3b2o3761d3Um1M/cmV7yXbyam8wSIYvd4a7j1ginxWrNDEfomE5ZIoWUhlX5e7sBE8aORTEQUH6ffDCw2MkgovYX5x/0usBYy4mTBWKC1f/BFJkbpggdGygWSpizKWF+Qp4Vl7EbquVnKao0EE91mRKIcQsLZWygi9mFGFjdEwQxI7aRtGgXgP0WJmoLuMpgQ9TQ6bGFPpgc3M+nydjrhhbAKPYHOVysmmmilHTV0wzy5s2twbDnc3BcNMoml5zMenPaG6Vmj4ip28n5GKSTM0s74hyS/f2B9vpDnu1tTW0f2Qp3X21t01ptr2XZeNHEIjr35tfwmFYac00dxI+hZudnx2evr1ITv5x8oglOj1x1ety03zK+tYCu/7t9vDEW0rh73fB5olX8Nr9CAhGbFHrbX/89hw+3mPE/rHWi9lOePz2nPxeMjiAVkukQs+Zqg6C/d1VTnXaIuNwFkMEadV43o+1IIXiEszVE2awnTMO6wZ9cZUJDRXzDuD5qw2C9/fCTxKPDh5an9+LriVnfjchFxKnDSnDGgMLaC1ox8GAOu2coZEB9y6EyMM4bSjx1auNxySQ1la8dKp6gwULQsFhEuUfU+HewLgJmk7dXES7/uKKmVKJyMM3WoTKrLUaWxdQupULezYcXqrcTb8BiGfN3Kz11NTRgpwcnVdm2ffYLB3HAl4MHDS2YM6q5eCPfnJB5vatk6NzN3wzgcPupaUxSBrB6E2IS2bwSz1f3D7naZkcGjLjgs/KWc99Gcb1i5qV2sR0Ra7sLFcWOMhgbi2D6ypYoGcVhzAkxGOlcHFysFDZFVFNCqk1H6EDPoPe31b+o5Wd1zmXfA5qN6BUk7TURs58bvh6F9klaU5Xlr2MVeYoxq+HDfF1AzKkGLDSuUhibKHf4oinbztBj6onr8SOCdBGLBAD+Hw0cP1wMIqli30aEr5aMJFpH4wAVTmBK3mUxAP6tbeu+eEg8f+vEwurLhMYx24aGZc3bIBOCmwoH5/GUzB3gblRjsnR28M3J/ZAjJhFln0/v7HSV8Sc1tc1ucLogYrFmCiXXQrXYB6iGHQhLYqDWyIaBM5lQk4DrxLS+NCz5phO/iFXv5dMh8TpK3u9sKggQLQtEId5R+it3xpjlgnCuis+PSTWQOrADfh3LOuGBQMGOnfBm3VpOo05OxsDY6ol3XOdUpWxLCG/MiV90c0ZmEWnztmOPLRC4KjCGk7RkeTcTagrLHx7Ma2K3n4kjwHarJu/GM2YuhzndLI6552PctgiLjXZskmcmcDMtUqXBUsNy6LqqAfk8LBHLo565P1xj7w/7JHD4x45Ou6R43cdxuR/rr0/XuuRtfeHPgDirhJGT7o1dk2YqxG7hah2yT9O6iiUnCg6Q9JDU5uJKBjjtZnC0hbxQFCLpuBVVQZkC7pDg94aDuvFVmXRkTn45It3sQpSoJcPBSgscuVcPddcQMIEyqc1kZWQGdOaTlgSB/JyDfEZDneOgRnvHsNhUAQGzEAYSTzmnTj624eT9/9dw1HgiZ9NVlBOOsR7AtWOB8WCGute5Y0IV2EDtPjGC0bhRkMEIUUfTBlWFLS3oqKpsYrGC0wQ2N6C8isWAjLc2tuI4+2lrr1RMfE4QY9qwnRKC3umqGZkOPCJdZq8+O34+HijEsB/oOk10TnVU6fQ/V5KKG0RRnZDJeSCjnSPpFQpTifMaQ0apdOcR0VYxoxl8QipFDdMuWSw30yP/Kbwrd8E0B9z/sLH3a5hn7948tNzwtPXlPAU6OIzZz7xmvHArfC+dKUWs/gTJejM5/NupD9n4yALfM7GeVw2TkVAn0c9cFrS/ZLF4eFhvS6NV1UvPyVx/LBloctzcnpmBTkGrQOuYsvGVcPE4H+88pY+Rzt8POZpmYMBqdSsR0YspaUO1ucbqjgzC68axZQ6o0ZbldAO5cBKyMmtsVJGBV9UbM4DaqZMgTUALJ8Rcq4qmZVeMxjcW7Ow/XjGbu3bMygJEQ2NcgG+BL8zqjlEMocRb7guac7/YE5csRLuWHY0N1r/51pkNLH6TvVx2FR8vBz8OdQAP1d3KZG37yCAsQbdCg/FenwqgvXeB0NlPYdhK5EC4dWvrYUsVVSCN7L+Q5DYhN8wbR+K/QY9+CKOJUsVi+M7M6HDKGOErekAWBaKCgBvzXe2/hoQjfml8LUWC6bc+l/IAq2u+cIOoaUMN4rT1fBYbCTkUGTQLS2VolJbW2VB7aG62wvh7fhWi3PMoEXfweAbOjukNf/OydFD/p03zNB+bKT2FZedFXr5phKdjvMoIEex30uuWAbFy58gSufk6Dx40eECC/jFtiNGJuSKpTpxD11hqpsHo+J+IBIBzym1wd4Y4LLOc0dCEaX9MmUC9ww2MFVSR5IaFxk0Run3nXHUOS4sQBD2mvPJ1ORdLeGi1cD7UfJFzgw2Y5ko57Gm2b8tqL4oRTplM9rAP6mlxXSQzjAZJIOYcpSSteLeJ+GLpVNcqIi8cC5MHMh3AVaNgMcPmiFrB8EBn3Pun6JgUNQzZ9iA0KLZMwLIRkupvX7meO0EKwbuPTea5eMo/V7g6I/wwa2oeBQgE00+DTcCAnivBW5FyW0+AKoDAmdmegCMKA2tY7HeVFUbWBuaXl9aseJbyAe+wLDmFIo5pyz4fACjlliLHHyD7Dak7IDc01nD4PMIvWHDe7GCgnHhYHOLwxWw/EYoNRNxj3/TG5rkVEySt2Wen0lwTJz4x2O2cuO5nGcr4Yv72Yo70l39AiC6+tbckeSSS6+6YGsBxdMaewhc6NA+SqBSoCuarFu1rBvVu6Fk8hSPbmBXldbwWgZmBXeJK3BSpSpSE7xmoHWJSTVGaF9lJ6oW4cbzQ1GfMmEJD7IYsaUr9oqtqos7IzsqN6GukRvTu8JBD4wr9/Sw6rMfJJVCuACBETNzK/LTuIQ2rRfbxsm44AaLDtqtyqW2azv0O/EwuqF2nB8SfOiixGpwOZkxqkvFZtiWV2R3YDZ6DKLqDb1mgYZjNMfkUeF4xmYSIlKYtsP44bIK0660+Q0PbMywGVj2S8UScs5wz68wJ9XefVe4bG5cbzjgEz76AvKtg1M/HOE4OMFBCoXLjdXZa/f6UtEl6RJ1Sz9afcDRg87gvREuKbdu8QiVOTFKMI6QENFb5BQ6fAAJVFLplAqP15QaNpGgCvjxw+ZahnEFCOnTLLvqkSt3bvpwbhh8NeY566Pkn12hM8m7VGoXBIj8UfyKC27MgcK6muqWmql+QbW2yOxjGFJdzHCgr2Y7MIPKFWEcW83IipdHOKevnY2BXahtg+BKDe5IZRgD/cVZt9zW2IE88GTKmaIqncbh8c29qSRC3O61EZ+QUQkF19YsfNGInOm6hS0S0nPDlON2jSkO3M5ekYW7LILkjs2+ncXLPRbGhBwlbhbOmRZSQ4Fn5Yu4Ubib0W7KlY8Q9TlqNC4wq8uRB6tJ9WF8r9m5ecGeRvNczi2EVt1M6xvl7h23pMgsR42VI2BrggoSYbKtLZZmaqW/qILx3WLv01kXTusdG0AIDtFzrpMGH6PKDUnUEeaipgc+eqvULFwaGdO1HEEnc2pSiqgDQo8oNqEqy+PdB+4PTxMrx5T2D6mIXR6odqBi4UUjb5iCWwaCl73I5IU9Hm8J80GaKOeQ0+P2Nuzs7ezXkY8c6AFekFX2iTp+3WnAQVr94dkm3I9z3wHDNYCgliBVlL2mGMUEakHoBPZEKvsZDCsFL6AhyJ00jf1BU1c98f9AWwlDZwWyDWrir6qiyg7WGv4AWoaWR994JfJr560r5VSQmb2SNTcl6sc9F31o5pKEad1BG7EOLRxZv/+YxnEttRj0lOYpZO8hclkOATYoGMUGKBey4EIvkcQrJhGLLbAt8CogHfckFHnICDeOSzQgmUnBjaxC/aoh1tdBU/Y7Zj/6NuBGkmvGClIW6FKAl+LDVceq1bQR0joe7dWKJy6leS/e2crfG9Vtic2xW4PhXn+w29/avhjsHwx2D7Z3kv3dl7/WDbEZNVSzh0pofno1JJymEaMmahhBNwt4xjEJwIofMmqsbVUIqfx1gwVeaVq7Z3I56TmVMJeTjV48eZzpjDLOomppEp3XVM6iOon2UMRgw6ZDAsQMO/aOSgMuGm/sguGt3FObG1S9EC83k1mZV6SP9a2wvocvrZBJE/XSiYfpuGwKmk5ZEuEibG+plimh31EitfEmF0VpLv2PggrpYuK8/lea+AGq3/A8553PoLMNaGTYSTjHbuqaWY2AWzBMW6ck5FOIdXvm8TOzapPyZdRN5QCshTh28SLPaGB2kXlVwO4pb1XeYmKZKK67rpQK1NZt0rxIkN7sxem/92JVANzeNeA/lCNQFxvN61aYj/Qz1VPyomBqSgttD5829psolWgDHIF07m4yAyXuKfqoInPQTAptlF0+mAzAFmslxybRD7e2d3b3Xu6/GnT9dfjD0fFnM/SdHkPfbqdq3VMhaZ/ujHcHg6wOmcAS4R8rk1yEOwHoInBVqhS/8bGYDNo4KJq70FIjVUvCANnClygCYeCqunBiWbxBl15cyBchtStxnLK6iXMtW6PXpKl4ghlz1SN8kQBM6LH3ddRwjwQBimg679SBT4VTKu3pQqXfqmFalzMrMQhJ7NpA2+kFScHdvd5bNVVSyFxOanWi7FUjr32IANcHNVyR/7e5uOobv91XS93Zu8lwMPx16VIE17zJjL4yPdcHdH2UoovGHXQy2oH6fpSmbRIyVbzYEP9sWu3ZPNfFaBzo+I52vMg3Z1y7n+AjrewmnRq0ixT2Wgvyu7itP82ZMl6QgbNQs441YhDw0qqP1pBRcY1kigWPNUa2AgS17LDogiNTKrIcAg2nbAHes7lVlYWJjqlids1grKy+RDEDEKJkXq2am6p5CfR+hWgsbaA7yZRBWlqIbU/lDA2YhBrwFE7KnKoQdF+pjsoKVx0iT96sjVeTqVYmyOIsUboJhEHDWpqSonOUO/UBFBTkVWWBubqOrKDstlWRYWjUKPJyApJA25JSeeopnAThpWeUhw9BFIT7d6Pnzw2OfNWIRaupgpUrAsyA9vm75Mwa1j3vXwXe31umzm5NMB5YchaGq3D6Pjjyv0dquEOJthI7+IcYSneZTC+rAH97WK1kkoFhFEtlgjoLGcQsq4jeSv8ulgfCgo3i7Mbr0leXuDdXkKNWagalvLAaqLxhSvHMkRKNYhd8uI4HtxdahpJSe1fmnOdZSlWGRGiR3N6uc1aQ4Ssy2D/Y2jsYDtCafnTy48Hg//+X4dbO/3PO0tIiCT8RzJOGbrNM4XfDxD06HLg/KknT8huNfUOw8Lw2sihY5l/A/2qV/nU4SOz/DUmmzV+3kmGylWzpwvx1uLW99V205q4LTZbG6mNf9Z1mtbaPvdLc+q58PGDGBASExwwTL6rItks94sGFVKmqlOdWWAp2nIIpH+4dri1ow4V2Isyadn1om5LTW2lcygRKlT6LOOodSyL/QlazjCKTwgyzxn1rrwhfmCm6VKors4GYnr1vnKEQr2JemWKiBUagH9obSAT4vfxLMToP7p5Cll5NJC/C2vCzS3NDsSAMWoUIoyTo1ggmhqp2Z5WeG2pPBaUfr3E7enQN6xD7hfeBZQs0z+MNXmpbb+IAF7excfDYj6UCeqrQIlzKrrtQwGIHKcFWqK66mbl9uEPSMTWmWlXqsYNHHZ0b3mFLGX5WM40t/gdWkLnqxdc/FYsgKYHuyyFr0QNGMsmQnc/odbU7mgndwRIdWmssxqWZL9Ov9iObCftIua5zhrZrOFUoFfho3vOFdgavtqn7tZxEpt0Zymi1+7wKz/P6oL/KOjr9VXX97skCc4cFpIzzhZ5ZoXBqTJFtgPka6wOWI9dx17edapSLDSO+wCJGvapKTt8tse+vpf5haTU2Mdm4q6ZTbRsVo3pltWTW38PoZD5dxAFwPqCgzaTaVt4Od6wdDfAGPVRSkIAda7UYdQQe/Lw1j20Y9xcIz3JnCN++qvMUN2TgH84P5F5BvF119HDExboKg3bxwb3fKFhP5mxEoFari58XDXiiIe3pzZjg7tpRDELRK80h3A0N8AIbre0zAolEeTXKZXrNMqK5YVcdRHMB4f7AkaALGvOZnXUZ+0ElG6ozR/bCFRCbm4B8eP+a5Fxc+0SC+6vOerpsUp0fBStKQ1ADT+MgiRBMhYziMFJPe0HoqRWsiDTyA9DF7EWtGN6uMynAdQhXbr2/ZXtXfO0e18k7SuPYhDk2/zIYgGFv6e3h+vpSRzLiXVLjOJe0M6juPdfXBEYAZUxxqTjG8jcZoXa8imiZl2BdipL9PmjmXFWwNHAWOccaygL25CZ3wH4ppJotQWB3LmL9LRi++B8sg2EfWFAPI250SsHfGhYxsDQzHAw6jIUzyl1Nb9eRYCFL2Pe6+8bdCMhJIPtYRwDpurfODjF3xj/NLD2JahmINRcJDFIS1iBvGOS15SnLHc/HteA7dwP7fvJ3XOkQqth4FOKhEX7v5gJHj2453Xvgc6TX9VoJ7JamhkiVuciMYNiJvO+x793DVjkMg9ulha0bFnUKfpIuepiwi6FkYYL6+aldmPd5R38JNRGCshBGjGsnRJk5+JR34vhghljH9txJJ86jVxb+4o6CjcJOQGiam5U7EwEo5drEcrejzNiuB6KAlbQ6C5g4GS+sZ8QsmqGK21UuJ4mG3xP/e5LKjF0lnvn6r6vrNTadV9HhWFzITdESVGouWORqvgtkdTRPj883Ep84WXsjiN+OrAk3msi5CDNi6oe936ucjjBuKgsM8bp7uVFMUFhw+xZ5WadpQ5fqwHm/Uw49fg+65VyQW+yYiygCHXRVEMgdnrnuVsFPmXZ0v5JaW5I9EBXjsDscFoR2Mxdq62CuyyK5YjTzMpm7rD2hV96V6JrEA+iJA2sJzrmuafRpygpM4A+T+kw6qMdB7fGXAlS/02M3+dpJqWTBNg9n2jCV0dlalNxPRyPFblDH9Y+fX6xtoMpJfv75YDarmAmnuX+qP9g9GAzWNhpstB1T/pVZqcyUq48MMIRYvLoBqhE3t6bLUR8jDdfgpu8hSWHUXnR3kEqQb0UvInkiT+8RJux+6ygc0fHVDLz5MjJ84aKaTdWdUccnMLb7N3+2QEFnV1oUrCmqlGpVDePWm6qDgLGhXKKXyCQwNy5Ke4RvmDZ84ldXt/AsoVUIrAHqhsacIS76GSvMtDU6XknOw1YZe9B5LOLsDpcdKUDxJEVOU3anfnKHXlId+U/ST2aLDg0Fptjc3Xo5zFg26o93R4P+ztZwv7//cjzo79B0Z//lgG7vj9n92ounhzF3LiyXwfGj/3xPAschVpNuRPtDnZqW9xMSKTQZWbmoHgrpEhLsrxAZ6kPw7dhu4X7/f4Ry267gnRO7IoshHHDwNfgd8jkO/jMV2aZU1WJJLaar5wqvBPP0aIFTnnqvDnlT+dT++ePpm3/5AqC6ymawlyxPmd5I8GWX3OKMfY2If7CSQFI9yxCbjfX44xjFPDiL5qOyAjDS8BMEk/XX1MVAuJCIHHsZ+KE7Dfje0lttpcbgRKiACxYoNDZ3BDdRYxQflWZlHceqYlyI9zBffP2HL11rX2DPN1QtLG2EPoPkZ6YwCBOK/rDbKS01WMmhVIMcu7ulzq0tVwiWIJ8t4o4n1DK/YT1wGUDKfNarOjvaOwra9cQOQXbL0tKwHpnyLGOiB8G++K8U+aLnOGSPzBU3HRbq9X+u+WfXemQNn36wcdpzq6znVlnPrbKeW2U9t8p6bpX13CrruVXW19oqqzP96nESMEjzMA6oNFDLf0mhF6Kekdhq79dF3jQKMX4qGb0Sa53mQDEKErJVu6V2/C3UG4dh3Aai/FsWYI28mtmprpzhgtuzwjS5glVEjleXkIW5dtgPIdim7aM9ornVvN1w3ibi4Y67KjTwVcvNfmp+fQyDu0Ae3QhtMHe1FNIZrYPoRfZVQRkatIdiKUEpzyWwrrgkdlyrIFP8Jgolg3LEzngWGbRaK9ycyhnbpLnHfFipHe4Sh/nUxXYS97EChQrLJt+z2rp5DRizv+sqKSx0JO6MeI5S3IqCqZRqV5y/ZoQGySQPbq24uPSyXAlQs8JORMizwiw9wm4t8P4azBmFvzN5TwBeQDKIZLWilWFgTV5445ShKpn8sdEDzNfuAkwPEjF6Q4TJi7XJH2s9wO8ajrDWEUdROGuuR99kZdLameIze3GBXQUM+z+dHm/ce/TXh4PBsM6gKqvMqiFs9p/p6OnePLCftXnkF+oQ+QXbQH7BXo9fd0NHLlZXhuDUjl35izyfwzuiYmXerNw8wVu7e9v72/UzPOMzdrnCuk1vTt+cYMaQv6NjBQ+tFvV2k4poo0DxG5PRwkRmRYzSj3uScSpoItVkE+NfoNTB5oxlnPbBKxT/ndxOzSz/5+nh28PqohyPecppjj6kf/XcxeuLfCZYK68ja9pKcWiWGrkiumFMTOQPWU7R0n1O+bKkNFsdJb2xhBSjnQsiU6v8BOqinUWz1gd7O4MGCX2iXN8h1gd5nELSDChg9cO/wqr4b5sdUFEkCsXuKnHDZ7ah8uhE1RbKvOjQvN7lXKwsOBpdSXaCdbCtKEhwf/jWfNr+rF+sqB30goU2sJGW12tsZJD6OlSImmSXRaL541SIzbv2/rlt7HPb2LtX+9w29rlt7HPb2Oe2sc9tY5+gbWwUvcr/eGTseoe1yQ5ijzWoJtEJeBdb4lBIgNqMLsiPa7JmP3Z0kRjube/v1ADFa/ryGxHGLlDoAHEM4gcXMwhvawTqrk4HhX0DRewFUmHGFQRlOUg2WtQXIqhCPOFKO8pZAR2scB/ACqeq9JfImfrivGGiQ/l+GUPd7e7gVUJzOJ2G3yBzW1VcwmsXE+Q86SSa10UZvTg/fLuRoJ4FincIOery/dPSTDGtBhrARR402NJRaVzoYVWMr9GL4/jtOYlXTMgLqJ3hUv31Blq/2YzyvHqvjdjvE5ZTbXiapHJpzxzgnmtdMpUgnKu8WjzyXTAmMOAXR2+BbiwQEN4RoTAgt7VaV4UWLH/kZz6ZkkOtS0VFysg5VEwmR4cfh4RSmJV5jCoEwCzkxdEG1thsru/D+ccAHxWbYdkqN/I4nsjt4/HH7OPRXz+c98i7v/r9PBVpj7z78NdGT7oeOXr713v2PBydT9r7XKY0b+VEPfnm+2k8v3m90RKfLHlYTvF3zuYfsxKpJlS4oPUVryaeSpMX7z7hMJ+K9FMXS/PLUvBViZBda6Y5sTPapX/4iLV3NV985PqhWvmlVJcgvq4uQTlcnVAdHTJKcb5wcV70yDmILmctkj6iOR9LJTh91BKFNJegRi6xprssuBet6vXx1kBVIJCqQSnFkjuYdcrbzcq2BluD/uBlf7hHBtsHw92D7Vf/NRgcDAaPXhU2iV7lsjDxbIklDV/1B/uwpOHBzuBga/cjloSd8C6v2eKS5hNL69Nl8pg/hg4P/fjBBOFLV2AUH7btu2btw/b+/HH3QrSotFQ3q+weAuPjgnxh/zy3D6Tup2pZJCAY4y3C5QfNMT1uvI+nhQTBtSl2t4Yfiwl2W0hR5b9+jK564oYIG5gxMGI3ti+EXy6xqr3d3e2XHuvNslIfscpP1MYhGdzq4k4jinZPFzRFHZ2bthi/NXCly5eFWTPFaX6JCecrIlBX8BSnqnLbdVlRa/dtBxVDQsp0uojKBo7j0rywx8WUuuTxXr13PpoEfVKOBJUqhy5dIquChMLQVevmFnZ3d3/84YdXRy+PT374cfBqf/DqeLh1dHT4OK4QAjBXzulO662kahHzIQo04ga/sKpGNfqjKxsJXNFjKIDFBflJktdUTMgRBNOTnI8UVQvsq+LtoxNupuUITKMTmVMx2ZzIzVEuR5sTOUyGO5tapZsYjb9pEQP/JBP5l9fb2y/7r7d3t1v4x0CN/mP5sFPWv4yGqoOK6sForkpPqWJZMsnliOZBmhNsaRdHY5FfQgP9RAXUA/81aKCt5BJn6sEieHeooOcXf61E1B55/ddzKsiPVrnkOpWRitqzakoCCunT7vtXo33WVv5RS/nS6uddB7W2hZ+8sq9A12ws9HFr+Zb1RufFXa1Y9PfKVWwndXJKi+q274c8xKsyPGwuB/wn9/GeFPCfmIwbg6ZUqQVWhsUsO1oFekGAtoU1arkUMlDqef4gdE+YDK/E6XuhiToWmcdiNyydgoBYVTG0kJ2eeWlPKucvVn1dFkXOQ0bJUv1CuVmsKuHtyDPCtgdTCqMYrRcbxLoJTJiOBtZPAs/FXPZdkH3aCqYMs6/rbpjfLi1VVQtZEWLf1jII3WRtgKUyU3KIvQcbAIJ4csm1XBWuj5wEdHr+rrtH+NFhJ0irIkUHTufOHlFBG8kt/ng+AMqEyctCxuE2MWeWYsINNL0UGcmpgQ9t19L/kLVcirUD0n+5newNd/a3Bz2yllOzdkB2dpPdwe6r4T7537pbb4XC3/oHy0t83YtGPBINqOn5dCesFCPHZKKoKHOq4qRgM2ULyzsZcs3IaX4U94uJogW4ctXsoVwYNsMi41xK5XTjXlBv2+U1EbycFNOFxorCIJb2gM/hjVhPB4lKvoK5hAurYMsZsPGIT7dd9yOpjRT9LK3tSyG1ofmqTtX6GQyP7KtZHgT2woNbyw+FCuyNSj5RleFQJ3Tk+/pAsRO7FJhIKvLr6VmsyGBdwapixJxnLF/gheV1H6iEA3+2cfdqZ7CztAVUsYkVNlbIrN7DDPfxqv7fjrpgWhG3cvB0Mqu/lWzE6jTXXefsaa5M3+jtD1crKyayXpBITg/fHkbPdQLuLqLNQzWBK5du/lAyIfXlIVfsgTqx7awjL8eFL+6W5OwaMJUoasbcUdIPntFVAZNGal1c2i9ZWr7K5KxqC/HknLrWDi7kS5qwYKiQOWOusGZcurxWa1iQ18eHZ/acH2IF9Cr3EuHHHa3fQCuLirm70zsuClvOuJovm6FSzee6HmOcA0DJdx3NwBx9/uw/P9AsfIp9vYA8K4qM6m5yM+faPRdsknH9Tbw5G8GZUMEuWCeVN7zZUZjv9vfmeLcHyWUbBEsrMHf1J+QwyzxQ41AECgNM3RCjBXRqUCkNXTTqIOLNTr3F1PWvgaq5mhVUUSOVP/y0fku90IJeY0GxHsHKwFO6fbk73NoIC6wSOqv7LG5L2F40PBxVQSihKlfVBJwSBcGvVp5hAoqtYrgfOQFRoh+0Pjeg54H/ptsurBcxELgvFFjLqiQtBBGS0IN30XfrJS9Mjpb3gvWIYr4nQb7YeIRS97lTHz9/1uOXSXj8MrmOX0maY2Bx0hXC8CzOf763nB7UrmuW03O9Ldw5xN5V2lAR1fQ9OTqHd5PvPSe6sx9Iu/wcTAodBtwx8zJJo9GAVCi6PtSBH9bqwhbryUJTqrI5VaxHbrgyJc3JjKZTLiAEUabXGP1gKBeg0tgD/n/LEVOCQQE2mbFHtcK/M33oSYS+d40GE7X52jlD+3uXe/UY5rQok1LTyTJXMBRHzy7vLrl+xpRVBSGhCXh66GgYVTF3ftKqhrh9Gkqh1zQWbpC7u0LSWIc9bted0hzajlEr/VgM1Yq0R1LTAXyg0FsYbjNLa727G6LSG6Zcu61aAVn3uu6F0toDgHQYlCaNMF3GMC2rOmVcXyeK0SyJs2k/1uVupKkc1D5Dl7yY0HLCNrABd6151Qs6mSg2qbU5ALzTPAfQ9IYrkBJqKLiONqnMc5bGSajLLRXr/61+rXYew8SXXO7n0ytQA5DjiD16bu6Vi7s0i6oYCZ6MND4SdpD1dX2XzhFGlIq8ZeaH03fnNW0EZnrNRXnbMXYFdDRTGBG0Hd+iqKPGybu3F+/O3y27FRMmk6/IjA7gfCum9PpivlJzOgL51ZnUY7C+ErO6BemrN61bIJ/N61+ned3uzbOJ/clN7BatX6OZPYLr6zC1W4C+fXN7XdlfEebXf3Zjx1Ja3OvZOAWvyu3Trgn5lJErD9kV2PfsWVHMlEpobx8GGdVp4Q+Yrp9mPc5ujbJxXH/yUAc8+jbJNJ/ThSYlvNKDxgmu71JwP8wYFVxMoA2YcN2cxA1XEkodxd0uQy8+jHRXGPvttM2rEaMG7rOrJhaKB7AQHqitE2wgvGgmSwbbI01XRSzkzeFRPG3AAHTGkli1yNeVAkb5/scj8nKws2XRrsvJhGnDsgNyQtMpkalhhrxwVTB7ZL8/4lEX2YVhG1hM2Em2zsowl+SfISr6X2TKbmnGUj6jOdaQ1WTCb7wtHPa0UmRc/06YmGpsmAjFnzPsrs1UQs5RpYQ+tPZBdFc5W7krfB5GnC6KKeu4PNf/uTYY9AeD/u4J/Lvd39pe65HWlzu+CP7dfpOnb/fePucQX+XSd+GER6c7OtUfBL/17emc3AKK9+8lzaFMVBgz0hPBukdRAnKm+8peVGqLcuwtJzKmiN3KDFpFW1W3vn1G2ucbh8i14UjYxFLlk5ge7jI6gEtIlmDRpHkeOoBAf/8xTSM5zS0PWNGTmRwaSy1oes2abRA+YbFuvK9uuVysbmsVSxmE+vlFfyVrXfXehnV/ofVKnYzpjOerCgd/d05wfPLCy2yKZdDCK2MjTkWPjBVjI531yBwNZO1CFPhkC+4yf8L2Vl+sMEjLx4C8ul6lLVSLclambiMYTS2+38h/05vWLkc9rVewy8014GwBbFDxFJ27pp4tyHeSnWTQHw63+s7T3IT+aa0QX9texxUUHcru2tx/NDHjoz4+1876+dx5TpkwUvdIOSqFKe87w1TNeesMr7DezfoHjRzyys3jWwBCywPXbg+faPaRt9xXVsJqpYCOlKQZqFlMQcVU4G28UQLJPw7t3/Jczu3ITqmpF0ElL3zMCNs4IDkX5W3P6g2AUcFvqzzGeauSuWuX+u7cakTr64qRjKHPDgw0Tr1ysRY5R58cq3e0sE+MKjIObuSEnOWMaij3SEoNhhp7/8iCWU3MyuuQlolTnRydQ1vhQslCakZ41LLQ9xRvS+awzEdcU6uty9ei82VZ13CQDHeSYQ3aNlU/UbfORQG01dAbfpSKHOWyzIInxzuZMIsC3Pio/mO1oJxfM3JltpIZy3g5u4LeuzezitrabqTgs++BQa/ya/k6e3H2RqWwhxG7FPdGs8piyQq6dwla5yyVItOVkBR6EWJkWn3btrd269NbBehLxShC6atVhijC6qC004oW98GuoFY7KmkDYCW2J07W/GJXuV3wuga920tsY0JvKM/pqKOe7GE+YsqQEy60YY17EHCDAZTfbpBstMivOl42gvNzh842gFhlnVaHKeA7EKQJDhTlQi9jXj4GoxEyKEGokGIx43/EgR2AwvDxA3bS42NyBavg2ZWlFPzgzdRo4EqlGONeNZv3iczeXUJGVkhXNb6DqFZiw26TktstmLINxNOZC78YRzufSuWrlUIbxCpspFp0rW66ZWltVCiZr6ysYmjnCgQJM3nvMHiBHLxRakLdFnzNR1TQS5rNuFjrkTXFCqms2HdpB3ywEWpwBhlTS774+eLiDD7fHfn5ow9pD3mx9qXQwj8hQV0pVe5VFc0gMwM6i3nc2e1QuV+pYr+XTD8iDcO/MJLZ4mMseXhKD2pNKepkFJeGbYBJYNbmvuzvv7wbRNcE4RuQGC6cmR43/l6M/MzyXJK5VK6dYwszK9i3C4ld/+7ZvRcWWODOU0atmtFW84c7292bubqem4fOedi89xlNp3Vc1y65XE60DzUNe5nmHFqF2zVqqPEI1cuhYjgFy2rT28azKocCVSSM7YAm0dpQkVGVIRiItMp5ffWP/nuErH96XDXTs7flP/pHDlAuhf21o2Dy1jbb2d172Wf7r0b94Va23ac7u3v9na29veHO8OXOI6Jj/SbNmJnKlW1UbS9wqgiZZ4pbYU1CoPsw2UsGrjmOt6BMSp5B4dU5DZ3Ws4NqgLWq1zHGHc9Ke75YHB1tZMjSwciU30umFlavX6t5qeW4AgPtJmF2CAcqFEvRCclSWjrO7UunY+f/RnwzrtfTius3jBLVjOYLkjHjTPeEvKsN5JsrziwtxSG1XACQW8kgGbTI46eTix45e3du//1g/5HnF917vuLeR+tvuKtwHKxolos076/oUIXAcdjAjq6rVKPDxBsmwD/Wvmh6Ed82/vmrI3yhfwEmQTyTCTmSs4Iqb26fxSDTMGjUq5/Es62vaxIP60b19pcpywu3226XYRrFqNEkZJMRMuMaROIJdMtzrKh98PmMTtjmhC9d1d/jWLExU2plZUreu+GriK/4wLduCl/+a5TLSVyadLMBuy6k0Oyzyys47bICSwzktyux3IeTu0UWj5vPLbM4aD9OaHFAf2nm6MB4Ou4YbeETskc3agd/xF8+hkHWuGEY1QllT8IVHXKxR3dHqOey5Imega7trZ8b18m8M+JzZ1BPHFuttwPgusvTOARvRoiN84EIsb57Wvvy/oIDYYC46IAvyKpYKpUVmCHaAhsQ4J/1eUnNPgR9RVB1d3FggsgRkobL5R5zxeY0z3tEyRI6luWS2sORWyFObYRRq2NyG45JGGtKRQYuNRoCM1IpRBDUTt3rKO+5MSnRXEzyaJgKBQicH0szoaWC0A+iCyqIXdEGnukYDh+N0oGKjhzQ5W0BNOd0VZaaQCI4CwZ+VDtW2WF7HZHxfvcqUdeS5sz3Y8QUfkAlh6rTPSJL4/5QJJv9AearFMyKHgxBZ13+O/fislxjZWpsha/T4yayauRdYev87Zuz1jkh5PS444ZbWhVcodH7NN4LdjdFtHtHmukD8FfVqiYxn3rtPt6TkH3cypUG46G9sXI5mcBNxNIpFVzPnF0UvgSTgIU+KmgHRoUqP9syumq3HszRbk3nxvW8MrUKA+T5bFoB288fGTzrfhq90LmchIlGLLq6oPgEubLg4mPJ91e1hfi3qhau0jlwIWPcdcmvr9CKEXYRLIvH//7KCxqj0hBFnbeYXCHM34N7gAvnRrYKLaLvEVng0GHqaftENXpeNTtpWsRC9yHQcxAnqSXqsZKzRvBWOJj3tdoky7TYrI54ZOYmc6rF+rrBtGNMsw3w9UgmYV889UWpOG0ZavOGqs1cTjbHpYCGZDrxB2oJzhE32XvSsIdgDrGrCklgfhvqVSUDbhyFxg4BbzTSDkFuKAUaU2kVCXbDFGRxmUa9e7iNhSuwMpFQ9QDJGwbBCAo4H27eTDLcFTxAC/t2JXAvZAmWoKI08akKZ9pyHw8MgWbMKDic45H2P23ECftyxvxOIuu5mlMlrnrkiill/8Phn0p2oHmHVZEp5SwSEUudNG0GT5biE2e94ETuRrd3nustjbKWr9Ff6hKYTXyw4lHSnGoftc4FN9xb/sIMICM4zYOStNRGzroDKqWa+GZX2KYxGUlptFG0SH7wf9WQhSZAaCSa5LyZGdXJkFy+w10YsqNE8cMmbg5NufAqmSM7CA7FxTtrZGwwbByZxmp3tu5cyiqTQJtk8FSrC993VUby4XGh+FlKC+wNHDF3zIcAj0Fq8L1qsu5X7LjAFsKV1HHGAukk/6Y3tBPppUjbmcFP2gikhnI3nT0Yzk7dxPIDtMN9ger6QuhK7gPPCmo2dwvbjGlI9oL4AZ8o57Nj4ifCNmJ3HKKLnBssu2NIWVjmDka3ImekoMrU4i4xQ0dRdCiB0cQN6922iLw4l4cKu3vQLiKDESt1sSJcN0ovptPaMvxie60FJS55KIwJPW1pbmWCBdH2bsAO8qlToCjWR8ZQQCZSCdKKVESwOfAcK5zP5A2rk3zOqLAIaoDcNFDVzhi0SWEZ7Eom00sXCWuvqIxrOspZRrS0mE8pXJkjBm6ZOI1p5KOjwfLlmLdiRnEW6kNfXSKb6Dhx56wgw1dksH+wtXcwHGDuNsQIvlmQSsRpNXQJhafg3l3iNEooeX7XmXPX94wZCpnnsXDiks0joQ7FgRk3MZO74dQNE2J0NWPk/Y9HmuzubO3YLdwe7u0kHfAnY5rynJtFsgpb13q0QtdfhfgJW/JaM1ourO8wTaVCyVlGq7K0Y5d1R10hKvw1WhUPCkPad7e220SxtX0vjlZ450WYsqJnH022SyOrsQ4g5pddaykUl2q5Vg+P2+rGNvt52gT9kVvMqiG5Jvvk+wo5/xWk36TOc0K7IPu+Qr7ObguWunCbwIod9TSqTL0adrjYt3e70BoAePwxevDEBKl/6RNT0wWdoARtoKBheMQwYvWnqqfZnLjiNIClpjX19Ph8oxdrOlZVaQHvTuZEWsQ7Rd//eJXcC7pVnODa8IqTBVYbLlIT6WdWgbK3gCxQk8kruFNZoDGpoSx1gtLa8k6eEDZ81XLwlyaGMGE933cpIgAD+h0UECnKX3DzIyha+37i9N5GHmhsTHwbffVAEduQxVkr84iehtmsFE4MQ5OSvGHKiYy0qilJUBjDceIyjbpmp/P5qh9TFNKP7mMQ3bDNUi9Wdr2p8jWWcixUmvuqjsshajATfsMEdhmJZ3W2nUJJI1OZO/OBV/rViBtFFY8Ih2pXfsAFL4iJRtl4Bh34mbrhKdQmLA2Wr7GTLVABqB7W14siMvPw9PeevbnYSMrrHjFzK8spB8y8lgjGBdHclE46n4PNB9MBRRaFiEBXdIClapFib6EsBJVhq5SgM29mTBtyeoZt0nUPXEy6F4edzLlioadMdKd+QjAV9HfD6nNpGdw2YWyNDjSydurdOpY5nRydr7UPJuWzGml1hBG0tMrHhBCsYwwBxg6AxA1RLLAjI2nPDSQ3NCL/TsfkChGMcQ1XIERcWWRbfZlLEb5Xrs5Rj1z5w+p+QlGFVzuhy1nHjbS3X0OA4yBmcbnKkEpICpDjYOgXUKDLL46cnrnazEhNVJM5y3PH5MJ6/PGrKl7V+V/UeZMYKfM+nQipjb35fOCkkT6sszqr47yeCfmaUSXIzAp81HS1FbQEkvPJ1GwG5PV5BvWrO4S+g+m7/9Jvd37+rzc/7b7578396an6x9nv6c6vf/tj8NfaVgTSWIGVY+3YD+5vf8+ujaLjMU+T38R734SRZaTSqg9+E+S3gJzfyPfevf6bIOR751/Hv7kYyVJk+EGWJvoEfkVBc/fSrf8Uj0y+J6UA4v5N/CZ+mTJBZrQo7GGGG0N7d4S91ZyWM5OCG6l8dUR2a3rxkB1+ioqlQfVKTaAYnsXKDWfzniunHqwDmvy25he8Fg8tFfltza1+LbkXXo9qqUjBFJ8xw1QL/nhsv5T74a8B3tzWMFENH52Lw21a65Hf1sKmwaewaWtutX7bIkQkv4nKIlp7xdlr7H0HswaICExBFWeuYjPXaDmNIYX2ulgmryHleE3LzCVsoQa5woVehEkSNNTay7U2LIJZrSRMXpvRHYqOuXwZqXhQP5o34EVAXFSpr1GiaxSza789PT/TRKp4yL+fvQ1Xc0jDTdbahlLAZY2NjKWaU5Wx7PJTClGdnvnMS/QcRnbz6CdnNi2UvG3H8A1fbSXDZJjUHQGcCrraBndQxe3MXxZvUZF/4Rn5fD5PLAyJVJNNlNOsyKA3/fXSR+DaXyS3UzPLNyqd49xdKyC+5K5foH9Lu82nOZ8Id6GBAPyWmR9zOcekAPjLZfGEcSGXAEV4HwzetaZ2N+k6ooVYCsV3Gxnfhuo1gqk4DIFmmbuBXT6+pXwvjtzkVLiHY2NvdbYgikswNbN09vfXh2+Rwn7vc9H/Hb8wFIMXuCauSlhCDnMrHkaZggiP93jbaROOdmH427nGAfYIpkaUgZUlKtnVwqGZyFxIBvAA2LRgv98fbCXD3wkTKS10mTsJ22oMjTishrr7K2PXPfILV0xPqbpONgLCHwoRsgtI3OpWdGIA5+1AoVrQWOt0Lx0DFK1ghRaPd059x8XcFRJ053IeGbi16mReVESxRgb2coHMPac6VCVn/aFrLucnyDD4hY95DezO+lP3KTxdyo0vOvUx6o17t0PBqX7pUHH8j5Uu7JSdbiVnqx796lnyCuTq9dcvPZus9BPkPOw2Ae2hR3Jg1/+mqdXaQ6BVsCZ8fVpySEgNeQEe6lWg8Nyd1VAsrZIQ0EICVQ5oFkmv/xfniY9hKANZYTinC3vzl1nRIyYteoQXN3t9ns6KHmEmTTa+PsybtIH4FdV+caHG785PyRuZsRwVjHlco8WT9WuLxcTibgcxGFmkCs3SHin4DBD69aHTAl3D55/5Hv0WbtAQ0OFGgaedRfxd/N19TY+i+OVm5yOw9NNQ+LBnqaVEO79UHYbkjIGK5YNiMV+k58fH2C4MlH1wxH5djHcmAHvPYT1FXe9VHeohhaAx3+sIB4XsUKiW4ZYKmmcoQtRKZjGSqFIsjwCi5djY6RJf6LjZe8l7aHSPzNkIlDxQ2bkwqoRqViHLdLNQsF4Y1xeS9fJwZeP4zp9gKyC7YWOQohkhoiGXGhSA1tAWq4dnb0L+zncV2wn0GfkwKKa83uHCcPeGzx/gY0JFSGcCrOM6daAL7cOmkTZ0Jfzfg29YhRsVI6MUTxPyxkUZ/V6yEgcmJxevoXWXFEBC3txZKAkVjCv7UhgmdPpTDI0uEsIerWTm8aFdgu8j/C4sThP5OBXSn2lX3JZMJepsVcoJeDqivApU1y0aoA5SYPuW++HGQ232eAgIJbKq/Hjh8328VZOQc8yeoWpWM7dV14nzdDT1t0YejfeEQTaN1cqb2TQkqvEXFwR0gCzL5F0R4ICQ5Dmr5tHKWQuH33yaTWvFf868m9aC/sziWryEP7nU1lpUu03H05k/HBf2nTq8SyLY4+5Z3V08GDKQKncjVQyiJet3heuFe+o8GD1y4sz61R10/ObXHvn5fY+8ZhP7hFUimwg9K0c5Ty9xGGaWRexzs7PnZmfPzc6em509Nzt7bnb23OzsudnZc7Oz5UqPNHqd1eXcygP5hJYMr++v3JQRDAt/VluG73rzbMz4lBIhLSR+89aM9pL/7OYMv6I/sz2jtoZvxqDhV/UZLRpcpHIWRxh9nEWjKppCcdTGbeG4VcuaAVaMMOgD1ozjN78ujcmPizasogmranvdt/iKOmDWml+2IQiYem6G2aCNJ2yG+XR68VFVgOPevfSJAvAgbI/LgIlTgMKbtYQfX18wCuitxIZxFSoYvJnBw0gxcZ7lVdU6zOaXakIF/6OpEp6OiZBxTREIqmYsY1ncfsnBlbOxIWxWmA5FbngJMbrnP9U24rldn/vha2vh9tyu77ld33O7vud2fe5/z+36/kTt+golszJ9woLdLeOem+EOIacBot5yjRtCFQKmOM1Xm4LjjWVuMmcKq4vzK2trOK2XwK5UqSlDrwVE34EGZuX3uoivUMay9My8e8Wn9lQjLQqmk64ieT75Sl1Vp/fKC4JQMS/T8J8C/gNCGfwh85xBXT202Nm/qgC3jgoDNYNVVeY5Su9+SqT+HQZejuDOFzMqTMPk3Xl+nwS0QGrR3VmVDavEanjXR5o2v3+gAEM8jo8qZELxdIoEhTw3bjkWqiKkclZQ4QVsqzGAs6tGjA0HUVyRQYc611brgFoVVCkqJuDtGfPcMOeKg65OXp+A0lPAswU86HWSAEa1nsdURv0CrfbqmhFZmRb55aTCmLa8ZF/dfDWyDdfUOVxTD5DuBQoIjn58waJuMm0KQctX/f5TKpDP2mMDR3drj39i1fFb4RBPrDf+iZXGZ43xWWNcKlXqa1cX44RcX0HW3fJn0Vf3Xu6VbHj33Q6yoDY0x7KomPHjZ/XwnZqqMCzw0WYDXRzKv1aFkCAjii4Yzf+IR4VgpTC0AwTHdMk31VjYcFVFkVFk2fJgKp1yw1JTqlUxB7cntalau3u7v3e5V083HJU8zy5XS43rh+7MdO4asCELRbVNY1eCwZFFdZw9VYRvogYQoRKF5WbckPOfDzHUUGDmG4NyNn6IjrJT453xS7b/Ksv2hqPBq/390XCLscFgMHq1/2pvb3/v5cvhIM2WPeDplKXXulzVHXbkhm8hy68Q9JMbpkIN5HYxjv3R9tarjL7af7XNtncGr16lL7N9mu2mo1fpq526TSaafEUrOq6HiELVljoXCJC/K5gI1R6VnCg6A2NJTsWktGs30pGUhiiZTcVyTkc522TjMU95leZGqiTDuh6J6LzUqVzZfX4qMtgaMSFTOY8XDNWQw466sP9SM9WHuNQemeRyRPMWXvDrroWwZfTijJpO8e7CMj6oPNIJXx1zOU+Z0CuTgV7j8K7hCpagaWLOH/Z6l3ZCrZDgOn47nKIkgSPGqr2SM3J+dvwP4qd7zbXBKoWRbKE1H+WsKtyji+wWiva4IfXmRpvPHBY0nbIw8FYyWKFG0HlFRFNUlCPrAvjqesucUTON6j36feMtgor7tJRabQLpbx6xPKdqcyI3h8lwK3nV7J4JhV3TVaHwZzmzIKNtK0xGPrx/HTzoXoIBOZXrSiThVQH8u2tbh2J+0vIyS0zL3jdWsFli1Y+qe+0pptZwsn2PbG1tDz+bEnThDOdtWQAiIJwe4OXNmMSwf9GiYD3flclMaf2RGRW06k1CXJ0Un31+QFQx65GsuJ70yEixeY8I+8WEzXpElPD1v6lqn3lVzL4OvcBvaH2WuBPiVvIqFv7rcv8J+Rn6WH6M5P8L6nvkTCpjSZ+c3LK0xD9fnJ1shC4BX5VYfXT2oTYNMVRNmAnGX2h70hKz93aWlhJrxveVRI5CX22cpuYewXZZvq84oQae4jmDTlhtQw3UBZZjQ46kKqSq16h4YJmrlx7DUrO2GPnIlZ7ROB3rgZXZsVesPoWlNfSjRy5rL9lOXu0NBsnw5c5wd9n18VkxpXpljeaqwrugxMygvi5Wzj07cU2JDoWHgvT70DwPHiMRXMT+4oLMfKWUMRcTpgrFhSEjLqCaJ5SlIHRsmIJWrBZdqItK5RrypTJj/bi1G3FlxLzaqrHXjEzTUikrnaMQipWJ0il4vqA2r1E0qL0APVrMHizkO5/PkzFXjC2wP/gol5NNbJ/eVwwbc21uDYY7m4PhplE0veZi0p/R3ModfURO307IxSSZmlnevpAG6d7+YDvdYa+2tob2jyylu6/2tinNtveybOmewr5BzyUcg1XHwFtEfgoHOz87PH17kZz842TZ9a02UiIsqitc4pGLWwv8+bfbwxN/28LfTafc2v2rj9ae+pQkLwBEX93vkF7K8uen6HYn2+McXMrQlAzqDLtyMvX+yFC23w9HeLYZkWLUITI0jwLP45WfvuDZFZFjwwTRhi60tzHjVIQbzfIxoSLsrl1VwZHN2AdR7/bVj8GNheBWduLl5JnJqlKP1g+VogtX/RWQRNUESpfpnl20MsHObhdER1rmpWG+B2jFCqeMsCC4RazsDYW27OjvR8wUSlqpCTK8uOE3tTyyzmBt0PNGXGxqPV3rkbV+bv8tNVP2v8NBYv9vuNeM1rZ4u4QM0ccpQA3LAhMTE64iTxt2bAhoWHT3/KouHR9w7avEuWLadsX206hMr5khVNB8obkmUpCpnIchZ1Y8C3tC5lY/DoffSNyj6MiQN3BrhBdmiP+oIxp35iUUGHSpC55yWerQ/qK9BY8QWzN2qflEULAzs1uuH6zZOZIyZ1R04f4H/CluMsjH0FfczRCX2W3RjVElW/9IyO1fXExW2FoAWsJXpiUvndiJa4TWDv33j/p+AHgyo3pdMyrKMQW9BBMiKusDxlnFHaNY1aROsZzduILih0WRM/L9u3PItWyTRCpniZ2TJbdFmkA02Mei2lBTro6/PeRiSJkyaAtnBEHpRrk/xr47daoWhZETRYspT7FdrK4YZTzqDc15FlctgK7VpTZ+Pivv3TBSiqrMm+uB51+tXvF1Oqrxw7BzqkkpwL/AOpoan7x//+795Ye3F+8/nF+cHF++f/fu4mO3rIRk41UlpZ/j8DWxBwJAIBlIPakG2liZYXS24kNvp3jKkw/jgU8HIh3BtVX5S1GCTKqDXt0DjzvwJ3/7+R+/7r/ZP/z7x6LW0u9S/oZ7boT1cyMV065cbnWGOs6F1bl4o0wFz1DgrV6/6z1/cVrhGhir1eioyOodsWsxBpCUXivWOFpAOxffe8HeryxfoAsQrcLIANoyz6fcXcA0PhHN3TcvhH7yCTc0r9/B6E+06siEcqFNTS4EJXuBrUFqDYk72R6t7cUDPO2xeJrNqMgul2xI/WWiqzoa7ju4sQU2kBJIfa4ZsWMXzeA5L6qHueK2/ZWojkRN87ySGZvN1FvC5CcI87EkT/rQEEmRIMAvu5EYibxCPn13VG8Xc2aNmgvIVJBlYwM/XuVHY8gz+KixRriOY/ErGWFM5pDbWYuiAvcYlDvygGD4IByeDx9Oj3tWl59J4VVy8tOH02Pdi0UPGvW0mtnjZ5eaL8KlgiXMQg1XuE/aqz6SQhtVpsBOqdN084UbLsYcJKtaEpaCFMoywRQc8TNu+CSWX85Oj4lipWa1NlrRbUc9NtOgrULPQMNnlo6hX08zYJz4aiMWe1KbDmabbqU7u7vZq/GrV9svd5cO5KjO0FfLS5aP1DxsKPYxrdcU+3vOcwM7vKv63uP7wtqBUPqra+BVnS5sm8asOh3VK+5sThB1Sh5ZpdFdaiF1pprMn3fsOImdUGLLl/0fcOEOV/5w++WyRGSPYjLLdlfEyN4c7+IU7Un1lA5XNOv5z4fDe6bd2t1b3cRbu3v3TL073Frd1LvDrTun1hljxaqmPj8+OTmLpl6C7r6RAPN1f81hakBNbrG3giapC37DaDCnUiqi+YznXS75Jh8rqLLM5NkE+zgT7DI+lAqzz0baz2mkdYj/89pquxfwbLJdncn2Dow/W26/esvtHTv37Rhwuxf4bMd9OjvuHRh+NueuyJzbje9nq+5D6Ho27n4Txl23n8823mcb7xe38Xpa/PpNvaux5j4GRc/23uWx9VnNvo8E6/MZhh8P2Gc0HT8euM9oXH4scF+b+dkB91VboT+ToXl5bBUs+QYym6rF/IfkOFUL/naznao1fut5T9VKnzOgnjOglqGTbz4XKqz0PzErqo2HyVImi0fl259WmrZbLyQMRT5jZ0P1Ot6I2fGtZv1YkaxoQt9yCDyuUkBIvWpXwNva2XoscC3onqKWgR3aY26dFN2gDh8JKuiKS8B6Z20V3wot3lZnGWy7T7cGw73+YLe/tX0x2D8Y7B5s7yT7u9u/PtaICrw0W67Pz6OwfAEDk9PjpyADB+UKWakDt7PgJM7eX7r7kAeamz+L+SgoOwBzw7BiaRG+76FtEbWf0CSE6kCtWCTjiIrQ+jHjYyiNYg7CkFErEkLJSMm5huLbBlgwNw4Ib8SasxHWWQERQ5gcS/VFXoRl96MsLOSPo/O63stSKbI6351Sy3uZIGXRLqG3vfVYKXMulZVgLjOuWGqkekJdaZX0Y8nEgU4C6M3YnCZ6NqdyxjZpzlO2NJa+DYX4P0cT/qZV4P8A3fdZ6SXPSu/9BPLNa7v/8Wru16jfBuA+v/Yapv7Sumko+PcVaZ5BovyCemUDhq9BawwgfdU64UekYfz5FEaPny+nDnoI/jzK3vKE8QSaYFXCdcK1cVhxdafex9/dXXjqRywchYWiQBj0RSf/P/bev6mRHFkA/H8/hYKNuIY9U9j8pi/mXtBA73BL02ybnnlvtzdArpJtLWWppqQCPHcXcV/jvt59kgtlSiqVqwxlGnczvXS8nYftKikzlUplpvKHG8A1RpBCtS8zCUUpofTpstThj1aZwgKr5C7nWjNb1mpAFdvdJkzEMoGK/X5x3svcI5jXESwL1/eZ/sXooCf3ECf4iY3+XrB8ar/rVGNjoXSVypDHZRnmlknumv9ep9mV+e468pHOMrMa76DQTm8pxxww7VTvW5bTAU+5ngIsZeBOGUZqdv6nk79evTs9P/z0P4g5S5waXVNq//H3d8XhUffwl7+/uzw8PDyEz/jvp7bKDiwxnj6PpcY8recvRs9iEW2zvNCaAeazrdrKZb3whMDeyJDX1PgmrItdI8cAEbCF4mIUhM3Z5z2TwJRk1RC5/48OEPvkvy8Oz4+v+v9YQ34IQ6o8DFyXlpcUzDWRwCnZbwUTMTaothMCA5vRP3w+uzyFuWBsN1yahs06bmkORdpJCkleOKwoJiznMeBacrQZ8/jXj5+OkaFP/nr1d/OpAnrAfQFz+fwP163aN7ZGg3CVRSNyvdJbuW4IAHvzz5Wjt19yTb/kLLnSOvsy4OLLZEqzLGL3bIGkOGC4epnk58nK0FQkNE+q640HqpUiLpxbzWKILNEWizG/XQYCh4NBzm6x3RxYRc4FZ+arHSM//+3sQ1uAb9h0CfD+zG/ZOtb7u7WxmHJoRqqfef2P7y9/Pfx08qW02JwIP7/8coS6yy/o8/lyOjEKzXvuiyUbBv0Ik6ovd1wYQA3ftTbpalXdnwV9CG83Y4fR62apOmY42KFhA/jKwn35aoL4bd5AmC/HbFCMyoLej1ffDuB8ThKdB7Y9zOHO+BqDtIO4VJZAqlV1pfKrB2t0+uxYxbQ5wifMZhYNaWwOaKoZyfitxKjwXBYiIZRknMUGFQcfFOy2HyDRAB6AQyDM5rNOOmWUZMjSEVOSpdQ8if0gT476Nr6XXIYg2KHR/QUNUVEWTDrYT7I8neQQMiBgCtubCs9GngdKTWlf2txKQa4tFaNrj8mhEZBxzrSP5jcUOr0gNElycFM4/5/zPkI7irFUuuP7jnZcakDJEdqGMndInHImdIe4R80uEUwbJTpyLVqTK55F5HSITTWzjNkkj9MLJ7e1LKHn2XUHa6ViUXthiQYUo2TEb5kwKOic33KaptMOEZJMKKhmYWsLrmEyCl7OwbTMlQ6mets72Iy60WbU27leoMLpEn3Kh2mKZwRVY6aQDaQwBMkdY1nNCpNrHPtDE7FSihQKzUvI8CvpZ0f1NWm5IIrrwnqGsZ3FVBZvcsMKqsgZZHyU9pYFjNB0JHOuxxPDT6uYmMZyNpTwhmEoIzLh0PMArLWO7YCkiCXS14xvZlKl39x8FWSINBPeNlDw5AifxyODkfd/Pz5XHZLICeXYZtTsMZnfKF12HlUdyHpJOVVlI4rHa5i3CYn2D9WwtnL79KIRuap3QS2tYbXjb0i2wkWYB83jx0ZhF8OdGe7zAweGecaVZqZ5ed/iEgzB0WNTejDTSEzLftS+5zMdGTvIAGDTK13OE6Epy3XAWUJicwhArDSQXDMOM0WQf2VHw+sYp+6jZRQAbpntrRO1DqhkwhVcsxm9OJep7wipOu5RAxgw++lxf+P0ol/+4BpdG35lAzdkkPQfPFDkqc28Ux3CRAJWNUmYZjFmvQujtpuTSjGyenL8ac128PN5X0zHi5SULvR4tt/2s7HkOTRSCvsfw/bMFCsSKaa+NxkCATsX/jICU5I4Z1QHzd38WjnO8pwBwrrC36FF1tc0Xz+TebKA+WXbZS7rJv6w7MeJHIA6nx0KEbQphLbZNh47jgSeJuboKZnDpSI2k+JQazbJjM10GiheZ4zetDZKl35pfwmGd+2+HpbdLrejQzOS71IZ35Cc/VYwpUHBy4pBymNyfN7HBMKfLy8v+mSDXJ71Ia9VxjJt3ZVzaVmoh4jj6TGKKa5ccuUd12Nbbh56zaHkRDEZqJKl28WJx0bGWYhhet3WwY7LbeoWWkfpnP5u8yWDJw2m9BlLhibsgR5btgOb67zWAv2l3iWxys0v4AkePJeg325fnH08+tvV8Xn/ymyCq8uzflvclt0y7c2nSps0LX0H3bkFX8K19qvbeBr4Xw0ZzfBGQccz1fpFscrGmzeKJDIuyrTu6mwRNh+m+s2bkp+E1CUXdYxNEAdXVpSkXNwAPhjK4frSwi0UkmDgTI3ykLPdy0DZqTsYXSwIE9Edv+EZSziFjoLm08aTltdoWmxZQQznM5yrmO6QTKY8nnZQM0GNAO+33alrrCfY2Qud/ZgPPGGTAcvrfjXr87y6sCL/6j1qWW3pVBQvRPaDO0bmPjLC0wiOBFWeCWgLBYcBZ6rVcVAVmPVjodft4v/a0m65oXCXZfd4skFydsvVrOowYAZr4B1wdtjybXXUokdw8rEVQOHQROqX3zxgJB3a58wiJ2zIBd7i4AUN+J/Mb4JQbzzEUgi7PEOvqKPJQ3I2ojl4UxUD80R1gudx/Qcc71tRng5TeQfXbHlSWkzvZU4ujy7sqNg0XXkwEbaY8dsyKocLrjlNSf9/zqE1ItOras3+aAc1A5aw4F0N8qJXumZnsgIyndbo8adSCji6QPAdtYODY9HaQYTGusDyFLbfs2b5hKz48VaM/IBTLRjWQSFmAFdYacn+bK1EK7yZawFeHhZ2RNtUndqKGWpmihAP6wHpVyZA+xmwsCMGRXTACP13IZAp4L4KnYX27abBStIKqWtDDkEEm2XECMdZk/oIh99wKFSvxNDrRZOEKDahQvMYb4/u4YylgrB7DH/sVIQ6V+ApGxapeeyWG3T576y8UDaIshx6Q5WuNOfuzP0cQ2M4uzEFilB3kKC/095UKs3TlDD0vmGBHewQbWzqwPcKBBvyoCcyzbJcZjmnmvlKWq2Ma3QGL0txAq7Ho88ujPc+Aw5ewEwGfFTIQqVT5GZ4x0t5uGZVPn895Qqa7p9edAh17jbwEBeC3xMlDZ9EhPxPSVma3tGpQn979cimdw4mx/fXkf3iGklW1dGE0aLKm+WkcEW6wJMd8ezagHIdIVjXHZKwjIHTnkirMxApAkeiOU5nInyoikRhlIQW6zIvyMfWDMJxCE2h5X/Z74sWWgo5kYWyogDpXn7tAbSSwg60etg/X6tV6YEAZRqPS08TkhIjRFnDCb3T2z2YxTl0w7zsggvtw4o+Bjg1h9v9VcpRysjZ2VGFHg3ROm0iRMPXqkVPIS4HKstAO7lA3luWQBFdX6r97aqLBRj7EciedOmP0OD4Vaf0iMko5nq6rCqQR1xPm1fngxQ6ZzMd6QEcKTQXTDSVEnoWmC7v5HqKUUdQ9scfaS74AWZ/o5rhPj9sq/pWkVkSgc8r5TXtZHWgZa7H5BDCZWgDkIXQ+fSKK7ksmh/hFOS0/xGIXoPw6HAuWMtiTQtS4yofUUGTOqVA1tfMmRo4IyavwNPQNO+ZFCOuiwSVj5Rq+FD3Xv+fZCWVYuUtWd/binZ72/tb3Q5ZSaleeUu2d6Kd7s5Bb5/8329qQC7RI/Xms2L5ulMuZry1lDjydAhF/wmqlHJIRjkVRUrzsJCuHrMpiaHKndGhK0XnrBKgqx4wnqN6GDOBtySQD5FKjAUbsLwsEOb09PK4RfBSko2nips/0EvaIbGTUWGk3bnUhk7mQTQnQPs2p/gETvsRkw7buqtmIJWWYj2Ja2uTSaVpuqxd9uYChkexRpWSMa8GtnmQKyVUC6M0lnquDaPwMSATOvWerRsh7wQEHxKDCtZoy8k/Ti9IgBMB1gbl8pbmU3LHE6PTwPFodzVcD+KfdfodbHe3WztgDVlzNuJSLFOAfYIZHpJf638/mgfXkiSYhalRgP29YANW5z+j5/8uZ6ujPs+x6nJGzPjeZe8lggvPPD08PwyeawTeHlQbh/kIjmW68a5gQqqrQ56z9hc22SNYNl/rl5E8Dgmrza2eXtxuG24/vbjdXavqURMaL2M/fzg8agZmxr0tpHVdo7aKO+3T+yOy193ehPKkxWjElGbJW3JizAkZa6bJqnU6dsj++oCXirnRddewqLRVjeyl5J0k/yyyjOUxVexfZMzuqYuVherBioz4rfMyhgFzxIGPE2P0ciGgFriRrJqNWB6RfhHHTCl+ax9EY1axjOau+jL1I46n2Zg1SN9ud73bXd85gf9urW9uVVZKUB19RdDHm8ucCmXdMZCdFroPBtQcFOeHl94rZ8tIcmuvlYefJFnOb424Pf7wj7VgOauHDojuVNKEDGhKRQzHXhA0IHOSy8KchjOmrsEzk62ywBbKtgoJADm3L5cE6NdawNabSbyDt59k2c2kBdaW4StTEi3ZQ3FAyGyaDMtZctVkUz5vCfwxH42Z0sGkjkY4dwcQyTKWeJCLgTNF/ZK/LzO6OkHOAgxn/VBGK1kZShnZ56JYTlaMkFoJv5ittI/RFTYSM2FYMhZqNLKYK6OV2Abx4PtK+Y3NecTIAVUMh/zejwjPrI61zt5ubOAj+EQk89FaRC4xFlJLVKfu+cRfUw2mRPFJlk6JpjfluqKvLKVKg3BN6YClCjUnITXEuGGJZIP95dmx8ufoSiyj4malLv4CalS4wpN9mdzgJwGm94bBsDC7+beCplgjO4jkc3FXgaJextVhLBu7j1mGBgVEWcFrGARQZRXL7hEhp8JoqDTXPHCkkxoEIDxs+XvzP/u7jc3y1guYGUVq88xjKkpPOqnyVSeggDHHKReqjtCApfKumc2b90R134S0Xbm7u4sYVTqaTO0IyBi4M6jSK2XbmFNb6B9HGdOyijbiivk5bppSZ1tRxWAzUsWgV9l8nQoTl+BV6i5bKgRjrHRwzwlJdE55arZMxnIuG9oAGATa6ntaZleAxjeQemw4ZND7wcxqGcViv8ouz47XOmgyeXuppLsnGoqOjrtoAyFgWNbxSrBJorqAnJ3XDxskx5pVAj74Y0tGkIrzhGK5Eu3EI3xf4ZtCsTxaLsuEXroyB9aH7AbRC0QO5x2LVJCz48MLI7IOEeNjP1TIK2/q2LEJ5emSkPtsMIAJnKlSj3uOjPR85kog3+3mwSD8RpUHAjidHggpSwcs1+SEC6WZZbEKbeAi8bsxIMaSLJ0DEcmlxdHMb+RhY2VsKA1cuW24CO4GRkU4l+hCDVcCJ6sDsczySpZSIHcg7QQccTmm71SC7jA3CAWUIFRIMZ3w34OobCSh//gZ26zxIbkGLHiCN7bwwWB37ZWBWIohrtVsoJ9IGvQrYwY2MdWjlV6eh5XsasGUdSCez7n33SRaf2wsSmHL1adyxEUd6UCkURBpdVLkMl1aIQTfIRUYEmZytwzgTbTwzk0FuOEDKugVTSZcrHTISs5AixajK2hg+lh+QBj95coTBuFf7qsHsyqZe7sWTKTD3zAdAjwOZYxzQrXzfN1RRWKZpiyGajz228sxU35gyEObyoIMuUhwU/ktnsqRsnvbt9lxc0M+LsbTLRDrwrIxm7Ccpkvs1HTi5qhtTK48+Kt8CLUHsI/pWq3rXgLbBDxLGJakXDehnEF1I4Wtmq7tgCDCEsmU0TvrquQ+3R7udLvDCjGWIpMaGlX5GEchMAoQIXY2niMJV1AeLOcqENxyiFm2QibM3qJVUC6jcHyJHmAYUMATVm9n6K29WpepEBhbEmRCb5giXJNMKsUHWKfH82dpUhg+NQw5YTrnMfIsVJaY4dpqrqrZMGD4x0VKc4DXD8kmXLuuarNR4udS29Awjkm1gtlejoyVLyjclxUwwCchK2QvLeMgCA1zu1AVoZpcm/fsuWiOSfhoqA+KIm0whpOtPbbDBkPWpWw33j7Y20wG7GDY7e1t097u1t5gsL+5vTfcrfDjku6eKhqlYzaM3QukE1Br9q6i4UXoxGR3Jsh3yEi2/ELTVN7h8idc6ZwPijA3zI5hk/zyAtIevV8D0l6rOg76XVxEpdIUKpOA37rcIcK7awLwT/HbmCrA4MRYpzy2qcCVXeTUndADgg7jQmkffkYC4/4do1o1DYImsj2WoMVa5ssn+UfNQl6Xihmmrw/NxkAfW9CgrsHJEuKxbrdblYlkwpYaV+C4iXqWgCln5EzACfpOoizyrGRGcC87qejUfvMbbNMgaSQsLQbX5BCoh/nWnWARHOpeLJZhAQPXVs8Pao8TD5nLrXejteOlGZEcgFDnqBkAzLO45kEGQZVRLQ9GBgQzvctRr+xkyZR486bUL6HAqQ0yAm8sIOdn68x4Z2XugLQZyWEp1lKPlbCjuRgVXI39qpWbEra0OS9IkVWOenvOSWVAJaG5YAtMWboIptz9kxcJ5fAzUqjKNaWAcdyzRtZRKngaW6QmVGDYuWINaoKbb71r//WqEloFtSyeNcAJC6Tg+DO4Vu2YJRUbwhbJNqtp4XMCXpwp4IvGfIM+W9ET/AkdKOYOk2CSE7dAp0McROZ+DJqzGehmd+gc0XvnNKfrilS9fkTqVpajMWfmeVbkl2rJaLcgPvC+YlvUV6WUwVqSVMobY4JRm2vPNPZLnrEtgirVXrrXqbEVbUbboZ0F8fkVM6v85gErC59ydpArQFBL1iCKwf0RSjGXj2GTFTbw4jhqsqygEXeZPWEYg1YTOjr23jlMwYJAfSsQw0tdhKoCRJjcUhbPCZEKMkQeyQ0J7+VtgkiJ07wUiGCWWArFE+wEPGagIkGL4qA6H8b//8kfqZg8AR5RUcVbzZvQkaFKTMfrYa7PaWDj4/2KH9tZRjENk8dtcgzAWyZpEXQfYHWX5uccFTyWGP7myf0yM0EsfV8zQV4zQV4zQV5IJgjuSVcttRR73zEdBEF6TQd5TQd5TQd5TQd5TQd5TQd5TQd5TQd5TQdpmw6C+tMLSQcBYF7TQV5MOojljkfSIIxUBp9DefxJnyHRmAoR1CUhOqfgVROjF58aMpcc0VfS4wWmhrQ39b5hfoiVD+Ql5YeEBuhrfshrfshrfshrfshrfshrfshrfshrfsizAfGaH/IsDPiaH/KaH/KaH/KaH/KaH/IgzSodhhF1G7d0WX4zP25pxfYnNZstpUrx4dQFnFPo7AT9T2gcSyz6C6XFcS6i6b0UcjL9YiH84pUcg/CH08tPJ+Tw8vJ/OfobdP0e5nTCoJfUF1ELbTJ72uBbgaQc2MKBkTreauG5b7SCPp3T436HnP/1/a8daEmy5mJRKYnlZGJkrQU5KocGfzEgFGkaax5HfwGIfOuxsJnMmI/GVrv1hcOlM9PMGOW4CNGXFT7JaKy/rKxFlalYPIb9HP0lJENtUggqKQe94QLcFaCs0ngMhbt95w64b9IYQofzdGDB4lhOspQrvHwZSZoidOW4X1aCvi/CCD9jcGHMnAEdO7S3iTryq/wNjinLh35KH645LHKIf/MdT/CS0/FVRZPHRYff/aL4JBfYi56aEXnvp7Jj8cpFLHFmi++SDxH0UOtcjHzXHMKMjYPtVDXhYsSUBmGBjkOmc6kyNB4CH4GmoxGi50olzwiTcMdVDVDk66UpOSuGsTn60ZCaFZ50xPsf2weuUIzQmnz44hH9YkfpVExGssruI9+MgGpN45townXOoBkBvqI2Lg+73e7mBllbmSUP/tJEmCVqVSsVfnUhyW2JFNKkJk+/nkh1GlU7WM6QadldOYCN/CTQluoFESscvk64tqNU6eoPgW+yNb10+9rd6QZajJzuLbVx2evuHDRwH3w/h0I/iI2+UslEW3hFwmUIuXtZK3IkJxNqM3n7iIUYYehnljOXUFZfre8kKlrTM6RjndmXR8/2784hrCoG30pqgB8JRUc469dK4nCsryNvt9ubJ0Sibvs+YnOI+6IFznyZsuBSPShWlr1UF/KO5f0xS9OvXKvvI25akzokb/PxunRSL/Z+S5eD7YHi/A22Adh8Z4P1NbgcaPAlXJ71Kz17Kp6BoYwL5XykZYMx182HcK1YOoTTiQvNhIaOQ+mU0FvJobXqesIyPfbdl0rDDkG4j3a6B3bUmOU2kQeyiVwv3zZGb8yz8dKa7PYh3oJwkYCxaaOacEpku6TI/dc29zIgaU1AnvWvTo6Ofz65+tQ/vPr19PLnq8OT/lVvc//q6N3RVf/nw82d3bYb0hYiDWi3JCpcnHxYZyKWxqhWmopknaZSsMqqSciq9m1MLWxwq+h3IDhMMIdtUmDTpnV2H6cFRnsNyXUdpat4TLm4JoqL2F4OVoPK4EoVi3/4fkApV3V/34fT0yhq3SN6HiTL9mSGtA4mr6VFV6hfukDGkLM1fy2etAZlpqtbBartVXG1asiQ50pX2MKVQBj7tJOqBxYXZaVD3F8L9OxFOMdUjaNJsrOkhTmqSCYxMso3FzporPfheIckHPxIckiOTz759avm9EL8Y4st8x7z6BVXmonY3rjb5upUjZHwKoyz8Bf35Wrg7YnGdnNG4XARmzBGbSW67/d2j/bebx7t7Lx7f7x3vH+y/27//fa79+/ed48OTo6esiZqTHvfbVH6Px/2/vCrcnCydbB1fLDV29rf398/3tzf39zdPdo8PujtbPa2j3vHvaOjk3ebrbORZlanPGq+y/ps7uw2r5CnYZBF/vUrVI6KK/U8+2Z3f+/97u7uYXdn++R9b++wu3+y+X6zt7t5cvhu++jdUfd4c3fnpHe8t7+38+5kb/vd+62jvd7m0eHB5vHh+9YJDhZHrlSxNF3nuKzKwZLQpvk3i338EULgPoEK13gQVWPG51dNPzr/yZZkIJ+k1OTosEM+fv7pVAxzqnRexHATc8nopEOOj37yUQfHRz+5WMb25Ps33VrW8W2vzSGWvszdxXltnSGjS48xxG9KMpYbVjMs1u+fbZT6NSFjKhI1pjf1qJFkm+0MevvJ7mBnJ97rbe5t7h9sbW724oPdAd1sny5jySGkvqJD3YqhknJxq0xDNdu45BCy6XXkuzETLr2+ogwoIiSENbM8qDMQ7kye1LWEze5mb71r/u+y230L/xd1u91/LKopGHwHUOrnGyJsVaLWyPYO9rrPgSyWNHjm8Koqz0MtBgqlHwwbn59amapZmlZaoGJ2/lgqDVJFy4Zuz5Z6XBEjfifo7IQwFDCmiJYR+RVLN3ixbR6u9ONGOe7HHTFD+YzbIgJhdL4tI1CjP0TOYpGWKJaL0hxl5feUzzWJXEpiT5ZHJfJkir+BKD6utEl/Jkmsigxvd6/Qll56gIidpll3qBjx+M2YpalsMljmWPCbO7tXfz36YCz4rf1tY8+UD54cHT/0qF+XlSfZP/c73YOIppBQo/ktgy2/LHqecdTWHNcF89ow9tX+4flahKECZh6F6Z+DaaOaQAs9ljnXU4wRCNgW7msHhbbRI5gMBXFiZXKe0eKOz/skxJiQVZt4msQ0T9RaB4auxKKy+v39m78E2/5JS4CaUYTgLlPuujWwYTUgCFaPzqEftwECShgElPQ0riHtNC+jjJOf+WhMDpUqcmpsfNs/9GhR46JKC0juXTodMJt49WgN8nLVLJqf+1+BQxJK3WUua4N4Xz1+yqoe/fS53yEfvV59KmIQ5HC0lTkAnVD3buAAv5+egxMg7b5M/F8WK7hpnCw6W5slzgfDLEaK/MLZ3VcgFNbUWTJS4VSKrH78io1+KuJnwpmmV4Xgy1J1mlCnKTEzGgp8fgIJZrj/K8gApRWvZH4FgWbLu/jyZy2WcsyJm8+ftJcd0oewtYsanx/RlA9lLjh9CqbPYRmCjUR1UM68hSk4xyra7G5217t7671d0t1629t5u3Xwv4Jp9FTkvtoMfBS7WbtvLma9g/XuPmDWe7vdfbu583TMMMfq6oZNr2g6MvtgPFma8WfHL8t0MsFyV2rZJoTdsPpG/NR/0kES4BYX+e2yNt0l3uPdhpfKjLA0NQ/E9qcSO+LpXL/q8j/5spg1WgiudLaz2TpcYg5B2H0mRZlH/5Sydid2CL+cCcv5bW0x/R1SC+R2d3a29hzxRcLuZ8Monoas4r+3Wfx5iEJCMv/dx4UGa6kyGsON1YA3RPhudrf3nwK6Yjmn6VXrwoNfkZ6CU7mSgnBclZZu4yk56zQvjVFXRKn0tKTZmIoCyrJ0qsUaS6f5HddjCUZbapQVY3l5D7ofOh7TnMZQoGGWyDs779+9OzjaOz559757sN89OO5tHh0dPkliKD4SVBeGeksWhqfVDLOQ1B6IUFL8ykjOjPnGDH1UmN+KR/tQFhBWQf4qyRkVI3KUTzMtScoHOc2nEekz5sNKRlyPi4FRajZGMqVitDGSG4NUDjZGshf1tjdUHm/EMMCGIQz8JxrJP59tbe2tn23tbNWWAW9n1p8oqq1z4PuYwsrbwg6MWeTUmOYsiUapHNDU64Rlk9on4vo9TN3nsXQdDi/B1J0VVc7RhIXa5ti6/cufSn23Q85+6lNB3hsrlqtYBrZwx1hAEVi+S+GCF2PmVgjwNRh9bzt33iauLOhzIfgCjNoZfJ+E0n+AgWojA5arVQV1882kVs2pseJWawSWaLfMCVQsLRmf+g6tSfA6pIMXlzSDWttNdQoUi7PNnd28tYXClKaDFAR7C0wHUqaMiiaE3uFPZJjSClq2MM/lWZ8INpKa473UHYUyHzFTalikRvH0KhVUk+fmKRv3KggToA+Zz4UQLG293QS711cuBPabLqWPux0w+ArgZklELmzFIwxrIUHRF6gUfnh+aAsKGb3B6Yx3d3cRp4JCGDJVRkudMKHVhk7VOmBiON/gsI7jzv0huh/rSfpnmmZi3cG4zhO1NhMKhZXLAqMhlXeQJarqXGeg3OhFrZkuZ6qYLJXhuJoJlgaGs/NCarTH1rDXPSo4s1zams1sg/8XGdlrYVs0sreO0veK7J0HyZJIvMzI3nAtnrQGLzOy18L5w0T2umX6I0f2hmvyY0T2fs9Vee7I3pnV+UEie1uuUDnqHzCy1+K41Mje/kIxvLXY3fKMQFhrptw3ieG1k/+bbi0tWKw5iBcnfrYg3q2D7e3tHh3s7uztbLPNze7eoMd6g+2dvcHW7nYvWZAez3VVqzSdZLWYVhvA+RKCeAN8n+X2dhGEv3kQr0V2uQGl/dahozMCuUEA1IKLliYAXuMdv1+8Y7gE/+nxjo20+IPFOzbg8BIugf5g8Y4NVHwxF0FPindsQOh73wMtPd7xEZxfwNXQN4l3bCDDD3qdFGL6w8U7ziL348Q7hpj9aPGOc3D7z413nEOQHzPecQ6yf4R4xxD013jHbxjvWCH8a7zjt4t3rBD+B493bMb1jxXv2ITDSzB1/zjxjk0UfDFm7pPiHZsw+t527rPGOz6G4AswaheNd2xC6T/AQP1DxjtWr+OfvRkBqmaV7mjuWjmjubJxWdhxNucjbpgPo9AaLmyizdZOcLcWSw4DPDfUT/nvLMFQObiq9lGAcIiEaD6GoisYOhdBz3YZFa66cRNOdYzm4NPYYqjeQcfM53qFwOdYYqV+IyZ0ToNGx4f4sO9IDPf4MjNmOITkuYYjEPFJIU6v7FdISc5+K6DbgyRUQPiAHdc224CdS6G9/MAQ+7eC+c7kJfcPhwd0/2C/N9iL42SH/qkFSRGLb0jTWbLBZ6yjGrR3tL1msItfSTIbkDZgxqQkWo6YIVW126Ad2XaCcoQdU5GkaIL5SaCf77oNnGSJo7Wapev2YHiwOdza2dsbbG0ndJduxexg8yDpsi7b3tvarZLTwfqNieqmbc2v4Tu2paPrjesbiUJLkwmjqsitRQlM7JnSMrAnecjG7pCYIWa3O+zu7lHaHdCD7uZgLyBekaPAsoWDP386g4/zCwd//nTmSgLbzirEVu9B40+aKe15iL1VzSsKryHtkw54g/8gZ9DSkSTyThj2kETFYzZhHd9/NaN6bN+XxIXNtqkFvNx+ecfYzc41wcrToBlqtW5U2FfzVBAloUOsYkYKGXpO6BRLWtt49NMLg+2GIaGhKzbjS6cd71+gsw09BTQAPbXlsMzY2AE06Fx/B+6KkXTNqa9tzSukXAghImQAK9vTkpRrltMUOt37MZmIU2kdhdf/vIY1uv7XNVk9Pbl8Tz69P/KDbu5tba4hTOGDpS/E+VMgynfAXNelxAWWOnD9iAh2rXdnQ8Uun4zg4tWXxRFQqh8a23rCYbCska5u8gY1xG5hjxrwEsTqJi6MLmU0wV2iZxr9z4zOFYFwAcU04UYK2ZDpjuFLIbUR8/kU6qaP4Risvj8zuJsWe++SSaE0DDLwPZmThr6z6DSDhweMrGRiFJS1Mq+vROa7YK5zqW208R0WdbN4gV5TaULsIVVk1ZmtmubR6Pe1DmDux/S9YaUIA/88Y62ujH5f6SA8OMLKWp2fMuudCppqjSbtnM1P4qGLsm+zFSsErqJwE/z5OhAyWmYrM+t1/edrvFuqtgl2QM80SBwW6TOqq9+tkcvpEBtkmHMGWrfxiZGbtn3bVBZQm72UitOAG5SWYQAXF+S6yFPoRXsN+VAQVgpSFXc2V+C8FBjIxBI0/ED/dKIKFCk/ZNh9v6ELQFVevd3e3tpQjObx+L9++8l+j5//rGVWWT0nPn6AFXzzWUxkgl3XvVQE1ldEMSYqlPUUbZAeXBDBNKpQUnAtjfGDQkkOQDlK/Ik7YLbrvPkG1jpnVIWsQCGBjKRypDr+TITOBZoJ8m8j37zxYQOJQVmZbaPtOcf3FPSv+WGpMrL6jioPaKeiTAmp68LpSUxkRpvzc4W/MqpUwDXPnmtkhy/7QMAhGM3AoJfV5faC6vHM3IFstQRamQFH5gveMqLT5K01wxvhkKWcrsGxvV2/ndje3qoABXbpMlUamMAyMf46YKjZ4C82l68JB78PDE1nmK12dv0XnF2o94TumnCWyEh7WlVOhTTvwg7NS9mDIRYB7JHVbHO8z4P5BoX2T3WCyRBZ1Jz8iNjrXhA2yXQJD4COT17bt23nSX+XzCGPQWhONSMDpu8Yq6Zl6juJBsHMAY2ZmixnydVybZnLwBItJwUR7Kwwg2+WMb9fVTHAn+Z1Akdm8GPZ5t/GSFwZShlGI62YBVkJv5iVoKhRWromTLN8wgVLzMkbc8VSmwRCISHQujDK221VDIf83o8Iz0Du69uNDXwEn4hkPlqLyGU+df11syyX93yCcR1cGTtH8UmWTokGq7WubJqlTOmApYrc8TQFVQzOozuWpoD95dmxKgVNLKPiZqUu2meDtbw/DozjZfFBH0afLxbhwJlV3DGq4Ppto+qJ8M45uqqYOYZaJpP7SUCWW0Ub1YAp+a2gKSohQad6Z+iUcqDsemw9/ew+Zhke5WOpbJfsQiRWa6/t4gjcANQ5SAKbZRYC8EFy12KXud+x023pM9KuRxzMXG+OXu6YTkCB0rqfRWjAUkxqqW/g5t1elQghbdEVQpWOJlM7ArI87nmq9Eo063qwo1TsPsBV2TsiL5McX6pisBmpYtCriJVOZXuW4KF0t0aAi6svx1hBR4s5GHROeVoawA3blKrWV6ZaZleAxjcQ5mw4xK7FZlbLKBb7VXZ5drzWQU/LjZB3wvUJn3EqoVDsOE8liLdwawebpMEJMDtv6bgJOqrFcgJ88MeW+SDv54n7ciXaCX74vsI3hWL5EsMRPtvhGxTxEAJ41bmJ3ef5fmLgQrgOsN5ipzkSLlApNgKCDmSBghMeRRsO2tKxW+qNaOuxtH377Ze2g53hjzG9ZeDlYRAeIvPAXSR0zpmyaiNMAmJFQhd5KuA1njhJ4VzaVBAKifrWqsQTIBCUE7twrVrSjakYMRUtd9eH3a3RYyzzaUlaUHknDELj5HCezkYFOTs+vDAkPESmPfZDhdu9fUl0izskIC2RgasZTu3rJVnwzOH5zCE/y2wzajB+o8ojv2N0BN/7omYxHqYDlmtywoXSjItFiQPc/d24F2b/3uyLJFhak9/6JaOvzwTY27abaqo0m2xkKdVGhC7M5YjFEo+ScBVxskVBDBL4n53HPvv2sLaUA/STybEBaeVYGsLNP8pNQaiQYjrhvwd+YiS///hZsWGRmk14bV6KeHJteBA/GASvvZoZSzHEdaZp9SgUSYPmXiiWLM6us4wal9kez8mk7o5ClUnArUGsc+FTgVymoO2PZW7tOZmTVI6CC1/VkPpMQdIuSotcpktLWfb1hjA0w8xEKKpcmpe71epWM+i8+efKDR9QQa9oMuFipUNWcgbGnRhdmQEXqOLzw2k//lrZKfj/kQpeif0LVfFKAF+VvAfJ8x+s5s0S4Y+q6M3i8SJVvRLIV2Xva5S9ko4vWN0rgXxV+EJq/EeofN9DIwhjm172Yd8+POYZNAEH5496yFfxe5HndxXEb380u/lfT925p64j0fc6UH1d8Zd6VraXWV9xkProl/+EM1LTfMT0f6TrwKL+Qv0GFrqXr0d8B6eBpc2PqkwsSoEXqW4sisSL9BVYCF9Vlq9xFFgivmAvgYXwxao939BFYEnxA+s+YVDRFR25XJkgtIiU37YIMMIxXJiRgDx5qJc7YRhDTskgl3dBZrLfo5djNrXZHGos74g5TwS5YwOXbgu5H2YoLkZlQLpNtC88qC4YvH1MUMLM8N9K6NrZZteSX4ylYI9YHksBqCRdvfgSHdKcV4B68ZlOMyIx4I+rCn/M4vpB/s7TlG7sRF2yiqvxv5Gji892ZcjHPultXvUwuPEDjc0X/71GDrMsZb+ywd+43tjt7kS9qLfjwVv928+XH846+M5fWXwj11wpj43eZtQlH+SAp2yjt3PS29635N7Y7W7bBkue6Coa0glPl5Va8rFPcHyy6mIic5aMqe6QhA04FR0yzBkbqKRD7rhI5J1aqxEQn6zB/WPkNX7EUhZiZBU8p9CLMDHYt87IoSQWqrE1PkPW+SD/TW/ZLLVuWC7YsgywGg44mwcbK3HQu3k7ZDvajrrrvd7mOhTY5PEs9C/aNPvqtXYJ/8FKz1vc/56ljDMHvtXKuvnsfo6Z0FJ1SDEohC4e2sM0v+O1PWwAW5rKrzBU/NrOY2sggOZPNRvJnP+OT8hZJLnQ0i+uEdH2QBvkkiZQiI/lsVHiQbZxpgJ74KN/XDEylGkq78zItlNfmZMMeWOrvsrP2luSclHcd8iExkBRwe/L1AZL13oBh499MpXFmze5Of8pZDFAwLxN0rEptSlXumMT7oOsCEzy90NmMiuMPZRE5CJlVDGSMk0KBfkDZDA1hBJmBiqw8CZOdXLU7xiqZrnMpGKEB9l0NEmgC2M9Ah7QbKsvSxUtt7BUjc/biq5eN+rNHqrLBTWo2PWIkmUUgUAVv03tIWqV8F/ODs/bqN/mOad407zMeLTm4JTsdzej3m9E09GqWsNUq4zGN0z7kkEKMyWoIlyMoKgI9KvAP2F8qpSMua2LZ4YQLkUa7HAw1A3WfmNSX5TXToaHo+vV6HfKOWaKRwb7JixyFss8McNxMUottpqOICkLpEMBhRmgQaRbvDEWGjCA/rbOxfpvhImYZqpAKFXHuhGaICOV7G89zXgcZIfZ3AQotkJ9mrtiQsmcrLJoFJF/MHbTIb/ynKkxzW/WIIeb37J0SryRBk6jnA6hZvEMJbgQLJ+7qjgEwYcscuUCK7Lqsi7sqPa3Kv5rc5B8GD3Ez467KJYPoIfS7k9OnKdTL3+58BLK4C4aeMUwOvYLYo4cmo5GIAvskB8HrqFXwNyOe6OQy+0p0MB/7nE7pOft0E0EVVP8rrCVvJxzKeEqzhk4s2Z3mB0TIAjGm7cuQ56zO5qmqkNyYH7VQR8ITciAplTELFcLWMFLc5wCQqfHaFQYligrQXvq1+V12zNniUbyx8zWxQQMwMm0CA6y0Ionj9QY91K/SAXL6YD7mq1O/Nd+mH8OmGOgMlCLfC/aMDWpJX+55sylG6pVshUqcEstiADNmeTQKQRGnufxmGuGna0AEV2jC4XgH1Vmu16CImhLkTjted3v79VheINxDJaumav/uX+yZv7AlgMpPOgHLV9wdQtlTt7bfbtWydMs+z//VtB0qkYFzZMI/4Z62r/dscGYpdnGUF5BRZ10w+h7KUtGzAy9UUHwyunOTEVjPfnn32EgD1iVGOWz/1prrJbiqke5TLy6mvjmnysOrwXuW+PUHBYuhXpJXAJtFCoT+ZKkFSqoWOalZllZnNKfExZ5gbYa0KU7vlVqo15W9pd+6xrYAcQv1oCuUTX4opmksPnsmaX8EU5TOA3D2ZrenrM94lsWTbjOGfZHNzJsY0h/AzZP/xzfsitIPL0KgFNXcc6MwfTPIyjO7qcNZStneBaf3GdSGclx9MtJiOG/aut7Kox19LFPsIML2Yx6m9FuJyxrUiWHtfI+XRwt0BKbQZ+DZW8QJ0WDuyPQfPCKk6sHlqa+OZqWqGF3nLQlwdI0E4O5w9iKhtXT4zWXZG+bV1SKUzQdlgRznSNyGqYnk6J6HWcnsIO6u+M6XWdPj7asfzem+oqrK7MFeLJmeX2Wx0uTf5bXT4//1bBG69gVqNvtLtDyHyrsLK3W9yHJGZYdmy9gKvqzlTZYtnTCNR+h+eNp4RbDc38ysy6zhGlekXjE1wdcmG/B8xuP+H+ZP37ydNzt9RYgo2G8q6Uyv7UiZU5UTEUzqzb2iep1e/vRIkxhxhcsj26ZSOSyqqRf2qIp8w54AIEgCDW0Lpmgg7R9S6BY5iwalM1kHkJmmEqqG1XYvhkGKyfkVIzsLWk36hqNu9eNurb+ifmTDJi7aZhIpYlitywPa++9MyqmsiNKY30ajU0pptQErmVBamep5NoRZcJ0zmNFVqnWNL4htxCIU3o0sezdPdfTDslyfstTNmK2grCNvtAsxzLKax3CJxmNdTlqGEthxvDjmtdGOQxrhrJRUQCTbZMKxZvnKAEN6pdT1YF11xMZFwbltZqmuhPtLLbETNzyXAozWqtbz2+01ichWI8tOhVT4os6ApfYFeqQp6wQ3N3znJnx1QtYIs0mmcxf0upcWogeWxi4JpxQXSChDUkTHhSU6lTOa7dW8fPti5YUXq6vHAz5c9eFpOLxKE3n1fNfjtfKwx6qb2lo9+xpBMsA/EnFDRcjcFGvnMm7lQ5Z+cASXkxWkJtXfuaj8QosgTHTyO2mWVQvPv2IwAlq1gEJcX7lXBqmKsfairq2itMUfIgJG3JRLWxrRigfrqxRwEXwBFdE3gmWoPZCBR2h7+n96af+ZfQxH2HjGbIKXxjhST7317EjvpBiPcvlkAemVtDypUPuxtIIA65cvWotyZilGch98KgrFgNzGs0W5ITRvjIpgntVzehEERrnUqHifCfzNJnDouI2iQRXOhrJW/BZrFtRBOxaFwZ4OdKOVe2SLFG78KveqGFA/SNDPRAU7hCk0D8NmpOnnmZZzmXOtV0IkrMRzSGOIBABT6NgTYk308R+6kf8kPc73YPQ/QjdZo5m2qU/eBPFldECUjwc8A4GLRGzsZxD0myW+5me9qrStzL0VHLshJFOSSpHI9uJgVye9YkRpniTk/ARh5PQdbkrW9d5irC40EbHIwMuaM6NHtPf+HD64aQ6m7BR6gOZwDNwgNJ0qqDcMBRDd1BK8Ojf+D37q6uYHjYOw/BVhV0hzNsdqIHt73kh4u/a/AAdha4jGMaOOKZqzJTjt+OTT+tMmFOj2qLeiBkfWW5L+5s3r6FlChSgr1yvDFh5jezv/fDeCgExL0dqTDd3dq/XPHont3ZRqS7DZcNmszX3srs7Ki/WVKcKiiMF9jVCeoT1Gq0D2qy2dWWRa52qKOjBdG1bNNgR4ec45UxoS9D2tyA0hY1qjhXINFhW3KdvWGWbygXz2rqPq/3D87UII/XMPIrc0nxqJH88sx1BPXB9NFFRCNYEXDsDaIRptiFEY+LKlQ0pDJcfn/dJiDEhq2aoO54mMc0TZdXySgIHq7fNfPOXoPp1ay3Dd+n/Dm0afZfGpzUyb+hXv3ifeo//92jdqGZRa9+70cL9Eto1LrZ62K3Rd2M0KlSHfPz800xvdujP+MBK+73y1BV/MW0aPximMFLhF87uFkTie3dmfNrGPRXxV+D5Aho0Lob2DGcviPoP2shRSH0FLV1aoPPk/vtCQhcClrfpwb/ZXe/uQQ/+rbe9nbdbB4v14DcI4X3UMjECH0MbbHoH6919wKb3drv7dnNnMWyCXuvLbpx96LvIu5AfvNLXtcbzs1gu0Jo6wAfa9y/RUoXxERcbqMLS1DwQ25+CbvNBP/DAAiMtm+sbWzTb2Wx9FRAQgdlW/y3oMK+J/okdouzwwHIotV1dNAxnaIfQ7s7O1p43QxN2P3sP3h5BxX9vs8jzkAOXA//dX2gEa6YyGhuDiwy4rmvhm93t/fZuk5zTdLn9a21qIk7l7kDhaPHs2XyKgQsEBI3STMShf3pob6ahNDmsbDamAlvPdgjXQRQ3WqXaeg4kGEOpUSDgGiPLMLjbD112wqsRdmfn/bt3B0d7xyfv3ncP9rsHx73No6PD9s3pnXti6QLttJqoXOlk7oAId/6vDIIcJxMGVzthcXU8ep07hfxVkjMqRuQIGvmTlA9ymk8j0mfM34yOuB4XA4hcGsmUitHGSG4MUjnYGMle1NveUHm8EcMAG8ZGh/9EI/nns62tvfWzrZ16rx2jfu/sri8gbn/47v8ev+9hNj7danzt8v81q/1iTMandfb/Ibv5/yAd/H/srv1/mE7962bmt2TA4Kqaingsc/y4HrsIRns/8w6fqYDwv8PYR66jkD2TzOv+vsFdFcDNZpraZo7gZjagNnrGIXlpLJUOBHUTnRpgMf+OWZazGC4c1sHpjwO62g34iVdTlqio5kwR8rOd34lu+3MFUoNppPmE/e4y6h2gNOW+q2RG9fitjWifeXjCRxhx+ZbovGDV0ZE2lWElbBv7FX64WoAyfqUgoAYu+UdFDsuDkzXhV1uEOm5mrcLnHkQLBm1c3frAjazw4OiGwEb5ZyriQunAdfooncAZge8S9y7hidskcSqLpNwPR+ajixLIyYRpmlBNm7fIB/srhnrElVchnLC0TmiSXMEDV25I82TMlMJQsnDHVDCHlyI+oaOgNmxZj2TC1+kgTnqbW43SpGSSUzMCOT32wYoIrqOIZZE/k0OzWvCQTJOQWR1ABv4IoXK4PrLcjQ8/uNzBHA7AMpDx4Wk8Qv75hWdqwcEzc7Vl42C2CY3HXLCrIDf64cnsC2Eyddu5wtirqxZC7eG32s6a5RIkWcuFs48vvm45G5U64MNzVB5tHN+JhUTGN8CrVi4cu88N2wt/Ay3EnJZpyqCZNAgF/M3scDWWub5C6VxqF+5wxvnWvUyYc4h6sEjDfXT1lYoQwRMC6lb5H5uIFRCs+ZVGos2ZykicxWcDSRdsqAVnnXmz3aRPn862ByV/Jpcfjz++JT/LO6OBTGiGtQH+qwZL5bAnDx/4ZL48J16mIwiR41xzrJZ8+zN+ahjkVAxlyK32WICml07WBAxqvm9kT3tunBz1wzxj15lRRSxW0XSSRvY5TJSjOXpYhRTr5ZsztW2lb8c4n9PnL02lmpsbYiBlyqhoSd5hSRFIxymXvT6vVNGg4Gl9yvqK+tN7pbd/3OserLQD52OfwAxhlEwzILFMWOM+eAgWpXOm43F7YNwsWJZSTD0H3hQDlgumITDA8uHfwu8axi1/9zpXVYEqByUhFz4sVcuXHpWsFaAf5rlZimcyaRY7C23mgAKZRCdTfXHNVEWDDH/qTBcyIZ9Pj5sn4lltnspX7ac4vajPACZ6RuPnI1s5Yn0ymdQOla+czBVomjPZjHXz9RO6AZtyyM2M/9//8/8qW5GpDpI9I/7y1adR8PPVhGYZFyP77MpfWoqOACd7ek5oVgcZCmWiz+3FwR3A1gy8Yikkwrw80D1kzYDnLEt5TFW1gif5au4tx52zaRKWpXI6cS6dZ5u4HHfOxOA8HBbps6McDDxn6ke01qdO7Ie1NxUJH0JGpcYuua41eFkDMy+E5hO29o20+UWxwKmtcmHP8VKzuPBfNIxrfyx1Cu/QaNIByrHJQgoAu29LGTtDVMaSP2B2WIz/LVN5w+k6LbRMuIJUoBL9/wN/Jcf2lykJnyOB1+VRB1bDUKEGZuHwQ85z9NrnIvTyVTN/FvBqOke4veyXQw9A4NJtnpM/5IafM90Jjce2AOyYVtKvbRiTbV7OuB6XdE1IUmDVB01zXWQVtzIYQTKfYOa398tCdHtGczph2iCW22wwWDemwRzDHtfwhfnYsenFABrkkNAU2rcrjPE4vcAnLHsRnnQg8B/SwyogQTKJVkCZZhLauPgsl0kR68UJCcFDfu/aYYyJ4HF7aNons0tl2jfKV3ZbDWZee2TqILV4wZnxXX8f7NEPeEEZMQt19bhohqPI06fN/vnTGRnLO4xnwekstwIkDxE9LvKZS6uqiTxn1l/HDLZBid8dVZ7FrTuBFnrMhPZVU3IipPZW4uxN1IotODBmNNdw2TSRgmuZr8zIrjlixz49V3jPvTyBWe3b1QuT+RI/cMLOW68H5nTr5ibFzdhoIT3bJJXVmfV3NRR6mcE3rLYSgtPwA+Q6/c7yt0RBIlcdsa81YCtoQXuMf8uBLRHmIhY9G0XfEdGkqFReIY2MWUP2UmqaOgQhT5gp3TTWQ4gUqhGNIFKwce5jd0BxQSY8zqVisRSJatCb4zFr7Wot8jSqvTCr78wBqbr2h5g5ZEa0IFTTHK91nF13IIfL/L+x1uajOfbgb3XdsNECT2MbRCpNUp6MSHgT7SrUoyJgV95oAUcoxiHBVozA0+ae5dUF9i8Z5j+9aMDyK9w5pxcPQnkaQlWFxHktOpXxIL+QZ65Wb+wusDFjT8n0liWEZy5NrLyzLHKwY+BmutmUq/C9rTOQ1NblKc52LA8pc7MITnLHECEK0esu0ttRQksoeVa266kbV2MW31zNioIngHZItLxhwqmskCequBF2VDBZqHRKuLiVNyxxvWaGOLnCWq1lpdM7qD3lan+S0wv05sPD7lR3JVSPz/u2cFEdNbivz2hd8BkyXUFWfEtRzyfM1lMA7SbDHGfr/gKtG3RnLNKHF7L4N8AMagk8ZZRoJpLgYfjaqWyC3WuQJ0mRsgRfjv7kdBVVTCYUAiSdsvLBMoD9paWOUo5DHtdRVi5ypqwZAdWdqdI20IZNOEQjW+ODWnjBaCh5E1fYLyYTSSa50KoDq66CVed6TK4nMgGxl15HK4+oPw0MC+U+WN7+AC/tOg8YJgarIo4ZS4Lbm/L2867OUc838ZDylCV+0a0gChbdiGySSnlTZC0XvByjxYKXoAYTVa7G5q/Iiz3CnvscKo+EQpS3liN+y8S8YyHXddI8qIB5JcidH1jBF5aSUEh8BoeJO9yi76WTOfE0FXrMNI8Dt9hK33+JcXltRVQ4VjO95ixQMCFWLEha8m4rY8r76Gh8Q0fsquooePw9SMT5OuFxaobAXjDIeVAWEhR00NhlnqBc8RGR1fUGOc4VnMWuVksdvWkqad0+ql0aYLWxZLZHhQ/HS+WgNgiUrZjOQxZ/xYIPs2CEw15N+OJ2qXnHV4k0oxAbVlcdX2lWlw6ze/WhLTZj2ZL59ts8xiAPMMcDquZ8SpROlyIXbPqt0UsWApLlucyXA2KdQ8JwLqWq9wstUFOaxjfzX3HxMFpn4Rl+eXmxoGfJjtBMjnknuJlmMTFYuv9IixM86ItEnnp+9+2ZbWxp5zexpKlLplrZpqcwx0Am08YVmx1k3kCVdSk7SdYHbOK2Rwni/v1M1dhZBuBfcsgDAt4s95m+YFS5Z5RtzZKg7zO1JXiiRoLkDBuhthMlrRBwWRF+aNufBmGlOaS3Qiy/L6UsdETOjHnBoQZ62U3GmQmfP53BiQdXIbUZDVHcQOYNNZZ3ohnfMaMJm7kWJ/NPOTL/pGtFC7gVoEIKXAic3K1s6aiwuxXXr4H1F1UgZ9RkM3pKp9Cg0WitOucZmshtNUfnYWi9dyrg/F81uniVcsD0HWPCZuQPphoOaEsPaLBoNfq73Jic0MS0Nlq5O+BRewuF8sRCLvMomBSKy0lBaJozWpMIJMgvqdd/ChR89+9canPSDcvJXGMkM7wBCThU5tgWhJIsZ0N+3wF1rFEAEGclJpLhSEYMQE8Sa5RDh01Q+6DPpqharDPLJAEQbC5lBMiCgq6maJCHbAeyuE+XNLDa1UKyui2/yXCRquYVxRKFFWdaw6GD/5CMr5xAlsgJZsuzKysGnsQJD/KBsu44W+IvS5lmFcnTIDHqkgIlyCMS4wUT2XH4FR5Oz0Nm9Jq63eVkfEhw7/wMvzTEbxDueAyYvRmcEuAAqZybVvqb9ShXrr5xZ2T/H3flnOb0RK9OdY1AMOics1uW+BgC6/wFUIiFJWoGBgTQs0vrEDwXW+IYheicCoWVuyPSN/yEmm9tOPTMc+iHfnl0UWmSpjWbZDoiJyKxejOUUyrld220hFv3fOWAeMlnwUvhYmsQ6zi0h82CgG7a0hjGt8kitrCZwu1tqwYvZBhnMl/Etz3z+FdZxtDewPUxeG67wJP+yWaB3UlP2/d1a6lx3zOl6SDlakzo7O5dQI8vPfcvZTcswc56gKKl3ms+Kc2yknrsHsuLzJD3pRDKXTLI+EbthBcMH4/+1t8x58J96/tPN0YzUeddLAQTzYiOt4+Ijq/doWf9l7VDa0pDuDuDnXnLqSObecjecz6gjPmNGgyiZYX0L4Uf/TmWhtddZqmYgIpQ0P1gkSMtXfiuK2CMhY6yoFLQlZD6CmRCteAiqcRKVPjUFcB4S/aifV/Xvk65slIGF2RIbzEydbbqdVTWeryOyAnNU270fF0v3uhZ4o2qFFyH2JBK6cbHMA1rZT6G0xwiPBFRmPk6ImdUPyOW312+jKlI1JjePNuJVZMwQy6MeDGg+slaWHG1gV/ewTY7T6U173wizrCghqiRWjeCsHTIw4g+WF5oFph51xpziwvNgfvhMkPlv1rBoXC8htJDTjjzeBJaGadHHy5aSmP7ZjP95xU3ucDAsHZC2Ho0VG2lF4oGOLdFKIfEIEdO4rH8ZAcGp8pz2At+ZPIp8MJ8YpkxOqsSo6W8eO4QlP8/AAD//1Q3MPk="
+	return "eJzsff9zG7mV5+/zV+CUqpO9R7YoWbJl3W3VMpInUZ3tcSxNspuZlAh2gySi7kYPgJbM2dr//eoDPKDRJCXLHnEmuVVVamI1ux8eHh4e3nf8jv1l/PH9+fs//A92plitLBOFtMwupGEzWQpWSC1yWy4HTFp2yw2bi1pobkXBpktmF4K9Ob1gjVZ/F7kdfPM7NuVGFEzV7vmN0Eaqmu1nr7NR9s3v2IdScCPYjTTSsoW1jTnZ25tLu2inWa6qPVFyY2W+J3LDrGKmnc+FsSxf8Hou3COAnUlRFib75pshuxbLEyZy8w1jVtpSnGDcbxgrhMm1bKxUtXvEvqVvGH198g1jQ1bzSpyw3X+zshLG8qrZ/YYxxkpxI8oTlist3N9a/NRKLYoTZnXrH9llI05Ywa3/szfe7hm3Yg8w2e1C1I5M4kbUlikt57IG+bJv3HeMXYLW0riXivid+GQ1z0HmmVZVB2HA7LKROS/LJdOi0cKI2sp67gYiiN1wGxfMqFbnIo5/Pkvw87+xBTesVgHbkkXyDDxr3PCyFUyaBJlGNW2JiRFYGmwmtbHu+2QUoKVFLuRNh1UjG1HKusPrI9HcrxebKc14WXoIJvPrJD7xqsGi7x6M9l8OR0fDgxeXo+OT0dHJi8Ps+OjFX3eTZS75VJRm4wL71VRTcLF7wf/zyj+/FstbpYsNC33aGqsqcOGep0nDpTZxDqe8ZlPBWmwJqxgvClYJy5msZ0pXHEDA0zQndrFQbVm4bZir2nJZs1oYLJ1Hx7Ev4I7LkrnxDONaMGMVCMVNwDQi8CYQaFKo/FroCeN1wSbXx2ZC5Fij5H/u8KYpZe6w2zlhOzOlhlOudwZsR9Q3eNJoVbS5+/2/UgJXwhg+F/dQ2IpPdgMZv1WalWpOhHCcQrBo9YkcfpfgTfp5wFRjZSV/jnwHPrmR4hZ7QtaMO7h4IHSkCoYzVre5bUG3Us0Nu5V2oVrLeN2xfQ+HAVN2ITSJD5b7pc1VnXMr6oTzrQKzVoyzRVvxeqgFL/i0FMy0VcX1kqlkx0WczmesaksrmzLO3TDxSRqLPSeW3YDVVNaiYLK2iqk6vr26kH8UZanYX5Qui2SJLJ/ftwNSTpfzWmlxxafqRpyw/dHB4frKvZXGYj70nYmsbvmcCZ4vwiz7PPZDykKerw52/payEp+L2nMKifVxfDDXqm1O2MEGPrpcCP9lXCXaRiRcOeNTLDL+NGpmb7F7IEAtDrgZLQWvl6A5tyxXZSlyawasENb/Q2mmpkboG2ECuyqw2UJhpZRmll8LwyrBTatFhY1NYONrq7vTMFnnZVsI9nvBIQfcXA2r+JLx0iim2xonKo2rTeZONDfR7F9oqgTSLCAkp6KTx46zgT+XpQm8574F3Br7BFJoIRxuyfw0gbxdCJ1K7wVvGgEOxGQXIp2q0xBAgJq4caaUrZXFmofJnrBzP1wOTUDN/KSxZbBVzaDDLwMrMNJEpoITG/n9O/7wzukk0myYEK04b5o9TEXmImMdb6TSt1AirI8Tu07RYHKGk51jbJyvzC60aucL9lMrWhDMLI0VlWGlvBbs//LZNR+wj6KQxnFAo1UujJH1nCCH102bLxg37K2aG8vNAi+PP7xjF2AnTSTzG9Exufu7U1e63TFtZVlkQU7RKKs7etOevnNXr+6kN5+sqAsczxiqR7IZrTufp/KLFBmHLegmawJgVdyFvF5ugOd2GvcE9/pHBIkd0Gh1IwsxgEJiGpHLmczBLRW3TvGR0CW8qkAUTCRNJayWOXgn6qKvspfZiD3jVfHy8PmAlXLqfvaPf3jJD16I49nx7MVodjQa7U/5i8NDcSiODovj4nU+PT7Ip/ujV3lEEfOx7GB0MBqODoajI3bw4mR/dLI/Yv9rNBqN2PeXp3+jlwsx421prxyNTtiMl0b0llU0C1EJzcsrWfQXVdByPMLChjGYLCD5ZlJoLxWkof3xTM7cweJOH/N8dYklNBRdOa0vKOY818pgIYzlGmJy2lo2ceAyWUzcNoNes75Cx/wQhJ71CCGLbfD097X8qRVfM2+SXSdO8nh55eh16/S1qWBgoUwWd06v6E0P/93GBEkbBfieoF9bQcO4M33olPOaxVzewFZRUIH8yvm3SfFYiLKZtSVkIyQAzTACtreKfUtymsnaWF7npJ6uHDMGA7uzBkxCWhLrtCTRcO2Ec4QtDauFgDRSNbtdyHyxPlQU2LmqMBjMpmTe5zPIj3CguKn6kyY8UjMralaKmWWiauxyfSlnSvVWEdJ1G6t4uWzuWT565gZgvLzlS8OMxX8jbaHim0VgTTfXYGU5eE5JC2cpw3EcjuJI1e5dz+I00FR0rzjNRM56Cx9hrjFAb/Erni9g6q2TOIUT6EyCewuk/jMdCX1ir+D0Mhtlo6HOD1Lt1PRU09aqWlWqNezCnfSfUVPHNePdJ145YM/GF8/BhzwonYRYrupaOEfAeW2FroVlH7SyKlfh3H92/uE506p1p2GjxUx+Eoa1dSH8OY3TV6sS6wvppjSrlBasFvZW6WumGvhzlIYeSxCnYsHLGT7gDGpMKRgvKllLY7Ezb4LODP2lUBXsVCdIyB3hJ1FVqh6wvBRcl0sCXIiZs10itqqU+RIyB4hKmmD2YD2obqup0H3O2HhUlqqeb+IAOhI8HPgXFKy5ImC0tkykRsbHBDOoeIQQFvP9c9Y64OWyO3GMt4ki6UE3ERd2jfX2j/Zfvu5NWOk5r+XPTjxm68fIL1ETnPV5lVK5Gzaa7RssefwP+oBJNZp71Z2VNfgumZOb5hod/qDUvBTs7dvTZA/mpVwxEU9L+QAbcUxfYrMFfoTV4hhQWom94Fk/LBNtQdJ9A3KwhaDxzLkuwMsGKr+qzSB539sDU+m9qFLVvGSzUt0yLXKYy1GyQ6+4PP1AUP3J1KG5hhse4PUEM7cBjaijJYh3Lv7jPWt4fi3sM/M8c9qLd2I0JELWhvLeQqh2vUEJptJO1xZwOAUjK1DJal4b7maZsQtVCdoTzifg3rRCV2yHrBar9E7AVDEtZkL3UKlXJmj81qOfybz3fDQV0bx15n0AuwgoMKBVz8Myd0Ok+DvSZ+y0NwBOr9a00HUJamdXyxro/b2tHX7ezIa1GX1Em4B19K2VXQMJxcqv19DtaOKHyCYEby+MEz3AbvN4VQ1ORiMqXluZA0FsVJCY10x88vr6wCtRBFSaqNtZBdd8y0v5swgOaXgrWS60s+CMtC2n5TifsaVqdRxjxkvyrjIWTgRI07nSywFeDUqJsRKO3Nq0zq/Ao9sZikshjAV7gKQg2EyWZRRovGm0arTkVpTLL7CXeVFoYczjCcu+SHHc7pYq8BYNSPpPFDPVVM5b1Zpy6bnZfUMgGbsFWYyqBNzlcC4Y5448/zBgPJyz8ILjYPnEDBy6NmPsPzrKRn2w046YW0fNbwNOge8nGT2YeP6MTAZLXtTwrRBU7K/Wu4S9PT/JZDOBZJtkHq0JHGSNqAtS8x17wYaMIJ2nJtvtr4rJ/tsd4Nxk/83PcJzhHVbTpRXmM6p9svbe79P/rIfI7wHPO+1i4Iz2JLGEF53rS3V82EPMM/ZnMPsaaUEy3MPPemPOhcpyaZdX61zxOENLu9y8Ou9gIwherqOjEF4Utb3KVbENnC5v1bAU1gocJIXoBzXj6LtmM97vx998hlE3T2ZLBH6feF7iYOtIK20XbFwJLXO+Acm2tnp5JY3aFs1P/RDs/OI7R/Q1DE/Hd6K1LdYklDau8imvebFOqVLlqZ/oLnTmQl01StZ207hvVT2XFrEXKB8lt+6PNQx2/5PtlKreOWHDVy+yl/uHxy9GA7ZTcrtzwg6PsqPR0ev9Y/Zf/QMOSD6ugO/hvvu9EXoYlIvkJ2++BPIMGDl0HIHw21zzui25ljZotSzEGLXwIbJEGzgNSkB0l3kOl9r73HIB+5UsiVmplKZTFCE1718NenoQ2YzQK1mzWBpkEMQoXB5kVGccMfZe2STVAO4raDE43Ct32s+FCrPNdlfXbqqMVfWwyNfWplHG8nJbu2z3gwPvdhjjxqhcdvE40DKi3E30zxTU7/RchDpCPg5CKzEoOBXsula3NawazjAVN5DS7K/nH1gyJ+Zi/k65vEH4+VYWolz645F2NdQl+uc6/V4fjg5HXyJmtZhLVW9TgH10I9wnv4Z/Or0Lry1JMMJpowD7UyumYp3/oOf/rOptYAPrAuAZ4IcjKTDcIEYiz8fvx8l7G5Gng2pvrOEflTXf+30ramWuxlIL81DGkM1nZimbTfM4/xDtlnCuev3p2fmHm0PYIOcfbl4+7+tRFc8/M9jXkHT33fh0MzKJpALda2VjpLTipIh+/PaUvRodHsCfQ2ltyCd7A3+gyq2w7JmzmBFDPh5OZaeYQ9d1ruGoGlHW1K1iP7RNIzTc939jC/GJFyKXFS9ZIefSujgH1Chg6tKFIkxC3w8MAVKztjZyToklYi50xi7a3MWxb+hFSjby8RmPA48QF8tmEcP+CfeMRsPRaHj0xv33xfDgRW+laoTNmgecj5u5Y/dS89p438n5BywKeRJ8FuL78WV0y7FnIptn5GPmJa0cAXVJO8H93At4xkMn8UQxq7kLStRzVipesCkvEezQZsBmUotbOEKc5w9+bqFDtlo66UZp+4BpbzB9jNVdZsGd1AD8fxZ6eI+X6ZPjPiuwN+sP/uuvsvkO+nisrclDTNG71+MDrUEqKNLxcB4ZK7QorjZZmxsZ4qsEF4TSQs4XSKXtBg008mMP3ESaBkHWmSdaOw1GKkH1mTdEPq/vJeDIQwV9BTmDGb2HvN4diK+d9EHKU11GKYWakWylKxcparTIpYG+4tQm7r1iLu8GwzfttJQ5M+1sJj9FiO6dZ0gvPtnb86/4N+B7eZ6xS70Er8IpCkXrk4QW6ZWs6ZIZWTXwf/Prbl2dfsyQnOzinT510jvskDbknEG3oizd7C/fnnW5Pju5ytrrnWx3lfkSavS4IpJ9m9wQB3GCIpoMsxae6Z/gAJ7JbknBriFHLWxTBmdbYBW8gGzFXDTe1HDhfTxN4pBr7J652DNnDddWJi52toaBE6bOmPBWCP3utZnOrsFPmIKjJJzhnY+d9flqkFCAspPM+oSmArGajWy+eU8wexdtd25vbzPBjc2qJUHwjOF3Bjd2J4inmJJNUJCMHVND3VyRrdAN02lzO6adHmSmne73Nt8gAu6j5w0KcvISFRIYOwMf06gVBLwssWUaoaXakOaCmT1UE7SquXLT+BWknpjNcGjfCGZVQ4xCs38mLt+ePR/4DMtoSXV0J5iMhMsgBOKcEADLBl4heJhcti4gV8eNYJMkGqwSwO/8c0tGJxXvEordSjxMPLrnPb5pjdAUb9gWy6T+Ox+zVdpHQjE4loizSrhQg5ptFgED6NJvz8YfILLGfsZnEVTKK30lCANkouKy3NLk4CxiboBgxPS1EYcApOcGF98/ZUwCE9413YHg3FH8hssSeWZryuC4nApt2RukLglZr9PGhRh/MwZ0o2+fA90w2dbST9dTMEM2sRs4BNR8MG6vKbmFmr2BUd3r23SupivhB1tHYsHNYkvDh2RVTBblWAtYqLnSWsDaXcvH5iSgasZrVS/TghhvqSSs8r0RlMc5wUcuPxexXPcHKDqJCdu5qmc+eYmXvTHhUVzXr+CY3cRUW0nnXWclWi03j3Uk1nnla9H4zSTaxQIWJQbC1i7VXNbrk05EGncibZ0UWpXC9GnxaIw71pq7EicsA3MjhfiD8zP2i59WEN79YedaTnnNr1y+IUrOtHAWSj2/AkBfJHQPzcI881K1RT85LDy4OzfsW9AfOV1lmkPgQIHgsp5pHuvGuml4v5jPOybs4IfI7qmAmbF3XWWCNGmKNEfp7IEvxsE2mwmbL4RxsZYEOoN/Dy+FDC4gCbHQ2TNrRU8SNUU+9baPAsHVbU3VTFpUysZEXaZaa2QhkpFWMfM4cUblNmFCBJhSTtynFCfql/W5XxJAdtENHhw4MkfJaYcqEexL0oByF+TY3vG2e9kRyI8FvkkTPpgsYo0cia4lK+RsJnTqfsMPFukmCHP5tI6hFTWvLRP1jdSqrvp+5463xn+5iIPLYhASL04dVt99/AM7L5w57RMB21Upmu2ubsqXL1++evXq+Pj49evXG8m5xVN4A0GD+OOl5OYeWkYaElz2C2mJcTdQs5CmKTkFrtdoJ2AtynxYiJv75VZCVa+hyhKJIOvRoUcj7TgZx0eJZEjccvYeZEsimtZkdWuGsPqH+/24Vsj8394mO6cR2PlZOP0criQv1hCVw/2DF4dHL18dvx7xaV6I2Wgzxlvk44hzWpuzjnVAKTxcLzF5NIzeBem6bO5BKCGjPcgqUci26mFKnR9+FZFKY6XCatOm7W3RD/GbARv/jGO7e7Iu6qrlkAZ56G6l138lGUijUS7UQ+eOt1dnv1lcVcswoS+YP6oz9ZbmnlphkQRuwCzMOm2EwG/NgPGfWy0GbJ43neMThSoIifJS5YLX2erE+a3pTQv+XlVvaVKUKfCV4raHJ+lFvxL7BS0s1MylNb6FRN72vJVmEbS1OBkCy6BbdudzsO597wV3OIfFHTAxd4cvNOEbw97yalrwAfvD6Qf2h9M37CaogYyNm4a9qeeyjiz+53fsxrjnVFe9SUjwpmGCPsO/CeUBzVS39YDNuJ5zKwasdMOvbxf//P6tElYKCTlXiLBz22rRs0yQtnPR++VuE+VyIYxY7W7Qs8ydrj+VNVJ9MCiLg5rswZqyL4Htc9SauTxVqhS83sQ0v/c/gTFy3mBezvnW4QL2oWyGNVbfRZOd3fvJ2qEKkLKeb7FkGjpod+ZElRMDu2OT6v03VNmuaafUlSC0V2EVr9sZpz4k0yXjXVuKG1EXKtr0LimfrCYoZaIUN9BgrQKnl4L9y3cXTNXlcp1Lc1VlGFNkn5o8Q7xz+WDaWm5bsy26jotCUlHUOgeDUqg68WE+QahspjHcWdQ/Yg6jMNfLxqq55s1C5kxojerGmHaXQr3hpSzSNEh4I3VrbBiPvRX8RrC2Tup+ZiGhxn3afaJmq/AjWPTFaOt8IfLrTW0K3nz8+N3Hq+/fX378/uLyzdnVx+++u3zwGrWuN9C2EnMvPPg08bMTK0KvzuSdRCcANbPsVOlG9Qq5PzsVK3i15X2MIR5zMzt4StNupZLZsIWpyU3W7d0I9Av38Js//fHf/3r87nj85wfTElwsHkLLe8T47gU6S3lPUrotNrA6erT1wuJ/xtbiNuSf3bVF/HcurXXqnGih0AiBtMLpRhFkL2ANYddvdIMad6VKoIuOIi72hkwl7Do3LO3p3V924LiN/wvpuvl8BI6kpvZPyhuhwa4F43NkKnR+InwRz/ra9v0YG0UX7xH/M3LpIYQJZCFlROi+bpM+vFut2Y0vBt0G+8dtTgj2taZp3RkRWsIQkhELRtmUScs7cG0CJFKqp1OhFDIJtDiXpM8mjKANOTvrJRRceLWz3QdrVrLYgpCmWEg3eVn0HQqy4vOtWgmpoeYGizUwHiEwmm+Vo1aKntzbmeXzLWHWcRbhxecrke+kL+D9wyf9Ae/pELgy/rkblZrt9cbd4nJ0k+5SusOwxLNbGvmjhw7NljtlDBK8Y4Q1Zb9AGatO5EhSAJxKkrOVx/fIkuTVsKuDkO3ViVNml+tD2S/5j0j6euk9n6Ga9a0vrtPDh+IfoTKUmnX6D+FvJ5De6+5+DUQBkQJeKRK9ivogqzbMLalR/1x1uheDVJ1OEC8X4q7662SAXNWIAKFdIqgGiYhunWmvGV98TFCnoXIb5xqv+zM2dw0YyNAnJoHsNRjodS11KVwRdtDuyMQKKgcVZgPftLudi1C6mFS9+b1A5jhLX3sTKf0Fsp/ybra089Iy9JSoD6tFJ4jU2OLRatEjWLSMEE+16E+16P+9a9HTjWlVrx/zb1WQnh4pIbn5qSr9qSr9qSr9qSr9qSr9qSr9qSr9qSr9qSr9M1XpqV73j1GanmD0VJ/+D1CfLhusTMonnynKFh2JrWKNljfw2J+9++vzTfXYzlfuhPg/VEm6q4FOXPA0U3CZ7WhjFRYLlDgTSB/OHn+G2ygy/wJj7terNE+Q6ouetfrbPgYbF/sxy81Taj3VnD/VnD/VnD/VnD/VnD/VnD/VnD/VnD/VnD/VnD/VnD/VnD/VnD/VnP/T1pwXZdnLWHr79nOZSr18orABejzv8hzhTWalnGquEfYvljWvvFOEEIPLJ1wJSgnMLlJBP7/DxXv+np/09kK6dEOxHbPgsKb74+x4Ja8r2AETmKDYT0NFOWn0Aq3lZkK7S5oTq2amylLhNtaTIA3+hZ35CQxLWV/TeEv2bJIVZTl5TlcHBYePqtlfZF2oW9N9f+HR/c6l4+FDozZ9930tPw1dQ6S1ua/h0kNjWcrpJoAVz7+7eHi6T7/kJ/snqqlZwfypxGZ7JTarpH6quPmHr7hZXbL/fwpwVmb2VI/zePU4q6R9Ks/ZUnnOCqGfqnV61TodnWDgZVVx9ADafM3ufnd25PqWZV+Ej1nw/S0hdPHH8f7XYXRw9HJ7OB0cvfw6rI72D7aH1dH+wddgZQohmm1hdXH25s2HL8NqS0dyz2VGhkOyky8XvYsUK96YEB5OD3Hcag+ropDmen0zXyMDoHxxkAWr8gHTbbjdlm/oW3g0HcYYZG3uK8ifnvxIRtuP/kbcFwc/ftWERMZ1vpBWuBL5Lc3t9MP3LB2GWa7nwkY3Iaa9NsVPLw+/YBaoa+X1cksTOI9N/P0wPV0R2A9C25QCvfiAjCzFEIle2aPqj43IEsS2Pdvk6VdO9gNPE3A/PzmAv9p4H/Xjz46G+cqZvcxeZK9fjkbZ/qvD/aMvmKKsmm26mMdOgIdJyQqONupy9+ENSsZExsY1IyzYcAj70L/GErwYfqH4ZLADZrKeC91oWVP/E/jKUM3E+AzXy2nhKUZlYaEDHvRFf9tqhO0yk6J5bNgCBqnK81ZrKL6+Sdqtq0fyBYL+Rm2reTSvgSt1JurreLr2L3PLEBI1J3t7CNMjSUssnaDYm5ZqvmcXWnA7hDsHsmnvYLR/uDfa37Oa52i/PaxQb6fF0BNniAHRN2hhq3L9NBnlL49HL/JD8frgYB//KHJ+9PrlC86LFy+LYvYFDEL395ZXWKxHjttt3gm/RJpdfBifv7/M3vz7my+YItmJ254XDfNL5rcTxfWPn8ZvgqfU/fu76PP0R/DO/QQI0y/q3t32Z+8vPufEpq6plE0Pb9DZ+wv2UyvgRHYFDLw2t0J3GwG/U+dUshaFtIv0PuPu4vkAa4kMSBzIis2FdfMisAT02aSoTebYzb0/eQ7RYRdiGUzSFDqCzrG+1yEZ3O821kI6MLEmlRufWMB7STuEg7dpb4UW3drFFHkHZx1L/+nkefZwj3J/xg8uVe+v1xgXKyNgQjP2pKQvnNLj6gr9WMzQ/eJa2FbXcRSk/fau1onP4UR14d5rsSRbn+g/FcE9TXWfRtCo/dLU6ZK9Ob0IvM7YR39ZuoflZLGToKkHs+qm48VrGBw91rgFPAKfepJwrSAvS8djrtGDT1J192YJt8px8V0cBu/REmRsbFkla1m11YAeRrhhUhVcMgEtEGuCUSaQGu767LVpSNMlCwxYxYMtxZBpVSF9BS0snEsaM+IGFRZGurfBw7xAZ94l452fl4JLZH/cgSg3LG+NVRW9nu1uYrssL/nWqpfBNg4+dlZcECIenCGgoHPLUyYxjnNdrEnE8/cbUU+6Jz825s6PCfj0eBp8agHV1c0huG9dHMqQ/KcoWTYhGQHYeKkUSJICDHNfO+b3R1n430YqbPG09lToEj3Ao0l7wxXUWeMvlE9347lzd7luQGrGTt+P372BW3YqQCx8X95A+0qE0+6uYRMMNgki3teDRZDoauukhstiMI2qiyQskQDB8k0ydh5lFZLJKPVsFSbpP2zyUytMLJyeoPxBJA0BkmWBgndX6m1YGmvLB6zMXfnpsbAGxRH6xsV3ILrdhB0FNq5CcOvyfBEHwk1JMyeYUsFdSJNzXYgiY38VWpFq63g5wKdNkBBw2lHND7G2W/ePNzPqFhvfXobtpWZfK2Mcb/bwXgheCH01K/l8WwJyN2Y5HDAqTYaY9CMzN3Kymd58akRuRREWimukm40H7PJ0wD6eDdjH8YCNzwbs9GzAzr5b59ndH3Y+nu0M2M7HcUiACJPdWkQIS4M5+VqNNCzEDRX/kNbRaLT3QxUGt+Rq66IpjPK1hfatLVJArhdNI7uuDF4smHXV+uXB/v5+b96q2VA5+OiTp1wFhSTxgsQXNbmiUM+1rAscCW6GpEoRRMYqYQwaKqWJvLhyVNigsZEAsyE85sG4w8ZTxqWRpDDvpNGfvn/z8T96NIoy8VfTFTRph/6cwGSk+Kxa0BPdW8LSnYgYbhW11Zx7987KhQi1qofOlQFVED3yNM9R1cSe+QKBFwewbhwGbP/g5fM0316Z3hedEI8GECxnw4TJOe57nHIj2P4oFNYZ9uzHs7MzKlzE/37P82tmSm4WZND91CorUsgEKmOXfGoGqNjWEm2vvNWApsyoY5dJE5aZEF1THZxBqr4RmorBfrQD9qP2X/1Y49iCNHPt/L7sdI3rvFYIss1F31T89FTw9I9U8BT5ItJ/m/wQB2Gy5zygGd5XrrQmLP6JCnRub283E/2pGuepGudrqnE6Bvp1zAOyku7XLMbjcb8vTTBVr35J4fh4zUNXluz8AxQ59EOr2SSYSjC6Jj2WEfHHSfD0Ee/I2UzmbekcSK0RAzYVOW9N9D7fIMHYOiMjcZiECmQD11POY/UirmBAOMJ2+IUyH9EhCscuNgFzns+EOJMIvuLXuOrIRm8WXpd1IT5hv1XQVVLQXi/wH7nfBTfQ7a2KEG+kQbnmz4LUFWi4M6XXmWz3h53EaQJ7p/tzf9XwCXrwr2EGhLE2txJ5/51rGd7DboubYjfdFdF7H5KhigFRGBqp48qEHc9nbKlaHer/0+/h7SqXztlq8FIaNxi4B3QMuddyxMPCBBkrahOhzDxuqwGAh2LRIUDbJvj6e0isjA/Xkhsfxx/N/5ly9HJZHxxNLlU8UchW89viOWKcBePkoYkwiar9TX93FCL48dUs+k3W+Ds6fAOXiLwX33lz+rn4zjth+TB1UpMxmpMX+uGXSmwMnCcJOVr81EotCte8XPxypoWLPETR3QEW6YvJICcnYxORm4xemuAE5hENgklzcYLEOfRdHj9EMFbHgUy9mH9ZiNqzg1tAROcSTU3WhbsYZTgk5ygFLoAQ6GlKOV/YctOVcMlsDKLfSfFFidYUznrTbokM48XfgSr5OEy+EBUPX0eIJPRpCmuss5+NslHKObhHocc78cGDS1x4nUThKE3cse/SeTUiHb830DlEhZMnvEfhn6YRCOogFcldQAgyB0GgsSzoNmPYrT92ohfDvYJLE0U5C1sMxqyHnu0+mIvXZf+j5JS9ARpO2K+GETyC93rgHgWDu+skN2BAbqbPoJGUoW2YbHBV9QAby/PrK6gVK8D/KeuBcW66GTE3oxjzcRQFszYljC7gEM54p/ekIH+F8z093uOCD1IDhXojw+eWpiv49hux1UwiPf7Ob3hW8nqevW/L8oOCeNJvwuupWLkJUi6IlfjgfrFCx++m+wKwv8Une0eRS6mC6eKYEJegEiwvHqIUGrNSzXEohMi0P3XXjulwOKNDhqoELrOap+Kqsxreqiis3FlCDU66UkVuY9QMEhCAIox4fRWo302C4AVQPJRMIJ/eVTEiqMjprtiuuzg52b1xE/saEcwQCseZxNPOPa7DUgckV3VNCQJTYW+h8vO0hTan/AAC6weTtbRoIlgAVl4q3BrNxmElPk9uqF508DAf7a9b37a0REDKtFrgdlRDFQqbKJu85rLqLb8WkYdTMqfs0dG4EhXq2nGQYbQArugoTa3N0cyKoFpROc9+q0XGLgRWV7CJW7wMZ9/ET9vF7WMkKmRfgKm7oD5BjDqh42zCFOOiCmTlXH/IyQZL7h717OvFyy7ki4cebYYQjaCi3L7Hg05Aineku5gSKfxXiNfiTmOwQKeVLngd6IoLZubKmQKMrSwuMk4mjiBDXhSTAZvQvhm6fSPcI6SbDb3mX0x8MCmEVCJEHBBO5Q9sSzODh9Nx2KZLdVFYPGy4MZDVQ59F2FuMgPp2lsNXUFETxhnsM6iXp37M0DvbJ3Z5a9sprhxO/zQw5O0X8m7R0gBQQJ4tpNBIX1wmK7y6Np1G6ICznamcs2kL6WR2sAcTiFKYvoctQp3J0gpN0m5liBNa2Qlb0mERNXeUeVIVVCydjjDBsjfSLimYFktDncwql+lF4TQi9sgkZIiGGjXe8QqHwz+gtcr1EX6w7Ghc1/KLo4waGMLczPsLRecOTSkCdSfQDFaKrDsTJHwrNqj8vMU9CJZKZz+j9j6a9rF7TionVew6JThmz9FNGnKGHv7e+ErtreTSg5C9BYdWODQKcGxSI0g6p2FtndyAMEBPOa6LMl19NQvBVAY9pkU8S2n0XSvAL97Ewv42TOGeCJwyMOwDOaOyl8gK8DclaXo9h52frS/D4cvD4z7xvQTq039NFhSdf6JPX9oNHkg4ScnTza3YA+YwmBLZ6k7FmdRJ9ZoW6CuJSDHj6LnqVIHpEj4SzRrZuAtB7uRpfz9oTt0T/w1DGsurxh913KaPuqbKhGuEGU9z8QkKdbx4JYlr085OEDlHyjWu9JO2dRzmu0LDxLxVLA5LG20qNljh2Iki/hnPdLaag57zMnfljtSKETeDB8UodUBRygKlXjqEO1HWU1vcsrhPHdHR69LYIKkQrLckJVYwqVQtbdSSWAICCU+qWzH8Ga4Bt4pdC9GwtvEhBfdRurn6VIWl7Sa6QkccrX7H5bwcpCtLvjTCc53zdw9G+y+Ho6PhwYvL0fHJ6OjkxWF2fPTqr31HLJzTRtjP7IdfXNpFw6STTi6zoaV0YRYXGXeqqF0glza5WBsmhCIqhgavPO+dM6WaD7wfAgbH80E6eFrp7HWcJR0vEIfdfs1VJTqI2BQp2harjHBGVTk3tWvfgRBNcHY58NB7emOD2l2+XKWKtuxYHz/CRsTBFForFMomd+mkYNbXmjfICcsSWsTlbXtlR1/QInXlS1k3rb0KP9a8VpQTR7+r1qYvcPNOlqXc+I6PkTt5ur+Rcc5o6Gga31CiczJsn5PcwqHjg/bGkv9bII9XhzbqtgsAdnvHbpZFQdDgZwfFmwJY0+6OukBjURd98m48zu86UjpU106T1YPE85vS3fOgVhFg36vBxQ/V1JmLRdZDNan7eWzV448o03nWCL1AkWap5sbiSVJK9BzriSuL/EmGTsW4jKAUabipEJWqjdWYPvY7nB1zDc1xlen3D14cHr18dfx6tOlf49+fnv1qjr7zM2z6YGp1K7aG8zE/nB2NRkUfs3ou1jsYPFwnuYxnguOXKFWROXQTcjHRiqC2mpeUWop2Bxs6QYS9QMrFpDtwUl18hS+DulAuY2lXRpIyDuCuNFiF3tOm0gGQDGvTJgGYgD+vkwv3WFSgmOG3KdnjC+e169nlCjprb/QjhcqYtoLGgOaoHM4EWc8pxSDMN2ZU5QutalWqea9PFGOlUtchRUCakx6t2P9ZnVz3JCz35EFn9lG2P9qnM/seZ2ngJbg/PsNHv62dGxK6vsrQxewmFGQEoGGAsuqbdJUqQW1If05RCae9l7o+G0e10Y+XxObCdT8xRho5bbMFTZnCwWpxq9W71p+XaFZJiozbC+RzIk9TujGDn6QPbUVH9XNkC3VL+jhI5dyoNIhn5gh2KtiC10UJf+HlQixd9OwWQdDaJttUC7TWcM7K7qFXM7ChrFZlN2tpu8tL3N2vLhvLWDDD7UIgeyHqMqjFA5UR+0MRmRZzXFgTk+4jUKWR/76+VRwFe6zf06m2psj6UZJyEzgx/FxWNUUKlJP5gDdIVrUN6kwN9R+q4cjHSnnQ3qIo27mzK9c9KbSeCPW5nVAHQ8jrw2OnCkL5Nc8HYd94yLG0g1g+goyZs4HF/PsbiO6A96geZP826P4RQh3Bh+A8ADvXVuq4+74n9r9Ha+gfcdGIhsbu4kNwjsPBrPKrLsEfmxWaSeEKWXyrTGgrvoJYFB3TQ/unXJ4psg6tluIm2NKTK782qIeZYa+6Vl6+GygcHVoWxEo8im0KWyV7fRCvDGWtCaHMW1kWKCPxuwnMvb5cF6Jh+6/Z6Pjk4OXJ/sh700/ffHsy+p+/2z84/N8XIm+hWvm/mK+TdrfNCu2f7Wf06v6I/hGxvEWc3fh7Q1ACumTGKlxAED7w/290/q/7I8S/s31WGPuvB9l+dpAdmMb+6/7Bi4PPhepUa2GPbYO9Hu1Mg9X2tUcazW8S8gELUbuE8FRgujdT3y4PhGcIZkSQMy5LxFCiH6cROqR7x2PLXcMF176lqmlRbNSc3itLJRNO24tVxMndsSyJLxQ9z6jD2PgKswjRPXRHRGjMlJw03ZG5QpgB43lOjkJ/FMvOFZNMMEF9jBOojvjTijgXixObuaoa1QYzkT2Lc3MjhzI3JyM7uRvnRpogzfH5INmpQcD2mnVFo99N0UGPQKdQhUjH9ecBxAL8zMkCP2hZY6QX/6OFTWuJv221O4A7skACdnk33mPnSoJ5nd5mRutwR6wgmXuvUw+AdySYrUSHzaAb1S7CikOInUCRmXTwwd/1MrwNON5jA8+QR4wVShjoCC6NMa6OEbXZIBKJrD0RQ2Xmui9jHs0w3r2ImXKb9pn3Xbtd5bWCkM17sTTk8Fp3dSP43bl24TnvPDWUhh4HDfZgOMqC26M78UXX1++eKjDaLE7LuFiaCkohkp+L5859jZEQkqEbdwnwarvYCPGZb2I06LrkDGmKw3AsDcctLLZ6/nx9Hf3XvWXUghtVb2sRPzro7HaxTGIpMaFgXUjRAtwfjgU0RzekwCOdmOsQ3FU6MjjJh2jIEwcFuH9x6Vm0h/zXk75MIZBRflAciD7xdJt0qEWMgV7sMKjqJLzfEwaMs1sxxWnyKeTP1yv4JCCxewtRSzp24DUVJrEcgtRYRS+K0d46M7ciXvWdTEtcnl0gOiEmG5jm0lXpgLGBYVuLUNnZ17E/a2Rr0fcXboHZaAD2/ce3KPa6JsZKuhGsG70dX65yXYDiDAo4f7iVeZokERR/EhTjxDwdRKUnTMI1rKDVgZl54myxycApzpx6PUPb9UduDD86eq6vSujdQzd503OUcey5MfZ+Nxo5x96Dl0ea6yuT6Ih3aY2zUnG7aQE+SnPNHARINtctBUqTmq0JQkOyihlVtvjYJMV+SL10JqCf2q7pAmteF8DO7TtoO9yv4LfqT2Ajg905id33cIogc79g+vMTGiDaz5nJuYu3EkTGRuCZ/dFolaeQLcIl9fSmGwmQ441174dv6ETwksRVH5sEodgGHd68AiYzuyXnnxHIwaq7aXiqUSYwdBfqQZ7t9ohoIFMetj2/6G643QsCHO6TT+nXow8sxf6ryEWgVQ9hLhfo6bIN6MRARFV5XabnrPrEc8uULigzIzp2kuh7GnsPuEWfJNU4dTfodtS6EbqLIdy1Wb6MUpeLmEoWB+iRq39g3hcd/UvsiRCNhQiRrAa4i4Nq05kUIYgTkhlSGztIJ5NRRK9twsGdJBvFlTDQv2lUSS4CZ5Qb+MciVOLMoK2G89bwaqM+IIKOF+czFSAzsuPYpFTzzLjfs/B7hiyMSRaOxvC4O15T13m0Fx2PhnfXFZWU7CTVwi2Q3dY8P7t4noXCyd4XUf0mtkZiOEO8LYw4cHsa53tX0xHh5qqBjiHumW6SExR+2OA6f9XnacTq+gz9FUE5H0/8bFiOktzSwNxa3lOXBHJHZG7zVcGPp1JcfsZI7U0JG6ITHFhhgokc7iTVlnDuO+BLZLcEnYwO68DoEWh6TPoNGJjD9xK8lSbdK+McTlJ45LpBQyWd68fBsf1V7Uy/8zMafOdNiySvvXGFSuCCVztJcT+fTrW48TZueP3icse1OuM1++MfT6qqEya4QYfeGo6OTkajnaBf3p1TviZCf1svlV1I/ZUJhphbL7mQs7w/PO4dHPpMwx2c/BbBPFFT1l5ydrBOkSfgAYEJsaeX6QMmaqy3SdIRSa4WkC5QZCNIP6nVS9XJqRMKGNfvb/7VEgXJr7RshFnhmlaX29rxq6ZD7WC75rZBI8OdVLjxG7Uq9Q0qgudhdn0PzwOsitrt26Ds+ZohWQ8L0djFGnTHfSHPOEKl4HGdVndQdWTtDE/WlDwXd9ond9glEf4vs0+q5QYLxQ2xd3Twar8QxXQ4O5qOhocH+8fD41ez0fCQ54fHr0b8xfFM3G+9BH5AlnRawfFt+PueAo4xtohYzfZ3fWrWop+ukAIdXkS9kgpJBQm4rtRlhoYUfMCmiYf1B1Kx4R2pXYnH0G1wF2sIKxRqHMLfvC72lO4mG6NaTsQOqPFKdE9Pl37I8xDVYe+6mNoP356/+xu9C80juPFwyKJA8HnmP6biFnL2dVWgsZaFu6J6hG5kuTYfAtod+tGj+UVVAQiWiOIBO/7OZI+3nHIgYo9Tp1oE0Bsd+MHT2y2l8cmJSPy8xnakkO6G5CZurZbT1grzAKx/WTMuoJeMl0xlHB/S1b7OWX3D9RJbPt4zyP4otIAuAaOxHopPC94a5yV3rRrUjALzEa6jDqRC9ASFahHanjgP5Y1ABK7C4WfQNi/e7Igzyl3XkwYExSeRt1YM2EIWhahhk/HC/xfF1wOSkAN2q6Xd4KHe/WEnvIsaev92KJ//8ks7nq7Keroq6+mqrKersp6uynq6Kuvpqqynq7J+46uy+jbHV2nATpt3cHCKOJX1oUqvAee6Ve9/31d58yTF+LF09E6tJcuBu7wtX626WWv3v8V+45hHWEDvnmgbYMAmFYaakOMC3mt4qCduFknglQqyfK0dbETb+abx6gBJMHkEF3wiAe+wS4HGCr16tdmPLa/PHHBK5DFJZL2H0CpTmoL3UQwq+7awDPC7ZinRKC8VPFxF2hI7OlDhUUaSPsFk1I6YnGeJQ2tNtd5bqErs8TJQPs4U4K48mF862U0z3T3DAKFt8j2z7bvXnGAOZx3BZcmNxBsznolaSHNuGqERr/EHQM8Jjd2syhjWSmh0+lCp5Eiz3lDp0djDy6w4ygDXZZRtEY7BUnD370LZjZIgOucdkYFyv2llBIyiOHJOWa6z+c9IzanL5Xp7QVWn5KXzpWDPduY/7wyck3/HQ9h5vk7Xpp73yDffmrb2QcsKVr7zfjnH/h/Oz57fu/V390ej/b6A6rwy28YwVZc3Yre+YX/VyyN/oxsif8NrIH/Dux7/sS90lPX22hCcA3YXLwpyDjsihJ46tWxtj+weHL18cfyiv4crWYmrLfZtenf+7o37PJ7RqYHnvRbpzoYaZ6wWvMLT6bJzkDLK0g9xAzTVlrzmmdLzPZ//goRNs1eJQvIhxuz9O/uEi8d+OB+/H0eICt1GEYN0b/xtQAdvaPKZ+V55G6qmocV5t9SUmuhGmL6QP1Y5JVMPNeUPZaVqe5z0ThU9gQr2UTmMn8hdFNdbZaLRy8PRCgv9Qr1+g1of9XEcx6pwBlh/82+xK35alkS0SbWKRN0IlW14HFXhNZLRP7LV413ddh6fx56DU4zcALvOt6Ix5ANOzce9n/U3a2rn7oLFXFIrb7CykFHr22BCxBGjKfFVJsTeXWv/dG3sA6+N/X/sfWtTG0nS7vf3V1RwPmDPSo3EzeCI+cAAMyZe27AWnp2z6zeg1F2Sat3q0vQF0J44//3Ek3Xp6gsgMO3LHGIjZg1IVZlZVVmZWZlPmpd1M82XNCL8oljqc9vY57axz21jn9vGPreN/eHaxpYCyOR/VllSL6uuwp6ONmEQHGtyTbwTcOpH4rSRcImNRHDvhNu9hh9bukgMd7f2titdJPQ1ffEXMcbOiRsGbsjyyJZz5M9lwT15nl/CbIUAWjeMz15gCSjTpMdKSl4G9SVxGVSWuqKzUBzSFGCgUxTuI0Xh0rL8xXtMfTGqheiQDS7SBu0tgbqbncF+wJHumXA81JFyyzpi6K3JCTIv6cyb12QZvRgdvH8ZaD8L86Dtk0458t7HzNCMEB8VstMpRO2/oOG7BBSgUw9LML5aLw40bfA5ZuwFhrKl/gB0wM9izmVcfq8p2J8CEfMsl2EQqvX/uucQVGQvs6wQKe7AuUq6vFqs8E0yJmZiLw7f074BEfB9fBE64Ta4NSi0FPljb+R0xg6yrEg5skhHhJjMDg8eJ4QiydNl5wKgWdiLw5dkCGV1/j6OHkO8BzYjoi4X8sifiAhhL44es46HP38c9djpz3Y9T5Kwx04//lzrSddjh+9/vmPNzbDsy9Yer1ixzLtefDuN1TdvX9al8k4VVH3Cfpfi+jGcqHTKE5O03jE3/lQZe3H6BYf5JAm/lFkeXxSJzL8izzxmmBGsf3wE723NFx/IPxJxxIVKL8hLXa0C8ku4p/lgoNj53MV53mMjMl3OGlv6kMdyotJE8gexmKj8gtzIFXi6LYJ73kCv95dGZujFB6uanFINuaP7/sooqLOxOdgc9Aev+sNdNth6Pdx5vbX/t8Hg9WDwYK50k+gu2dLIeSuwNNzvD/aIpeHr7cHrzZ1HsER1gOHFZ7G84PEUyn4272gfHtjxXQjCQlf4bfs+i+Zh+zA6eCxTYZFeiY4YgpFN42uGLLB/HIPj0PypZIs5AevsHzMk1YG6P7k3noYQEpnli53N4WMlIW4WKinrXx/jqx6bIdwCor75qrF8Lv1yBa52d3a2XplfNmClHsHlF3rjWFIMYT0ib/WyBQ9RJMXGMm+a8ZuD7b0H0ZyJVPL4Qtemr0DxFwCe6qnK2vasKHdr+21HiCGuZDpclgUT0pR6uQbbPF7MuCke71V75+t3WFuUgyctSv1BqlpUJgm5ocvWzQ3p7uz8+ssv+4evjo5/+XWwvzfYPxpuHh4eHDxM4jYBs3NNd1JtJeXLuMwCdUQE7B+ixKjW79FmVGau6AkBYMmE/abYW55M2SEKWRSL5Tjl6PaOvio2PjqV+awYwy3cmCog+G9MFYKk442pGgbD7Y0sDTd0Nv4GBEP/Cabqf73d2nrVf7u1s9WQP9y1nd3+Q/Wwcda/jYeaORfVklHnKptxgN9OYzXmsbPmEpE/kslv4YHWefo4ehTx34MHWldHhjYDgtdYPe2Cjs5/Lk3UHnv784gn7FcEFGQWKs9F7bGTJAzIIX3adf9uvM8K549ixfePOman1f20dNQ5qyzhF3P2HfiaNUYfxstf2W80r7jdmkW/l0/F2CTGTmnsuq27Kbd0T4Xya8B/E+q+EvDfhLIFziFB4aTpEu4iN1V23JnLdOpBtN9yyVWgVOv8yeieCuW+4pfvmbdfba8bvNtchDMyEEsUQ1B2cmatPbS50c8I/axAXpqIHlA/Hcp82VXB26FVhI1Feweca8HjKikKaY0iyVsaWD8JPefXqm+S7MNGMqWbfT1rp/n9wd07rY2RjgTrZ6m5yZoEqzSfsQOy+aulG8Y8uZCZ6krWh8YCOhmdtvcIPzxoJamrrWjIaV3ZQ57wWnGLPZ73kDIV6mKh/HQbb863KpnKHPUU8KRintMPjdnX/w9bi1Wy9pr1X20Fu8Ptva1Bj63FPF97zbZ3gp3Bzv5wj/3f6rNeU05PpnjXP6L7n8W98P6ELcedsuvZcifaNvjbNOUJ8DZLM4sq5ZbQnUJrTe/R/NA6oDXgVJkaNHuCCwN0G948YwXUerpves69bcJravJitpgtMwIu0mZpj4XOKPNIeK9yD/KVwiUAzy9yNSc17unp5tP9WGW5SvpRWFmXhcpyHnd1qtbPaHg6UXU4DdM81pBbMvm7QWAvsxZr8CwlTujY9vUB+gWxQhOplP3z5Mx3ZDSuYIkYcS0jES/1hWVOMu5A88+m7Pa3B9srR0BTMYWx0aGy+kAz3KWr+n8/bKOpI21l6GlVVn8vxFiEK+CcPQkl5wbukP3HYGX5m6znLBIk1nufayXcXEQbByk6NsiEb/xSiERlFwcyFdndm6FZdWTtOPeL2y058EB/teYctFELpB99xhY1p8Il9BjSTDaxMedWta8iNS/bQjy5pvYNAZeETmQSVRSzZnMBrclU2Tma1bCGE/b26OAMT0sHwNYTXu2lpt/vkWY5k1G38dCWTu+aKVQYzywE6oZDqvla16MvcyIo8Daoy+Y0+/ON/fkORwP7E9+z27PckR7upswB36czMF1M0sff1DdnLTmTEOyMIwilbwJvGEXYbn/vjnZ6ePUfvqRdv0iFufoDdhBFlqiJA4HSmGRmiPGSOjWgjtSm1ldJpMlBpqng0f1roJtYJhY85blK7eHn1VvqRZYAnwzR5x4jUrMZ37rYGW6+dAyWBZ3lfea3JWwyTcfbQ0EocH+qsgk4ZylqxLHC9PAlQ5Pux47JlOg7r88MaHXgv/mWSevFHyBLMyIBrDmkcUsiFaG710XbrZe9yAGglURsIQBwZHsSxEtbM7qK0vnapY9fv+rx2xQ8fptax++kzNGSAxS6ioqzP9+h4g50n5w6nJ7pbWHOIRSITNDbysP0Rc8XfDf4yex07+WqBNgg+7cJP4cvoiDeHTMzaL3RAHDzYLq6z93SgZ+9wYA4Pa7ZvhlxxtMIies9diXTvOAxm/NwJhOAbh4BQT+1+YkiNYii/12M0XCBANiQpPaA8317+dCTGH2ntQYTlTqihl13s7d7sVvNYQ4XRVCgNXWVuNZNS+Do0cXtkOtnIkWbRSpoIhfNdTT0UMzNO6l5PFUT+nSoSsNSeywy19rdAEkPoIOHbq8xNsIjQTJlEw5LCmpwMGi3ml7TD5x6C2Me2uCmb00daQEbiqMHzdQaDm5E+/Ws56C1B0Tp0GwHRujxwGzzabr7pJYLEcnscwDou8Cvpn3sk3uu8vKB2lboshdTXkzFS0Ljsy12dPOqF3w6BXp6CU7DtNx5HKMb2OfspQFIcRgKpqNNqOJYhH4R6mqsavy/7nnFPLlIviW7X8+voJkgg1I9Wm1unYv2M9LzwEj0yQj9I4FBgCx5i8/hRlQpey/yX05OR5YWbG7dPOmtTIqblrHNB9XEn8mNSN6OKUdJ3UFzJ/vw9P356eh01aWYChV8R2F0IsdFoH/wUHqVmY4E7O92N9kDwumayO8upO6T1dXWNCStGlYHSTZ6dA853y60DiKb8noOr38P4XWszXOI/clD7BDr9xhm9+j6PkLtIOivH24v+YWN1pHk19+YsS2fmMs7VCe5aVNV1vZlpgn5TLBLS9klogdznJVU5EWaZDY+jA9YLzxYr3Aloy74MXFrmlf6+JMHmZOjbZOMMvdlhnyjPwvRg240odvy+QEvFDKZAqBZJuj3kzKRXMlUJfMq3qjJu3KZ7uitwsj9hmQvx4LnAUmqLoXFPVKQizY+sWxMLurFknbUOQ/vGfbRm4W9Ozj0pzUfRFKNAL4eqWWTJ6QV5YdfD9mrwfYmxJ4V06kATOdrdszDGVNhLnL2wqBg9thef+zSzdCOLxcvmfSi8SbKcK3Yv1xW9P+wmbjhkQjlnMOlnaJUaSqvbCyc1tSNafa5nhj6Hw0TMznFMzt11xZpwEbapcQrDH1QP1eZWLkBPncjzpaLmWi5PNf/tTYY9AeD/s4x/Xerv7kF5Pv6L7fX/qe6J7o66+/vPOchT+wR1yfcO93eqf6YyBsTkrJ2C8UZ/iyQxiadbcZ8P5Gie5w2p03YKuNFyJpCmQcEjIcJhqWM0AyBXN3q8uUK57R2iEwbjkBMsSufJPRwW9AB7hUamELBIYJiO4Bg66QTHrqJmWUPUny6kEON1QUPP4v86Zg143137Mqku6VNRSgo1c8y/Z3w2vXaOr6/Eb8qCyZ8LuPlChw+Rt+djpgen72wNlsqImrhFYmx5EmPTVIhxhmAd3SArAlEoT/ZoLuI478AMEjjjQE6po7S5tCiTJSp1dB9x0N2OmLv1L/5lahLy+tp3cEq13nQszmyccWzlF+bpp4NyreD7WDQHw43++aluU598zb+K621j6BoRHbb4v5Rl4zN+ng66dxNsZ3PnGcET1TWY8W4SPLirjPM02uZ1KnvEO8GyZukMC/NPLYFYK7KdnuitY88tK8yYzLbGxEfHKeKR+RmiTSUPNa6TVZM8FP3cWpPHcfqGiMbp6Z8C6MXvBc2Z0S8fI12d8VND54aSTSRN2Udo5Grby3SHNgTS1Wsr6eCRUK/2WE7WffK5FqgRZUxFP2OFvjE2C4AKxtbBOwsFjxDRmvOioxyIWFKqYVIMANPCH5A6H44x4cjaiuMtEq0VpPuvmSup3jTMic2/+ue8+NtFXMwOtotjX1uprtXdQ0HwXA7GN6D4vQ0vsM5oH7UpO434PnnMFZFZIHFUvvIpKsosOzG/afZWSw/C3aZbwYABi7ml9R792pe7rbmM5JxStA9YFJ517I4e371RumwuxHbHPeq/1AsVkTQvc3QGolQJVFWGkmuF2GxaC7b1uZOdXo4QF/xLdG98Vnvq9MURUwQELRTR8whlF/FjqoGQ4gAvA63PMD8kFc5GF7PyO82t7icMH7FZYzGso39dhCPRZqzY6T1iNo9SLKhnKHgr5sk6zH5XefLenQ+7U6tUNqaOlsjwsNDferpbYQW81A6KK7vUKUm9dLX5dD23CiohPFEJcs5Eo3MsAwiLpu3MvZRd9KTE3aJLwUyusRO0T/YMDXdJQh0TfRa1Zv3AeKOJ4kqz6oxmNo2VScx7OZWMqtFfDSJaO6Vx5LxzTTaaKZSi1ZKbRBl0mTaU2mcVFpTFKmKRVaVxZNtXNfOFRQxmsm+DsN6sPR6pQkewev/WvssxzzhFzyaywRh4FQAehhJZRjw3kaolk9AV1QyP8/Pz+7J/PzVprS7utg35+dnroV/wJy7UqSxdVWQwI3Ogbm3l7AH09hymgoUxD6gDMN+Yayi5WMieTjuPH9daUpRYXTkQ8PWyGQ0a31d9vZe3U6iaYKwApHf+/k6N2F6vfB3SuSNiGPFrlUaR+2S6WDdzimnMbtr9V6AWNLOM8FRvdB084fbW69aSe7s0l8/YEVDWePWEnjbqsi6csnFaprZVFMzLmNhLNE9hXhEj2+RIr8Z0N9JhqOqkvprm4zKTpJ0rdETlmkSjRTuiKeRXnIttPLx+vKP/gdNWf/kqGymh9vyj/6hIVSqBH8N1huS3twS2zu7r/pib3/cH25GW32+vbPb397c3R1uD19tPyA71i7SXOQz1dlCVdZCT+UJ8yyVMNYUJboPg91gYJrj2AjKtJARMuKpJbnxdKPX5QBrZa9jCrawOdqHjoV5PIfRguFdxAWWC/uzEOkSIcm1cqAD2viODB03cbNTOtAiFYC/Q1pRyAujuS10uu78X8tv1vzavWL6DSMJKFFzHi/RMt6E7hk7rQxkmyviab+SUisTktVmMAgGje3x2/F5j52djvDfj/iPGp23r3nHvY/W30mDcGzVCWmRqmqpHCqXOE4L2NJ1lSN0Zox52ySnOh5dNGU8A3Eu8/nLQ/2F/jmFBPWZDNghWmukNtw+90nmblCvVz/zZ0Purj+sOek2/jIT8cKstlllmgYNADLmqskYmwMCKJnIKXXLM6qoefDlnE/FxlSujOpvqAxSMRFp2hlMyQczfJnx5R/4xk1h4b/GsZo6UCOAgNVozxYqycRXt1f0tKsaLD6Rf12L5S6Z3G6yWNl8bZvFUPs4o8UQ/a2VoyHj6bSjt4RPqB7NqC36Uf/lMQqyog3dqMYoexKtaIQLvKgia0n1XHV76peBtuWtnhvTybw143N7UC0c6/a1g+gyUzRPA71mWEJcIoLv755Ufnm70wsF4gYw7ihlcVlAVsBIpjCYKbOEzG6dVFObl1XiQ9RXRLvuJg8sYWqst4ap5Z7IVFzzOO6xVBXUsSzG092YxzDiUov/ZZ7HSGXfuGPixprxJKInNe4SM0KVJM5QOzFf1/aeGZMj0Wgae8OUItDE2bEykWRoVIT2SNmCJwwcIfUlXlbosNkoLaIo3xOdBlg9FsBjybOOtpjbIuivh0e0rLJiZRy215IZb1fPDMzQSSqmGlTaAAAYTXUhvCTU6R6ywcw/UhbN/0PhK6Tll6JP+Lzt/c58cVWtIaPO5XVyVBdWZXuX0hq9f3dWMmgGZezkqOWGW9kV7DDoXbKISW7fEQ3qRT67h35Lfaymvp56q6b3aKj1o0atNAUPcWPFajrF4Z8LdMuX2dzERemXecqTDNQ71wXKDrasq8+GoitX694a7cZ0ZlyrK0M4DAI6ckOl5fxewLP6TpMts1hN3URj4V1dBD7BLkGu/ljw02WFEfsth/GQK/OAi5lsl/wqhzAjwISI/PF/urSGBprUpNy8FrNLknPwEz0PINRMf4BDq8UXrK+sx9CoKHjaPlHVTdLopAnBYlbt5xAnLMSmRrJ1LXnLjMjubLW5UovNeu6HnveaZ8n6eq7LjjE/FSdNTa+xSCEh3O0+rxSnaUNtXPF0A93pJkVCDcmywB6oFTSH32TvC99AqtJ34RBI3RWB2WUwsf66bMwONR+kD9n0iIxxf6iUnCggFWTiSqB7BiI+Vbx7jIg8IMqRnypBUUHa3kSPzqCg82HmjZTQq6IP0BJZRKXBvVQFRYIWRe6fKnemoX0sMWwmUms4jOisuj+V6C+MjdRc2JXUmfKX1zxNLnvsUqQp/k/Sf0rbgcctUUWRpiqtLitOdNrBup5Xy/HMROZGxyszB4anKTFzGP1FVpCp4B8sf5Qw5pnNWpeJxNuijvy5GchGMJ4HZ2GR5WreXjmk0qltdqXbNAZjpfIsT/ki+MX+qyIsHQKkRqJBLBOxgkIy9Q63SQijePnDru2ZiTZbl8xsO/gWhnkTjfQDhrUjU+N2e/NWVjo0Ctbr2+CpuHO/b0NGsulxDvws5AtgiLhBQIWuh6Bn8TDX3ysna/8KxiW14K6kljPmtk7wb37FW4VeJGGzMvjJZN4QuZkOB8PEqetSrku3xpK0ANVVRngn94FVBZWYO1ZgLjIq9oIbaXZQ5qpj/E+YYZFWgO44LFvEMqcsV5kzJMIkOuiGVmELnub+o89JQrszRQmJsQYuzbD22VYLz6/l4Qk8K2oXEdGIpbtYblwzisFO0UNV2LDM9hoMBaZ4yI1JPW15DJtgyTLcDbqDfGgcKNKtItKpgCIJVQTuVcoScY1EVAHjfK6u/POlWBgLnkBANZI98Zw3zhi1SQHQURKxSIUXJhMWV1QkM2RLRSxTaMURcroyx4KeZfwyprGxkem7NmyUoupBOHzoywutJlpO3Egs2HCfDfZeb+6+Hg6ocjmmHMF3S+cztDR0sbtZ28jVvdx6GhVBnrftWpw5c33PRc4J2NUcP1LHptjcmip44yJzYA58lVIQV5KbYVyObiYE+/DrYcZ2tje3cYS3hrvb1XwiY+NPeChjJBt0Eeta9zg0/VWYndAqGqdA6tlyZkDGDkIEhLAXc+VxhRMNtm7BFUI+sr5GS/AgNyS+u7nV3BSbW3fKqMM7z5MUTM++DtmuLKwaH7SZX7XxssCTagmS8HRLXVtmO4+l/IuXWJRDyoztsZ9K4fzNWb9BVecYG0mSxk+p6xkTN0ANNO6zVcVm97iNQjMP94fNHTLc2mkTqyPg4cfo3hNjx753E9T9nYpfTm2gqGG4pzB896fE06xP7MbVUqpHU0+ORi97vqcDV6VBvDmZUwXBG0ff/vEyuJN0OE7ksVrHCcSi2UuYu/GJALoFFImSx65+jbFQLXQwyXBtv9RKSmPJW3WC/XzndrAh+ZttBjdhtd53pU0ATXbbDvAc5W+4+B4VjXU/Nn6vXXkToveDie+9X90RUMShtgH+Kswj2A3VfF4kxqvVISVArhqTkZeYkpQHZMfxYRpLW9Sb6VGgkHZ0m4Nohq1DvcB2vSrrNVZ6WCg9966OywEtFJvKK5FgdavxAhPbWaQqV6GK4cmV9TA8Hcs85WlZ9QrEXQM/YJIXkmmmbeO5DFOF2LsMAWEJQ5Tga2BAE+6M/+Hs83LhhXlk+GcPN5cYK/W5x/Jr2HKpIebarpN99MhkXhjr/JpiPtgvVyKJVOrnhhlaLDORwC0UuaQyMoVLn3kjQpLhyZluk5716Ikp6/lpJ9cytb3vPE3yRclU1N8NREQqLNyzjRs70w9obO3EPuvgpjo+HK0172Au55U7uCWNoOFVPiSFYF3nPdKwOqhOWSz0DDVWODdU3FDL/DuZsEstYJ3XcElGxCWEDX8Zz6r296nBOeqxS3tYzZ+0qSLLlciKeVMAW7t7FQEYDZIvLzp7i1o/0EUBauIC/fDdSubYyZnBZta7iWfsWsSxUXJmSOaOn9vivKr/zEmgwqdcqbjPp4lCtI25xMlc2bTO8qxO4mol5FvB04TNYfDxvK2tIDZILKezfMMJry8jwq9uynv4enb6t+z99pu/vftt593/3tibnaR/nP0Zbv/z7/8Z/FxZCrc1quvwJFGOtSM7uL39rbrOUz5Bw9VPyQfbhFGYU0qB39efEvbJDMnYJ/aTfV7/lDD2ExPev2UyRqdG/YMqcu8nhCXThMfmSzf2J39k9hMrEtrcn5JPyT/wXjHniwUOM91YRhvpW814OXOVyFylFh1R3OQ9f8iWd4pSpWGY9YwRGB6kciXFdc/AqbvoQMY+rVmG1/yhVco+rRnu14I76bWiRhMxkcq5yEXaoN8f27JyN/0VwuvL6iaqyKOVOb1Maz32ac0tGv3kFm3NcGuXzRNE8CkpI6KVr5h4De47mtVRxGhCnkphEJtlBlzoJPcppfa62MDjupVjPS1A/GIJM7IrTOqFmyQASh7ajGaqMqwms+TETV6Z0RyKlrksjJQ/qB3NBvA8Is7L0lev0NXL2cVvT0ZnyNz0h/z97L27mo1tnWbBWl27mMWrqJGJSq95GonoQi7u0SRy0aYrCIjq5MxWXuqXQy9u7v3JhE0Xqbpp5vAN9zeDYTAMqg8BEhUznTa4IxS3M3tZvKep2AuryNG6HjQEKp1uaDsNJkO2Ya+Xviau+YvgZpbPY5cNwdjIXCtkvqAmHofQfiszi89jOU3MhYaNCszdX2N1TRdeRv8yVTxuXKol0Ca8TQZv46kh8N2qoJNEpF8UZDQuSkAj+WkIPIKNKBNXj4+dbzRPcBXzxHzYDMqqZ4uyuBKRzrHPfn978F7vsD/7Mun/qX+Rc528IDNmUMICdoDMfU9Khh774o1pA6njwvRv8zROtHs01bIMiswbkugAYpVJycDFSNqljN/vDTaD4Z9MJCFfZNDNMOXAX6nmdR6WG1S7u/8U4nOP/QMYgTOefg5ervoOTsIPDHcrLOdjTgzJvJkoVEkaq2+24eARHHQY8Tg17rveQLelBN3KzgMTtzpk5H3piGqMDN3LBXvMeDo2K1k6l77Bzm9Ikmf/kBNZIbsVf+ouh6fNubGgU49xb8x3Wxyc8i8tLo79oxvSOjvtTs7mdpVrozfvYfsxi7X+9pWN5LhpTFKOuAkYLp0ei+n++DcPP/fKpAz38e/QS3YFqVaCjuouRDgyZ9Uutmch6AgJoRxw2+kIx/i/9Tw+rKKDgSwlHPMlUhyLaNFjebjoMbm42u3LcL7oMZGHwcvvT/J5WBN8o1jgaWRuUo1PRyfsnYpEzPJKEAnM2G39FlIMILttLUEvIrXIRNhjCzkngX5/4gTRFXn+yPfoX+EGtbzYUfyI+Kn/uztC4gde/nI1JG5aR3MHfNiD2isQskeMsiWQHAlysWxSrK4X6dnx6UsmUfbeEftVM96EAHDPaWjQ8kb0nEI/acz2OtJkorCAZmCGVfI8HQhRo5gFLReLZHUBsExNckwX2Abz9d5L9oUm67FrMcZ9dUMuu0zytCAgPlNeo5KNRUr84pcOSNaQ4MU4zMDaQDbD+iR5M1JGQ6yyjLUNDakenL0zojHgQBCstz+9Nwygut7+hKEmlfoBpBIkS6vkSOqaz8zti8ymTeu9kTG+gryJCzOqzoxKZRiwdzrnBfc4YKrB2fH5W8Q8FgqdRkzDPZlgAQjBuIwvuWGsRQffBg9eoaK0R1hmVh5YXVz3D3h3EX6ZyONcSHumDbgtmym4YH7JCT2LeHUVZCtBvkREeddA++mFJ2x2fwgk3CFPE098Zh7jvAWMjXT1DE/nlXCbG9e+dPC762jsSxhV08Arr1fTMA/jzwcENITcrRbrMg+cQILnqpoHV9U0ZCijzgX4bctsGhx3aCaUPD953U2DoR/ZXPNZ+MGttgZTzTYdT8aPdTtspw77JGE16V3c3aaDZ6Ly3MhTwTF09a4wvXBPzAtGjx2bsH55Bx29+2ePvfnQY2/FFJ+AE1kX6BmSpcILPYzIVxXsc7Oz52Znz83OnpudPTc7e2529tzs7LnZ2XOzs5WandV7nVXtXEuAcdGr8z82kiGTrxTKkEnFPv3xYhkyqbulz8GMBwczZPL/XTSjyXJTe/xY4QyZ/PjxjAoPf5mAhky+ekRDJqGa+xlGj4to2FxqE8wwjDglbbVVI5pBUQw36D3RjKN3/1xZko/LNiyzCUu0veridtwBs9L8sknBczPMr9AM88nO2vphCcBx51raQgH6ID3ymQoYvwTIfbNS8GPxBb2EXjewnJSpgtamKF8YMdec0i0cah18WTRim/JE/qfuEp5MWKJ8TBHQnAgRichvv2ToisUkZ2K+yFscueEFnm+Xo9+e2/U9t+t7btf33K7vuV3fc7u+53Z9XbTrW6QqKsK8I1KR4mRmuMXIqZGYbQ4GFfoykUoed1uCY4NlSNDCSUm8ghVLR/P0P409fz4r0aR9yZCYKK2Msu/IA0OBnneqzqlQmg4P0s7s84ot7SlHWi5EFrSB5Nniq9TBVDJ2aQ1BQsyLMvq/Bf0fGWX0D7QNJ1w9nX2Ef5UJbi0YRHbMikgr5d1PKdTfaeDVNtxoOedJXgt5t57fJyHNbTUzReBnmXpmdSXTtP77ewAYfPPcZhWKJEWBFm0oUoR+GLdERUAeH0+sgQ2PgR67Kpux9kDkNuS5vkX0fPA6oOwZT1OeTOm1ZyJj1FMSDdTVyfoTBD0FL46ur9T5JI6Mkp+HIKN2Frm6vdWeT2rQoRf57axCf29Zy97OrLLKtnXX1IiuqXu2LhThqcW/dYBF7du0bgStjvr9QzqQz97jyt7jD+w6PvuNrX7jD+w0PnuMzx7jKh6jOQ8dbZXGDl9VXZXuoiUUha0WQdbc8mfer+683DNx/91OUJbIdNKwqLrix85q6TvJS2BY0qP1BrpkzXL7tTKFBDz0rLBhkKLfozcqJSu5oQ0hmjxTfFOOhYQYDFFmRq1qgfA0nEkU6BSp6GjFzZpUpmqs7s3e7sXudoW0cSHj6MIIqCPa1g/MmWldNZxhoqJcpomBYDDbwozJyl3R1qjbIVGEaj6XORu9OcBIujFlKuj4R26Ixund2p1sT16Jvf0o2h2OB/t7e+PhphCDwWC8v7e/u7u3++rVcBBGqx7wcCbCz1nR1R12aIZvCMtySP4JwAAtBnJjN+zujbc29yO+v7e/Jba2B/v74atoj0c74Xg/3N+uxmS8yTvi6Kj8wTJlF6tO+elCJPZ1eZGqacrnFCyJeTItcApyZbZURlkyG4DDAvDuhsDLsyzL3FhZZFhh14jzIgtVZ/f5SRLR0iRTNlPXPsPU8dOtqEn7R8PmPnRP3GPTWI153JCL/nUbIyJagYmI56KN0HMoPkIeaaWvKrlYhiLJxArTPUZm62/18KbhioagqUvOHnZPT8B04ixzHb+NTPFNQ3DFtcej+ejs6A9mp3uLABuhFLohF0CNGseiBO7JFtENgfaYIbONl009c7Dg4Uy4gTeDQYceQesV4U1R7hxVoaLD3jJngAAt8R7tusnGhvKo2ygy9GkJebxxKOKYpxtTtTEMhpvBfr17JgG7hqIj4t8gnroAvSotJ2MfP7y1KstZMAT3JbPSJHGd+piPZFvj1G6lqYIuw2Za9b6BYbMC1w/CvbY7ptJwskHz7ubm1vCrOUHnJnDetAUoA8L4Acakq2wxtKigmXu2K1M+49WPzHnCy94kzOCk2Orz1yxdzHssWnye9tg4BRZfgl9M0dQtKejX/+Zp88yni/mqy9itJWYXtDqLo1MfKd/4r9r9x+wN9bF8jOX/D+3vsTOV5tj67PhGhIX+54uz45eoE6cuAd+VWX149rEyDct5OhW5C/5OZMshvtndXnW5q8H3p6beVgraaSrPIyC9Z2GxI4b8IjVfyFhQJ6wGU+8kcBLVJGeHKl2otHyaWIFNj6quWfV++0hOz7hfjnUPZxi7Y/fJsWameSRbu8FWsL87GATDV9vDnVX5k/MFoHE7Ys0D3gVHco7kf1gCjEPbgMOAHSSWCtbvwwHXH2MeXQx/MUlmFillIpOpSBcpIEjHMiE0T4KlYHyCN6kUYLILqQHyMKxCHwWKQPf91m7MwIhZtzXTvWZUGBbATe4ZKHONTIROhlOk0AHCL+XO7QWtJmJ2L5Av8B/xeCqWgtB80TB8I58ByKOPHEzoo43NwXB7YzDcyFMefpbJtD/nMeyOvhZOHxMiwANAyOaFNAh39wZb4bbY39wc4h9RyHf2d7c4j7Z2o2iy6u6wDXousFIttUtPfwa+RIONzg5O3p8Hx38cr8qfyWPomikzzZcwt+b086ebg2N729K/y2CgfpRbu5t7j/fQliRZA8D71e3X//qqkT87hTsR1S/ypHxSpqZkiORaOJnKeBS0dcMxGW14W9FAHFeaR9HL46WdfiGjS6YmuUiAw73MbIxZT4Xor4gBueNWF1wtpFYz2Ija7zaRaJgGltwyTryaPTPNOtpq6wdpypcG/ZWExNMpYfBlPTCd5i7ODob4OFNxkQvbA9QMSYW4TDjDzVNl7/gSRZ/6vV9LBvCBghpZJJnMkcntrVlTJ63/a438vLFMNrJshjztfoz/IvCB/x8OAvxvuFvP1obcLqhYdAXp3Qod+VYk09xdRXZvYGxKaFi29/wqLx2bcG1R4gyYNjiGbMcFACIZT3i8zGQG8J6ZunZDznmyLNeEXcM/docfwJpYI+/IsHd0a7gvoPIWUF3WCKHmXhbYCbj2RbaQoVRF5tpfNJdg+27NUEocl+QFgIk5bO9A3Mgsz6rCb6TOjJVCN7U22f+i/+Q3GQTGFXMz+DC7daLX87QQ64+kHP+SybTD1gLns0poyVonmLiy0Wq7SxpULa8fAH3cx+ua86SYcPJLIqTS8DL6oPOsgha4Q6rliMWVARQ/WKAQ4KfTEXVhb26JUM0DzCmCm0UYUDbYY0Wd87zIvtkTQyhSLAFAV3CY8uIWkdtjbLtTh+lykSPEvJjJULeLzUpF6Y96xWMZ+agF8BFTAKSa+WDvXQlWJO5Z0vbAs18tv6Im9fHdsAhxFgm9L4iouWLHHz6cfrj4+P78w8fR+fHRxYfT0/PHLllBxcZdFaWP9PAVswcU0LkXaZ2xL/JAa5zlgs87PvSY4ilPPo1Hbzo42rinvPNuLMigPOhu0Ace+OO/v/njn3vv9g5+f6xoseXFKqK940ZYHyFZMDNwueUZajkXLJxxWYOpkJE2eMuv3/Y9e3HCcyDgSXh0KHyvdMSu5BhAUVbBGlESqVRsey/gfhXxktERpWmNAlh/0ruLlMYXirn95gXJVMWHHuF2PuhH/Z4IX2uKB/PyFQ3fICd7SQ/11YbErWqPV9biHp32UDnN5zyJLlZsSP1tsquq60AN9w3dSJsxFX9kmYvIVxf15Dlrqru5/Lb9pamuNzWP49Jm9FaI8sQbxuQXGPO+Jc/6MTRaypwBv+pCwmrqtO/T7Vm9bcpZ1DAXtDLSlgOh2k5kWR+NULZ5o9YY4Zmfi+9GVRN2TbWdlSwqeh6DHecMfp0+SBnYHz+eHPXQ8G+uEuuSs98+nhxlZXYVkHS9nlZzHD+wGi/dpYIN5GG4qkk5mcf1oUqyPC1CUqfceLqApWhIDmniiFGAqgU6PwO2OFdsLnM59e2Xs5Mjlgpka/httLzbzoAko7WFIUj3DERUp8c4rICsnjDOLNoIpIc+OM09GW6G2zs70f5kf3/r1U608iZ0Z+jpduE3y9Q8qDn2/l73OA3uOs816ci8BUjpYa43jpa4QUNsWH9q4lNV4nTRBssF3GkPr7h2Qis3NRziMYrWzaXmSmfKyex5p7FM408zsxuXtHDLU/5w69V/3SN+KyYcxWAe7awgpccosndHO3Taq6kY9JtsxocdzTp6czC8Y9rNnd3uJt7c2b1j6p3hZndT7ww3b506i4RYdDX16Oj4+MybeoV91/Tcfki1tW6vOczlnXjYLbgVgHygk99SuOoWXQHpnnMZtz3J1/XYgqMhcPAcgn1YCHaFLehJ9jlI+zWDtEbwP26stp2B55BtdyHbWyT+tSO3/4+9729qHUcW/f9+ChW36gH7gkkC4cepmj9CAjPUcjjMCbPzdubcCoqtJFocK2s5cNhX77u/aqkly7EDDsTAYXJr7+4hcVrdrXaru9U/1pHbpSO3C3bu4wRwiwlcx3FXF8ddwOF1OLeicG4xv9dR3QVRXcuudXD3QwR3cT/XMd51jPfNY7xGFu0btTphrFKzrCyauwyL1vHeEvFe5Narhn2XROv1AsPLI/aKoePlkXvF4PKyyL238DMi966j0K8UaC7PrSnzPkBlU0rMX6TGKSXYwa9qop1PX6PaKaXxo9c9pZSuK6DWFVCLK6BSOfnwtVCWUgxSVk0eLvM+qqLyfBjxYDkf6Ol6+/PU00Z6VcGQc2eMMVT8iwwY+FgwDclbFn0ePHEhsBTmxmzi+eYN+8395rLITVfP2ysF2vBxk0yLUW0siaryFUvgurC3ihmF5m4rRgZz+G02642DnXprp7l3XT/6VG992tv3jlp7f2wuibXSpYG3ei5fK8DkvLsKMUAsK1SliG5hw0m9+k59WaShVHJ16L6Ks6PKO51TGVt4++rzmo4twjEi0yEhVFppBWQ80qGRHf0Y8KHqw5IYjIk7ioRQMojFPQSNJUuUCuYJImGCWPdsoPusqCLrKAl1qz7nFqHsfsymgHmJDXHkPMOlHvNFFGT17phKMmAsIrNpTm4ae81lrUwYvgQpDQGPmZ+I+OHHkB8QE0SdWNTNyYWsyrFndywmbJdCi6TSXPoYDvFfxxP+0C7wX8D3XTu9a6f3Uaf3L+Dt/uXd3Pfo31rkXt97tUu/tW9qEHlPnqfB6S39yjkc3oPXaFF61z7hI8rg4ziMhj9v5w4aDH4cZ6+8YKzAEzR4xmzEZRI/uH2nvrqfLW48daYIh1x5lY6YCHMSWgBmMAIMFSrdlgmyvDzV+nR1O5XBe/MLGlNErULuY55AMyrVZWRAJTvYJyzyBaQFOi/dmYgtgXGewLRxfY8l/4DWc6ffVRH4Vzb6FXoU4We1bG6sal0lp1rGRZrmNhXcDP+9Cad9+OzGs5nOwozEhkpntFtSmAOWGNP7jsV0wEPImKeRm7iTppFCqOjr6c/9k/PL9td/aspZYMzonFH7x68ns3an3v7HryfX7Xa7rf6Gf7TbP/3XE2Kc2WJtH8xtcs6weNYGd3T2rG6iDdsLL4peD0e1pdt6ZRkBwxoiXddU+EvA2uyREQBPtcSXPBrZE4eY562QqCXJFjC590eNwP+e/p+r9mW33/tjW8uDm1JlceC2K7QeIoJDJPSS7N8zaIYswZrDBZUAA/TPv11cn6u1FGwDLgzdYR13NOaQzUpC1YlLUxLNJjA1R9GaSjTA7P7+5WtXC/Tpz/1f4a8M6hZuRrhs/YeZVm0HW2uHEDLCyM1GY+OmIAFs88+NzqdvcUK/xSzoJ8n024BH3yYPdDqF3MEliuKAnILpoiuRtl5Co4DGgZUJBUsfqKhFTDq3nKcQGNsrPVB9zO+qIKA9GMTsjqv9gvfThuBgvdwx8svfLz6XRfiWPVSA7y/8ju2oUwdSp1WKthgC5fkzr/fl7Pr39tfTb6nHZlT45fW3jrZd/qFDS9/OJxABP+O2WTII6BfFJPntnkfAWJC7stTnu7qvhHzVuwRgu9nrsFU1AKfeUHcAfGbjvr2YIQiVFDHmW5cNZqO0ofeTHHLxXCWLLh3fXq1hzvicgJTD2OCLpk7WVko/erRHp62OlSyBI3zCsLJoSH04oKGAY8rvhLK3aSxmUQDZ45z5QIrBD/SYObtUoYF6QB0CTg2BCdJJMJJV06XogUxDCk9Cs98IRi5hfi+5dlFA0LrrLWCCumACNdMidk4nSG4PQ70EzqbSZyPHfpzKqEn9S6ytjMgNctG7sZS0QUH6MUtsNj9w6PwKpj/FKkxh4n8m+qjGUYwFDKUyc0drpjQAgQZMJpjKXCN+CFNIajg+tabekoglYER7ZkRr0OdTj5wPYR4WVJEyLPI4vzJ6OxEp9nx6U1NPAkoJmAuaaUp7UjLiEAM9vyJJzO845PfXIDN6QpVp5o624IlajKoo5+AhrZV2lvrUOG56da/pNVo3S3Q4rTCm3A5D2GzwxcYwlgzEQETAkNgIFlpWQIqyExSGYDMwE8YnZAamE+HqRXD4h1BtT1oeEcmTmdpMieMsHsRsM4YiDAm3R1DxYaEaxAgNRyLmyXgC8rQFmw7RZzYESdYCBSoTmJUisO09rgwc9gqZVOWkAH9BvmElmcbN4SOnQqSY8ThAAcGSzPP6yGDk7NfupayRQEygjE+tUiPwOkgsxMGPQJhDTiWTpdnCpyV4wqeLqEa9fX5VSFxmpZlkcYm1XiLfsIRabTE2C1li0IxnIcucGebvRw6Mr7MQqy30IGlz32IKDAE3U9Kj1D80tDWqkNiZz3QEkU5AAGJDNMFxbgkjNGRx4khWJFTxiiYsdZDMMA5Ywqm/Qmi6C7sx99W+xQ7iKGyfjKo1SAUTLsHCALWfxCK0EyFlzTwKIq+E/bzb2z2/6qVfmEHXskbu2cCAdIr+nQdmcYiVd7JGWBQor5oEDO6cYX3QCPqkkoxsnXa/buMEP1v3xRJ/CYVLZ8lYVCWSYNXUMvOP4S8ylWwWiOhhYt4cjQR8pf8FClMQH262LBYk3SsjWVYylLLOyLc1lzb/3OglNN65EHGwhPuF4zIfKmJMO53HqdiiYxcGFNRL2hJCHLatjx3DAoSpri4c4RDDx1jRThI2mYLPdO4YXheM3pblikNDRYyBOKHzgREQoNlst+FDMZEnofBvSQyxBpnAvRCZzgYh90n3sqebxP1yfX3VI7vk+qIHkcdE+CKUZTnAg4oIb2saz7taTUHDCl1cCfEIbDevZs0BS8CkBTXpmJIIk6TqsVBwlhKYRr10siMONKuIOa53FC6Y77ZYMyBEgiV94MnQgD0yYwsnsJnJayXIr/QuiWVufhWdInYK9Mu9FxdfOn/vdy97fXgJ+tcXvbK02SlmFRG4+TUzJi0RhD7VqtvdawRJsntuuGC/BcUCU9jAQNdnKsZFdVedzU1JAuHP0rLu7GrKy4I3c3MzladIJKkU1cAn8J0rKwptWm9BA1GdymHm0qpbKM2CgXE1LMwEp5cpY8fbnN9GkwvCIu+e3/IpCzhVEwXhr91nbS9YWiypaHPdNxf4KFlSI1MRcv+hpi0TbRHo+21z6kKij3qzlzr7wWOiZMImAxbn5N/EPPtXqPL7Z9rKKsun2eyd6H644gSemcwIhIiWs0zPBFmbOwxgvlGZ48BCLFYljUa9rv+/LO+qTYW7TqfHk10CgWE3IU6ROWBAtZIdOABN+7Y8ad4TNBmK9Knruki99JNHnKQ2PgeyGrAhj/QtjkJUWfVwqEHAyzoPvogi3J6hNdTVxkC7nxGN4dKPSKbcE1lzntf7P+D6vlXr02Eo7tU1WxykHhNco1x3rtCRUvEOJBDQhL9i5jN+l2bl8IgnMAy+989LNT2RJVtyG79EoAAwxUXf1WhZtEbX/EqoIMOHHD8QJnxs+KJmblEErgKL6AdBZ7wZhK/svGdoEUA2LLwN0B/qVHPAGiyiOcQlXGHar9FLROXNzAjw9GhCiBoVwAQ2h8q5JVw6MALSyyyg/WdFBUJML6h4BHv8r1nkp6ONdLAQf10ELGVtJJIcSHgn9DbqwWbzLnVHg981JGSvxKC3ZgSHNpFsQqOE+4Ag5A4Ao2lE2Hc99QxDogiUSzW9CZqyJYLccTmjIYwRtRfKQCiLE5oJpZlwZ2zXGNLQ2u+KtzQ9SHS8E28qZcLDkLBI6mgEdE9XkQEVWnViryp6MeTOTGQ6ncZiGsOFU/iwjHOtg8EV6b1NJfVqq8zG2OizosEqmMmAj2ZiJsMHLc3qNwiS6GtWaevXQ5hkTCESXCPUhNtAacKp9J1IAXLiEfLPlLOQYvoAVUnpVQge2fTe4GTk/sbDD240y6yQqSShCKwohAp1IjPTpAtE6cbj0xvQaTeeRuumRgI2ZZFSgQJtBrh4tiA5HKfeZnZXpBfNwEgosS+LknywZ5CGAzF3YbHEgIaIxASGKWlVoPmefowwraZAQFvt3uV2rksPnNuM+mOrM4Rmpc4QZQUndKtxcDxPsxuG8VbrsLxZWtEXh6bidLufhRiFjFxcdDL8KMjWyd3jFeQfuj/LIHICX0AX3USPk3P0PYqEVtH5rTrazyCmBfsJzJ6jLfBM0PCzybIjJjyfJw8FWdorWboDyTyFu/MZoqlsbiK9QkdECYeeVAWthFaC0/W92Al11hGskB5pJvlBrb6JuYjzeF+2/+sJQS0mpiIGu16WXSzH7EsRJ2PSVukytADJWZTED30uRVU870Bz5/iBnPe+qHKKHIad9kK0qhJNRKlwlzs0okGeUzBnPh8CzaEzYqKvIg1F616IaMQTuNYC4wPuHJNZAUM2/y/ZCEW08YnsHO55B439o716jWyENNn4RPZbXqveOm4ckf+XPeAAydUq+Azum79JFu8Y48L5CkSQEsOeGlRdgEQqEYLvRjGNZiGN3Ua6yZg9EB+sFWVDO9ZAxxgBSTYCxmOVVEB8BscfOhHDUOhcsAGL0wZhxk43KpsgeiGZjh8k92mIE2RqxDc6KrV6CbkUCfAJHtTuhLK+4RSfqNN+xISh1tuc37uBkImIdgI/tzdTIRMaVvWWbV4p8OoNI1RK4fNsYptFOSVUZXpK187FNAqbAwJtU01k6zYS9xG4rZQAKWohEZM/zq+IQxNRdrUyLu9oDNl8Adg06njEtxrMJfxnnn/H+/X90gFYEHnIZhNRlQoMsoRF9Jj+2vm1swivijQY4lSowH6dsQHLyx/Y+f8RURXY2JoRgG+OJCNwaXrmefuy7TxXiDweVLvtGK46eER3T2YsErLf5jGTZQWDT5+gsvhaP83kMUSgNbd1fnW3Dz7I+dXdwbaXWWtC/ScWew5LNz+3O8XIOJoK+A734SZONKFoiH4965DD+n4T4isSUt2gV/MncgruhPATlpAtDDrWyNHOgKeGOdi62/AzaxrhpeS9IH/OplMW+1Sy/yFj9p2aXFk1E05CKpGJMroJc8SgrxcGBRJBNgz0AgfNmrARiz3Sm/lQDgCpkupBHcGQbEpj032ZWojjh+mYFWjfen2nXt9pnar/3ttp7mV2KqKJx6clzsdi6di8jmkkMRwDMdhM+AAS8gNy2b62UTlsI8nRX0OQKjtrGvM7uLTofv5j29nO7KGjVHcoaEAGNKSRr449J2lAxCQWMzgNvc0cnVATW4LSpaqtXAYA/HfMAh3XklkOPObrZQi90r9+lmeXrTrLb0MZh3PxFlwh21114K4Hp45Uc176RT5loQw8Sz2B6hnz0ZjJxFnU8EivDbmYMZ9OWWBRng2MK4pQdRwa2VfDELAFh3EosEo2hkJ4+Jzni8kGKKkN94OMYoT+5aCETCYmpEvEExV5n8bM5xKsEhwQr2JfIb/FmkedOSBnwyH/biGqZ7bgIu7T7q5OLtBPwD3ctkeuY9VVGUKfYE595xN7TTV4gHlDUwhw09t0X5UVTEIqE5LcCxLSAQthhHAYqitGFfJRLZKB+uuLrrTn6IYvvNnthrc5L3wONzJSYdlepTTYRZTQW8dgOIP4878hzDvk6ZaCuJq8K/OaEgipGVGBByQEkdlUOxQqywo+xSSArKiguHuEnMM9ypTGCXcC6SSHgVIe2P4eQOH3mJtlvRf4CkhQnARhSiPpJCtXNYcD4I5Dz/w8QQMGdzGFYl78TpBkEW837u/vPUZl4k0eEIIWDP1mUJlsGPVEIH4OgBDKmKZdtLU0qNw8u0xqs23I2aAJE58bmZcvbTeeRS/Tdxm54MDYqOlJK5GAfgg8hFdmymIu0gY+uMonApSVtfcSMe0rMl5B67HhEC6J7qDD+RTdXKR+i11fdLdruuGR9ZdSviNMgsqlZi7alBIAkTWygvCAOC+vIOfXLSqOhV0C8Bs/tmZUWnGRUkx3opx6VJ9n5AayZvFWoSqRcaN0aQ2sTdl1sheIGBarACgDIBfd9hWorLamuGtBubKSNYJgAY9NKA8rIg5CQkQtYFyVrDWiEADtWRDI+yFvHoDgTZkeCCroZJOBcsZgOxywOCGnMBOb8SjPG5Uz8GYCqFavXgLVMuWaPzyHwMWDPDBXBlNp1I3crsngLhBU9XiVIVR3J/RieSQqLIUxI0+AWFUPA56oKoECly6TdAccpKigIPsc0rb5fywO2lNxROU3yeBGnw/JDfzI44G+sVV/AEdvjEkE/zvUN5zziX5RUGBfQfZOkVDx4Am3ajWihLul6MgjkZeV56LxZhqtNwaP0rSrD8WIR3miHZVGlUrLsyIW6WibVQuunZAK20DUSuaWQUUTEd/ivLXNPzdu+YBGtE+DCY82apATpDyUaNQHgE/WBxg6wbflfqZCpud89Ej+l8klgrE488lExpNX30Ead6xDeGmOM9x7IIYwOcsXYch8KO0z79/1mEkLGLJrVP7LkENdYBQ4r3goRhLr/uyYHbM2hO0xn26JXBc2HbMJi2lY4aSmU7NG7sXk0qK/xYeQAkL0HNNtRzfpXhSB6mQCHiF2ZZNmmlDMVMskqcfx3yBApcICwWAueOJtzkvVEd0ftur1YYYZleikgkFVKO/xLIrAsjYYGx8P/4aDHdqfxVzaXVCxWDWuNhIBw1u0DMlpFo5t0aMEBjxg+EkBY/EnuSlTLjLYEmRCb6G0N4EbJMmhjMs9gixkJacgkBOWxFA3BiiIKK0kM2CztarwwoAXxX24b1T4WpBsAs1TAldR2O8uRYKpYVwX1UZMJ/VIxtIfSP1eZtBQMQkxdClNPWMnCU3XdkFRngq738DvlKWhj0n1JwicMhRpgTMc7B2yFhsMWZ2yA3//+LAZDNjxsN443KeNg73DweCouX84PMjI4+qOp8UWJVKNuXuOdlLcykhLtqLB/JDL9M0EdQzXCCxCeYEUq3u9/QH0rOCDmVsbhjDAiaZQPahKe21cA7gqszYOLIzVyorXEFWXOm5tgWKa1dykl3P9KVx+AAWn4LJzH0uBM2+RMXfcCAg84IcwMdOknxFsdwVG9gmjicy+ivDlDbzBgwdzLKkRa1PbPsk+Cpr1xkLF8vUhvBgAJDOgLi9XzKVjB1+3rBDBlWdeklan3o00USsS8OJmJCcrCRAthUfSezGAYH5stCJuo9JgIAlu0YjbWgyuvQMQN6y3rjmbYEi3ajFNCxiYsXoWKB4nFjNTW2+glZOlOZVsuV8kUXMIwLNq09wKgqygogx6ECQFUTY16pk3WTAZbW6m9qVqcIpJRioaq4izq9XmorMiNkhiRbIJNM3ctywR6o3m0WjG5djuWvpSqlcazgsym2aOejznhARUncRmYhpMIV8i6Cmnr+CsSkjBi2GG6KzUWIhWerbJDnzh8BiJmtBIJWxDfUb+9TLr7dTx/xoHmZdLOr0sVqmisUEK9G1M5jVu1umsqNmQipSaqqalzwn1Q0dqQIkr87rIns3YCfaEdgxzQ4mzCFaLfwJRUsaGiC0MuHbOYjf/hi5QvffGcrrJaNWbvFhkvs9sB1rgVewIdsuZ3xCbeH9PH92VVAcngoRC3IILRrHWHmqTovBh3rdAajLaPc+NPa/p7bt+lsrPz7hZ6SePeFn6KeMHmQYEuWINuNWF+hKFlK3HwGKFXX1x7BV5ViAYTvUEiJoDAJzPGtZTuCVY8LlRiOnln8Eqg4Rb3GJYnyXKqRB5ojbEvZfHAhGECG/mghIIZxVfRJIH6nYKeAYmkhpR7HTn0/n/CHVgiidURDTK0i0XLWjYkGUmgszU+qBvo8Gq+xUL23hGeHWH8o3FMbCiU7ZFdPhAnVlR8XOG15ZKnf5m2b2Ed4xB8ezbXE0lCPJ3XQmyrgRZV4K8k0oQ/U6iSDhq7w3LQTRKJt9gXQ6yLgdZl4Osy0HW5SDrcpB1Oci6HGRdDvJUOYi2n95JOYhCZl0O8m7KQVA6niiDgEl8KjaBQFU1hKmQKCyFcPqSQNqsiqpFo3dfGrKQHd4L+fEOS0PKu3qvWB+C+sFdL5cwn128UBBWWh/iOqDr+pB1fci6PmRdH7KuD1nXh6zrQ9b1Iev6kHV9yLo+ZF0fsq4PWdeH/ID1IWrCcOLmLV2nnyzOW9rA+aQQBg+plJA5jwnnIPI4/4T60L3XGEq4Fknod8gzePiGGH6zRg5I5efz66+npH19/b86f1dTv4cxnTCwkbxvUS61Cd5poDeDSQoY8dCZOtZr4TG69CbGdd7t1cjlz2e/19RIkm2TiwrJy5OJiCzKXgoarGZNkJdA81zf+5vCyI4ec4fJQNMJtG5t43DcYA0jhasx+rbBJ1PqJ982tr3MUswfq/fZ+5vLhtyiKqkkBXoLZTXgucI1CQRQuXQmd6j7JpjaovKmAJ0asBN2bzINIdMVaBgJGmp+pXC/bThzXyJQfuBw6URDQH2jdNaR3eWKXjf3mEI5tEvadM3hLFZtn3GPoMUvSLORK4SrLXm96eoS1G6KWUC/i5abHjmzSyEsdMktRHRbMBlY7Qv2Oo9GeMrDkB2ISatwJU0Ih3qgRCkLHTtlSSwgax4KXZ0YQUJHI0BF4AuaUybuG5fZE5TryoycDXiHuBJM5GZGJg3z/olz4GYSJhXM6wcjjCCOGkot4zKSLfbds8MIaJJQ/9ab8CRmkFy0q38id6/b9Xq9uUu2N+bZo78pYkyFVtVGRl5NSnJZJrk8mefXCpiU51F2guUcm6qeyqHEyC6ixlK9I2a54POMKwsly1d7CLzKq2m129O8zDHQpd6ivRw7za/k7nWj3jrezTNRfb6AQx/ER9/IVKIZ6kpIt94Rdxtc6a5qRzpiMqFYydvTb2o00qmfU5hEGi/YrTdSFaX56fIxL+zV8bP8bxcwVs4Gr6U1IDSGqsNdtYSsutzN8daF9TL21uuNAhar77x6+TliFq7nova+Fc5inbLkVj2qVqreqitxz+LemIXhC/fqbdRNaVa77HW4/pqsXu73j2+H3YxQZuINF70ngg0YazA10OCaqpGIaYqJly16Ggp/Jk2MNB0wZqb5EJ5IFg6V7wY5GxGAgHtVQu8EV6NVdwI2TcZ2+lLq2GkUvnut+jFC9VmMhTywfmhm+ZZxen0+HbO4IuHrqbwXwqNAOZuY1aSX1GIXzGKT7ORj7aXD0nlRuL7o9U873V9O+1977f7v59e/9NunvX6jedTvnHT6vV/azdbB4xLgUK6SiTyHdxVx4er08w6LIOcxgMLSKNihIdRaursmhqAG8DU0xVUgUs58MRUw0TVsk1mi/rHDvkNpMlwbiCG5yZPU98eURzdEcvBLEntJaYGqtFTd/MPOA4KbxwIX/dzzvOczV2NSEYttJNPltbN4riw6w32ESKDDCY8e24tn7UFa6Wp2gSZ4VZxWbMFKQx7LxEXM1H4pvHI7svnnht4UiL3iv/5nc8kdgvsKbxK0KtqYjkPMEMJF8TSGcZLpYL3P3RYJuIojiSHpnn61+5et6SXA3RKvDKR4qCJMmbDIxxt3HK4OvbsU4+34Z+K8E079nL49ATWLeZYmY1P1/8rtRP3s8KBzeNbstFonZ93D7tHp0cnR2f7J2clZvXN82nnOnsgxbbzZpvR+aTd++F05Pt073use7zX2jo6OjrrNo6PmwUGn2T1utJqN/W6j2+h0Tk+a7WfuTnrUvMn+NFsHxTuEEIlbRf7yHUqh6p1azXtzcHR4dnBw0K639k/PGoft+tFp86zZOGietk/2Oyederd50DptdA+PDlsnp4f7J2d7ncNGs9M+bnbbZ/Uld45LOavM1ummXTlY4Po0/2K+zT/SGJi/lAnn7g3CJSSbM57u0jwDO5c/YUsG8lWIhHTaNfLlt5/Oo2FMZRLPfHUTc83opEa6nZ/wd+rfJpexPPv+Rfcq4l0br83HNEkviSWui32GwJYe6xbQD2TKYhA1ELFe72I3ta+h60oUyDG9zWeNBPusNWgcBQeDVss/bDQPm0fHe81mwz8+GNDm/rLSFImkT4dJKYEK0s3NCg1N2O413LU6NvI9lHNjeb374qqOTyrBmuGrqvoMIFz1ZvIgR/Vms95s7NThP9f1+if1H69er/+xrKUQiaQ/UK1+XpFgNIlKE9s4Pqyvgljd+mDF6VUZTrTB8IaeSGBkRKR3eY46NWFhmBmBqi5SVTcdQAdu0fLTnpF7kHOUJGwyTfDGG50pkgiP/A5y5ahtLtMUq1raP8DCHTHg/JRjEwE3Ox/bCOT4rzJnIcGQ+54vluW51pUV8buUfs5p5FQTI0zytEaePOjdUKq4mxmTviJNLGdTfbvb17505QkiuEyx7ZBx4hXlMKo2FDnebP65scCDb7YO+j93PoMHv3e0D/5M+uBpp/vYo7gIIRvP8n++t+rHHoUug1B4csfUK18VPy+gRYgjdc66mMa+1Wtfbnu6Jh3WARMrfgB+O0KJoAkU044FDIhTcSRXbOG3qi+nzh7RxVAqTywtzoN2Lt3LHnEpJmQLC08Dn8aBhKTrKMjmojKZ39m/Oa/9s7ZAW0aQXT0pLPdc+R5gWg0QT7Y6l2oeNyABkuxy0vI4R7SxvMAYJ79Aek1bylkMNVVmfmin/SJeqDrfyvmgViFbnW1VgCznyfyt9wIanF51LKhyWwvU+1b3Obva+em3Xo18sXb1eeQrRa6ONgxs+2JSc23vAglAsGQlkgA1wCFPqhYFs4zRRRfb88z5DPXmoEX+wdn9Cwhye+pUTJS7lCRbX17wop9H/opopmF/FvHkFUmnIbRHSoADvz2DBXPS/wI2qNaKfRH3VaJZdRdfhgnYyjEmZj170l7XSE+lrV3l5LwDM41EHHH6HEpX4RkqH4km2JprLmC9yBVc4BU16836Tv1wp3FA6nufGq1Pe8f/W7lGzyXuxW7gk9TN+30LKWsc79SPFGWNT/v1T83W8ynTZVj9W/bQpyHkXibjSQkanyOcbQM/bdPJIhbTJFMQdsvyL+LXXvuFtPmz+I5VRBdc5yv4zqUyIywM4QEfv0qpI5bP+asu+5Vti5njRcRlMm01Gy9kCPs+FVFaR/8YT5ya8gzdpwjCbmfAYn6X20x7h1SCuINWa+8QP+RRwL67FD2fWMn/w15AKGwwgDAOs7OXckp9iGORAS/I8G3W94+eg7pkMadhv3TjwReUp+ilTEtBdVylnm7hKTkfNE+dUT6cj7SE0zGNZqp/mBNsyQbN4a4Kuq36IgRjBTwxG0G3oP0xjamvelTMM7nVOjs5Oe4cdk9PzurHR/XjbqPZ6bSfpTEkH0UUwseVK8PztCwIskdcVlskXE3xOyRBgPvGgD/SrW8F+YFm5zOVVkF+FuSCRiPSiR+m0H2XD2IaP3ikx5hNKxnxZDwbgOO5OxIhjUa7I7E7CMVgdyQaXmN/V8b+rq8A7AJj1H95I/HfF3t7hzsXe629nKyDO9A62HmmqsbgwNu4wtL6wgaNeeLkmMYs8EahGNDQ2oTpkNpn0voWru48ab/1XkLDe3B151UV4oaN2nJ7qX3d3vVPqb1bIxc/9WgE5SKRz6UvHF+4Rs4j31OebyVS8G7c3AwDXkKR64FVTFWhn2vwmCcws6GrIvAdOLVz9D6LpL+Ag4qZAdVaVU7ffFgUzZycKO6VJqBCv2VBomLqydjSdxiyg0mTNX1xSaeq13ZRnwLJ/GmzdRCX9lCYTOgAyh1ZUILSgRAho1ERQSf6KzIMaYYsbMwDqasRG4mEq+CQmmEgdec4mEdII7MQdpPn8BTmvUaERcoegr9nUcRCryx5Efue9E0KbAkCV7eVNu92wNRHCm8WeOQKOx4pQx3SbhGmvlZVrRZ1Q6H4gWwZmxGiYZxGVBVbUQlW6gQyFXaTUO4oSiDxBl6dHQ134Rfe93EyCf+bhtNox+C4w+HexcEDRhFpAU2dhhAS0NUonJzUAZa7Da+00MVMziYsKLEfzxU4LueSpZXA4bqqGxyChLanehQdUDsnpaXFDAf8O4ZQCdpeKbMXcVs2szdP0ltl9i7CpCIWV5nZi6SUzezNU/4+M3sRzw+T2Yv0vEkO6aoye909+RiZvW+5K6vO7J3bnQ+S2Vtyh37ozF6ksdLM3h4GUcrl8OZydxEkMVI2z6rXyeHFxf9F92RFbFqQxKsXXlkS797x/v5+gw4OWoetfdZs1g8HDdYY7LcOB3sH+41gSX6s6qpWJnQyde1e5RpiAmeJm9un8lpfnMTr0LuS29tlCJ6/zH2K2Bcn8SKxGNEpQekK1MLTisDI3Dy9nct8clFlCmCd7/h2+Y7uFvzV8x0LefGD5TsW0LDOd1w637GAiz92vmMBQe6lRcVEFd4DVZ7v+ATNf5V8xwI2fNDrJJfSD5fvOE/cx8l3dClzssI+RL7jAtr+uvmOCxjyMfMdFxD7I+Q7uqiv8x1fMd8xw/h1vuPr5TtmGP/B8x2LaX1FV/f/s/f1y23cyIP/6ylQyh+2tqjRh+3442ovpUjKrm5lS2fKya9ua4sEZ0AS0XAwHgwlM3V/3Gvc692TXHWjgcF8kUOJjOUka9VGImf6C41Go9Ho3kC+YxMPf+U7rpPv2CTBbzvfsYkjfwe2Za4a97mWjiqDpQHdFIPfYL5jE0t/gg3qN5nvSERvidoPxjUrdUcjjPCZprws/FxlciITHlMWWo2lZ0fB8bM12dp2GuAHkH4MvXVMqhwmE1icSEqJzVUs5rFezqBlT6c8sdWNm3iqc9TCT2OLIXeu6s6fAZ/tFQJjpUNlKvXLXEPuptfo+MQ87DoSg8PNVArXDqVyQDi8lWgelptOc5aJz3PISYDapwmm3RBcaraBM5dDCITDWS/7PBeuM7mT44vx+C1/8/bN0eh1GEav+E4HkRoufkeZVsWGf5visF57R9PKgrr4FSKjhLSRgGgVy9VEgKjK3QYJMnWCsoKd8iSKTRTBIYHasNk+JU6KyDY20VW5vhyN3x6PX7x6/Xr04mXEv+cvQvH2+G10KA7Fy9cvvi+L09L6OwvVou2sr/471NLR9sZ1jUSxpclMcD3PaEeJSuyUkhTYidxXY7tIVIR5eDg+/P4154cj/vbwePTaE948i/3CwZ8+Xq4oHPzp46UtCUydVRhV74EFArYiaSxoPTS9Vdmnj5faHEPSk9b0gLxGmcCWjiyCLpgyyRXT4VRAyzzbYjTl+ZTeV0wl3WsBb7df3hlCt+owz+LCuOyW60b5fTUvEqYVdojVAqwQyHPGF6akNeWjQ0mbJDoAlwLkaprxxYueiy/wMmuMGoBeUDksgA3Ft4R3WMzuMfNpomxz6iHVvDKj6VNoGALC6MwZ6IxlLjIeY6d7B1MkYawoUDj89xCoZsP/DNnzi/Obn9jHn2w6IWPHr18c7xma/AeLWIiNp2D93pGwXZcwDOCT6yAasu0yvaxil1UHl6++LY2A6CmSVQgOSIcK1gXyBjeEpjDBZFDUvAcdjeN5ZNPoYsHx90jl3lDd1KFLKNIdL5gWOQSxZE4p0z3QS2iNKu5EtgAUkN7EeOX9CnCL1vTeZbM5tFZWORu5nsxRQ99Zk2uHD48E202TiVfWCmjYDeAzD9cHlVO2MeYZOanBwJWbEDtKodEYbVtzngWT3/Z6yHm9NyxksBfROqdYz3cnv+32kJ1dA2F3r65PaTIpKdE445NZt2Dzg3TouujbTGaF4VEUsjP8bugZmVylvgxBGYbfDSFYmahym2BLdPCszMs8jjfHx1dr5HIxRk5wncF+cXIG1eSofdtCzbGfXWEVF5426Fz5CVwyYcN5FgcAb4j3ocDZMVYVOQPpQvAyMYlM0LE+s4lR1lShI+VA+t33Pb2y4cuyvXr38uWLAy14Fk5/+Px3+tz8/V2u0tLoWfPxBxjBZ5+SmYrAZ40Kq4iqr5kWIilJlhoNNloP6C4rcuNCqUTmCm4ZmWVHjdA5ityKOxLUdR4+wbHOhPOrUBU4XiBjsZpgFrFZE8HAjnORsF/BvrnNByUSo7NSmpS+5riegu41B5ZrKKIA14gsob2SM5WovG6cHqREoLEtX5f0K+Vae1qzAf0qjfk1gbc2ihbBcvdUkObW8OfTCm7PtpKAdivkqCzvQI53+GbSzN/RNryRDpXlrXS8fFk/nXj58kWJKNyXdqDqIUJ6BosKIiAlNiIcCePZmG/oLl8TDwSTAS+7FWWrrV0/4Npl/B4bx6hiCcA95WXnNFFs+MMQZ6hLamCUYuHRHpBnCwsEzNThD0PMsLRP9Txk+AJ5Tg4ieN8QYoBytAU9SLp5ckhvU+dJd5Ys8aYJdDLOBRuJ/F6IwnUHpPk9lM7Vbhtsh9bc1IT8icF29zI33k60QIpeot2FAb9pKiIXqJmPzFfeMNY8QQ+WeRg3ibtjpdyZQ6hmuzAgu/4HJdVwx7AkV+jimc1kIiJYeUOpRUyXQGCbonMKYRSn23o+HssvDiI+g3df3x0cmKN180Sgsgn0hs0Wtr8u9Hb9ImcQ4EUfYLRgWs7SeMFy3LXWnU0YypiPRKzZvYxjdC9xPboXcYzc31ye6cLQhCqY3+7WTbsnjZJKmM3xtvSgj9BbzdEuCE37owOOu0kbGb5rdD0NvXX+EFKZM6tQ22LuxtdalhaOtnEDFuzzHKLyslBWmIV2o1N4BkXXY4r0iy+hSHP8AKpa46dsnkQiq0wCmsUBYxcQ0wEXXcINTQe6SgHGIOmOO4Cn7+GKgkqKmFFue8Qh5npz9GLG9DwJOANaYwiCffcV2omi5tnO8jbZmlAI13kwWxAEo/KgLLuC63w3qIYeCEpp34e8ajojcjbJ6qWej46h4MVRyawUm84yeca60yaApODB2DWBFlg+8ozLuNgAN0xT7vbtrc6uVfBcpQNk43cw5mI8hp5TkMKkUlIU4v65uLk8gzrIEGm5TSDsRn3CS2QxMps9G6mEzUhpahM8YK4hCFDF68D6HdVCNQPwu9+2zUd732bui5HoZvjx85LeQFB9i+kInwh8xeoHfpRYi6wUJrZ/t8eJUQuBchsttp4jk4lxiiHKwUdQHi63j5o9HOywY3HH3SY6V37ffvqQOtiBfkw59KpKBJSFyhZgMotwUZJnUmhyGxEJmhWVwYoO50UJBPetpbAhbZ4wjhf1DUW0AniWfxY86xyGDqcc+ooH2531fndrEzFW2aIQLSQtspmA82Kmxs1WHILs7PLs5BpEeGKU9syB8qf7s642z/KOF5C2xDoocPmGU7AuebB4bjjlZ8PBlBrHz3Sx5Pcg1Ot6XwRVk3ISj0SWs3OZ6FzIZF3h4CT/atqL2L+2+iIR9nRx8+zXT25dfSZAbNtu6oXOxewgjXkOJnRtLTdcbHEp8UfRIFuXRO8C/6aJs0e5dhGYwt48VJlpQFpalkD6tFpAGDBRyWIGqRcElsE+buYp4SctoMyUHLMhvBTIaAg6aP4ABofW2Yb/js1hMo/LS2ESNXjuEENYX12rihoWtz02qaQ00sjluiTWtfChRG7T0PanEJ0DWDCesZrIpIlrZ2k5Wtp1ZZGpWOiyMDZfbwjoZYgJriUBB7ksZiv5VhV2nv1791aOeMIHPJrJBPrYZAI3zslkAADXqOLzh/N+LGPOwf9TOngF90/UxSsI/MvJa3DyCvH8id28qhC+VUevysdmlb3EycNdvYLIv5y9xzh7hRyfsLtXEPknd/jQ4Suk8adw+b6GR2BxP/3FfokAN+8JWDr/qIt8mb8nuX6XSdysanZZmi3+v1bd1lXXiuhrLagW/5NdK7vbrEcspJa+P8UamfNsIvI/ZeiAWH+icQOi7q+gQUPQgGTzJ44YlCTwJN2NdZnYrI6X2GhxSLpT+JfL0uqydBfi13JqulP4ZN2eTXk23UXxB/Z9LKfw8IBP7F0ZL7WIFZ92SDAyMGyaEYwepFTC5Qm4+KRmjLNRpu69m8lujt5MxYJuc+ipumdzqADN7sXI3kuG4dUACpLDXEI6XbSfO1JtMnj3nKBIAPjfy+gStupYyuupSsrq9zsRVIiupmB9PuaZ/LZuOpX4/JR4+jEo6UeV1/fqNxnH/OBVcMiem9H4b+z0+hONDLvqs6PjwZFJaH/PQ/jgv/bYSZrG4hcx+pfMD74/fBUcBUe2Kwpjz//1z5v3lz3zzj9EeKv2bCmPg6Pj4JC9VyMZi4OjV+dHL9+QuA++P3wZHJWFroMxn8l4sTmpl8R01WcGPntucyIzEU153mORGEme9Ng4E2KkI0jHTSJ1r/dqAjRP1uj+Y9xrvDKlLJIJOXjWoU/8i8G2xgnevY9M7Zm6nhnVea9+5XeiKq1bkSUi3tYoV3kw2FzHD7yCnPH7thnyMngZHO4fHR3vY0NxGVap36zBempjbS/8eyPdNrj/VZWM3Q5sTjrLKbb4aD6HIsmV7rH5aJ7k82VzmGf3MqlSDyq3JcqffdKQ/CvYkPAM6UYA5IPxXEChwt/ME6rKJBSoIJgMc4dpQRtlikfgKMxEFkoeG9sGmcfFfuDKPa6h/0wcq3uATJ36ijvJ4N2z567Kz947Fstk/qXHZjxEiSbyS3G1geQa7FRvUVz12ULNnz3LYP3neIsB1Mle0qErtXAZytx8K92KgCdGdgAYS1U6hyw5aDAYC66hIAEUS8X7A1DgRaUiAQwcCpvouTA3KM5P+z3YT6WZSpUWUBPFgeRRhF0Yg2dVhUA2d1bMH09VaGJsSVtqek7oVpquo8PgqLqobpdUr2LXCicLHAHPFb+LeeI74T9fnnzo4n7Dc9bx5llx45G2gwv25vA4OPrMcj55rrHAG1x6Cm9FbvWXa3NTAq4/JxMI0GEipDC/InyutQpNX0+8/wNJ+yNqzCITuCuA3zE3MbkrykvIIAJd9Gp0M+WDuSkeAPdNXMA9/yxinEGzsZi4zfkEL2WBgNUcCzNgR1KCCR/DTU4g9PO+TPY/Q3dRnmqYPlC1okdhhCbKWOn2d75IZejdDqO7CVhshbtr7lokWmXsuQgmAftfQtz22C8yE1Dl83YP73DLO7gr4zZpGDTK+BhrFlckIZNEZK2jakAw8xAxVwywZs/trQuCSt+V+d9rYXI5e4Y/grsul0vYM9aO4EL1EGd/ZeIsFOhC0qArubL9goQVR84nE3RjCOQVKWrgKzdxnwW+ltMq0KB/9nEC6XTbDxNh1RT7oK3kZYNLkdRhBmUE6jOMYOKIe/DaxmUsM3HP41j3WIbKr3EuxLD2jXgMnVMyvcYueGuBU2To4gx0zWhtUQnaSqluEzsXnd/iJvkqpbqYyAEgWosHNc+hlcByRiwbd/MYataPpKvZas1/7Yv2dQCWgRKgDve9eANqVrv8RcWwvDBUF5UiB26xpfHBqBO8DgaeHAKw51k4lbkIod62YSSvyYVj8o87gMKiB1rYUiTWe9538/u5d1Gyx85wpwuzrf+pf74Hv+COiMf4oANavGDrFqqM/UTzdq90T7Po/wzXihd6MudZFJjf4f7swed7MZqKOD0YqwEoII8PwN+LRTQRI67FQYnBgfWdhQ6m+ezf/xMBOcLKwiie/c9eY7UUWz3K3sSru4nP/r1r+VrjvDWMYbGwV6i3pCWgJGVE1icrS0GHKis8y9LgEFhWbtKNbTXgyupBeKf1Qb2s7M/9zjWwPYo3J4YNb6BrUvU+aBYpTj5as7RbwnkMRywlbE1vt0yP8E4EM5lnAiWPd1YPxvwzqnn8XXgnBnjxdOARpwdhJmDD9O9TLM7u0Pq2VcKCn0TYfUKD5Tj9+dxXpP/UxvcigU3gVZ+ZDi7sODg6Dr6n0idgPCum1e7yPl6frtESWyRQTHfbE8RaUe/sCD0fMHt48bp9aOqTo2mIGmbHeVcRbM0zAc4tx2Qanl+c7dlL9tS8olScoiQHgsnwZvMiYBf+9WQ2Lx/HEQICas+O63ItgK6n+vdTng+kHsAUkNEe6XrJf5DC2/JXdf3i7D87JcQ4RvumK9Dh4WHnzjBYPVNsr9b3CcuEKTvWbmBK/jNZG8g8iNhM5nKCXxSysINhh0pElXGpCqZ5RMKJ3B/J5CC8E6C4QTiRP8Avf3dy/P7oaA0xguINtqr8tItUGdNwd79RVWvMAydHh0dvgnWUAuAnIgvuRBKpbIss+dUTSoNoSWCGhBpbNyLho1h0Z0hlIhgVzWSWMTOOFc+bKH7Wh1N1DZdNWQYXEM0p6WFwCB730WFwSPVP4Fc2EvakYQalbTSUDy1KGjP2I7iYmiAqiMmAx6a10BoqTmK0Q3xJYyVzK5SZyDMZavac5zkPb9kd5qMVEU1T9u6LzBc9lmbyTsZiIqiCMGVfQC1aLKO812NylvIwL6D6uRQAw8GF2tMT6PtjQFFWFNJEbVKxeHOLE9DgfllXHaf2fqTCObC8V/NUXwWv1htikdzJTCUAjcdPZ6zPfbJWDTpPFswVdUQtoRHqsYeMENaVk5kA5PoJDFEuoMjoUxqdG6Jo1cBAk1g2g65MKGgQaSS9glLFcMAssWMVio0JvaOEtxsrx438B9uFxPdYFsXW+fmHn8/2isUetsYy53AzmEBCE/w7ATYFTClUB8IQ9e6luofMmPcikvPZrjEuu9BieBcN4unP/T67Owbz6syng4iaAOFw51zYkt0eLohxag/Wi+CQqjgtMGYbiTGU+3JAaR9QPFwaI0+L8AmpmbqHWktA94wnfGJiTz9dfOzfBFfZxHQSYs/xAzCe7FN/f8TBfU9Usp9mauwaybBSyxcotApHQTOptS2DrxhEGeD0LIWgItMiROUEzxZ0LwfvK1UJqQn85ILPNONhpjRyze5VFkctKprcRQH0Ggwm6g5jFvtkitBG1I2BORzppqo0JFvS0ht/1Bs9DLAdKD00FMQX6hsY06zImmGwlkIfOBoIKEvHM8wj8EzAwyRYFeApoAl5vFyKVobQXcYPP8Lf7LTogrX6JEpq8AJiszigkKglGhgSG5CEyfKl0tNel/pW+pFKqTGDJl5ACtiEOjGwm8s+A9cGXPkei+RE5jwuutwVresIovgiwnkOPh4byYRDvKvH+gfvL96fl+KiMqEs9ZGK8BmIKSYQP4OpOMYi7ZZKhRH9Wzdnf7EV0/3GYXgqBlVaFZV478ExTnHOixl/QwCLzZOGAYIhiJAGK7T1aM/OP+6LBFaNqIQCzAyt0Lbm2xDeHGLLFCxAXzpeGYniGNmd++G5DhECLwd6yo9ffT/cc+yd39Gg8rxIl/XI8MWI+1N7VuMdrOlemRQrCmDdysOv10gBaBhtCmWxYR7rgKLu8NqQWjQQRPw6jCXEqvHrNU5BeIwTFZaVgd/Lf2sNq6ipnIeX6j4+75982AtMph7g0eyOZwuw/N7AE2hW9NEEUZTGBN7F2rpmGmI2phm5oiEFaPnZhz7zOWbsOYC6l3EU8izS5JaXLnAIHVTNzbO/edWvO3sZ1M/6q7RpdF0aH9bIvKFf/fp96h3/X6N1o66y9qm/Jt1PoV3jeqNnujW6bozgQvXY1ae/V3qzY3/GJSNNYNmDR/zJtGl8r+bGKvwsxf2aTPg+5ZYZaezM+LCJe5GEj+DzCTRoXI/timavyfoftJEjNODHli4d2Hlw//1EYRcCkXXpwX98uH/4Gnvwv3h39Ordi7fr9eAHhsx51DY5whhDF27g7OANcnP07uXhu+NX63Hj9VrfduPsEwvfpfyYI/28VMkYGs9XuVyjNbXHD3b23xIvsFNF+IYXSlQRcQzMhvRVwZHfD9zbgbGOzfVhN5++Oj56gBAEtfrvIIe2JvrnBMINWyQyeVcbNGSsI0Pfv3r14jV9KJNIfPG5WI9BLX8Tj2AOBhJA2O2fN2Y6hb6RMmEjmde98OPDl2+6kmta9W+3fy1dTTSo7MEqLi1OPZtXMQyBoKHRuUhCPz49ppNpSNYzI5tOOZ6Wy7AHDXyKLG6zK80pcgD70lDF4EDADmeepia524EuOuHVBPvq1U8//vj29PXZ+Y8/Hb59c/j27Oj49PSkswVw4YmBU8QtifzCHmZmGJr0xeuIKGZDwH6BzTZsiwTIRPvF1UFPinAK+4dilzyZsFNs5M9iOcp4tghYXwh3MjqR+XQ+wsyliYp5MjmYqINRrEYHE3UUHL080Fl4ECKAA9ij4/8FE/Xd5YsXr/cvX7yq99oB9/vV9/trmNs/fPf/b7Xj/19d/h/S5Z+k9o139icu/J3Nljlp3DNaaVaZKg3cY5j6Fjv4/7G79n8znfr3AfM7NhJ4VM2TcKoy8+e+WWB23BX9H80zJRL+OyI7tR2FaE2C1ynkXRwV4MlmHFMzR1BH9CUbI+N4eQl6KnmGuklODbTAzxm0WQQTG7F9cNYJoK3dYP6S5StLPCnfmWLsn4Tfmm76ukQpcBpAQe3fVFImlMfSdZWEdobvqLBC5eGZnMB1SphneTYXZehGNvSkAatw2tBH5o/BGpJxI4UJNXjIP5lnODwGWRN/tUGo8wZj5T+3lC0UWuPo1gE3qsJS6CBgcP6FDqC8kRc6XSknDK+Yd5l9l8nITpIwVvOomA+n8KfNEsggEYnDCVjzFHlP35qkq7D0KuYrF5tnHkUDfGBgQQISaEmqsuqMKXGOLwVyxidebVhnBvhM7vNRGB0dv3i5XEkuAAK7OHPJigjYSYRU5Dt2AqOFD6k48pXVEgT0B/hyYHldMdyNDy8dbg+HJbBIZFyOxjEko4di6qDBFVxd1djDNuPhVCZi4N2NXo6MXvAvU3fFReYaE2IGHYza8re6Yk0zhZas48DR44WSd8UDbe1U0glH6dFG+NYsRCq8FVlhF87s3w3Ty3yHXgislnEssJk0GgXzHcxwDaWGBsY6F96FXZwNvn1nE1oWUUdW03l0+RX/NTqxxW4m7ssmYXkCa36lUWgtqMDirI8N3vJXnTWxVt7shvTh6LBdnGbsO3ZzdXb1jv1T3YMHMuMpGFktfvDANiz2Kxb8Jfa8sOmGhMBqLiyrhd6Cv9OstRfJWPnaSssCvM6srfEUFD5vVE9aN85PbX4F5qzZzow6EKEOFrM4oOfMRTl4BJZFSDAr3qzUtlU6X6np7UNTquZmQYyUigVPOop3XEgE4oPesNfxKh2M5jKuo6yPqFu9d4/enB0dvt3tRs5VnyEGP9momRA4lG+cB8to0Xkm8nDanRiLxTQsSxZOA2/nIygMkwtd6OG//M8a4BbfO5+r7EAVQAvHaaVVLV5aaVmLR1fqXFXiqYqCjuJeIlFPAqkyQab64AKquYw2hulaRezTxVkzIpnW8Mj0QSgurusY4P/xEGJjzBQQ68hUVFtUHonMFmhqQVbZ3TweoQXYdIccMP6///N/NaP6TzWSaI3426NXI+/rwYynKVQKNHzt/m13bZ5o9ZzxtC5FbJ+Gi/7To9ujrZl4LcAJVNnTI91R1kx4JtJYwmFWaedfEF8nrxvaAm7LpIlEGqvFzIZ0Noa4gNuCGNx2qO26cZY9wC2oC39io4gdWDqpiOQYb1RCIQOeuNbgRQ3MbJ5AgGVvGYUb9ObX5QKBWOeC1vHCs7h2HzTApS8Ln8IFNJp8gAL2eg6A+NJVMoQhKHLJl2w7iONfVaxuJd/n81xB+Re4mFew/z/Mt5DZiJeEFsx/zoWjugSwGkD5HhjR4UC2BXrpucBE+co3f5oUu4Eu+LGBcDrsV2NHAMVs23HKaH105xzqVgJk6CfqX7+mNCZqXi5kPi3kGrFobqo+5DzL52kprAzBccgcgA95EZcFzNB7nc+gDzrErs1tMBw3AYVHRGR6XOMH8GePrhcjaXiHhMcAItcmx+Pi2jxB6gVNsOHRKbjoZZIgYUHmGiXTLELKi08zFc3DfH1BAj3F3CUwsEVwvC1D+2B1KaF9pu1hDXvuYd5bgdq7WrwmZvOuFXXBvqcLmmXzBOvqyaSZjnkWPwz7p4+XbAqBB8hRMuhIW5GSZUIP51nl0Kq8RW7B+stU5NMSf/dcOxWncAJk4UAOCV0HVxlLVO52idWTqF0qODAVPMvhNIHNVCJzle1WbFeL2aGnW413CyeEld4myG5b7SPykXlB2LbxWoLTjptFCq837OQf7xR4SEqjU4HcVOilwq9fbcUnp1SGxd405fHiN5G9YxovctUZk9EG2cL2GL+qEZxtcO0yFp0aBV+R0YjMvfdiXTFrzN4ouIhGDILByIXOm2AtY2SuG9nwMgUbcZ8RFli7ZhKuZIpQJZGu86bDqZh19XvmWRzUXqj6Oy0klcf+xNw4YvMsJhLK1xyHeZgOe3jFC/4D+WRDc/MIf9fDholGIdOujJSapDyYEf8k2laoN44AjTx4AafGjOMF22SCkTb7rJdnCz/uJXDXLq4buHxEOOfieimVFz5VZUps1KJXggfL4lCmtlYvrZeabuxpFd9B1cbUXhMrziznGe5jAGoDh7DjKuk91RmIauPyAKNzYcpDqgwGgXiEnLaYstdtpreVRK5g5OgQLW6y70DuI4nqAwjrdhDmnq1lPE+h/Pok4+CnqoxF6j4J2ImjUOriazlmLteI0lU0AoCbfxrrFxe7R7iOmoSwK4CynSqOSosLXq4dWsjDgF0lru4pTaVMaBhGOW4CBWcDBbgGuYVTEd4Oqib0AdI7Ybm6FYl19aFGIaxZ8zjniVBzHS+YTO7UrYhsj56xQa4hCEfX8CFYwu6nIhPM1kxlF9fEBjxsvSFbehYuSpqCT3XWYBuu09IBRXHVYoDVBLqxhlkP+LzxCiFQV4QNcbcCn1BxQ3OQbX5HBtGdw6dg8yGSyHsYP7Y6l4gvOdrhaB6LyEgn2LE+np7PZjxbeE7ee5o49E1H366AU0jEH/+SIHavrXpZbdU5JSiJmczzYtPGiV7cbBVzGj7TxWCKJEqVTHKoWqNtbS8z6qjpMxXhuhcPg92d5oXZ8tGgsHCzfyKyjqNaVBVT44IwmJk50/MwFKLIQSvQwsTfIuIxl3ETVrIAW8TsWDZ2SnwxAmgxUr4nCBXc48WalqjK4QYMOLAHsRYexzaNkfaQoIU9tNl0vuaYJ4F7xt08UALsHl4hlB40ylGwmbuXWgRVsZRgdhCRm/mxapj1/currjM+Vt1meyHOEy9fxUrRzmkQMctUTJXzscq+i4UYuYdTsJY6YGQ+HFwyI2SDbEFmeJmsoXvZfqecg6DJRuhYDR3Aukq12QvTma6mYX4RohX6dS0yaN4AeQLF4iW+pBgPIR+l6OFWYCYZebDq47AC9crxEBByK4RBOAta6nKpU1j6qn0GriAVftzOh0g18HtU9MxsLo5fToNGYvxcqQrk5iHrQFB56JxNcLU8DIHG9t8LqDWeQjDRGoVmQg2IYKJU9KD9YZ3MD844b468XBW10jZOH83nurYVZIgsU9lgNI8mIg8yKFmR+PeZNj6wiI8ZfBX5xWKcB+yDgJqTd4IpuMqcT/1VhyqymJclzO4pn2sX81u9YK0xp0v7DZrMXScsbd4apfigGXtKu0HdQNTyTVAjfdq7qVB3vtcj7cbrvUeXnUGz3Z7Bp7yZmjQTd1LN9ebE1TR2tvYwEoVrWNUDSJpWuOUkDxpCVe3a1lWaPtU69fx7i5akuZT+GugmfprV2Oe0NixLDdQaQTbynigG4nlQEC1isVK387SjB1XAaJZ9i8w9RAQ22GkWypOPnm06BFZEo+Aiq41JTeSdSNoiUlleF40/CO2qYUNX4KPRUDKONZfw2N7G1XzNrQ7QUo3ttpyu1FS9SPKpyGXo5frt9t2HKGTd1ef3YTXLq2WAPISmWFrUUXc7nePYh6HND5+IQfmMcvV7WAPgcbvgCwBh2lAazcOK9LCZQVeJqQxuq6hxcRmrPN5oKqGAQXKnbJnIKpkpX0DzF++9lnwlU+i4dEXOhwO3tOuJt1Axb9HGLNbTW0A9EV4jwwc7mMmZWCn3CnB4xxWoByiMbvSU4etcpCvnalWPlhzXLTk6alOMZcqxJMrdLgn7yq9qniVi8XuzFzU+30YkOsHbIbGK0n9pJrTmk+b3WlnTOQ9v218h4wgHTv4afnNzbZenjiaRIDSLo8VeIJr1zGCRedBlBfdasj54/e7Tmg3HePbIlkRTt0y1irEPUY6RivwJ0A6kDZAPzGti303bVgrE/vsn11O3c4Cjbcs8MuBOBF2RIZge7hkKN2GdXeg3h0lARZHFMg+ZiGQGXYx2ujOxggF7IduBptaYhlaeYWUdiBhL18UlyQN2yeGGBfQdVRSyBzgUaYfkFljxMAurhhGEYgFBbF5PW/d3U8EjUcnIXbLKLVnpOskCIrshT1RiBsIgr2y/RGRnqxnjYKdK9NoOZJkQhB7zBUY/wWvNM5liZ3gddJw39nCzUWpNc6dEzv9uj7ONRH4vREL749HCxL5JHtjbnTz6+wxObRKI19agWcnRo5QAZ+wJUa6ywEPKYeJA97I4E7xmEVhxYNtQejbYqT3+QeXgy40LZLYnK4AHBw01FHLDYFvKYWM9ll8gXa9ygF/8o+OOSMGdBpUzsLfY9JH2tKBAxu2DgWRJ+dCn/D/cPXATqgZKgjUNXc3RWLp3qA1/+/6hCRnJUAzWstVd9U35g1TeXnFTHd3it3kI1UWnMDl/acJ2NQGmvBiQGXiQJizVA00n2lRdPI2ho6ZveRosRg0gWZAVFuMJC9lq+MCsjJsRM0Y+3eyyNt4XuMsf8D8E4ddg0WDg3PRWCQyA0GiVrD+MRzFyNXBV2//tjpz1nGpIWxGWkJXHCJeIPJPijg6JnTtFpDCiJWgmpv2w5jHW2iePVnWnKCzPeKK5GSfWB30ynm8NHLwhEwntg9jN6bU3vgzq+c3SPGDnSUR+M1ZyLex3DVokKcOltEA85bXgqWixtyEe8FRWN8Un1xcP2BgTpGZ9a3GK30NWzz7EelgVM+EJdppFZTFneR6gypdnX6OQmoVDYTogQlclFezUEOJzO6vmVgu/8PNR6Hmcu70H9Lm+k9GcEwk9oMFcSELqMrrbw1izIB4ccakQ+YHC9zCXgMdgpxFFOTaxFgbYOtIaUlxjAlQ9N9UzifZfz0c6l/m8wct/QNht5YgUo1ISALvyziwh8QcS5W5lmtoUHyZ4FsvaUs1wICktqIX8FrVtVd2l6gsU+4uztWq+WVyi2SW66PEK6mYlb1NHH6AZp4FXYbITrzV+0TwYYMy/R7XE7FsScIewiRDRimDQCgrAWdKtJHSRQV/+VuPbLF0yYQh+lRTIl2hA1BJhbaLCJN800bEEfVu4Z0XIZ2XYp8PUXhn+sTwU+XJ56EeKwVXBqE3H1dC83TxvWmgFFNbrpQDRWiFjKECwU5Voe8y48vijYsYAi9yhYKdG2CMjZjenjw2YkY9Z+q6NkBXEtHrEQkMjKqmnjFf92uoupwaw2PUUZ9o+b238+TzW1pD17IrjqbJG7Ow0ISMnWmxcokVECP7CNdRJT3wxxYUr4n1iDrVW4a1+5VmO/tXpv/qv4Hryl0VH4+FgNAu1RZg+oorpsNIo/7W5GXrZf1oztLad9mdnoTrsTnIrtly5BO0auGICu4nqAcmVhYFj/FT00a1jsZ8IAk3XRIJl2uGltZa0WO+0jUvLeHiKsdZS5pXvHkD/E7Q35S4oBR0VQxS5qrTv2OvgjWs2WZdcUb5WJmzM72DzP661oguKBizDgJ2ju69zltc7qjiVeKb9AuTm4lGpn8oqTv0GNqt4ahHCAxlFzEM4AMw3yOVXty9TnkR6ym99GbWT8hALM5YJmBfQeIesQ3yzBriQr89bG3+/hyGp4jFzY2eVEMvARY5b4VqLUFcHZmcVoxZ9Y83vrru51orfLXQvr/1d/K9WBbz411rEvM5aOC3nYS4TbyctZSR43OZAkZJSq0mbkutFYnBoe8VtRAzC2pKLDeA9aHWhtClsvTx9vZ3HOoNaEwRVkLDZqC0NIYIOhLX2s3gUfQ3NKx5GJrVy+33FR0hXk/U1hbeayKYmSY8irF9qhVSnaRkxtSZv5X+Na3wLGY9s9dZGXdUl2RJxqzvrNRHY3oPpUSNa7yAHiKpkLyPMaxy2ZcpW9LZbQZzX1WwN/6ElNvmxf4IHxGf9E48SXW1ttoKkSie5tchq6PG2hG7XcI5o92juSKvf+u9R43oKgMCenZ+edafEa+29CSJMWy7MVLSm9ez8I2voUr6MKKkHId+pfNla1WoJOX5lKw83ZHXxlh5zO80kqVCnQeOp0SoZrfSz4CrX3EWTwVsq02qyKK9O+16KhM55GledL7jt1aRKaszgimiPZcLUv1AZmye3SSm50jKKR+LBPc/gkqTeWc3lEv5+ISi1Erew1UFE0P99DrX6qhtC7BcPgZUvqcy80LoMZ35s/eL0/XXHGAS92ewWtzBxcW1qbXQLPdAhmt5ZfQGgBZ93xVWOGTDHzsOp+kiAMclmE1FyB5kRaDS6H0UaL6r7ZA9Ele+lO8hWM9tt57hTRZBnPBSZmudiPVYrwqVDEjjLgEUZZhaMsIjw0lev0FQ59lCCwaBzpKCjQDK4EF/L31tmv5aQXa3SB7SSMQCzahnJ1Kh2iGfpgcIpg6lKGwmqSrADOXAkNuYZ9EXI2VRBhwS+hCb7WrvgfGLzvJomsGwyNVJ8c3OJjaGBtFjOZN6zFtaQxHii4Sa7s0lTVU1aaL1sWCEoXUVLcTQj9Ao57TQhn6pSzsijxg2yErBgEUtFBgOHGSPuyplM4DIaFDdiNzeXT3DcntoYtdvh1UNVI+IEisdMRNUGWwk4lbWUWCCrR2iprV5psxuJXW27q4hjpXWQhu1C0iGPRTRoKsMA/0wI7R1obtjs3pcI/AhLC8iOZnxRvQJS+q0wC99iHvmuxaezrp6Fea95qFtm46czd3mMQAY7zQO4FedCjR088ClysAFhPMdkxtIXjE847L1EyKFLX6J2yim95I5CgbFMhEJC1ilcT5UzsQk/pUx87bKJn3NsUorLeUxAEH3fSLc/gapyXzphHuvckMJFiX++dvah31HhzHvNMmyRH9SpqygcLuGf5xDZNeKiwYQjp8INMruP81Pbz5exKNHB3yyQnWbpPXjEPzZbPiAfSf1qQ1ZFECVai7C+JXzANunsQ79/flrOXsAtIYSMuLfNo9IBmcstNCWfy+U4ZUKfspGawL4yw5hGLrKZTHDjbdVvkqWhp3+Tj9enHRWQ3mwe0xZOATzcnIvzKeVdr2cAqTDE40Ttp6naeo4EOIBEbwb9He6nIjEkWuNCRd1KoIgVNfZ34HU9mYl8qqJ1qf6nAU6kERAW8hhLxZ0CbTCwv/A8nDYgbU6b7IQZh6khUxJw95gIJgG7+hfg/vTh5OeTi8uTHy/PGygw0tnI9MDbxcnETgpnmqzfXhIVkdg///jzxYd/sEre/4ermwF9Few83lihrCq+mu5qoei0tvRdG9Z2O9Utj8ynqY0un7bSkK20oA+wohu6crIkHcwXDAkCgxzuhpinOzjXa6CMxheOkQ1cuTP6pyLV/z8ALAlShw=="
 }
@@ -0,0 +1,63 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package inventory
+
+import (
+	"errors"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+// Config defines an inventory monitors are created from.
+type Config struct {
+	// Path of a JSON or YAML inventory file. Exclusive with URL.
+	Path string `config:"path"`
+
+	// URL of an HTTP endpoint serving a JSON or YAML inventory.
+	URL     string            `config:"url"`
+	Headers map[string]string `config:"headers"`
+	TLS     *tlscommon.Config `config:"ssl"`
+	Timeout time.Duration     `config:"timeout" validate:"positive,nonzero"`
+
+	// Interval at which the inventory is read.
+	Interval time.Duration `config:"interval" validate:"positive,nonzero"`
+
+	// Entries is the dotted key of the list of entries in the inventory.
+	// The inventory must be a list of entries if not set.
+	Entries string `config:"entries"`
+
+	// Templates are applied to every entry, as `data`, to create the
+	// monitor configs.
+	Templates template.MapperSettings `config:"templates" validate:"required"`
+}
+
+// DefaultConfig is the canonical instantiation of Config.
+var DefaultConfig = Config{
+	Timeout:  30 * time.Second,
+	Interval: 1 * time.Minute,
+}
+
+// Validate checks that exactly one source is configured.
+func (c *Config) Validate() error {
+	if (c.Path == "") == (c.URL == "") {
+		return errors.New("exactly one of path or url must be configured")
+	}
+	return nil
+}
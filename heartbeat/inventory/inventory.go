@@ -0,0 +1,223 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package inventory creates monitors from the entries of an inventory, such as
+// a service catalog, that is read from a file or an HTTP endpoint.
+package inventory
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/cfgfile"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/common/reload"
+	"github.com/elastic/beats/v7/libbeat/keystore"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// Provider periodically reads an inventory and applies the monitor configs
+// templated from its entries to a runner list. Monitors are only started,
+// stopped or restarted if their config changes.
+type Provider struct {
+	config Config
+	source source
+	mapper template.Mapper
+	list   *cfgfile.RunnerList
+	logger *logp.Logger
+
+	// hash of the last inventory applied without errors
+	hash [sha256.Size]byte
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewProvider creates a Provider from its config. Monitors are created using
+// the given factory.
+func NewProvider(
+	rawConfig *common.Config,
+	factory cfgfile.RunnerFactory,
+	pipeline beat.PipelineConnector,
+	keystore keystore.Keystore,
+) (*Provider, error) {
+	config := DefaultConfig
+	if err := rawConfig.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	source, err := newSource(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper, err := template.NewConfigMapper(config.Templates, keystore, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Provider{
+		config: config,
+		source: source,
+		mapper: mapper,
+		list:   cfgfile.NewRunnerList("inventory", factory, pipeline),
+		logger: logp.NewLogger("inventory"),
+		done:   make(chan struct{}),
+	}, nil
+}
+
+// Start reads the inventory, and keeps reading it at the configured
+// interval until stopped.
+func (p *Provider) Start() {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+
+		ticker := time.NewTicker(p.config.Interval)
+		defer ticker.Stop()
+
+		for {
+			p.update()
+
+			select {
+			case <-p.done:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops reading the inventory and all monitors created from it.
+func (p *Provider) Stop() {
+	close(p.done)
+	p.wg.Wait()
+	p.list.Stop()
+}
+
+// update applies the current inventory. Monitors are kept running if the
+// inventory can not be read.
+func (p *Provider) update() {
+	content, err := p.source.fetch()
+	if err != nil {
+		p.logger.Errorf("Failed to read inventory from %v: %v", p.source, err)
+		return
+	}
+
+	hash := sha256.Sum256(content)
+	if hash == p.hash {
+		return
+	}
+
+	configs, err := p.configs(content)
+	if err != nil {
+		p.logger.Errorf("Failed to parse inventory from %v: %v", p.source, err)
+		return
+	}
+
+	p.logger.Debugf("Applying %d monitor configs from inventory %v", len(configs), p.source)
+	if err := p.list.Reload(configs); err != nil {
+		// Failed monitors are created again on the next update.
+		p.logger.Errorf("Failed to apply inventory from %v: %v", p.source, err)
+		p.hash = [sha256.Size]byte{}
+		return
+	}
+	p.hash = hash
+}
+
+// configs applies the templates to every entry of the inventory.
+func (p *Provider) configs(content []byte) ([]*reload.ConfigWithMeta, error) {
+	entries, err := parseEntries(content, p.config.Entries)
+	if err != nil {
+		return nil, err
+	}
+
+	var configs []*reload.ConfigWithMeta
+	for i, entry := range entries {
+		entryConfigs := p.mapper.GetConfig(bus.Event(entry))
+		if len(entryConfigs) == 0 {
+			p.logger.Debugf("No template matched inventory entry %d", i)
+		}
+		for _, c := range entryConfigs {
+			configs = append(configs, &reload.ConfigWithMeta{Config: c})
+		}
+	}
+	return configs, nil
+}
+
+// parseEntries decodes the JSON or YAML inventory, returning the list of
+// entries found at key, or the document itself if key is empty.
+func parseEntries(content []byte, key string) ([]common.MapStr, error) {
+	var raw interface{}
+	if err := yaml.Unmarshal(content, &raw); err != nil {
+		return nil, err
+	}
+	doc := normalize(raw)
+
+	if key != "" {
+		m, ok := doc.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("inventory is not an object, can not read entries from '%v'", key)
+		}
+		v, err := common.MapStr(m).GetValue(key)
+		if err != nil {
+			return nil, fmt.Errorf("inventory has no entries at '%v'", key)
+		}
+		doc = v
+	}
+
+	list, ok := doc.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("inventory entries must be a list, got %T", doc)
+	}
+
+	entries := make([]common.MapStr, len(list))
+	for i, item := range list {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("inventory entry %d must be an object, got %T", i, item)
+		}
+		entries[i] = m
+	}
+	return entries, nil
+}
+
+// normalize converts the maps decoded from YAML to use string keys.
+func normalize(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			m[fmt.Sprint(k)] = normalize(item)
+		}
+		return m
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalize(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,258 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package inventory
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/cfgfile"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func TestParseEntries(t *testing.T) {
+	entries, err := parseEntries([]byte(`[{"name": "a", "port": 80}, {"name": "b", "meta": {"team": "x"}}]`), "")
+	require.NoError(t, err)
+	require.Equal(t, []common.MapStr{
+		{"name": "a", "port": 80},
+		{"name": "b", "meta": map[string]interface{}{"team": "x"}},
+	}, entries)
+
+	entries, err = parseEntries([]byte("catalog:\n  services:\n    - name: a\n      tags: [web]\n"), "catalog.services")
+	require.NoError(t, err)
+	require.Equal(t, []common.MapStr{{"name": "a", "tags": []interface{}{"web"}}}, entries)
+
+	tests := map[string]struct {
+		content string
+		key     string
+	}{
+		"invalid document":  {"[", ""},
+		"not a list":        {`{"name": "a"}`, ""},
+		"missing key":       {`{"services": []}`, "catalog"},
+		"entry not object":  {`["a"]`, ""},
+		"key on a list doc": {`[]`, "services"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseEntries([]byte(test.content), test.key)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestProviderAppliesChanges(t *testing.T) {
+	dir, err := ioutil.TempDir("", "inventory")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "inventory.yml")
+
+	factory := &testFactory{}
+	p := newTestProvider(t, factory, common.MapStr{
+		"path":    path,
+		"entries": "services",
+		"templates": []common.MapStr{
+			{
+				"condition": common.MapStr{"equals": common.MapStr{"protocol": "http"}},
+				"config": []common.MapStr{{
+					"type":     "http",
+					"id":       "${data.name}",
+					"hosts":    []string{"${data.url}"},
+					"schedule": "@every 10s",
+				}},
+			},
+		},
+	})
+
+	writeFile(t, path, `
+services:
+  - {name: a, protocol: http, url: "http://a"}
+  - {name: b, protocol: http, url: "http://b"}
+  - {name: c, protocol: smtp, url: "smtp://c"}
+`)
+	p.update()
+	assert.Equal(t, []string{"a", "b"}, factory.running())
+
+	// unchanged inventories are not applied again
+	p.update()
+	assert.Equal(t, 2, factory.created())
+
+	writeFile(t, path, `
+services:
+  - {name: a, protocol: http, url: "http://a2"}
+  - {name: d, protocol: http, url: "http://d"}
+`)
+	p.update()
+	require.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"a", "d"}, factory.running())
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 4, factory.created())
+
+	// monitors keep running if the inventory can not be read
+	require.NoError(t, os.Remove(path))
+	p.update()
+	assert.Equal(t, []string{"a", "d"}, factory.running())
+
+	p.Stop()
+	require.Eventually(t, func() bool {
+		return len(factory.running()) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestProviderHTTPSource(t *testing.T) {
+	var mtx sync.Mutex
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mtx.Lock()
+		auth = r.Header.Get("Authorization")
+		mtx.Unlock()
+		w.Write([]byte(`[{"name": "a", "host": "a.example.com"}]`))
+	}))
+	defer server.Close()
+
+	factory := &testFactory{}
+	p := newTestProvider(t, factory, common.MapStr{
+		"url":      server.URL,
+		"headers":  common.MapStr{"Authorization": "Bearer token"},
+		"interval": "10ms",
+		"templates": []common.MapStr{{
+			"config": []common.MapStr{{
+				"type":     "icmp",
+				"id":       "ping-${data.name}",
+				"hosts":    []string{"${data.host}"},
+				"schedule": "@every 10s",
+			}},
+		}},
+	})
+
+	p.Start()
+	defer p.Stop()
+
+	require.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"ping-a"}, factory.running())
+	}, time.Second, 10*time.Millisecond)
+
+	mtx.Lock()
+	defer mtx.Unlock()
+	assert.Equal(t, "Bearer token", auth)
+}
+
+func TestConfigValidation(t *testing.T) {
+	templates := []common.MapStr{{"config": []common.MapStr{{"type": "icmp"}}}}
+
+	tests := map[string]common.MapStr{
+		"no source":      {"templates": templates},
+		"both sources":   {"path": "inventory.json", "url": "http://localhost", "templates": templates},
+		"no templates":   {"path": "inventory.json"},
+		"invalid period": {"path": "inventory.json", "templates": templates, "interval": "0s"},
+	}
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewProvider(common.MustNewConfigFrom(config), &testFactory{}, nil, nil)
+			require.Error(t, err)
+		})
+	}
+}
+
+func newTestProvider(t *testing.T, factory cfgfile.RunnerFactory, config common.MapStr) *Provider {
+	p, err := NewProvider(common.MustNewConfigFrom(config), factory, nil, nil)
+	require.NoError(t, err)
+	return p
+}
+
+func writeFile(t *testing.T, path, content string) {
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+}
+
+// testFactory tracks the monitors it created by their id, and whether they
+// are running.
+type testFactory struct {
+	mtx     sync.Mutex
+	runners []*testRunner
+}
+
+func (f *testFactory) Create(_ beat.PipelineConnector, c *common.Config) (cfgfile.Runner, error) {
+	var config struct {
+		ID string `config:"id"`
+	}
+	if err := c.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	r := &testRunner{factory: f, id: config.ID}
+	f.runners = append(f.runners, r)
+	return r, nil
+}
+
+func (f *testFactory) CheckConfig(*common.Config) error {
+	return nil
+}
+
+func (f *testFactory) created() int {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	return len(f.runners)
+}
+
+func (f *testFactory) running() []string {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	ids := []string{}
+	for _, r := range f.runners {
+		if r.running {
+			ids = append(ids, r.id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+type testRunner struct {
+	factory *testFactory
+	id      string
+	running bool
+}
+
+func (r *testRunner) Start() {
+	r.factory.mtx.Lock()
+	defer r.factory.mtx.Unlock()
+	r.running = true
+}
+
+func (r *testRunner) Stop() {
+	r.factory.mtx.Lock()
+	defer r.factory.mtx.Unlock()
+	r.running = false
+}
+
+func (r *testRunner) String() string {
+	return r.id
+}
@@ -0,0 +1,114 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package inventory
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/elastic/beats/v7/libbeat/common/transport"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/v7/libbeat/common/useragent"
+)
+
+var userAgent = useragent.UserAgent("Heartbeat", true)
+
+// source reads the raw inventory document.
+type source interface {
+	fetch() ([]byte, error)
+	String() string
+}
+
+func newSource(config *Config) (source, error) {
+	if config.Path != "" {
+		return fileSource{path: config.Path}, nil
+	}
+	return newHTTPSource(config)
+}
+
+type fileSource struct {
+	path string
+}
+
+func (s fileSource) fetch() ([]byte, error) {
+	return ioutil.ReadFile(s.path)
+}
+
+func (s fileSource) String() string {
+	return s.path
+}
+
+type httpSource struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func newHTTPSource(config *Config) (*httpSource, error) {
+	tls, err := tlscommon.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := transport.NetDialer(config.Timeout)
+	tlsDialer, err := transport.TLSDialer(dialer, tls, config.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpSource{
+		url:     config.URL,
+		headers: config.Headers,
+		client: &http.Client{
+			Timeout: config.Timeout,
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				Dial:            dialer.Dial,
+				DialTLS:         tlsDialer.Dial,
+				TLSClientConfig: tls.ToConfig(),
+			},
+		},
+	}, nil
+}
+
+func (s *httpSource) fetch() ([]byte, error) {
+	req, err := http.NewRequest("GET", s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status %v", resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+func (s *httpSource) String() string {
+	return s.url
+}
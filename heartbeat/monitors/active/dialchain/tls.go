@@ -23,6 +23,7 @@ import (
 	"net"
 	"time"
 
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlscheck"
 	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlsmeta"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common/transport"
@@ -59,3 +60,23 @@ func TLSLayer(cfg *tlscommon.TLSConfig, to time.Duration) Layer {
 		}), nil
 	}
 }
+
+// TLSCheckLayer runs the checker against the TLS connection established by a
+// preceding TLSLayer. If the checks fail, the connection is closed and the
+// dial fails with the validation error.
+func TLSCheckLayer(checker *tlscheck.Checker) Layer {
+	return func(event *beat.Event, next transport.Dialer) (transport.Dialer, error) {
+		return afterDial(next, func(conn net.Conn) (net.Conn, error) {
+			tlsConn, ok := conn.(*cryptoTLS.Conn)
+			if !ok {
+				return conn, nil
+			}
+
+			if err := checker.Check(event.Fields, tlsConn.ConnectionState()); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			return conn, nil
+		}), nil
+	}
+}
@@ -0,0 +1,81 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tlscheck
+
+import (
+	"errors"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+// Config configures the checks applied to an established TLS connection.
+// A failing check marks the monitor down.
+type Config struct {
+	// ExpiresWithin fails the check if any certificate of the chain expires
+	// within the given duration.
+	ExpiresWithin time.Duration `config:"expires_within"`
+
+	// WarnExpiresWithin reports a warning if any certificate of the chain
+	// expires within the given duration.
+	WarnExpiresWithin time.Duration `config:"warn_expires_within"`
+
+	MinVersion *tlscommon.TLSVersion `config:"min_version"`
+
+	// MinKeySize is the minimum size in bits of RSA and DSA public keys,
+	// MinECKeySize the minimum curve size of ECDSA public keys.
+	MinKeySize   int `config:"min_key_size"`
+	MinECKeySize int `config:"min_ec_key_size"`
+
+	// RejectWeakSignatures fails the check if a certificate is signed using
+	// MD2, MD5 or SHA-1. Self-signed certificates are not checked.
+	RejectWeakSignatures bool `config:"reject_weak_signatures"`
+
+	// VerifyChain verifies that the presented certificates chain up to a
+	// trusted root, either the system roots or CertificateAuthorities.
+	VerifyChain            bool     `config:"verify_chain"`
+	CertificateAuthorities []string `config:"certificate_authorities"`
+
+	// RejectRevoked fails the check if the server staples an OCSP response
+	// reporting the host certificate as revoked.
+	RejectRevoked bool `config:"reject_revoked"`
+}
+
+// IsEnabled returns true if any check is configured.
+func (c *Config) IsEnabled() bool {
+	return c != nil && (c.ExpiresWithin > 0 ||
+		c.WarnExpiresWithin > 0 ||
+		c.MinVersion != nil ||
+		c.MinKeySize > 0 ||
+		c.MinECKeySize > 0 ||
+		c.RejectWeakSignatures ||
+		c.VerifyChain ||
+		len(c.CertificateAuthorities) > 0 ||
+		c.RejectRevoked)
+}
+
+// Validate validates the Config object.
+func (c *Config) Validate() error {
+	if c.ExpiresWithin < 0 || c.WarnExpiresWithin < 0 {
+		return errors.New("expiry thresholds must not be negative")
+	}
+	if c.MinKeySize < 0 || c.MinECKeySize < 0 {
+		return errors.New("minimum key sizes must not be negative")
+	}
+	return nil
+}
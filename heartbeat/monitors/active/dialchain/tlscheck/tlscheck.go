@@ -0,0 +1,193 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tlscheck
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlsmeta"
+	"github.com/elastic/beats/v7/heartbeat/reason"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+// weakSignatureAlgorithms are rejected if Config.RejectWeakSignatures is set.
+var weakSignatureAlgorithms = map[x509.SignatureAlgorithm]bool{
+	x509.MD2WithRSA:    true,
+	x509.MD5WithRSA:    true,
+	x509.SHA1WithRSA:   true,
+	x509.DSAWithSHA1:   true,
+	x509.ECDSAWithSHA1: true,
+}
+
+// Checker checks the quality of established TLS connections.
+type Checker struct {
+	config Config
+	roots  *x509.CertPool
+
+	// now is overwritten in tests
+	now func() time.Time
+}
+
+// New creates a Checker from the configuration. It returns nil if no checks
+// are configured. A nil Checker accepts all connections.
+func New(config *Config) (*Checker, error) {
+	if !config.IsEnabled() {
+		return nil, nil
+	}
+
+	c := &Checker{config: *config, now: time.Now}
+	if len(config.CertificateAuthorities) > 0 {
+		roots, errs := tlscommon.LoadCertificateAuthorities(config.CertificateAuthorities)
+		if len(errs) > 0 {
+			return nil, fmt.Errorf("failed to load certificate authorities for TLS checks: %v", errs)
+		}
+		c.roots = roots
+		c.config.VerifyChain = true
+	}
+	return c, nil
+}
+
+// Check validates the connection state, adding the check results to the
+// tls fields of the event. All failing checks are reported in the returned
+// validation error.
+func (c *Checker) Check(fields common.MapStr, state tls.ConnectionState) reason.Reason {
+	if c == nil {
+		return nil
+	}
+
+	var failures, warnings []string
+	fail := func(format string, args ...interface{}) { failures = append(failures, fmt.Sprintf(format, args...)) }
+
+	if v := c.config.MinVersion; v != nil && state.Version < uint16(*v) {
+		fail("TLS version %v is lower than the minimum %v", tlscommon.TLSVersion(state.Version), *v)
+	}
+
+	certs := state.PeerCertificates
+	if len(certs) == 0 {
+		fail("no server certificates presented")
+	}
+
+	now := c.now()
+	for _, cert := range certs {
+		name := certName(cert)
+
+		if !cert.NotAfter.IsZero() {
+			remaining := cert.NotAfter.Sub(now)
+			if c.config.ExpiresWithin > 0 && remaining < c.config.ExpiresWithin {
+				fail("certificate '%v' expires in %v", name, remaining.Round(time.Hour))
+			} else if c.config.WarnExpiresWithin > 0 && remaining < c.config.WarnExpiresWithin {
+				warnings = append(warnings, fmt.Sprintf("certificate '%v' expires in %v", name, remaining.Round(time.Hour)))
+			}
+		}
+
+		if size := tlsmeta.PublicKeySize(cert); size > 0 && size < c.config.MinKeySize {
+			fail("certificate '%v' %v key size %v is lower than the minimum %v", name, cert.PublicKeyAlgorithm, size, c.config.MinKeySize)
+		}
+		if key, ok := cert.PublicKey.(*ecdsa.PublicKey); ok {
+			if size := key.Curve.Params().BitSize; size < c.config.MinECKeySize {
+				fail("certificate '%v' ECDSA key size %v is lower than the minimum %v", name, size, c.config.MinECKeySize)
+			}
+		}
+
+		if c.config.RejectWeakSignatures && weakSignatureAlgorithms[cert.SignatureAlgorithm] && !isSelfSigned(cert) {
+			fail("certificate '%v' uses weak signature algorithm %v", name, cert.SignatureAlgorithm)
+		}
+	}
+
+	var issuer *x509.Certificate
+	if len(certs) > 1 {
+		issuer = certs[1]
+	}
+	if c.config.VerifyChain && len(certs) > 0 {
+		chains, err := c.verify(certs, now)
+		if err != nil {
+			fail("certificate chain verification failed: %v", err)
+		} else if len(chains[0]) > 1 {
+			issuer = chains[0][1]
+		}
+	}
+
+	if len(state.OCSPResponse) > 0 && issuer != nil {
+		status, err := ocspStatus(state.OCSPResponse, certs[0], issuer)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("invalid stapled OCSP response: %v", err))
+		} else {
+			fields.Put("tls.server.ocsp.status", status)
+			if c.config.RejectRevoked && status == "revoked" {
+				fail("certificate '%v' has been revoked", certName(certs[0]))
+			}
+		}
+	}
+
+	if len(warnings) > 0 {
+		fields.Put("tls.check.warnings", warnings)
+	}
+	if len(failures) > 0 {
+		return reason.ValidateFailed(fmt.Errorf("TLS check failed: %v", strings.Join(failures, "; ")))
+	}
+	return nil
+}
+
+func (c *Checker) verify(certs []*x509.Certificate, now time.Time) ([][]*x509.Certificate, error) {
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	return certs[0].Verify(x509.VerifyOptions{
+		Roots:         c.roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+	})
+}
+
+func ocspStatus(raw []byte, cert, issuer *x509.Certificate) (string, error) {
+	resp, err := ocsp.ParseResponseForCert(raw, cert, issuer)
+	if err != nil {
+		return "", err
+	}
+
+	switch resp.Status {
+	case ocsp.Good:
+		return "good", nil
+	case ocsp.Revoked:
+		return "revoked", nil
+	default:
+		return "unknown", nil
+	}
+}
+
+func isSelfSigned(cert *x509.Certificate) bool {
+	return bytes.Equal(cert.RawIssuer, cert.RawSubject) && cert.CheckSignatureFrom(cert) == nil
+}
+
+func certName(cert *x509.Certificate) string {
+	if cert.Subject.CommonName != "" {
+		return cert.Subject.CommonName
+	}
+	return cert.Subject.String()
+}
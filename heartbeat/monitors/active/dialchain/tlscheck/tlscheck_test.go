@@ -0,0 +1,280 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tlscheck
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+// makeCert creates a certificate signed by parent, or a self-signed CA if
+// parent is nil.
+func makeCert(t *testing.T, name string, key crypto.Signer, parent *testCert, notAfter time.Time) *testCert {
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  parent == nil || name != "leaf",
+	}
+
+	signer := &testCert{cert: template, key: key}
+	if parent != nil {
+		signer = parent
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer.cert, key.Public(), signer.key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCert{cert: cert, key: key}
+}
+
+func ecKey(t *testing.T, curve elliptic.Curve) crypto.Signer {
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func rsaKey(t *testing.T, bits int) crypto.Signer {
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	require.NoError(t, err)
+	return key
+}
+
+func certPEM(cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
+
+func newChecker(t *testing.T, config Config) *Checker {
+	c, err := New(&config)
+	require.NoError(t, err)
+	require.NotNil(t, c)
+	return c
+}
+
+func TestNewDisabled(t *testing.T) {
+	c, err := New(nil)
+	require.NoError(t, err)
+	require.Nil(t, c)
+
+	c, err = New(&Config{})
+	require.NoError(t, err)
+	require.Nil(t, c)
+
+	// a nil checker accepts everything
+	require.Nil(t, c.Check(common.MapStr{}, tls.ConnectionState{}))
+}
+
+func TestCheck(t *testing.T) {
+	inYear := time.Now().Add(365 * 24 * time.Hour)
+	ca := makeCert(t, "root", ecKey(t, elliptic.P256()), nil, inYear)
+	intermediate := makeCert(t, "intermediate", ecKey(t, elliptic.P256()), ca, inYear)
+	leaf := makeCert(t, "leaf", ecKey(t, elliptic.P256()), intermediate, time.Now().Add(10*24*time.Hour))
+	rsaLeaf := makeCert(t, "leaf", rsaKey(t, 1024), intermediate, inYear)
+	otherCA := makeCert(t, "other", ecKey(t, elliptic.P256()), nil, inYear)
+
+	chain := []*x509.Certificate{leaf.cert, intermediate.cert}
+	tls13 := tlscommon.TLSVersion13
+	tls12 := tlscommon.TLSVersion12
+
+	tests := []struct {
+		name     string
+		config   Config
+		state    tls.ConnectionState
+		errMsg   string
+		warnings []string
+	}{
+		{
+			name:   "expiry within threshold",
+			config: Config{ExpiresWithin: 14 * 24 * time.Hour},
+			state:  tls.ConnectionState{Version: tls.VersionTLS12, PeerCertificates: chain},
+			errMsg: "certificate 'leaf' expires in 240h0m0s",
+		},
+		{
+			name:   "expiry outside threshold",
+			config: Config{ExpiresWithin: 7 * 24 * time.Hour},
+			state:  tls.ConnectionState{Version: tls.VersionTLS12, PeerCertificates: chain},
+		},
+		{
+			name:     "expiry warning",
+			config:   Config{WarnExpiresWithin: 30 * 24 * time.Hour},
+			state:    tls.ConnectionState{Version: tls.VersionTLS12, PeerCertificates: chain},
+			warnings: []string{"certificate 'leaf' expires in 240h0m0s"},
+		},
+		{
+			name:   "minimum version",
+			config: Config{MinVersion: &tls13},
+			state:  tls.ConnectionState{Version: tls.VersionTLS12, PeerCertificates: chain},
+			errMsg: "TLS version TLSv1.2 is lower than the minimum TLSv1.3",
+		},
+		{
+			name:   "minimum version met",
+			config: Config{MinVersion: &tls12},
+			state:  tls.ConnectionState{Version: tls.VersionTLS13, PeerCertificates: chain},
+		},
+		{
+			name:   "rsa key size",
+			config: Config{MinKeySize: 2048},
+			state:  tls.ConnectionState{Version: tls.VersionTLS12, PeerCertificates: []*x509.Certificate{rsaLeaf.cert, intermediate.cert}},
+			errMsg: "certificate 'leaf' RSA key size 1024 is lower than the minimum 2048",
+		},
+		{
+			name:   "rsa key size does not apply to ecdsa",
+			config: Config{MinKeySize: 2048},
+			state:  tls.ConnectionState{Version: tls.VersionTLS12, PeerCertificates: chain},
+		},
+		{
+			name:   "ecdsa key size",
+			config: Config{MinECKeySize: 384},
+			state:  tls.ConnectionState{Version: tls.VersionTLS12, PeerCertificates: chain},
+			errMsg: "certificate 'leaf' ECDSA key size 256 is lower than the minimum 384",
+		},
+		{
+			name:   "weak signature",
+			config: Config{RejectWeakSignatures: true},
+			state: tls.ConnectionState{Version: tls.VersionTLS12, PeerCertificates: []*x509.Certificate{
+				{Subject: pkix.Name{CommonName: "weak"}, SignatureAlgorithm: x509.SHA1WithRSA, NotAfter: inYear},
+			}},
+			errMsg: "certificate 'weak' uses weak signature algorithm SHA1-RSA",
+		},
+		{
+			name:   "chain against custom CA",
+			config: Config{CertificateAuthorities: []string{certPEM(ca.cert)}},
+			state:  tls.ConnectionState{Version: tls.VersionTLS12, PeerCertificates: chain},
+		},
+		{
+			name:   "missing intermediate",
+			config: Config{CertificateAuthorities: []string{certPEM(ca.cert)}},
+			state:  tls.ConnectionState{Version: tls.VersionTLS12, PeerCertificates: []*x509.Certificate{leaf.cert}},
+			errMsg: "certificate chain verification failed",
+		},
+		{
+			name:   "untrusted CA",
+			config: Config{CertificateAuthorities: []string{certPEM(otherCA.cert)}},
+			state:  tls.ConnectionState{Version: tls.VersionTLS12, PeerCertificates: chain},
+			errMsg: "certificate chain verification failed",
+		},
+		{
+			name: "multiple failures",
+			config: Config{
+				ExpiresWithin: 14 * 24 * time.Hour,
+				MinVersion:    &tls13,
+			},
+			state:  tls.ConnectionState{Version: tls.VersionTLS12, PeerCertificates: chain},
+			errMsg: "TLS check failed: TLS version TLSv1.2 is lower than the minimum TLSv1.3; certificate 'leaf' expires",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := newChecker(t, test.config)
+			fields := common.MapStr{}
+			err := c.Check(fields, test.state)
+
+			if test.errMsg == "" {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.Equal(t, "validate", err.Type())
+				assert.Contains(t, err.Error(), test.errMsg)
+			}
+
+			warnings, _ := fields.GetValue("tls.check.warnings")
+			if test.warnings == nil {
+				assert.Nil(t, warnings)
+			} else {
+				assert.Equal(t, test.warnings, warnings)
+			}
+		})
+	}
+}
+
+func TestCheckOCSP(t *testing.T) {
+	inYear := time.Now().Add(365 * 24 * time.Hour)
+	ca := makeCert(t, "root", ecKey(t, elliptic.P256()), nil, inYear)
+	leaf := makeCert(t, "leaf", ecKey(t, elliptic.P256()), ca, inYear)
+
+	staple := func(status int) []byte {
+		resp, err := ocsp.CreateResponse(ca.cert, ca.cert, ocsp.Response{
+			Status:       status,
+			SerialNumber: leaf.cert.SerialNumber,
+			ThisUpdate:   time.Now(),
+			NextUpdate:   inYear,
+			RevokedAt:    time.Now(),
+		}, ca.key)
+		require.NoError(t, err)
+		return resp
+	}
+
+	tests := []struct {
+		name    string
+		staple  []byte
+		status  string
+		revoked bool
+	}{
+		{"good", staple(ocsp.Good), "good", false},
+		{"revoked", staple(ocsp.Revoked), "revoked", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := newChecker(t, Config{RejectRevoked: true})
+			fields := common.MapStr{}
+			err := c.Check(fields, tls.ConnectionState{
+				Version:          tls.VersionTLS12,
+				PeerCertificates: []*x509.Certificate{leaf.cert, ca.cert},
+				OCSPResponse:     test.staple,
+			})
+
+			status, _ := fields.GetValue("tls.server.ocsp.status")
+			assert.Equal(t, test.status, status)
+			if test.revoked {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), "certificate 'leaf' has been revoked")
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
@@ -60,34 +60,13 @@ func AddTLSMetadata(fields common.MapStr, connState cryptoTLS.ConnectionState, d
 func AddCertMetadata(fields common.MapStr, certs []*x509.Certificate) {
 	hostCert := certs[0]
 
-	x509Fields := common.MapStr{}
+	x509Fields := certFields(hostCert)
 	serverFields := common.MapStr{"x509": x509Fields}
 	tlsFields := common.MapStr{"server": serverFields}
 
 	serverFields.Put("hash.sha1", fmt.Sprintf("%x", sha1.Sum(hostCert.Raw)))
 	serverFields.Put("hash.sha256", fmt.Sprintf("%x", sha256.Sum256(hostCert.Raw)))
 
-	x509Fields.Put("issuer.common_name", hostCert.Issuer.CommonName)
-	x509Fields.Put("issuer.distinguished_name", hostCert.Issuer.String())
-	x509Fields.Put("subject.common_name", hostCert.Subject.CommonName)
-	x509Fields.Put("subject.distinguished_name", hostCert.Subject.String())
-	x509Fields.Put("serial_number", hostCert.SerialNumber.String())
-	x509Fields.Put("signature_algorithm", hostCert.SignatureAlgorithm.String())
-	x509Fields.Put("public_key_algorithm", hostCert.PublicKeyAlgorithm.String())
-	if rsaKey, ok := hostCert.PublicKey.(*rsa.PublicKey); ok {
-		sizeInBits := rsaKey.Size() * 8
-		x509Fields.Put("public_key_size", sizeInBits)
-		x509Fields.Put("public_key_exponent", rsaKey.E)
-	} else if dsaKey, ok := hostCert.PublicKey.(*dsa2.PublicKey); ok {
-		if dsaKey.Parameters.P != nil {
-			x509Fields.Put("public_key_size", len(dsaKey.P.Bytes())*8)
-		} else {
-			x509Fields.Put("public_key_size", len(dsaKey.P.Bytes())*8)
-		}
-	} else if ecdsa, ok := hostCert.PublicKey.(*ecdsa.PublicKey); ok {
-		x509Fields.Put("public_key_curve", ecdsa.Curve.Params().Name)
-	}
-
 	chainNotBefore, chainNotAfter := calculateCertTimestamps(certs)
 	// Legacy non-ECS field
 	tlsFields.Put("certificate_not_valid_before", chainNotBefore)
@@ -98,9 +77,53 @@ func AddCertMetadata(fields common.MapStr, certs []*x509.Certificate) {
 		x509Fields.Put("not_after", *chainNotAfter)
 	}
 
+	// Details of every certificate presented by the server, starting with the host certificate.
+	chain := make([]common.MapStr, len(certs))
+	for i, cert := range certs {
+		chain[i] = certFields(cert)
+		chain[i].Put("hash.sha256", fmt.Sprintf("%x", sha256.Sum256(cert.Raw)))
+		chain[i].Put("not_before", cert.NotBefore)
+		chain[i].Put("not_after", cert.NotAfter)
+		chain[i].Put("is_ca", cert.IsCA)
+	}
+	serverFields.Put("chain", chain)
+
 	fields.DeepUpdate(common.MapStr{"tls": tlsFields})
 }
 
+// certFields returns the x509 fields describing a single certificate, except
+// for its validity period.
+func certFields(cert *x509.Certificate) common.MapStr {
+	x509Fields := common.MapStr{}
+	x509Fields.Put("issuer.common_name", cert.Issuer.CommonName)
+	x509Fields.Put("issuer.distinguished_name", cert.Issuer.String())
+	x509Fields.Put("subject.common_name", cert.Subject.CommonName)
+	x509Fields.Put("subject.distinguished_name", cert.Subject.String())
+	x509Fields.Put("serial_number", cert.SerialNumber.String())
+	x509Fields.Put("signature_algorithm", cert.SignatureAlgorithm.String())
+	x509Fields.Put("public_key_algorithm", cert.PublicKeyAlgorithm.String())
+	if size := PublicKeySize(cert); size > 0 {
+		x509Fields.Put("public_key_size", size)
+	}
+	if rsaKey, ok := cert.PublicKey.(*rsa.PublicKey); ok {
+		x509Fields.Put("public_key_exponent", rsaKey.E)
+	} else if ecdsa, ok := cert.PublicKey.(*ecdsa.PublicKey); ok {
+		x509Fields.Put("public_key_curve", ecdsa.Curve.Params().Name)
+	}
+	return x509Fields
+}
+
+// PublicKeySize returns the size in bits of RSA and DSA public keys, or 0 for
+// other key types.
+func PublicKeySize(cert *x509.Certificate) int {
+	if rsaKey, ok := cert.PublicKey.(*rsa.PublicKey); ok {
+		return rsaKey.Size() * 8
+	} else if dsaKey, ok := cert.PublicKey.(*dsa2.PublicKey); ok {
+		return len(dsaKey.P.Bytes()) * 8
+	}
+	return 0
+}
+
 func calculateCertTimestamps(certs []*x509.Certificate) (chainNotBefore time.Time, chainNotAfter *time.Time) {
 	// The behavior here might seem strange. We *always* set a notBefore, but only optionally set a notAfter.
 	// Why might we do this?
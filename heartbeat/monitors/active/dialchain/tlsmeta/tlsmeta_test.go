@@ -112,7 +112,7 @@ func TestAddCertMetadata(t *testing.T) {
 	certNotAfter, err := time.Parse(time.RFC3339, "2020-07-16T03:15:39Z")
 	require.NoError(t, err)
 
-	expectedFields := lookslike.Strict(lookslike.MustCompile(map[string]interface{}{
+	expectedFields := lookslike.MustCompile(map[string]interface{}{
 		"certificate_not_valid_after":  certNotAfter,
 		"certificate_not_valid_before": certNotBefore,
 		"server": common.MapStr{
@@ -138,19 +138,46 @@ func TestAddCertMetadata(t *testing.T) {
 				"public_key_exponent":  65537,
 			},
 		},
-	}))
+	})
+
+	hostChainFields := common.MapStr{
+		"issuer.common_name":         "GlobalSign CloudSSL CA - SHA256 - G3",
+		"issuer.distinguished_name":  "CN=GlobalSign CloudSSL CA - SHA256 - G3,O=GlobalSign nv-sa,C=BE",
+		"subject.common_name":        "r2.shared.global.fastly.net",
+		"subject.distinguished_name": "CN=r2.shared.global.fastly.net,O=Fastly\\, Inc.,L=San Francisco,ST=California,C=US",
+		"hash.sha256":                "12b00d04db0db8caa302bfde043e88f95baceb91e86ac143e93830b4bbec726d",
+		"not_after":                  certNotAfter,
+		"not_before":                 certNotBefore,
+		"serial_number":              "26610543540289562361990401194",
+		"signature_algorithm":        "SHA256-RSA",
+		"public_key_algorithm":       "RSA",
+		"public_key_size":            2048,
+		"public_key_exponent":        65537,
+		"is_ca":                      false,
+	}
 
 	scenarios := []struct {
-		name  string
-		certs []*x509.Certificate
+		name          string
+		certs         []*x509.Certificate
+		expectedChain []common.MapStr
 	}{
 		{
 			"single cert fields should all be present",
 			[]*x509.Certificate{cert},
+			[]common.MapStr{hostChainFields},
 		},
 		{
 			"cert chain should still show single cert fields",
 			[]*x509.Certificate{cert, chainCert},
+			[]common.MapStr{
+				hostChainFields,
+				{
+					"subject.common_name": "GlobalSign CloudSSL CA - SHA256 - G3",
+					"issuer.common_name":  chainCert.Issuer.CommonName,
+					"not_after":           chainCert.NotAfter,
+					"is_ca":               true,
+				},
+			},
 		},
 	}
 
@@ -160,7 +187,16 @@ func TestAddCertMetadata(t *testing.T) {
 			AddCertMetadata(fields, scenario.certs)
 			tls, err := fields.GetValue("tls")
 			require.NoError(t, err)
-			testslike.Test(t, expectedFields, tls)
+
+			chain, err := fields.GetValue("tls.server.chain")
+			require.NoError(t, err)
+			fields.Delete("tls.server.chain")
+			testslike.Test(t, lookslike.Strict(expectedFields), tls)
+
+			require.Len(t, chain, len(scenario.expectedChain))
+			for i, expected := range scenario.expectedChain {
+				testslike.Test(t, lookslike.MustCompile(map[string]interface{}(expected)), chain.([]common.MapStr)[i])
+			}
 		})
 	}
 }
@@ -275,7 +311,7 @@ func TestCertExpirationMetadata(t *testing.T) {
 }
 
 func parseCert(t *testing.T, pemStr string) *x509.Certificate {
-	block, _ := pem.Decode([]byte(elasticCert))
+	block, _ := pem.Decode([]byte(pemStr))
 	if block == nil {
 		require.Fail(t, "Test cert could not be parsed")
 	}
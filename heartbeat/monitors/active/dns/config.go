@@ -0,0 +1,125 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/elastic/beats/v7/libbeat/conditions"
+)
+
+type config struct {
+	// Hosts is the list of names to resolve.
+	Hosts []string `config:"hosts" validate:"required"`
+
+	// Resolvers is the list of name servers to query. Defaults to the
+	// name servers configured in /etc/resolv.conf.
+	Resolvers []string `config:"resolvers"`
+
+	QueryTypes []string      `config:"query_types"`
+	Transport  string        `config:"transport"`
+	Timeout    time.Duration `config:"timeout"`
+
+	// DNSSEC sets the DO bit on queries and reports the validation status
+	// signaled by the resolver.
+	DNSSEC bool `config:"dnssec"`
+
+	Check checkConfig `config:"check"`
+}
+
+type checkConfig struct {
+	// RCodes lists the accepted response codes, e.g. NOERROR or NXDOMAIN.
+	RCodes []string `config:"rcode"`
+
+	// Answers lists record data that must be present in the answer section.
+	Answers []string `config:"answers"`
+
+	// Condition is evaluated against the dns fields of the event.
+	Condition *conditions.Config `config:"condition"`
+}
+
+var defaultConfig = config{
+	QueryTypes: []string{"A"},
+	Transport:  "udp",
+	Timeout:    16 * time.Second,
+	Check: checkConfig{
+		RCodes: []string{"NOERROR"},
+	},
+}
+
+// supportedTypes maps the configurable query types to their DNS type codes.
+var supportedTypes = map[string]uint16{
+	"A":     dns.TypeA,
+	"AAAA":  dns.TypeAAAA,
+	"CNAME": dns.TypeCNAME,
+	"MX":    dns.TypeMX,
+	"TXT":   dns.TypeTXT,
+	"SRV":   dns.TypeSRV,
+}
+
+func (c *config) Validate() error {
+	for _, t := range c.QueryTypes {
+		if _, ok := supportedTypes[strings.ToUpper(t)]; !ok {
+			return fmt.Errorf("unsupported query type '%v'", t)
+		}
+	}
+
+	switch c.Transport {
+	case "udp", "tcp":
+	default:
+		return fmt.Errorf("unsupported transport '%v', must be one of udp or tcp", c.Transport)
+	}
+
+	for _, code := range c.Check.RCodes {
+		if _, ok := dns.StringToRcode[strings.ToUpper(code)]; !ok {
+			return fmt.Errorf("unknown response code '%v'", code)
+		}
+	}
+
+	return nil
+}
+
+// resolverAddrs returns the configured resolvers with the default DNS port
+// added where missing, falling back to the system configured name servers.
+func (c *config) resolverAddrs() ([]string, error) {
+	resolvers := c.Resolvers
+	if len(resolvers) == 0 {
+		cc, err := dns.ClientConfigFromFile("/etc/resolv.conf")
+		if err != nil {
+			return nil, fmt.Errorf("no resolvers configured and failed to read system configuration: %v", err)
+		}
+		resolvers = cc.Servers
+	}
+	if len(resolvers) == 0 {
+		return nil, fmt.Errorf("no resolvers configured")
+	}
+
+	addrs := make([]string, 0, len(resolvers))
+	for _, r := range resolvers {
+		if _, _, err := net.SplitHostPort(r); err != nil {
+			r = net.JoinHostPort(strings.Trim(r, "[]"), "53")
+		}
+		addrs = append(addrs, r)
+	}
+	return addrs, nil
+}
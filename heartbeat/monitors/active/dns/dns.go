@@ -0,0 +1,325 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/look"
+	"github.com/elastic/beats/v7/heartbeat/monitors"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/reason"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/conditions"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func init() {
+	plugin.Register("dns", create, "synthetics/dns")
+}
+
+var debugf = logp.MakeDebug("dns")
+
+// DNSSEC validation states reported in dns.dnssec.status.
+const (
+	dnssecSecure        = "secure"
+	dnssecInsecure      = "insecure"
+	dnssecBogus         = "bogus"
+	dnssecIndeterminate = "indeterminate"
+)
+
+func create(
+	name string,
+	cfg *common.Config,
+) (p plugin.Plugin, err error) {
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	jf, err := newJobFactory(config)
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+	return jf.makePlugin()
+}
+
+// jobFactory creates one job per host, resolver and query type.
+type jobFactory struct {
+	config    config
+	resolvers []string
+	rcodes    map[int]bool
+	answers   []string
+	condition conditions.Condition
+}
+
+func newJobFactory(config config) (*jobFactory, error) {
+	resolvers, err := config.resolverAddrs()
+	if err != nil {
+		return nil, err
+	}
+
+	jf := &jobFactory{
+		config:    config,
+		resolvers: resolvers,
+		rcodes:    map[int]bool{},
+	}
+
+	for _, code := range config.Check.RCodes {
+		jf.rcodes[dns.StringToRcode[strings.ToUpper(code)]] = true
+	}
+	for _, answer := range config.Check.Answers {
+		jf.answers = append(jf.answers, normalizeData(answer))
+	}
+	if config.Check.Condition != nil {
+		jf.condition, err = conditions.NewCondition(config.Check.Condition)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create dns check condition: %v", err)
+		}
+	}
+
+	return jf, nil
+}
+
+func (jf *jobFactory) makePlugin() (plugin.Plugin, error) {
+	var js []jobs.Job
+	var keys []string
+	for _, host := range jf.config.Hosts {
+		for _, resolver := range jf.resolvers {
+			for _, typ := range jf.config.QueryTypes {
+				typ = strings.ToUpper(typ)
+
+				u := &url.URL{
+					Scheme:   "dns",
+					Host:     resolver,
+					Path:     "/" + host,
+					RawQuery: url.Values{"type": []string{typ}}.Encode(),
+				}
+
+				job := jf.makeJob(host, resolver, supportedTypes[typ])
+				js = append(js, wrappers.WithURLField(u, job))
+				keys = append(keys, u.String())
+			}
+		}
+	}
+
+	return plugin.Plugin{Jobs: js, Close: nil, Endpoints: len(js), Keys: keys}, nil
+}
+
+func (jf *jobFactory) makeJob(host, resolver string, qtype uint16) jobs.Job {
+	return monitors.MakeSimpleCont(func(event *beat.Event) error {
+		return jf.query(event, host, resolver, qtype)
+	})
+}
+
+func (jf *jobFactory) query(event *beat.Event, host, resolver string, qtype uint16) error {
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(host), qtype)
+	msg.RecursionDesired = true
+	if jf.config.DNSSEC {
+		msg.SetEdns0(4096, true)
+	}
+
+	resp, rtt, err := jf.exchange(msg, resolver)
+	if err != nil {
+		debugf("query for %v %v against %v failed: %v", host, dns.TypeToString[qtype], resolver, err)
+		return reason.IOFailed(err)
+	}
+
+	fields := responseFields(msg.Question[0], resp)
+	fields["rtt"] = look.RTT(rtt)
+	if jf.config.DNSSEC {
+		fields.Put("dnssec.status", jf.dnssecStatus(msg, resp, resolver))
+	}
+	eventext.MergeEventFields(event, common.MapStr{"dns": fields})
+
+	return jf.check(resp, fields)
+}
+
+// exchange sends the query to the resolver, retrying over TCP if a UDP
+// response was truncated.
+func (jf *jobFactory) exchange(msg *dns.Msg, resolver string) (*dns.Msg, time.Duration, error) {
+	client := &dns.Client{Net: jf.config.Transport, Timeout: jf.config.Timeout}
+	resp, rtt, err := client.Exchange(msg, resolver)
+	if err == nil && resp.Truncated && client.Net == "udp" {
+		client.Net = "tcp"
+		resp, rtt, err = client.Exchange(msg, resolver)
+	}
+	return resp, rtt, err
+}
+
+// dnssecStatus derives the validation status from the response of a
+// validating resolver. A SERVFAIL that goes away when validation is disabled
+// indicates records that failed validation.
+func (jf *jobFactory) dnssecStatus(msg, resp *dns.Msg, resolver string) string {
+	if resp.AuthenticatedData {
+		return dnssecSecure
+	}
+	if resp.Rcode != dns.RcodeServerFailure {
+		return dnssecInsecure
+	}
+
+	cdMsg := msg.Copy()
+	cdMsg.Id = dns.Id()
+	cdMsg.CheckingDisabled = true
+	cdResp, _, err := jf.exchange(cdMsg, resolver)
+	if err != nil || cdResp.Rcode == dns.RcodeServerFailure {
+		return dnssecIndeterminate
+	}
+	return dnssecBogus
+}
+
+func (jf *jobFactory) check(resp *dns.Msg, fields common.MapStr) error {
+	if len(jf.rcodes) > 0 && !jf.rcodes[resp.Rcode] {
+		return reason.ValidateFailed(fmt.Errorf("received response code %v", rcodeString(resp.Rcode)))
+	}
+
+	if len(jf.answers) > 0 {
+		found := map[string]bool{}
+		for _, rr := range resp.Answer {
+			found[normalizeData(rrData(rr))] = true
+		}
+
+		var missing []string
+		for _, answer := range jf.answers {
+			if !found[answer] {
+				missing = append(missing, answer)
+			}
+		}
+		if len(missing) > 0 {
+			return reason.ValidateFailed(fmt.Errorf("expected answers missing: %v", strings.Join(missing, ", ")))
+		}
+	}
+
+	if jf.condition != nil && !jf.condition.Check(common.MapStr{"dns": fields}) {
+		return reason.ValidateFailed(errors.New("dns response did not match check condition"))
+	}
+
+	return nil
+}
+
+// responseFields converts the response into ECS dns fields.
+func responseFields(question dns.Question, resp *dns.Msg) common.MapStr {
+	fields := common.MapStr{
+		"id":   resp.Id,
+		"type": "answer",
+		"question": common.MapStr{
+			"name":  strings.TrimSuffix(question.Name, "."),
+			"type":  dns.TypeToString[question.Qtype],
+			"class": dns.ClassToString[question.Qclass],
+		},
+		"response_code": rcodeString(resp.Rcode),
+		"header_flags":  headerFlags(resp),
+	}
+
+	var answers []common.MapStr
+	var resolvedIPs []string
+	for _, rr := range resp.Answer {
+		hdr := rr.Header()
+		data := rrData(rr)
+		answers = append(answers, common.MapStr{
+			"name":  strings.TrimSuffix(hdr.Name, "."),
+			"type":  dns.TypeToString[hdr.Rrtype],
+			"class": dns.ClassToString[hdr.Class],
+			"ttl":   hdr.Ttl,
+			"data":  data,
+		})
+
+		switch hdr.Rrtype {
+		case dns.TypeA, dns.TypeAAAA:
+			resolvedIPs = append(resolvedIPs, data)
+		}
+	}
+	if len(answers) > 0 {
+		fields["answers"] = answers
+	}
+	if len(resolvedIPs) > 0 {
+		fields["resolved_ip"] = resolvedIPs
+	}
+
+	return fields
+}
+
+func headerFlags(m *dns.Msg) []string {
+	var flags []string
+	if m.Authoritative {
+		flags = append(flags, "AA")
+	}
+	if m.Truncated {
+		flags = append(flags, "TC")
+	}
+	if m.RecursionDesired {
+		flags = append(flags, "RD")
+	}
+	if m.RecursionAvailable {
+		flags = append(flags, "RA")
+	}
+	if m.AuthenticatedData {
+		flags = append(flags, "AD")
+	}
+	if m.CheckingDisabled {
+		flags = append(flags, "CD")
+	}
+	if opt := m.IsEdns0(); opt != nil && opt.Do() {
+		flags = append(flags, "DO")
+	}
+	return flags
+}
+
+// rrData returns the record data of a resource record, without the header.
+func rrData(rr dns.RR) string {
+	switch r := rr.(type) {
+	case *dns.A:
+		return r.A.String()
+	case *dns.AAAA:
+		return r.AAAA.String()
+	case *dns.CNAME:
+		return r.Target
+	case *dns.TXT:
+		return strings.Join(r.Txt, "")
+	case *dns.MX:
+		return fmt.Sprintf("%d %s", r.Preference, r.Mx)
+	case *dns.SRV:
+		return fmt.Sprintf("%d %d %d %s", r.Priority, r.Weight, r.Port, r.Target)
+	default:
+		return strings.TrimSpace(strings.TrimPrefix(rr.String(), rr.Header().String()))
+	}
+}
+
+// normalizeData makes record data comparable regardless of case and of
+// fully qualified names.
+func normalizeData(s string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(s), "."))
+}
+
+func rcodeString(rcode int) string {
+	if s, ok := dns.RcodeToString[rcode]; ok {
+		return s
+	}
+	return fmt.Sprintf("RCODE%d", rcode)
+}
@@ -0,0 +1,355 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/heartbeat/hbtest"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/isdef"
+	"github.com/elastic/go-lookslike/testslike"
+	"github.com/elastic/go-lookslike/validator"
+)
+
+func TestDNSUp(t *testing.T) {
+	addr := serveDNS(t, fakeDNSHandler)
+
+	events := execTestDNSCheck(t, common.MapStr{
+		"hosts":       []string{"example.com"},
+		"resolvers":   []string{addr},
+		"query_types": []string{"A", "mx"},
+	})
+	require.Len(t, events, 2)
+
+	testslike.Test(t, lookslike.Strict(lookslike.Compose(
+		hbtest.BaseChecks("", "up", "dns"),
+		hbtest.SummaryChecks(1, 0),
+		hbtest.URLChecks(t, &url.URL{Scheme: "dns", Host: addr, Path: "/example.com", RawQuery: "type=A"}),
+		lookslike.MustCompile(map[string]interface{}{
+			"dns": map[string]interface{}{
+				"id":             isdef.KeyPresent,
+				"type":           "answer",
+				"question.name":  "example.com",
+				"question.type":  "A",
+				"question.class": "IN",
+				"response_code":  "NOERROR",
+				"header_flags":   []string{"AA", "RD"},
+				"answers": []common.MapStr{{
+					"name":  "example.com",
+					"type":  "A",
+					"class": "IN",
+					"ttl":   uint32(300),
+					"data":  "192.0.2.1",
+				}},
+				"resolved_ip": []string{"192.0.2.1"},
+				"rtt.us":      isdef.IsDuration,
+			},
+		}),
+	)), events[0].Fields)
+
+	testslike.Test(t, lookslike.MustCompile(map[string]interface{}{
+		"monitor.status":    "up",
+		"dns.question.type": "MX",
+		"dns.answers":       []common.MapStr{{"name": "example.com", "type": "MX", "class": "IN", "ttl": uint32(300), "data": "10 mail.example.com."}},
+	}), events[1].Fields)
+}
+
+func TestDNSChecks(t *testing.T) {
+	addr := serveDNS(t, fakeDNSHandler)
+
+	tests := []struct {
+		name   string
+		config common.MapStr
+		status string
+		errMsg string
+	}{
+		{
+			"expected answer",
+			common.MapStr{"check.answers": []string{"192.0.2.1"}},
+			"up",
+			"",
+		},
+		{
+			"expected answer case insensitive",
+			common.MapStr{"query_types": []string{"MX"}, "check.answers": []string{"10 MAIL.example.com"}},
+			"up",
+			"",
+		},
+		{
+			"missing answer",
+			common.MapStr{"check.answers": []string{"192.0.2.1", "192.0.2.2"}},
+			"down",
+			"expected answers missing: 192.0.2.2",
+		},
+		{
+			"unexpected rcode",
+			common.MapStr{"hosts": []string{"missing.example.com"}},
+			"down",
+			"received response code NXDOMAIN",
+		},
+		{
+			"accepted rcode",
+			common.MapStr{"hosts": []string{"missing.example.com"}, "check.rcode": []string{"NXDOMAIN"}},
+			"up",
+			"",
+		},
+		{
+			"matching condition",
+			common.MapStr{"check.condition": common.MapStr{"contains": common.MapStr{"dns.resolved_ip": "192.0.2.1"}}},
+			"up",
+			"",
+		},
+		{
+			"failing condition",
+			common.MapStr{"check.condition": common.MapStr{"equals": common.MapStr{"dns.response_code": "NXDOMAIN"}}},
+			"down",
+			"did not match check condition",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := common.MapStr{
+				"hosts":     []string{"example.com"},
+				"resolvers": []string{addr},
+			}
+			config.DeepUpdate(test.config)
+
+			events := execTestDNSCheck(t, config)
+			require.Len(t, events, 1)
+
+			validators := []validator.Validator{
+				lookslike.MustCompile(map[string]interface{}{"monitor.status": test.status}),
+			}
+			if test.errMsg != "" {
+				validators = append(validators, hbtest.ErrorChecks(test.errMsg, "validate"))
+			}
+			testslike.Test(t, lookslike.Compose(validators...), events[0].Fields)
+		})
+	}
+}
+
+func TestDNSSECStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler dns.HandlerFunc
+		status  string
+	}{
+		{
+			"secure",
+			func(w dns.ResponseWriter, req *dns.Msg) {
+				m := answer(req)
+				m.AuthenticatedData = true
+				w.WriteMsg(m)
+			},
+			dnssecSecure,
+		},
+		{
+			"insecure",
+			fakeDNSHandler,
+			dnssecInsecure,
+		},
+		{
+			"bogus",
+			func(w dns.ResponseWriter, req *dns.Msg) {
+				if !req.CheckingDisabled {
+					m := new(dns.Msg)
+					m.SetRcode(req, dns.RcodeServerFailure)
+					w.WriteMsg(m)
+					return
+				}
+				w.WriteMsg(answer(req))
+			},
+			dnssecBogus,
+		},
+		{
+			"indeterminate",
+			func(w dns.ResponseWriter, req *dns.Msg) {
+				m := new(dns.Msg)
+				m.SetRcode(req, dns.RcodeServerFailure)
+				w.WriteMsg(m)
+			},
+			dnssecIndeterminate,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			addr := serveDNS(t, test.handler)
+
+			events := execTestDNSCheck(t, common.MapStr{
+				"hosts":     []string{"example.com"},
+				"resolvers": []string{addr},
+				"dnssec":    true,
+			})
+			require.Len(t, events, 1)
+
+			testslike.Test(t, lookslike.MustCompile(map[string]interface{}{
+				"dns.dnssec.status": test.status,
+			}), events[0].Fields)
+		})
+	}
+}
+
+func TestDNSTruncatedRetriesTCP(t *testing.T) {
+	handler := func(w dns.ResponseWriter, req *dns.Msg) {
+		m := answer(req)
+		if w.LocalAddr().Network() == "udp" {
+			m.Answer = nil
+			m.Truncated = true
+		}
+		w.WriteMsg(m)
+	}
+
+	udpAddr := serveDNS(t, handler)
+	l, err := net.Listen("tcp", udpAddr)
+	require.NoError(t, err)
+	server := &dns.Server{Listener: l, Handler: dns.HandlerFunc(handler)}
+	go server.ActivateAndServe()
+	defer server.Shutdown()
+
+	events := execTestDNSCheck(t, common.MapStr{
+		"hosts":         []string{"example.com"},
+		"resolvers":     []string{udpAddr},
+		"check.answers": []string{"192.0.2.1"},
+	})
+	require.Len(t, events, 1)
+
+	testslike.Test(t, lookslike.MustCompile(map[string]interface{}{
+		"monitor.status": "up",
+	}), events[0].Fields)
+}
+
+func TestDNSUnreachableResolver(t *testing.T) {
+	l, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.LocalAddr().String()
+	l.Close()
+
+	events := execTestDNSCheck(t, common.MapStr{
+		"hosts":     []string{"example.com"},
+		"resolvers": []string{addr},
+		"timeout":   "100ms",
+	})
+	require.Len(t, events, 1)
+
+	testslike.Test(t, lookslike.Compose(
+		hbtest.BaseChecks("", "down", "dns"),
+		hbtest.ErrorChecks("", "io"),
+	), events[0].Fields)
+}
+
+func TestConfigValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		config common.MapStr
+	}{
+		{"unsupported query type", common.MapStr{"query_types": []string{"PTR"}}},
+		{"unsupported transport", common.MapStr{"transport": "tls"}},
+		{"unknown rcode", common.MapStr{"check.rcode": []string{"NOPE"}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := common.MapStr{"hosts": []string{"example.com"}, "resolvers": []string{"127.0.0.1"}}
+			config.DeepUpdate(test.config)
+
+			_, err := create("dns", common.MustNewConfigFrom(config))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestResolverAddrs(t *testing.T) {
+	c := config{Resolvers: []string{"127.0.0.1", "127.0.0.1:5353", "::1", "[::1]:5353"}}
+	addrs, err := c.resolverAddrs()
+	require.NoError(t, err)
+	require.Equal(t, []string{"127.0.0.1:53", "127.0.0.1:5353", "[::1]:53", "[::1]:5353"}, addrs)
+}
+
+func execTestDNSCheck(t *testing.T, config common.MapStr) []*beat.Event {
+	p, err := create("dns", common.MustNewConfigFrom(config))
+	require.NoError(t, err)
+
+	sched, _ := schedule.Parse("@every 1s")
+	wrapped := wrappers.WrapCommon(p.Jobs, stdfields.StdMonitorFields{ID: "test", Type: "dns", Schedule: sched, Timeout: 1})
+
+	var events []*beat.Event
+	for _, job := range wrapped {
+		e := &beat.Event{}
+		_, err := job(e)
+		require.NoError(t, err)
+		events = append(events, e)
+	}
+	return events
+}
+
+// serveDNS starts an in-process DNS server on an ephemeral UDP port.
+func serveDNS(t *testing.T, h dns.HandlerFunc) string {
+	l, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	started := make(chan struct{})
+	server := &dns.Server{PacketConn: l, Handler: h, NotifyStartedFunc: func() { close(started) }}
+	go server.ActivateAndServe()
+	<-started
+	t.Cleanup(func() { server.Shutdown() })
+
+	return l.LocalAddr().String()
+}
+
+func fakeDNSHandler(w dns.ResponseWriter, req *dns.Msg) {
+	w.WriteMsg(answer(req))
+}
+
+// answer builds an authoritative reply for example.com, or NXDOMAIN for
+// any other name.
+func answer(req *dns.Msg) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetReply(req)
+	m.Authoritative = true
+
+	q := req.Question[0]
+	if q.Name != "example.com." {
+		m.Rcode = dns.RcodeNameError
+		return m
+	}
+
+	var rr dns.RR
+	switch q.Qtype {
+	case dns.TypeA:
+		rr, _ = dns.NewRR("example.com. 300 IN A 192.0.2.1")
+	case dns.TypeMX:
+		rr, _ = dns.NewRR("example.com. 300 IN MX 10 mail.example.com.")
+	}
+	if rr != nil {
+		m.Answer = append(m.Answer, rr)
+	}
+	return m
+}
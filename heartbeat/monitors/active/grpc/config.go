@@ -0,0 +1,86 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grpc
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlscheck"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+type config struct {
+	// Hosts is the list of servers to check, as host:port.
+	Hosts []string `config:"hosts" validate:"required"`
+
+	// Services is the list of service names to check the health of. The
+	// empty name checks the overall health of the server, which is the
+	// default if no service is configured nor discovered.
+	Services []string `config:"services"`
+
+	// Reflection additionally checks every service listed by the server
+	// through the reflection API.
+	Reflection bool `config:"reflection"`
+
+	// Watch uses the streaming Watch method, waiting until the service
+	// reports an accepted status or the timeout expires, instead of Check.
+	Watch bool `config:"watch"`
+
+	// Metadata is sent with every request.
+	Metadata map[string]string `config:"metadata"`
+
+	Timeout time.Duration     `config:"timeout"`
+	TLS     *tlscommon.Config `config:"ssl"`
+
+	Check checkConfig `config:"check"`
+}
+
+type checkConfig struct {
+	// Status lists the accepted serving statuses, e.g. SERVING.
+	Status []string `config:"status"`
+
+	TLS *tlscheck.Config `config:"tls"`
+}
+
+var defaultConfig = config{
+	Timeout: 16 * time.Second,
+	Check: checkConfig{
+		Status: []string{healthpb.HealthCheckResponse_SERVING.String()},
+	},
+}
+
+func (c *config) Validate() error {
+	for _, host := range c.Hosts {
+		if _, port, err := net.SplitHostPort(host); err != nil || port == "" {
+			return fmt.Errorf("invalid host '%v', must be host:port", host)
+		}
+	}
+
+	for _, status := range c.Check.Status {
+		if _, ok := healthpb.HealthCheckResponse_ServingStatus_value[strings.ToUpper(status)]; !ok {
+			return fmt.Errorf("unknown serving status '%v'", status)
+		}
+	}
+
+	return nil
+}
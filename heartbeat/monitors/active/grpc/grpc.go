@@ -0,0 +1,454 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/look"
+	"github.com/elastic/beats/v7/heartbeat/monitors"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlscheck"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlsmeta"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/reason"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/v7/libbeat/common/useragent"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func init() {
+	plugin.Register("grpc", create, "synthetics/grpc")
+}
+
+var debugf = logp.MakeDebug("grpc")
+
+var userAgent = useragent.UserAgent("Heartbeat", true)
+
+// Services implemented by most servers that are not checked when listing
+// services through reflection.
+var ignoredServices = map[string]bool{
+	"grpc.health.v1.Health":                    true,
+	"grpc.reflection.v1alpha.ServerReflection": true,
+	"grpc.reflection.v1.ServerReflection":      true,
+	"grpc.channelz.v1.Channelz":                true,
+}
+
+func create(
+	name string,
+	cfg *common.Config,
+) (p plugin.Plugin, err error) {
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	jf, err := newJobFactory(config)
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+	return jf.makePlugin()
+}
+
+// jobFactory creates one job per host and service. With reflection enabled
+// it creates one job per host instead, which lists the services of the
+// server and continues with a job per service.
+type jobFactory struct {
+	config     config
+	tls        *tlscommon.TLSConfig
+	tlsChecker *tlscheck.Checker
+	statuses   map[healthpb.HealthCheckResponse_ServingStatus]bool
+	metadata   metadata.MD
+}
+
+func newJobFactory(config config) (*jobFactory, error) {
+	tls, err := tlscommon.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsChecker, err := tlscheck.New(config.Check.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	jf := &jobFactory{
+		config:     config,
+		tls:        tls,
+		tlsChecker: tlsChecker,
+		statuses:   map[healthpb.HealthCheckResponse_ServingStatus]bool{},
+		metadata:   metadata.New(config.Metadata),
+	}
+	for _, s := range config.Check.Status {
+		jf.statuses[healthpb.HealthCheckResponse_ServingStatus(healthpb.HealthCheckResponse_ServingStatus_value[strings.ToUpper(s)])] = true
+	}
+
+	return jf, nil
+}
+
+func (jf *jobFactory) makePlugin() (plugin.Plugin, error) {
+	var js []jobs.Job
+	var keys []string
+	for _, host := range jf.config.Hosts {
+		if jf.config.Reflection {
+			js = append(js, jf.makeReflectionJob(host))
+			keys = append(keys, jf.url(host, "").String())
+			continue
+		}
+
+		services := jf.config.Services
+		if len(services) == 0 {
+			services = []string{""}
+		}
+		for _, service := range services {
+			js = append(js, jf.makeCheckJob(host, service))
+			keys = append(keys, jf.url(host, service).String())
+		}
+	}
+
+	return plugin.Plugin{Jobs: js, Close: nil, Endpoints: len(js), Keys: keys}, nil
+}
+
+// makeReflectionJob lists the services of the server, continuing with a
+// check of every listed and configured service. The listing event itself is
+// only reported if it fails.
+func (jf *jobFactory) makeReflectionJob(host string) jobs.Job {
+	return func(event *beat.Event) ([]jobs.Job, error) {
+		eventext.MergeEventFields(event, common.MapStr{"url": wrappers.URLFields(jf.url(host, ""))})
+
+		listed, err := jf.listServices(host)
+		if err != nil {
+			debugf("listing services of %v failed: %v", host, err)
+			return nil, err
+		}
+
+		seen := map[string]bool{}
+		var services []string
+		for _, service := range append(append([]string{}, jf.config.Services...), listed...) {
+			if !seen[service] {
+				seen[service] = true
+				services = append(services, service)
+			}
+		}
+		if len(services) == 0 {
+			return nil, reason.ValidateFailed(errors.New("no services listed through reflection"))
+		}
+
+		eventext.CancelEvent(event)
+
+		conts := make([]jobs.Job, len(services))
+		for i, service := range services {
+			conts[i] = jf.makeCheckJob(host, service)
+		}
+		return conts, nil
+	}
+}
+
+func (jf *jobFactory) makeCheckJob(host, service string) jobs.Job {
+	return monitors.MakeSimpleCont(func(event *beat.Event) error {
+		return jf.check(event, host, service)
+	})
+}
+
+// url returns the url reported for a service. The url fields are set by the
+// jobs themselves, as continuations of a reflection job each check a
+// different service.
+func (jf *jobFactory) url(host, service string) *url.URL {
+	u := &url.URL{Scheme: "grpc", Host: host}
+	if jf.tls != nil {
+		u.Scheme = "grpcs"
+	}
+	if service != "" {
+		u.Path = "/" + service
+	}
+	return u
+}
+
+func (jf *jobFactory) check(event *beat.Event, host, service string) error {
+	eventext.MergeEventFields(event, common.MapStr{"url": wrappers.URLFields(jf.url(host, service))})
+
+	ctx, cancel := jf.context()
+	defer cancel()
+
+	start := time.Now()
+	conn, info, err := jf.dial(ctx, host)
+	if err != nil {
+		return reason.IOFailed(err)
+	}
+	defer conn.Close()
+
+	servingStatus, err := jf.health(ctx, conn, service)
+	total := time.Since(start)
+
+	method := "Check"
+	if jf.config.Watch {
+		method = "Watch"
+	}
+	rtt := common.MapStr{"total": look.RTT(total)}
+	fields := common.MapStr{
+		"method":      method,
+		"status_code": status.Code(err).String(),
+		"rtt":         rtt,
+	}
+	if service != "" {
+		fields["service"] = service
+	}
+	if err == nil {
+		fields["health"] = common.MapStr{"status": servingStatus.String()}
+	}
+
+	info.mtx.Lock()
+	defer info.mtx.Unlock()
+
+	if info.connect > 0 {
+		rtt["connect"] = look.RTT(info.connect)
+	}
+
+	root := common.MapStr{"grpc": fields}
+	var tlsErr reason.Reason
+	if info.state != nil {
+		tlsmeta.AddTLSMetadata(root, *info.state, info.handshake)
+		tlsErr = jf.tlsChecker.Check(root, *info.state)
+	}
+	eventext.MergeEventFields(event, root)
+
+	if err != nil {
+		debugf("health check of %v on %v failed: %v", service, host, err)
+		return rpcError(err, info.dialErr)
+	}
+	if tlsErr != nil {
+		return tlsErr
+	}
+	if !jf.statuses[servingStatus] {
+		return reason.ValidateFailed(fmt.Errorf("service reported status %v", servingStatus))
+	}
+	return nil
+}
+
+// health calls the health service. In watch mode, it waits until an accepted
+// status is reported, returning the last reported status once the timeout
+// expires.
+func (jf *jobFactory) health(
+	ctx context.Context,
+	conn *grpc.ClientConn,
+	service string,
+) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	client := healthpb.NewHealthClient(conn)
+	req := &healthpb.HealthCheckRequest{Service: service}
+
+	if !jf.config.Watch {
+		resp, err := client.Check(ctx, req)
+		if err != nil {
+			return healthpb.HealthCheckResponse_UNKNOWN, err
+		}
+		return resp.Status, nil
+	}
+
+	stream, err := client.Watch(ctx, req)
+	if err != nil {
+		return healthpb.HealthCheckResponse_UNKNOWN, err
+	}
+
+	received := false
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if received && ctx.Err() != nil {
+				return last, nil
+			}
+			return last, err
+		}
+
+		received = true
+		last = resp.Status
+		if jf.statuses[last] {
+			return last, nil
+		}
+	}
+}
+
+// listServices lists the services of the server through the reflection API.
+func (jf *jobFactory) listServices(host string) ([]string, error) {
+	ctx, cancel := jf.context()
+	defer cancel()
+
+	conn, info, err := jf.dial(ctx, host)
+	if err != nil {
+		return nil, reason.IOFailed(err)
+	}
+	defer conn.Close()
+
+	stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, rpcError(err, info.dialError())
+	}
+
+	// Send errors are reported by Recv.
+	stream.Send(&rpb.ServerReflectionRequest{
+		Host:           host,
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{ListServices: "*"},
+	})
+	resp, err := stream.Recv()
+	if err != nil {
+		return nil, rpcError(err, info.dialError())
+	}
+	stream.CloseSend()
+
+	if e := resp.GetErrorResponse(); e != nil {
+		return nil, reason.ValidateFailed(fmt.Errorf("listing services failed with %v: %v", codes.Code(e.ErrorCode), e.ErrorMessage))
+	}
+
+	var services []string
+	for _, s := range resp.GetListServicesResponse().GetService() {
+		if !ignoredServices[s.Name] {
+			services = append(services, s.Name)
+		}
+	}
+	return services, nil
+}
+
+func (jf *jobFactory) context() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), jf.config.Timeout)
+	if len(jf.metadata) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, jf.metadata)
+	}
+	return ctx, cancel
+}
+
+// connInfo collects timings and the TLS state of a connection, as reported
+// by the dialer and transport credentials.
+type connInfo struct {
+	mtx       sync.Mutex
+	connect   time.Duration
+	handshake time.Duration
+	state     *tls.ConnectionState
+	dialErr   error
+}
+
+func (i *connInfo) dialError() error {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+	return i.dialErr
+}
+
+// dial creates a client connection to the host. The connection is
+// established in the background by the first call.
+func (jf *jobFactory) dial(ctx context.Context, host string) (*grpc.ClientConn, *connInfo, error) {
+	info := &connInfo{}
+
+	opts := []grpc.DialOption{
+		grpc.WithUserAgent(userAgent),
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			start := time.Now()
+			conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+
+			info.mtx.Lock()
+			defer info.mtx.Unlock()
+			if err != nil {
+				info.dialErr = err
+				return nil, err
+			}
+			info.connect = time.Since(start)
+			return conn, nil
+		}),
+	}
+
+	if jf.tls != nil {
+		hostname, _, _ := net.SplitHostPort(host)
+		creds := credentials.NewTLS(jf.tls.BuildModuleClientConfig(hostname))
+		opts = append(opts, grpc.WithTransportCredentials(&timedCredentials{creds, info}))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+
+	conn, err := grpc.DialContext(ctx, host, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, info, nil
+}
+
+// timedCredentials records the duration and resulting state of the TLS
+// handshake.
+type timedCredentials struct {
+	credentials.TransportCredentials
+	info *connInfo
+}
+
+func (c *timedCredentials) ClientHandshake(
+	ctx context.Context,
+	authority string,
+	rawConn net.Conn,
+) (net.Conn, credentials.AuthInfo, error) {
+	start := time.Now()
+	conn, auth, err := c.TransportCredentials.ClientHandshake(ctx, authority, rawConn)
+
+	c.info.mtx.Lock()
+	defer c.info.mtx.Unlock()
+	if err != nil {
+		c.info.dialErr = err
+		return conn, auth, err
+	}
+	if tlsInfo, ok := auth.(credentials.TLSInfo); ok {
+		c.info.handshake = time.Since(start)
+		c.info.state = &tlsInfo.State
+	}
+	return conn, auth, nil
+}
+
+func (c *timedCredentials) Clone() credentials.TransportCredentials {
+	return &timedCredentials{c.TransportCredentials.Clone(), c.info}
+}
+
+// rpcError converts a failed call into a reason. Calls failing because the
+// server could not be reached report the underlying connection error.
+func rpcError(err error, dialErr error) reason.Reason {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+		if dialErr != nil {
+			return reason.IOFailed(dialErr)
+		}
+		return reason.IOFailed(fmt.Errorf("%v: %v", st.Code(), st.Message()))
+	case codes.NotFound:
+		return reason.ValidateFailed(fmt.Errorf("unknown service: %v", st.Message()))
+	default:
+		return reason.ValidateFailed(fmt.Errorf("call failed with %v: %v", st.Code(), st.Message()))
+	}
+}
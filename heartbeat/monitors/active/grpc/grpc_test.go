@@ -0,0 +1,344 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grpc
+
+import (
+	"context"
+	"crypto/x509"
+	"net"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/hbtest"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/isdef"
+	"github.com/elastic/go-lookslike/testslike"
+	"github.com/elastic/go-lookslike/validator"
+)
+
+func TestGRPCUp(t *testing.T) {
+	srv := startServer(t)
+
+	events := execTestGRPCCheck(t, common.MapStr{
+		"hosts":    []string{srv.addr},
+		"metadata": common.MapStr{"authorization": "Bearer secret"},
+	})
+	require.Len(t, events, 1)
+
+	testslike.Test(t, lookslike.Strict(lookslike.Compose(
+		hbtest.BaseChecks("", "up", "grpc"),
+		hbtest.SummaryChecks(1, 0),
+		hbtest.URLChecks(t, &url.URL{Scheme: "grpc", Host: srv.addr}),
+		lookslike.MustCompile(map[string]interface{}{
+			"grpc": map[string]interface{}{
+				"method":         "Check",
+				"status_code":    "OK",
+				"health.status":  "SERVING",
+				"rtt.connect.us": isdef.IsDuration,
+				"rtt.total.us":   isdef.IsDuration,
+			},
+		}),
+	)), events[0].Fields)
+
+	require.Equal(t, []string{"Bearer secret"}, srv.lastMetadata().Get("authorization"))
+}
+
+func TestGRPCChecks(t *testing.T) {
+	srv := startServer(t)
+	srv.health.SetServingStatus("up.Service", healthpb.HealthCheckResponse_SERVING)
+	srv.health.SetServingStatus("down.Service", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	tests := []struct {
+		name    string
+		config  common.MapStr
+		status  string
+		errMsg  string
+		errType string
+	}{
+		{
+			"serving service",
+			common.MapStr{"services": []string{"up.Service"}},
+			"up",
+			"",
+			"",
+		},
+		{
+			"not serving service",
+			common.MapStr{"services": []string{"down.Service"}},
+			"down",
+			"service reported status NOT_SERVING",
+			"validate",
+		},
+		{
+			"accepted status",
+			common.MapStr{"services": []string{"down.Service"}, "check.status": []string{"serving", "not_serving"}},
+			"up",
+			"",
+			"",
+		},
+		{
+			"unknown service",
+			common.MapStr{"services": []string{"missing.Service"}},
+			"down",
+			"unknown service",
+			"validate",
+		},
+		{
+			"watch serving service",
+			common.MapStr{"services": []string{"up.Service"}, "watch": true},
+			"up",
+			"",
+			"",
+		},
+		{
+			"watch not serving service",
+			common.MapStr{"services": []string{"down.Service"}, "watch": true, "timeout": "200ms"},
+			"down",
+			"service reported status NOT_SERVING",
+			"validate",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := common.MapStr{"hosts": []string{srv.addr}}
+			config.DeepUpdate(test.config)
+
+			events := execTestGRPCCheck(t, config)
+			require.Len(t, events, 1)
+
+			validators := []validator.Validator{
+				lookslike.MustCompile(map[string]interface{}{"monitor.status": test.status}),
+			}
+			if test.errMsg != "" {
+				validators = append(validators, hbtest.ErrorChecks(test.errMsg, test.errType))
+			}
+			testslike.Test(t, lookslike.Compose(validators...), events[0].Fields)
+		})
+	}
+}
+
+func TestGRPCWatchStatusChange(t *testing.T) {
+	srv := startServer(t)
+	srv.health.SetServingStatus("slow.Service", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		srv.health.SetServingStatus("slow.Service", healthpb.HealthCheckResponse_SERVING)
+	}()
+
+	events := execTestGRPCCheck(t, common.MapStr{
+		"hosts":    []string{srv.addr},
+		"services": []string{"slow.Service"},
+		"watch":    true,
+	})
+	require.Len(t, events, 1)
+
+	testslike.Test(t, lookslike.MustCompile(map[string]interface{}{
+		"monitor.status":     "up",
+		"grpc.method":        "Watch",
+		"grpc.service":       "slow.Service",
+		"grpc.health.status": "SERVING",
+	}), events[0].Fields)
+}
+
+func TestGRPCReflection(t *testing.T) {
+	srv := startServer(t)
+	srv.health.SetServingStatus("test.Echo", healthpb.HealthCheckResponse_SERVING)
+	srv.health.SetServingStatus("extra.Service", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	events := execTestGRPCCheck(t, common.MapStr{
+		"hosts":      []string{srv.addr},
+		"services":   []string{"extra.Service"},
+		"reflection": true,
+	})
+	require.Len(t, events, 2)
+
+	testslike.Test(t, lookslike.MustCompile(map[string]interface{}{
+		"monitor.status": "down",
+		"grpc.service":   "extra.Service",
+		"url.full":       "grpc://" + srv.addr + "/extra.Service",
+	}), events[0].Fields)
+	testslike.Test(t, lookslike.Compose(
+		hbtest.SummaryChecks(1, 1),
+		lookslike.MustCompile(map[string]interface{}{
+			"monitor.status": "up",
+			"grpc.service":   "test.Echo",
+			"url.full":       "grpc://" + srv.addr + "/test.Echo",
+		}),
+	), events[1].Fields)
+}
+
+func TestGRPCTLS(t *testing.T) {
+	tlsServer := httptest.NewTLSServer(nil)
+	serverCert := tlsServer.TLS.Certificates[0]
+	tlsServer.Close()
+
+	cert, err := x509.ParseCertificate(serverCert.Certificate[0])
+	require.NoError(t, err)
+	certFile := hbtest.CertToTempFile(t, cert)
+	require.NoError(t, certFile.Close())
+	defer os.Remove(certFile.Name())
+
+	srv := startServer(t, grpc.Creds(credentials.NewServerTLSFromCert(&serverCert)))
+
+	events := execTestGRPCCheck(t, common.MapStr{
+		"hosts": []string{srv.addr},
+		"ssl":   common.MapStr{"certificate_authorities": certFile.Name()},
+	})
+	require.Len(t, events, 1)
+
+	testslike.Test(t, lookslike.Compose(
+		hbtest.BaseChecks("", "up", "grpc"),
+		hbtest.URLChecks(t, &url.URL{Scheme: "grpcs", Host: srv.addr}),
+		hbtest.TLSCertChecks(cert),
+		lookslike.MustCompile(map[string]interface{}{
+			"tls.rtt.handshake.us": isdef.IsDuration,
+			"grpc.health.status":   "SERVING",
+		}),
+	), events[0].Fields)
+}
+
+func TestGRPCUnreachable(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+	l.Close()
+
+	events := execTestGRPCCheck(t, common.MapStr{
+		"hosts":   []string{addr},
+		"timeout": "200ms",
+	})
+	require.Len(t, events, 1)
+
+	testslike.Test(t, lookslike.Compose(
+		hbtest.BaseChecks("", "down", "grpc"),
+		hbtest.ErrorChecks("", "io"),
+		lookslike.MustCompile(map[string]interface{}{
+			"grpc.status_code": isdef.KeyPresent,
+		}),
+	), events[0].Fields)
+}
+
+func TestConfigValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		config common.MapStr
+	}{
+		{"missing port", common.MapStr{"hosts": []string{"localhost"}}},
+		{"unknown status", common.MapStr{"check.status": []string{"HEALTHY"}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := common.MapStr{"hosts": []string{"localhost:50051"}}
+			config.DeepUpdate(test.config)
+
+			_, err := create("grpc", common.MustNewConfigFrom(config))
+			require.Error(t, err)
+		})
+	}
+}
+
+func execTestGRPCCheck(t *testing.T, config common.MapStr) []*beat.Event {
+	p, err := create("grpc", common.MustNewConfigFrom(config))
+	require.NoError(t, err)
+
+	sched, _ := schedule.Parse("@every 1s")
+	wrapped := wrappers.WrapCommon(p.Jobs, stdfields.StdMonitorFields{ID: "test", Type: "grpc", Schedule: sched, Timeout: 1})
+
+	results, err := jobs.ExecJobsAndConts(t, wrapped)
+	require.NoError(t, err)
+
+	// Drop the cancelled events of reflection jobs, which are not published.
+	var events []*beat.Event
+	for _, e := range results {
+		if !eventext.IsEventCancelled(e) {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+type testServer struct {
+	addr   string
+	health *health.Server
+
+	mtx sync.Mutex
+	md  metadata.MD
+}
+
+func (s *testServer) lastMetadata() metadata.MD {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.md
+}
+
+// startServer starts an in-process server implementing the health and
+// reflection services. The test.Echo service is registered so it can be
+// listed through reflection.
+func startServer(t *testing.T, opts ...grpc.ServerOption) *testServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ts := &testServer{addr: l.Addr().String(), health: health.NewServer()}
+	opts = append(opts, grpc.UnaryInterceptor(func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		ts.mtx.Lock()
+		ts.md = md
+		ts.mtx.Unlock()
+		return handler(ctx, req)
+	}))
+
+	server := grpc.NewServer(opts...)
+	healthpb.RegisterHealthServer(server, ts.health)
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "test.Echo",
+		HandlerType: (*interface{})(nil),
+	}, struct{}{})
+	reflection.Register(server)
+
+	go server.Serve(l)
+	t.Cleanup(server.Stop)
+
+	return ts
+}
@@ -0,0 +1,314 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/look"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/reason"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+func init() {
+	plugin.Register("http_api", createAPI, "synthetics/http_api")
+}
+
+// Step states reported in http_api.steps.status.
+const (
+	stepUp      = "up"
+	stepDown    = "down"
+	stepSkipped = "skipped"
+)
+
+// createAPI makes a new multi-step HTTP API monitor. The steps are executed
+// in order, sharing cookies and variables extracted from earlier responses.
+// The monitor is down as soon as one step fails.
+func createAPI(
+	name string,
+	cfg *common.Config,
+) (p plugin.Plugin, err error) {
+	config := defaultAPIConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	runner, err := newAPIRunner(&config)
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	job := jobs.MakeSimpleJob(runner.run)
+
+	// The url of the first step identifies the monitor, as long as it does
+	// not depend on variables.
+	if fs := config.Steps[0].Request.URL; fs.IsConst() {
+		addr, _ := fs.Run(&beat.Event{})
+		u, err := url.Parse(addr)
+		if err != nil {
+			return plugin.Plugin{}, err
+		}
+		job = wrappers.WithURLField(u, job)
+	}
+
+	return plugin.Plugin{Jobs: []jobs.Job{job}, Close: nil, Endpoints: 1}, nil
+}
+
+type apiRunner struct {
+	config     *apiConfig
+	transport  *http.Transport
+	validators []multiValidator
+}
+
+func newAPIRunner(config *apiConfig) (*apiRunner, error) {
+	tls, err := tlscommon.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	transport, err := newRoundTripper(&Config{ProxyURL: config.ProxyURL, Timeout: config.Timeout}, tls)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]multiValidator, len(config.Steps))
+	for i := range config.Steps {
+		validators[i], err = makeValidateResponse(&config.Steps[i].Response)
+		if err != nil {
+			return nil, fmt.Errorf("invalid response check in step '%v': %v", config.Steps[i].Name, err)
+		}
+	}
+
+	return &apiRunner{config: config, transport: transport, validators: validators}, nil
+}
+
+func (r *apiRunner) run(event *beat.Event) error {
+	// A fresh cookie jar per run, so sessions do not leak between checks.
+	jar, _ := cookiejar.New(nil)
+	client := &http.Client{
+		CheckRedirect: makeCheckRedirect(r.config.MaxRedirects, nil),
+		Transport:     r.transport,
+		Timeout:       r.config.Timeout,
+		Jar:           jar,
+	}
+
+	vars := common.MapStr{}
+	for k, v := range r.config.Variables {
+		vars[k] = v
+	}
+
+	start := time.Now()
+	steps := make([]common.MapStr, len(r.config.Steps))
+	var errReason reason.Reason
+	for i := range r.config.Steps {
+		step := &r.config.Steps[i]
+		if errReason != nil {
+			steps[i] = common.MapStr{"name": step.Name, "status": stepSkipped}
+			continue
+		}
+
+		var stepErr reason.Reason
+		steps[i], stepErr = r.runStep(client, step, r.validators[i], vars)
+		if stepErr != nil {
+			errReason = stepReason(stepErr, fmt.Errorf("step '%v' failed: %v", step.Name, stepErr))
+		}
+	}
+
+	eventext.MergeEventFields(event, common.MapStr{"http_api": common.MapStr{
+		"steps": steps,
+		"rtt":   common.MapStr{"total": look.RTT(time.Since(start))},
+	}})
+
+	if errReason != nil {
+		return errReason
+	}
+	return nil
+}
+
+// runStep executes a single step, extracting variables from the response
+// into vars.
+func (r *apiRunner) runStep(
+	client *http.Client,
+	step *apiStep,
+	validator multiValidator,
+	vars common.MapStr,
+) (common.MapStr, reason.Reason) {
+	fields := common.MapStr{"name": step.Name, "status": stepDown}
+
+	req, err := buildAPIRequest(step, vars)
+	if err != nil {
+		return fields, reason.ValidateFailed(err)
+	}
+	fields["url"] = req.URL.String()
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.config.Timeout)
+	defer cancel()
+
+	start, resp, errReason := execRequest(client, req.WithContext(ctx))
+	if errReason != nil {
+		fields["rtt"] = common.MapStr{"total": look.RTT(time.Since(start))}
+		return fields, errReason
+	}
+
+	bufferBodyBytes := minBufferBodyBytes
+	if validator.wantsBody() || len(step.Extract) > 0 {
+		bufferBodyBytes = maxBufferBodyBytes
+	} else if r.config.Response.IncludeBody != "never" && r.config.Response.IncludeBodyMaxBytes > bufferBodyBytes {
+		bufferBodyBytes = r.config.Response.IncludeBodyMaxBytes
+	}
+
+	body, bodyLenBytes, bodyHash, err := readBody(resp, bufferBodyBytes)
+	fields["rtt"] = common.MapStr{"total": look.RTT(time.Since(start))}
+	if err != nil {
+		return fields, reason.IOFailed(err)
+	}
+
+	responseFields := common.MapStr{
+		"status_code": resp.StatusCode,
+		"body": common.MapStr{
+			"hash":  bodyHash,
+			"bytes": bodyLenBytes,
+		},
+	}
+	fields["response"] = responseFields
+
+	errReason = validator.validate(resp, body, bodyLenBytes)
+	if errReason == nil {
+		errReason = extractVariables(step.Extract, resp, body, vars)
+	}
+
+	responseConfig := r.config.Response
+	if responseConfig.IncludeBody == "always" || (responseConfig.IncludeBody == "on_error" && errReason != nil) {
+		sample := body
+		if len(sample) > responseConfig.IncludeBodyMaxBytes {
+			sample = sample[:responseConfig.IncludeBodyMaxBytes]
+		}
+		responseFields.Put("body.content", sample)
+	}
+	if responseConfig.IncludeHeaders {
+		headerFields := common.MapStr{}
+		for k, vals := range resp.Header {
+			if len(vals) > 1 {
+				headerFields[k] = vals
+			} else {
+				headerFields[k] = vals[0]
+			}
+		}
+		responseFields["headers"] = headerFields
+	}
+
+	if errReason == nil {
+		fields["status"] = stepUp
+	}
+	return fields, errReason
+}
+
+func buildAPIRequest(step *apiStep, vars common.MapStr) (*http.Request, error) {
+	varsEvent := &beat.Event{Fields: vars}
+
+	addr, err := step.Request.URL.Run(varsEvent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to format url: %v", err)
+	}
+
+	var body string
+	if step.Request.Body != nil {
+		body, err = step.Request.Body.Run(varsEvent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to format body: %v", err)
+		}
+	}
+
+	req, err := http.NewRequest(strings.ToUpper(step.Request.Method), addr, strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Close = true
+
+	if step.Request.Username != "" {
+		req.SetBasicAuth(step.Request.Username, step.Request.Password)
+	}
+	for k, fs := range step.Request.Headers {
+		v, err := fs.Run(varsEvent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to format header %v: %v", k, err)
+		}
+
+		// defining the Host header isn't enough. See https://github.com/golang/go/issues/7682
+		if k == "Host" {
+			req.Host = v
+		}
+		req.Header.Add(k, v)
+	}
+	if ua := req.Header.Get("User-Agent"); ua == "" {
+		req.Header.Set("User-Agent", userAgent)
+	}
+
+	return req, nil
+}
+
+// extractVariables sets the configured variables from the response headers
+// and the JSON decoded body.
+func extractVariables(extracts []apiExtract, resp *http.Response, body string, vars common.MapStr) reason.Reason {
+	var decoded *common.MapStr
+	for _, extract := range extracts {
+		if extract.Header != "" {
+			v := resp.Header.Get(extract.Header)
+			if v == "" {
+				return reason.ValidateFailed(fmt.Errorf("could not extract variable '%v': header %v not found", extract.Name, extract.Header))
+			}
+			vars[extract.Name] = v
+			continue
+		}
+
+		if decoded == nil {
+			var err error
+			decoded, err = decodeJSONBody(body)
+			if err != nil {
+				return reason.ValidateFailed(fmt.Errorf("could not parse JSON to extract variable '%v': %v", extract.Name, err))
+			}
+		}
+
+		v, err := decoded.GetValue(extract.JSON)
+		if err != nil {
+			return reason.ValidateFailed(fmt.Errorf("could not extract variable '%v' from JSON path %v: %v", extract.Name, extract.JSON, err))
+		}
+		vars[extract.Name] = v
+	}
+	return nil
+}
+
+// stepReason wraps err into a reason of the same type as r.
+func stepReason(r reason.Reason, err error) reason.Reason {
+	if r.Type() == "io" {
+		return reason.IOFailed(err)
+	}
+	return reason.ValidateFailed(err)
+}
@@ -0,0 +1,123 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common/fmtstr"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+// apiConfig configures the multi-step http_api monitor.
+type apiConfig struct {
+	ProxyURL     string         `config:"proxy_url"`
+	Timeout      time.Duration  `config:"timeout"`
+	MaxRedirects int            `config:"max_redirects"`
+	Response     responseConfig `config:"response"`
+
+	// configure tls (if not configured HTTPS will use system defaults)
+	TLS *tlscommon.Config `config:"ssl"`
+
+	// Variables holds the initial variables available to all steps.
+	Variables map[string]string `config:"variables"`
+
+	Steps []apiStep `config:"steps" validate:"required"`
+}
+
+type apiStep struct {
+	Name     string             `config:"name"`
+	Request  apiRequest         `config:"request"`
+	Response responseParameters `config:"response"`
+
+	// Extract lists the variables set from the response for later steps.
+	Extract []apiExtract `config:"extract"`
+}
+
+// apiRequest configures the request of a step. The URL, headers and body
+// may reference variables using the %{[name]} syntax.
+type apiRequest struct {
+	Method   string                               `config:"method"`
+	URL      *fmtstr.EventFormatString            `config:"url" validate:"required"`
+	Headers  map[string]*fmtstr.EventFormatString `config:"headers"`
+	Body     *fmtstr.EventFormatString            `config:"body"`
+	Username string                               `config:"username"`
+	Password string                               `config:"password"`
+}
+
+// apiExtract sets the variable Name from either a dotted path into the
+// JSON response body or a response header.
+type apiExtract struct {
+	Name   string `config:"name" validate:"required"`
+	JSON   string `config:"json"`
+	Header string `config:"header"`
+}
+
+var defaultAPIConfig = apiConfig{
+	Timeout:      16 * time.Second,
+	MaxRedirects: 0,
+	Response: responseConfig{
+		IncludeBody:         "on_error",
+		IncludeBodyMaxBytes: 2048,
+		IncludeHeaders:      false,
+	},
+}
+
+// Validate validates the apiConfig object and names unnamed steps.
+func (c *apiConfig) Validate() error {
+	names := map[string]bool{}
+	for i := range c.Steps {
+		step := &c.Steps[i]
+		if step.Name == "" {
+			step.Name = fmt.Sprintf("step-%d", i+1)
+		}
+		if names[step.Name] {
+			return fmt.Errorf("duplicate step name '%v'", step.Name)
+		}
+		names[step.Name] = true
+	}
+	return nil
+}
+
+// Validate validates the apiRequest object.
+func (r *apiRequest) Validate() error {
+	if r.Method == "" {
+		r.Method = "GET"
+	}
+
+	switch strings.ToUpper(r.Method) {
+	case "HEAD", "GET", "POST", "PUT", "PATCH", "DELETE":
+	default:
+		return fmt.Errorf("HTTP method '%v' not supported", r.Method)
+	}
+
+	return nil
+}
+
+// Validate validates the apiExtract object.
+func (e *apiExtract) Validate() error {
+	if (e.JSON == "") == (e.Header == "") {
+		return errors.New("exactly one of json or header must be set to extract a variable")
+	}
+	e.Header = textproto.CanonicalMIMEHeaderKey(e.Header)
+	return nil
+}
@@ -0,0 +1,278 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/heartbeat/hbtest"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/isdef"
+	"github.com/elastic/go-lookslike/testslike"
+)
+
+// apiTestHandler serves a login endpoint returning a token and a session
+// cookie, and an items endpoint requiring both.
+func apiTestHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		var creds struct{ User string }
+		if r.Method != "POST" || json.NewDecoder(r.Body).Decode(&creds) != nil || creds.User != "alice" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "s1"})
+		w.Header().Set("X-Request-Id", "42")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"auth": {"token": "abc", "expires": 3600}}`))
+	})
+	mux.HandleFunc("/items", func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("session")
+		if err != nil || cookie.Value != "s1" || r.Header.Get("Authorization") != "Bearer abc" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"count": 2, "request": "` + r.URL.Query().Get("request") + `"}`))
+	})
+	return mux
+}
+
+func apiTestSteps(serverURL string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"name": "login",
+			"request": map[string]interface{}{
+				"method": "POST",
+				"url":    serverURL + "/login",
+				"body":   `{"user": "%{[user]}"}`,
+			},
+			"extract": []interface{}{
+				map[string]interface{}{"name": "token", "json": "auth.token"},
+				map[string]interface{}{"name": "request_id", "header": "x-request-id"},
+			},
+		},
+		map[string]interface{}{
+			"name": "items",
+			"request": map[string]interface{}{
+				"url":     serverURL + "/items?request=%{[request_id]}",
+				"headers": map[string]interface{}{"Authorization": "Bearer %{[token]}"},
+			},
+			"response": map[string]interface{}{
+				"status": []int{200},
+				"json": []interface{}{
+					map[string]interface{}{
+						"description": "two items",
+						"condition":   map[string]interface{}{"equals": map[string]interface{}{"count": 2, "request": "42"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func execAPICheck(t *testing.T, configSrc map[string]interface{}) *beat.Event {
+	config, err := common.NewConfigFrom(configSrc)
+	require.NoError(t, err)
+
+	p, err := createAPI("api", config)
+	require.NoError(t, err)
+	require.Len(t, p.Jobs, 1)
+
+	sched, _ := schedule.Parse("@every 1s")
+	job := wrappers.WrapCommon(p.Jobs, stdfields.StdMonitorFields{ID: "test", Type: "http_api", Schedule: sched, Timeout: 1})[0]
+
+	event := &beat.Event{}
+	_, err = job(event)
+	require.NoError(t, err)
+	return event
+}
+
+func TestAPIStepsUp(t *testing.T) {
+	server := httptest.NewServer(apiTestHandler())
+	defer server.Close()
+
+	event := execAPICheck(t, map[string]interface{}{
+		"variables": map[string]interface{}{"user": "alice"},
+		"steps":     apiTestSteps(server.URL),
+	})
+
+	testslike.Test(
+		t,
+		lookslike.Strict(lookslike.Compose(
+			hbtest.BaseChecks("", "up", "http_api"),
+			hbtest.SummaryChecks(1, 0),
+			urlChecks(server.URL+"/login"),
+			lookslike.MustCompile(map[string]interface{}{
+				"http_api": map[string]interface{}{
+					"rtt.total.us": isdef.IsDuration,
+					"steps": isdef.IsSliceOf(lookslike.MustCompile(map[string]interface{}{
+						"name":                 isdef.IsNonEmptyString,
+						"url":                  isdef.IsNonEmptyString,
+						"status":               "up",
+						"rtt.total.us":         isdef.IsDuration,
+						"response.status_code": 200,
+						"response.body.hash":   isdef.IsString,
+						"response.body.bytes":  isdef.IsIntGt(0),
+					})),
+				},
+			}),
+		)),
+		event.Fields,
+	)
+
+	itemsURL, err := event.GetValue("http_api.steps")
+	require.NoError(t, err)
+	require.Equal(t, server.URL+"/items?request=42", itemsURL.([]common.MapStr)[1]["url"])
+}
+
+func TestAPIStepsDown(t *testing.T) {
+	server := httptest.NewServer(apiTestHandler())
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		user     string
+		extract  map[string]interface{}
+		errMsg   string
+		statuses []string
+	}{
+		{
+			name:     "failing status check skips later steps",
+			user:     "bob",
+			errMsg:   "step 'login' failed: 401 Unauthorized",
+			statuses: []string{"down", "skipped"},
+		},
+		{
+			name:     "missing json path",
+			user:     "alice",
+			extract:  map[string]interface{}{"name": "token", "json": "auth.missing"},
+			errMsg:   "could not extract variable 'token' from JSON path auth.missing",
+			statuses: []string{"down", "skipped"},
+		},
+		{
+			name:     "missing header",
+			user:     "alice",
+			extract:  map[string]interface{}{"name": "token", "header": "X-Token"},
+			errMsg:   "could not extract variable 'token': header X-Token not found",
+			statuses: []string{"down", "skipped"},
+		},
+		{
+			name:     "undefined variable",
+			user:     "alice",
+			extract:  map[string]interface{}{"name": "other", "json": "auth.token"},
+			errMsg:   "step 'items' failed: failed to format",
+			statuses: []string{"up", "down"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			steps := apiTestSteps(server.URL)
+			if test.extract != nil {
+				steps[0].(map[string]interface{})["extract"] = []interface{}{test.extract}
+			}
+
+			event := execAPICheck(t, map[string]interface{}{
+				"variables": map[string]interface{}{"user": test.user},
+				"steps":     steps,
+			})
+
+			testslike.Test(
+				t,
+				lookslike.Compose(
+					hbtest.BaseChecks("", "down", "http_api"),
+					hbtest.SummaryChecks(0, 1),
+					hbtest.ErrorChecks(test.errMsg, "validate"),
+				),
+				event.Fields,
+			)
+
+			v, err := event.GetValue("http_api.steps")
+			require.NoError(t, err)
+			var statuses []string
+			for _, step := range v.([]common.MapStr) {
+				statuses = append(statuses, step["status"].(string))
+			}
+			require.Equal(t, test.statuses, statuses)
+		})
+	}
+}
+
+func TestAPIStepConnRefused(t *testing.T) {
+	server := httptest.NewServer(apiTestHandler())
+	serverURL := server.URL
+	server.Close()
+
+	event := execAPICheck(t, map[string]interface{}{
+		"variables": map[string]interface{}{"user": "alice"},
+		"steps":     apiTestSteps(serverURL),
+	})
+
+	testslike.Test(
+		t,
+		lookslike.Compose(
+			hbtest.BaseChecks("", "down", "http_api"),
+			hbtest.ErrorChecks("step 'login' failed", "io"),
+		),
+		event.Fields,
+	)
+}
+
+func TestAPIConfigValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []interface{}
+	}{
+		{"no steps", nil},
+		{"missing url", []interface{}{map[string]interface{}{"request.method": "GET"}}},
+		{"unsupported method", []interface{}{map[string]interface{}{"request": map[string]interface{}{"url": "http://localhost", "method": "TRACE"}}}},
+		{"duplicate names", []interface{}{
+			map[string]interface{}{"name": "a", "request.url": "http://localhost"},
+			map[string]interface{}{"name": "a", "request.url": "http://localhost"},
+		}},
+		{"extract without source", []interface{}{map[string]interface{}{
+			"request.url": "http://localhost",
+			"extract":     []interface{}{map[string]interface{}{"name": "a"}},
+		}}},
+		{"extract with json and header", []interface{}{map[string]interface{}{
+			"request.url": "http://localhost",
+			"extract":     []interface{}{map[string]interface{}{"name": "a", "json": "a", "header": "A"}},
+		}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config, err := common.NewConfigFrom(map[string]interface{}{"steps": test.steps})
+			require.NoError(t, err)
+
+			_, err = createAPI("api", config)
+			require.Error(t, err)
+		})
+	}
+}
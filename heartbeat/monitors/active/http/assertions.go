@@ -0,0 +1,428 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Supported assertion operators.
+const (
+	opEquals    = "equals"
+	opNotEquals = "not_equals"
+	opLT        = "lt"
+	opLTE       = "lte"
+	opGT        = "gt"
+	opGTE       = "gte"
+	opContains  = "contains"
+	opMatches   = "matches"
+	opExists    = "exists"
+	opNotExists = "not_exists"
+)
+
+// assertionConfig selects values from the response body using one of JSONPath, XPath or
+// a regular expression and compares each of them against the expected value.
+type assertionConfig struct {
+	Description string      `config:"description"`
+	JSONPath    string      `config:"jsonpath"`
+	XPath       string      `config:"xpath"`
+	Regex       string      `config:"regex"`
+	Group       *int        `config:"group"`
+	Operator    string      `config:"operator"`
+	Value       interface{} `config:"value"`
+}
+
+// bodySizeCheck bounds the size of the response body in bytes.
+type bodySizeCheck struct {
+	Min *int `config:"min"`
+	Max *int `config:"max"`
+}
+
+// Validate validates of the assertionConfig object is valid or not
+func (a *assertionConfig) Validate() error {
+	selectors := 0
+	for _, s := range []string{a.JSONPath, a.XPath, a.Regex} {
+		if s != "" {
+			selectors++
+		}
+	}
+	if selectors != 1 {
+		return fmt.Errorf("exactly one of 'jsonpath', 'xpath' or 'regex' must be set for an assertion")
+	}
+	if a.Group != nil && a.Regex == "" {
+		return fmt.Errorf("'group' can only be used with 'regex' assertions")
+	}
+
+	if a.Operator == "" {
+		a.Operator = opEquals
+	}
+	switch a.Operator {
+	case opExists, opNotExists:
+	case opEquals, opNotEquals, opLT, opLTE, opGT, opGTE, opContains, opMatches:
+		if a.Value == nil {
+			return fmt.Errorf("operator '%v' requires a 'value'", a.Operator)
+		}
+	default:
+		return fmt.Errorf("unknown assertion operator '%v'", a.Operator)
+	}
+
+	return nil
+}
+
+// Validate validates of the bodySizeCheck object is valid or not
+func (c *bodySizeCheck) Validate() error {
+	if c.Min != nil && *c.Min < 0 {
+		return fmt.Errorf("body_size.min must not be negative, got %d", *c.Min)
+	}
+	if c.Max != nil && *c.Max < 0 {
+		return fmt.Errorf("body_size.max must not be negative, got %d", *c.Max)
+	}
+	if c.Min != nil && c.Max != nil && *c.Min > *c.Max {
+		return fmt.Errorf("body_size.min %d is larger than body_size.max %d", *c.Min, *c.Max)
+	}
+	return nil
+}
+
+func checkContentType(types []string) respValidator {
+	return func(r *http.Response) error {
+		contentType := r.Header.Get("Content-Type")
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil {
+			mediaType = strings.TrimSpace(contentType)
+		}
+
+		for _, t := range types {
+			if strings.EqualFold(mediaType, t) {
+				return nil
+			}
+			// Allow wildcards like text/*
+			if strings.HasSuffix(t, "/*") && len(mediaType) > len(t)-1 && strings.EqualFold(mediaType[:len(t)-1], t[:len(t)-1]) {
+				return nil
+			}
+		}
+		return fmt.Errorf("received content type '%v' expecting one of %v", contentType, types)
+	}
+}
+
+func checkBodySize(check *bodySizeCheck) sizeValidator {
+	return func(size int) error {
+		if check.Min != nil && size < *check.Min {
+			return fmt.Errorf("received body of %d bytes expecting at least %d bytes", size, *check.Min)
+		}
+		if check.Max != nil && size > *check.Max {
+			return fmt.Errorf("received body of %d bytes expecting at most %d bytes", size, *check.Max)
+		}
+		return nil
+	}
+}
+
+// assertion is a compiled assertionConfig.
+type assertion struct {
+	description string
+	kind        string
+	expr        string
+	operator    string
+	expected    interface{}
+	pattern     *regexp.Regexp // for the matches operator
+	selectFrom  func(doc *assertionBody) ([]interface{}, error)
+}
+
+// assertionBody lazily parses the body, so it's decoded at most once for all assertions.
+type assertionBody struct {
+	raw string
+
+	json       interface{}
+	jsonErr    error
+	jsonParsed bool
+
+	xml       *xmlNode
+	xmlErr    error
+	xmlParsed bool
+}
+
+func (b *assertionBody) decodedJSON() (interface{}, error) {
+	if !b.jsonParsed {
+		b.json, b.jsonErr = decodeJSONValue(b.raw)
+		b.jsonParsed = true
+	}
+	return b.json, b.jsonErr
+}
+
+func (b *assertionBody) parsedXML() (*xmlNode, error) {
+	if !b.xmlParsed {
+		b.xml, b.xmlErr = parseXML(b.raw)
+		b.xmlParsed = true
+	}
+	return b.xml, b.xmlErr
+}
+
+func compileAssertion(cfg *assertionConfig) (*assertion, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	a := &assertion{
+		description: cfg.Description,
+		operator:    cfg.Operator,
+		expected:    cfg.Value,
+	}
+
+	switch {
+	case cfg.JSONPath != "":
+		path, err := compileJSONPath(cfg.JSONPath)
+		if err != nil {
+			return nil, err
+		}
+		a.kind, a.expr = "jsonpath", cfg.JSONPath
+		a.selectFrom = func(doc *assertionBody) ([]interface{}, error) {
+			decoded, err := doc.decodedJSON()
+			if err != nil {
+				return nil, fmt.Errorf("could not parse body as JSON: %v", err)
+			}
+			return path.eval(decoded), nil
+		}
+	case cfg.XPath != "":
+		path, err := compileXPath(cfg.XPath)
+		if err != nil {
+			return nil, err
+		}
+		a.kind, a.expr = "xpath", cfg.XPath
+		a.selectFrom = func(doc *assertionBody) ([]interface{}, error) {
+			parsed, err := doc.parsedXML()
+			if err != nil {
+				return nil, fmt.Errorf("could not parse body as XML: %v", err)
+			}
+			return path.eval(parsed), nil
+		}
+	default:
+		re, err := regexp.Compile(cfg.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex '%v': %v", cfg.Regex, err)
+		}
+		group := 0
+		if re.NumSubexp() > 0 {
+			group = 1
+		}
+		if cfg.Group != nil {
+			group = *cfg.Group
+		}
+		if group < 0 || group > re.NumSubexp() {
+			return nil, fmt.Errorf("regex '%v' has no capture group %d", cfg.Regex, group)
+		}
+		a.kind, a.expr = "regex", cfg.Regex
+		a.selectFrom = func(doc *assertionBody) ([]interface{}, error) {
+			var captures []interface{}
+			for _, m := range re.FindAllStringSubmatch(doc.raw, -1) {
+				captures = append(captures, m[group])
+			}
+			return captures, nil
+		}
+	}
+
+	if a.operator == opMatches {
+		pattern, err := regexp.Compile(fmt.Sprint(cfg.Value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern '%v' for 'matches' operator: %v", cfg.Value, err)
+		}
+		a.pattern = pattern
+	}
+
+	return a, nil
+}
+
+// check applies the assertion to all values selected from the body, returning
+// an error describing the first value that doesn't satisfy it.
+func (a *assertion) check(doc *assertionBody) error {
+	values, err := a.selectFrom(doc)
+	if err != nil {
+		return a.failure(err.Error())
+	}
+
+	switch a.operator {
+	case opExists:
+		if len(values) == 0 {
+			return a.failure("matched nothing")
+		}
+		return nil
+	case opNotExists:
+		if len(values) > 0 {
+			return a.failure(fmt.Sprintf("returned %v, expected no match", formatAssertionValue(values[0])))
+		}
+		return nil
+	}
+
+	if len(values) == 0 {
+		return a.failure("matched nothing")
+	}
+
+	for _, v := range values {
+		ok, err := a.compare(v)
+		if err != nil {
+			return a.failure(err.Error())
+		}
+		if !ok {
+			return a.failure(fmt.Sprintf("returned %v, expected %v %v", formatAssertionValue(v), a.operator, formatAssertionValue(a.expected)))
+		}
+	}
+	return nil
+}
+
+func (a *assertion) failure(msg string) error {
+	prefix := "assertion failed"
+	if a.description != "" {
+		prefix = fmt.Sprintf("assertion '%v' failed", a.description)
+	}
+	return fmt.Errorf("%s: %s '%s' %s", prefix, a.kind, a.expr, msg)
+}
+
+func (a *assertion) compare(actual interface{}) (bool, error) {
+	switch a.operator {
+	case opEquals:
+		return assertionEquals(actual, a.expected), nil
+	case opNotEquals:
+		return !assertionEquals(actual, a.expected), nil
+	case opContains:
+		if arr, ok := actual.([]interface{}); ok {
+			for _, elem := range arr {
+				if assertionEquals(elem, a.expected) {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+		return strings.Contains(assertionString(actual), fmt.Sprint(a.expected)), nil
+	case opMatches:
+		return a.pattern.MatchString(assertionString(actual)), nil
+	}
+
+	actualNum, ok := assertionNumber(actual)
+	if !ok {
+		return false, fmt.Errorf("returned %v, which is not a number", formatAssertionValue(actual))
+	}
+	expectedNum, ok := assertionNumber(a.expected)
+	if !ok {
+		return false, fmt.Errorf("expected value %v is not a number", formatAssertionValue(a.expected))
+	}
+
+	switch a.operator {
+	case opLT:
+		return actualNum < expectedNum, nil
+	case opLTE:
+		return actualNum <= expectedNum, nil
+	case opGT:
+		return actualNum > expectedNum, nil
+	case opGTE:
+		return actualNum >= expectedNum, nil
+	}
+	return false, fmt.Errorf("unknown operator '%v'", a.operator)
+}
+
+// assertionEquals compares numbers numerically, booleans as booleans and
+// everything else by its string representation.
+func assertionEquals(actual, expected interface{}) bool {
+	if expectedNum, ok := assertionNumber(expected); ok && isNumeric(expected) {
+		actualNum, ok := assertionNumber(actual)
+		return ok && actualNum == expectedNum
+	}
+	if expectedBool, ok := expected.(bool); ok {
+		actualBool, err := strconv.ParseBool(assertionString(actual))
+		return err == nil && actualBool == expectedBool
+	}
+	return assertionString(actual) == assertionString(expected)
+}
+
+func isNumeric(v interface{}) bool {
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func assertionNumber(v interface{}) (float64, bool) {
+	if isNumeric(v) {
+		rv := reflect.ValueOf(v)
+		switch {
+		case rv.Kind() >= reflect.Int && rv.Kind() <= reflect.Int64:
+			return float64(rv.Int()), true
+		case rv.Kind() >= reflect.Uint && rv.Kind() <= reflect.Uint64:
+			return float64(rv.Uint()), true
+		default:
+			return rv.Float(), true
+		}
+	}
+	if s, ok := v.(string); ok {
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// assertionString returns the string representation of selected values, XML text is trimmed
+// and JSON objects and arrays are encoded as JSON.
+func assertionString(v interface{}) string {
+	switch vv := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return strings.TrimSpace(vv)
+	case map[string]interface{}, []interface{}:
+		encoded, err := json.Marshal(vv)
+		if err == nil {
+			return string(encoded)
+		}
+	}
+	return fmt.Sprint(v)
+}
+
+func formatAssertionValue(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return strconv.Quote(s)
+	}
+	return assertionString(v)
+}
+
+func checkAssertions(configs []*assertionConfig) (bodyValidator, error) {
+	var assertions []*assertion
+	for _, cfg := range configs {
+		a, err := compileAssertion(cfg)
+		if err != nil {
+			return nil, err
+		}
+		assertions = append(assertions, a)
+	}
+
+	return func(r *http.Response, body string) error {
+		doc := &assertionBody{raw: body}
+		for _, a := range assertions {
+			if err := a.check(doc); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
@@ -0,0 +1,203 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/isdef"
+	"github.com/elastic/go-lookslike/testslike"
+)
+
+const soapBody = `<?xml version="1.0"?>
+<soap:Envelope xmlns:soap="http://www.w3.org/2003/05/soap-envelope" xmlns:m="http://example.com/stock">
+  <soap:Body>
+    <m:GetStockPriceResponse>
+      <m:Price currency="USD">34.5</m:Price>
+      <m:Status>OK</m:Status>
+      <m:Item>a</m:Item>
+      <m:Item>b</m:Item>
+    </m:GetStockPriceResponse>
+  </soap:Body>
+</soap:Envelope>`
+
+const jsonBody = `{"status": "ok", "version": "7.12.1", "count": 3, "healthy": true, "items": [{"name": "a", "size": 1}, {"name": "b", "size": 20}], "tags": ["x", "y"]}`
+
+func unpackAssertion(t *testing.T, cfg map[string]interface{}) *assertionConfig {
+	ac := &assertionConfig{}
+	require.NoError(t, common.MustNewConfigFrom(cfg).Unpack(ac))
+	return ac
+}
+
+func TestCheckAssertions(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		cfg     map[string]interface{}
+		wantErr string
+	}{
+		{"jsonpath equals", jsonBody, map[string]interface{}{"jsonpath": "$.status", "value": "ok"}, ""},
+		{"jsonpath equals mismatch", jsonBody, map[string]interface{}{"description": "status ok", "jsonpath": "$.status", "value": "down"},
+			`assertion 'status ok' failed: jsonpath '$.status' returned "ok", expected equals "down"`},
+		{"jsonpath numeric", jsonBody, map[string]interface{}{"jsonpath": "$.count", "operator": "gte", "value": 3}, ""},
+		{"jsonpath numeric mismatch", jsonBody, map[string]interface{}{"jsonpath": "$.count", "operator": "lt", "value": 2},
+			`assertion failed: jsonpath '$.count' returned 3, expected lt 2`},
+		{"jsonpath all wildcard values", jsonBody, map[string]interface{}{"jsonpath": "$.items[*].size", "operator": "lt", "value": 10},
+			`assertion failed: jsonpath '$.items[*].size' returned 20, expected lt 10`},
+		{"jsonpath bool", jsonBody, map[string]interface{}{"jsonpath": "$.healthy", "value": true}, ""},
+		{"jsonpath array contains", jsonBody, map[string]interface{}{"jsonpath": "$.tags", "operator": "contains", "value": "y"}, ""},
+		{"jsonpath matches", jsonBody, map[string]interface{}{"jsonpath": "$.version", "operator": "matches", "value": `^7\.`}, ""},
+		{"jsonpath not a number", jsonBody, map[string]interface{}{"jsonpath": "$.status", "operator": "gt", "value": 1},
+			`assertion failed: jsonpath '$.status' returned "ok", which is not a number`},
+		{"jsonpath exists", jsonBody, map[string]interface{}{"jsonpath": "$..name", "operator": "exists"}, ""},
+		{"jsonpath missing", jsonBody, map[string]interface{}{"jsonpath": "$.missing", "value": 1},
+			`assertion failed: jsonpath '$.missing' matched nothing`},
+		{"jsonpath not exists", jsonBody, map[string]interface{}{"jsonpath": "$.error", "operator": "not_exists"}, ""},
+		{"jsonpath invalid json", "notjson", map[string]interface{}{"jsonpath": "$.status", "value": "ok"},
+			`assertion failed: jsonpath '$.status' could not parse body as JSON: invalid character 'o' in literal null (expecting 'u')`},
+		{"xpath equals", soapBody, map[string]interface{}{"xpath": "/soap:Envelope/soap:Body/m:GetStockPriceResponse/m:Status", "value": "OK"}, ""},
+		{"xpath attribute", soapBody, map[string]interface{}{"xpath": "//Price/@currency", "value": "USD"}, ""},
+		{"xpath numeric", soapBody, map[string]interface{}{"xpath": "//Price", "operator": "gt", "value": 40},
+			`assertion failed: xpath '//Price' returned "34.5", expected gt 40`},
+		{"xpath count", soapBody, map[string]interface{}{"xpath": "count(//Item)", "value": 2}, ""},
+		{"xpath invalid xml", jsonBody, map[string]interface{}{"xpath": "//Status", "operator": "exists"},
+			`assertion failed: xpath '//Status' could not parse body as XML: no root element found`},
+		{"regex capture", jsonBody, map[string]interface{}{"regex": `"version": "(\d+)\.(\d+)`, "group": 2, "operator": "gte", "value": 10}, ""},
+		{"regex capture mismatch", jsonBody, map[string]interface{}{"regex": `"version": "(\d+)`, "operator": "gte", "value": 8},
+			`assertion failed: regex '"version": "(\d+)' returned "7", expected gte 8`},
+		{"regex without groups", "hello world", map[string]interface{}{"regex": `w\w+`, "value": "world"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker, err := checkAssertions([]*assertionConfig{unpackAssertion(t, tt.cfg)})
+			require.NoError(t, err)
+
+			err = checker(nil, tt.body)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAssertionConfigValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  map[string]interface{}
+	}{
+		{"no selector", map[string]interface{}{"value": 1}},
+		{"two selectors", map[string]interface{}{"jsonpath": "$.a", "xpath": "/a", "value": 1}},
+		{"unknown operator", map[string]interface{}{"jsonpath": "$.a", "operator": "like", "value": 1}},
+		{"missing value", map[string]interface{}{"jsonpath": "$.a", "operator": "gt"}},
+		{"group without regex", map[string]interface{}{"jsonpath": "$.a", "group": 1, "value": 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := common.MustNewConfigFrom(tt.cfg).Unpack(&assertionConfig{})
+			require.Error(t, err)
+		})
+	}
+
+	_, err := checkAssertions([]*assertionConfig{{Regex: `(a)`, Group: new(int), Operator: opExists}})
+	require.NoError(t, err)
+	badGroup := 2
+	_, err = checkAssertions([]*assertionConfig{{Regex: `(a)`, Group: &badGroup, Operator: opExists}})
+	require.Error(t, err)
+}
+
+func TestCheckContentType(t *testing.T) {
+	tests := []struct {
+		contentType string
+		expected    []string
+		ok          bool
+	}{
+		{"application/json; charset=utf-8", []string{"application/json"}, true},
+		{"Application/JSON", []string{"application/json"}, true},
+		{"text/xml", []string{"application/xml", "text/xml"}, true},
+		{"text/html", []string{"text/*"}, true},
+		{"application/json", []string{"text/*"}, false},
+		{"", []string{"application/json"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s in %v", tt.contentType, tt.expected), func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			resp.Header.Set("Content-Type", tt.contentType)
+			err := checkContentType(tt.expected)(resp)
+			if tt.ok {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckBodySize(t *testing.T) {
+	min, max := 2, 5
+	check := checkBodySize(&bodySizeCheck{Min: &min, Max: &max})
+
+	require.NoError(t, check(3))
+	require.EqualError(t, check(1), "received body of 1 bytes expecting at least 2 bytes")
+	require.EqualError(t, check(6), "received body of 6 bytes expecting at most 5 bytes")
+
+	require.Error(t, common.MustNewConfigFrom(map[string]interface{}{"min": 5, "max": 2}).Unpack(&bodySizeCheck{}))
+}
+
+func TestAssertionFailureReported(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/soap+xml")
+		fmt.Fprint(w, soapBody)
+	}))
+	defer server.Close()
+
+	event := sendTLSRequest(t, server.URL, false, map[string]interface{}{
+		"check.response": map[string]interface{}{
+			"content_type": "application/soap+xml",
+			"body_size":    map[string]interface{}{"min": 10},
+			"assertions": []map[string]interface{}{
+				{"xpath": "//Status", "value": "OK"},
+				{"description": "price below 30", "xpath": "//Price", "operator": "lt", "value": 30},
+			},
+		},
+	})
+
+	testslike.Test(
+		t,
+		lookslike.MustCompile(map[string]interface{}{
+			"monitor.status": "down",
+			"error": map[string]interface{}{
+				"type":    "validate",
+				"message": `assertion 'price below 30' failed: xpath '//Price' returned "34.5", expected lt 30`,
+			},
+			"http.response.body.content": isdef.IsString,
+		}),
+		event.Fields,
+	)
+}
@@ -37,6 +37,7 @@ import (
 // multiValidator combines multiple validations of each type into a single easy to use object.
 type multiValidator struct {
 	respValidators []respValidator
+	sizeValidators []sizeValidator
 	bodyValidators []bodyValidator
 }
 
@@ -44,13 +45,19 @@ func (rv multiValidator) wantsBody() bool {
 	return len(rv.bodyValidators) > 0
 }
 
-func (rv multiValidator) validate(resp *http.Response, body string) reason.Reason {
+func (rv multiValidator) validate(resp *http.Response, body string, bodySize int) reason.Reason {
 	for _, respValidator := range rv.respValidators {
 		if err := respValidator(resp); err != nil {
 			return reason.ValidateFailed(err)
 		}
 	}
 
+	for _, sizeValidator := range rv.sizeValidators {
+		if err := sizeValidator(bodySize); err != nil {
+			return reason.ValidateFailed(err)
+		}
+	}
+
 	for _, bodyValidator := range rv.bodyValidators {
 		if err := bodyValidator(resp, body); err != nil {
 			return reason.ValidateFailed(err)
@@ -65,6 +72,10 @@ func (rv multiValidator) validate(resp *http.Response, body string) reason.Reaso
 // for those purposes instead.
 type respValidator func(*http.Response) error
 
+// sizeValidator validates the size in bytes of the whole response body, which may be larger
+// than the body buffered for bodyValidator.
+type sizeValidator func(int) error
+
 // bodyValidator lets you validate a stringified version of the body along with other metadata in
 // *http.Response.
 type bodyValidator func(*http.Response, string) error
@@ -79,6 +90,7 @@ var (
 
 func makeValidateResponse(config *responseParameters) (multiValidator, error) {
 	var respValidators []respValidator
+	var sizeValidators []sizeValidator
 	var bodyValidators []bodyValidator
 
 	if len(config.Status) > 0 {
@@ -91,6 +103,14 @@ func makeValidateResponse(config *responseParameters) (multiValidator, error) {
 		respValidators = append(respValidators, checkHeaders(config.RecvHeaders))
 	}
 
+	if len(config.ContentType) > 0 {
+		respValidators = append(respValidators, checkContentType(config.ContentType))
+	}
+
+	if config.BodySize != nil {
+		sizeValidators = append(sizeValidators, checkBodySize(config.BodySize))
+	}
+
 	if config.RecvBody != nil {
 		pm, nm, err := parseBody(config.RecvBody)
 		if err != nil {
@@ -109,7 +129,15 @@ func makeValidateResponse(config *responseParameters) (multiValidator, error) {
 		bodyValidators = append(bodyValidators, jsonChecks)
 	}
 
-	return multiValidator{respValidators, bodyValidators}, nil
+	if len(config.Assertions) > 0 {
+		assertionChecks, err := checkAssertions(config.Assertions)
+		if err != nil {
+			return multiValidator{}, err
+		}
+		bodyValidators = append(bodyValidators, assertionChecks)
+	}
+
+	return multiValidator{respValidators, sizeValidators, bodyValidators}, nil
 }
 
 func checkStatus(status []uint16) respValidator {
@@ -237,18 +265,12 @@ func checkJSON(checks []*jsonResponseCheck) (bodyValidator, error) {
 	}
 
 	return func(r *http.Response, body string) error {
-		decoded := &common.MapStr{}
-		decoder := json.NewDecoder(strings.NewReader(body))
-		decoder.UseNumber()
-		err := decoder.Decode(decoded)
-
+		decoded, err := decodeJSONBody(body)
 		if err != nil {
 			body, _ := ioutil.ReadAll(r.Body)
 			return pkgerrors.Wrapf(err, "could not parse JSON for body check with condition. Source: %s", body)
 		}
 
-		jsontransform.TransformNumbers(*decoded)
-
 		var errorDescs []string
 		for _, compiledCheck := range compiledChecks {
 			ok := compiledCheck.condition.Check(decoded)
@@ -269,3 +291,17 @@ func checkJSON(checks []*jsonResponseCheck) (bodyValidator, error) {
 		return nil
 	}, nil
 }
+
+// decodeJSONBody decodes a JSON object body, converting numbers to int64 or
+// float64 so they can be used in conditions.
+func decodeJSONBody(body string) (*common.MapStr, error) {
+	decoded := &common.MapStr{}
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(decoded); err != nil {
+		return nil, err
+	}
+
+	jsontransform.TransformNumbers(*decoded)
+	return decoded, nil
+}
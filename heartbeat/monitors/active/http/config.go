@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/elastic/beats/v7/heartbeat/monitors"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlscheck"
 	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
 	"github.com/elastic/beats/v7/libbeat/conditions"
 )
@@ -58,6 +59,7 @@ type responseConfig struct {
 type checkConfig struct {
 	Request  requestParameters  `config:"request"`
 	Response responseParameters `config:"response"`
+	TLS      *tlscheck.Config   `config:"tls"`
 }
 
 type requestParameters struct {
@@ -78,6 +80,9 @@ type responseParameters struct {
 	RecvHeaders map[string]string    `config:"headers"`
 	RecvBody    interface{}          `config:"body"`
 	RecvJSON    []*jsonResponseCheck `config:"json"`
+	ContentType []string             `config:"content_type"`
+	BodySize    *bodySizeCheck       `config:"body_size"`
+	Assertions  []*assertionConfig   `config:"assertions"`
 }
 
 type jsonResponseCheck struct {
@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlscheck"
 	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
 
 	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
@@ -78,6 +79,11 @@ func create(
 		return plugin.Plugin{}, err
 	}
 
+	tlsChecker, err := tlscheck.New(config.Check.TLS)
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+
 	// Determine whether we're using a proxy or not and then use that to figure out how to
 	// run the job
 	var makeJob func(string) (jobs.Job, error)
@@ -91,15 +97,16 @@ func create(
 		}
 
 		makeJob = func(urlStr string) (jobs.Job, error) {
-			return newHTTPMonitorHostJob(urlStr, &config, transport, enc, body, validator)
+			return newHTTPMonitorHostJob(urlStr, &config, transport, enc, body, validator, tlsChecker)
 		}
 	} else {
 		makeJob = func(urlStr string) (jobs.Job, error) {
-			return newHTTPMonitorIPsJob(&config, urlStr, tls, enc, body, validator)
+			return newHTTPMonitorIPsJob(&config, urlStr, tls, tlsChecker, enc, body, validator)
 		}
 	}
 
 	js := make([]jobs.Job, len(config.Hosts))
+	keys := make([]string, len(config.Hosts))
 	for i, urlStr := range config.Hosts {
 		u, _ := url.Parse(urlStr)
 		if err != nil {
@@ -114,9 +121,10 @@ func create(
 		// Assign any execution errors to the error field and
 		// assign the url field
 		js[i] = wrappers.WithURLField(u, job)
+		keys[i] = urlStr
 	}
 
-	return plugin.Plugin{Jobs: js, Close: nil, Endpoints: len(config.Hosts)}, nil
+	return plugin.Plugin{Jobs: js, Close: nil, Endpoints: len(config.Hosts), Keys: keys}, nil
 }
 
 func newRoundTripper(config *Config, tls *tlscommon.TLSConfig) (*http.Transport, error) {
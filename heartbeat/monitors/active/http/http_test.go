@@ -407,6 +407,40 @@ func TestHTTPSServer(t *testing.T) {
 	runHTTPSServerCheck(t, server, nil)
 }
 
+func TestHTTPSServerTLSChecks(t *testing.T) {
+	server := httptest.NewTLSServer(hbtest.HelloWorldHandler(http.StatusOK))
+	defer server.Close()
+
+	runHTTPSServerCheck(t, server, map[string]interface{}{"check.tls.min_version": "TLSv1.2"})
+
+	cert, err := x509.ParseCertificate(server.TLS.Certificates[0].Certificate[0])
+	require.NoError(t, err)
+	certFile := hbtest.CertToTempFile(t, cert)
+	require.NoError(t, certFile.Close())
+	defer os.Remove(certFile.Name())
+
+	// Both the direct IP jobs and the transport used with redirects run the checks.
+	for _, maxRedirects := range []int{0, 1} {
+		t.Run(fmt.Sprintf("failing check with max_redirects %d", maxRedirects), func(t *testing.T) {
+			event := sendTLSRequest(t, server.URL, false, map[string]interface{}{
+				"ssl.certificate_authorities": certFile.Name(),
+				"max_redirects":               maxRedirects,
+				// the test server certificate expires in 2084
+				"check.tls.expires_within": "1000000h",
+			})
+
+			testslike.Test(
+				t,
+				lookslike.Compose(
+					lookslike.MustCompile(map[string]interface{}{"monitor.status": "down"}),
+					hbtest.ErrorChecks("TLS check failed: certificate", "validate"),
+				),
+				event.Fields,
+			)
+		})
+	}
+}
+
 func TestExpiredHTTPSServer(t *testing.T) {
 	tlsCert, err := tls.LoadX509KeyPair("../fixtures/expired.cert", "../fixtures/expired.key")
 	require.NoError(t, err)
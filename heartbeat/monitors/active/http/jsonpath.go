@@ -0,0 +1,229 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/jsontransform"
+)
+
+// jsonPath is a compiled JSONPath expression. Only a subset of JSONPath is supported:
+// the root `$`, child access by `.name` or `['name']`, array indexes `[n]` (negative
+// indexes count from the end), wildcards `.*` and `[*]` and recursive descent `..name`.
+type jsonPath struct {
+	expr  string
+	steps []jsonPathStep
+}
+
+type jsonPathStep struct {
+	recursive bool
+	wildcard  bool
+	isIndex   bool
+	name      string
+	index     int
+}
+
+func compileJSONPath(expr string) (*jsonPath, error) {
+	p := &jsonPath{expr: expr}
+	if !strings.HasPrefix(expr, "$") {
+		return nil, fmt.Errorf("JSONPath '%v' must start with '$'", expr)
+	}
+
+	for i := 1; i < len(expr); {
+		step := jsonPathStep{}
+		switch expr[i] {
+		case '.':
+			i++
+			if i < len(expr) && expr[i] == '.' {
+				step.recursive = true
+				i++
+			}
+			if i < len(expr) && expr[i] == '[' {
+				if !step.recursive {
+					return nil, fmt.Errorf("unexpected '[' after '.' at offset %d in JSONPath '%v'", i, expr)
+				}
+				p.steps = append(p.steps, step)
+				continue
+			}
+			end := i
+			for end < len(expr) && expr[end] != '.' && expr[end] != '[' {
+				end++
+			}
+			name := expr[i:end]
+			if name == "" {
+				return nil, fmt.Errorf("missing name at offset %d in JSONPath '%v'", i, expr)
+			}
+			// Filters and scripts aren't supported, names using these need the bracket notation
+			if strings.ContainsAny(name, "?()@ '\"") {
+				return nil, fmt.Errorf("unsupported name '%v' in JSONPath '%v'", name, expr)
+			}
+			if name == "*" {
+				step.wildcard = true
+			} else {
+				step.name = name
+			}
+			i = end
+		case '[':
+			end := strings.IndexByte(expr[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated '[' at offset %d in JSONPath '%v'", i, expr)
+			}
+			inner := strings.TrimSpace(expr[i+1 : i+end])
+			switch {
+			case inner == "*":
+				step.wildcard = true
+			case len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0]:
+				step.name = inner[1 : len(inner)-1]
+			default:
+				idx, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("invalid subscript '[%v]' in JSONPath '%v'", inner, expr)
+				}
+				step.isIndex = true
+				step.index = idx
+			}
+			i += end + 1
+		default:
+			return nil, fmt.Errorf("unexpected '%c' at offset %d in JSONPath '%v'", expr[i], i, expr)
+		}
+
+		// `..[n]` is parsed as a recursive step followed by the subscript, merge both
+		if n := len(p.steps); n > 0 && p.steps[n-1].recursive && !p.steps[n-1].wildcard && p.steps[n-1].name == "" && !p.steps[n-1].isIndex {
+			step.recursive = true
+			p.steps[n-1] = step
+			continue
+		}
+		p.steps = append(p.steps, step)
+	}
+
+	return p, nil
+}
+
+// eval returns all values selected by the expression, in document order.
+func (p *jsonPath) eval(root interface{}) []interface{} {
+	current := []interface{}{root}
+	for _, step := range p.steps {
+		var next []interface{}
+		for _, node := range current {
+			candidates := []interface{}{node}
+			if step.recursive {
+				candidates = jsonDescendants(node, candidates)
+			}
+			for _, c := range candidates {
+				next = append(next, step.selectFrom(c)...)
+			}
+		}
+		current = next
+	}
+	return current
+}
+
+func (s jsonPathStep) selectFrom(node interface{}) []interface{} {
+	if m, ok := jsonObject(node); ok {
+		if s.wildcard {
+			return jsonObjectValues(m)
+		}
+		if !s.isIndex {
+			if v, found := m[s.name]; found {
+				return []interface{}{v}
+			}
+		}
+		return nil
+	}
+
+	if arr, ok := node.([]interface{}); ok {
+		if s.wildcard {
+			return arr
+		}
+		if s.isIndex {
+			idx := s.index
+			if idx < 0 {
+				idx += len(arr)
+			}
+			if idx >= 0 && idx < len(arr) {
+				return []interface{}{arr[idx]}
+			}
+		}
+	}
+
+	return nil
+}
+
+// jsonDescendants appends all values nested below node to res, in document order.
+func jsonDescendants(node interface{}, res []interface{}) []interface{} {
+	var children []interface{}
+	if m, ok := jsonObject(node); ok {
+		children = jsonObjectValues(m)
+	} else if arr, ok := node.([]interface{}); ok {
+		children = arr
+	}
+
+	for _, c := range children {
+		res = append(res, c)
+		res = jsonDescendants(c, res)
+	}
+	return res
+}
+
+func jsonObject(node interface{}) (map[string]interface{}, bool) {
+	switch m := node.(type) {
+	case common.MapStr:
+		return m, true
+	case *common.MapStr:
+		return *m, true
+	case map[string]interface{}:
+		return m, true
+	}
+	return nil, false
+}
+
+// jsonObjectValues returns the object's values sorted by key, since JSON objects are unordered.
+func jsonObjectValues(m map[string]interface{}) []interface{} {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	values := make([]interface{}, 0, len(m))
+	for _, k := range keys {
+		values = append(values, m[k])
+	}
+	return values
+}
+
+// decodeJSONValue decodes any JSON document, converting numbers to int64 or float64.
+func decodeJSONValue(body string) (interface{}, error) {
+	var decoded interface{}
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	// TransformNumbers only handles objects, so wrap the document
+	wrapped := common.MapStr{"doc": decoded}
+	jsontransform.TransformNumbers(wrapped)
+	return wrapped["doc"], nil
+}
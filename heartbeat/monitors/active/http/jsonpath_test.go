@@ -0,0 +1,67 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONPath(t *testing.T) {
+	doc, err := decodeJSONValue(`{"a": {"b": [1, 2.5, {"c": "x"}]}, "list": [{"id": 1, "n": {"id": 2}}, {"id": 3}], "odd key": true}`)
+	require.NoError(t, err)
+
+	tests := []struct {
+		expr string
+		want []interface{}
+	}{
+		{"$.a.b[0]", []interface{}{int64(1)}},
+		{"$.a.b[1]", []interface{}{2.5}},
+		{"$.a.b[-1].c", []interface{}{"x"}},
+		{"$['a']['b'][2]['c']", []interface{}{"x"}},
+		{"$.list[*].id", []interface{}{int64(1), int64(3)}},
+		{"$..id", []interface{}{int64(1), int64(2), int64(3)}},
+		{"$['odd key']", []interface{}{true}},
+		{"$.a.b[5]", nil},
+		{"$.missing.x", nil},
+		{"$.a.*[0]", []interface{}{int64(1)}},
+		{"$..[1]", []interface{}{2.5, map[string]interface{}{"id": int64(3)}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			p, err := compileJSONPath(tt.expr)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, p.eval(doc))
+		})
+	}
+
+	for _, invalid := range []string{"a.b", "$.", "$[1", "$[x]", "$.a?b"} {
+		t.Run("invalid "+invalid, func(t *testing.T) {
+			_, err := compileJSONPath(invalid)
+			require.Error(t, err)
+		})
+	}
+
+	arr, err := decodeJSONValue(`[{"a": 1}]`)
+	require.NoError(t, err)
+	p, err := compileJSONPath("$[0].a")
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{int64(1)}, p.eval(arr))
+}
@@ -62,7 +62,7 @@ func processBody(resp *http.Response, config responseConfig, validator multiVali
 	}
 
 	// Run any validations
-	errReason := validator.validate(resp, respBody)
+	errReason := validator.validate(resp, respBody, bodyLenBytes)
 
 	bodyFields := common.MapStr{
 		"hash":  bodyHash,
@@ -145,6 +145,19 @@ func Test_handleRespBody(t *testing.T) {
 	}
 }
 
+func Test_processBodyChecksFullSize(t *testing.T) {
+	max := 200
+	validator := multiValidator{sizeValidators: []sizeValidator{checkBodySize(&bodySizeCheck{Max: &max})}}
+	require.False(t, validator.wantsBody())
+
+	// The body is larger than what gets buffered, the check must still see its full size.
+	body := strings.Repeat("a", 1000)
+	fields, _, errReason := processBody(simpleHTTPResponse(body), responseConfig{}, validator)
+	require.Error(t, errReason)
+	assert.Equal(t, "received body of 1000 bytes expecting at most 200 bytes", errReason.Error())
+	assert.Equal(t, 1000, fields["bytes"])
+}
+
 func Test_readResp(t *testing.T) {
 	type args struct {
 		resp           *http.Response
@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net"
@@ -31,6 +32,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlscheck"
 	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlsmeta"
 
 	"github.com/elastic/beats/v7/heartbeat/eventext"
@@ -54,6 +56,7 @@ func newHTTPMonitorHostJob(
 	enc contentEncoder,
 	body []byte,
 	validator multiValidator,
+	tlsChecker *tlscheck.Checker,
 ) (jobs.Job, error) {
 
 	request, err := buildRequest(addr, config, enc)
@@ -71,7 +74,7 @@ func newHTTPMonitorHostJob(
 			Transport:     transport,
 			Timeout:       config.Timeout,
 		}
-		_, _, err := execPing(event, client, request, body, timeout, validator, config.Response)
+		_, _, err := execPing(event, client, request, body, timeout, validator, config.Response, tlsChecker)
 		if len(redirects) > 0 {
 			event.PutValue("http.response.redirects", redirects)
 		}
@@ -83,6 +86,7 @@ func newHTTPMonitorIPsJob(
 	config *Config,
 	addr string,
 	tls *tlscommon.TLSConfig,
+	tlsChecker *tlscheck.Checker,
 	enc contentEncoder,
 	body []byte,
 	validator multiValidator,
@@ -98,7 +102,7 @@ func newHTTPMonitorIPsJob(
 		return nil, err
 	}
 
-	pingFactory := createPingFactory(config, port, tls, req, body, validator)
+	pingFactory := createPingFactory(config, port, tls, tlsChecker, req, body, validator)
 	job, err := monitors.MakeByHostJob(hostname, config.Mode, monitors.NewStdResolver(), pingFactory)
 
 	return job, err
@@ -108,6 +112,7 @@ func createPingFactory(
 	config *Config,
 	port uint16,
 	tls *tlscommon.TLSConfig,
+	tlsChecker *tlscheck.Checker,
 	request *http.Request,
 	body []byte,
 	validator multiValidator,
@@ -125,6 +130,9 @@ func createPingFactory(
 
 		if isTLS {
 			d.AddLayer(dialchain.TLSLayer(tls, timeout))
+			if tlsChecker != nil {
+				d.AddLayer(dialchain.TLSCheckLayer(tlsChecker))
+			}
 		}
 
 		dialer, err := d.Build(event)
@@ -166,7 +174,7 @@ func createPingFactory(
 			},
 		}
 
-		_, end, err := execPing(event, client, request, body, timeout, validator, config.Response)
+		_, end, err := execPing(event, client, request, body, timeout, validator, config.Response, tlsChecker)
 		cbMutex.Lock()
 		defer cbMutex.Unlock()
 
@@ -227,6 +235,7 @@ func execPing(
 	timeout time.Duration,
 	validator multiValidator,
 	responseConfig responseConfig,
+	tlsChecker *tlscheck.Checker,
 ) (start, end time.Time, err reason.Reason) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
@@ -284,7 +293,11 @@ func execPing(
 	if resp.TLS != nil {
 		tlsFields := common.MapStr{}
 		tlsmeta.AddTLSMetadata(tlsFields, *resp.TLS, tlsmeta.UnknownTLSHandshakeDuration)
+		checkErr := tlsChecker.Check(tlsFields, *resp.TLS)
 		eventext.MergeEventFields(event, tlsFields)
+		if errReason == nil {
+			errReason = checkErr
+		}
 	}
 
 	// Add total HTTP RTT
@@ -313,6 +326,11 @@ func execRequest(client *http.Client, req *http.Request) (start time.Time, resp
 	resp, err := client.Do(req)
 
 	if err != nil {
+		// failed TLS checks are reported as validation errors
+		var validateErr reason.ValidateError
+		if errors.As(err, &validateErr) {
+			return start, nil, reason.ValidateFailed(err)
+		}
 		return start, nil, reason.IOFailed(err)
 	}
 
@@ -0,0 +1,501 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// xmlNode is a minimal XML tree used to evaluate XPath expressions. Namespace
+// prefixes are dropped, elements and attributes are matched by their local name.
+type xmlNode struct {
+	name     string
+	text     string // only set on text nodes
+	isText   bool
+	attrs    []xml.Attr
+	children []*xmlNode
+}
+
+// parseXML parses body into a tree, returning the document node holding the root element.
+func parseXML(body string) (*xmlNode, error) {
+	doc := &xmlNode{}
+	stack := []*xmlNode{doc}
+
+	decoder := xml.NewDecoder(strings.NewReader(body))
+	for {
+		tok, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		parent := stack[len(stack)-1]
+		switch t := tok.(type) {
+		case xml.StartElement:
+			el := &xmlNode{name: t.Name.Local, attrs: t.Attr}
+			parent.children = append(parent.children, el)
+			stack = append(stack, el)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 1 {
+				parent.children = append(parent.children, &xmlNode{isText: true, text: string(t)})
+			}
+		}
+	}
+
+	if len(doc.children) == 0 {
+		return nil, fmt.Errorf("no root element found")
+	}
+	return doc, nil
+}
+
+// value returns the string value of the node, the concatenation of all text it contains.
+func (n *xmlNode) value() string {
+	if n.isText {
+		return n.text
+	}
+	var sb strings.Builder
+	for _, c := range n.children {
+		sb.WriteString(c.value())
+	}
+	return sb.String()
+}
+
+func (n *xmlNode) attr(name string) (string, bool) {
+	for _, a := range n.attrs {
+		if a.Name.Local == name {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+// descendantsOrSelf returns n and all elements below it in document order.
+func (n *xmlNode) descendantsOrSelf(res []*xmlNode) []*xmlNode {
+	res = append(res, n)
+	for _, c := range n.children {
+		if !c.isText {
+			res = c.descendantsOrSelf(res)
+		}
+	}
+	return res
+}
+
+// xPath is a compiled XPath expression. Only a subset of XPath is supported: absolute and
+// relative location paths using `/` and `//`, element names or `*`, a final `@attr`, `@*` or
+// `text()` step, the predicates `[n]`, `[last()]`, `[@attr]`, `[@attr='v']`, `[name='v']` and
+// `[text()='v']`, and wrapping the whole path in `count()`.
+type xPath struct {
+	expr  string
+	count bool
+	steps []xPathStep
+}
+
+const (
+	xPathAxisChild = iota
+	xPathAxisAttribute
+	xPathAxisText
+)
+
+type xPathStep struct {
+	descendant bool
+	axis       int
+	name       string // "*" matches any name
+	preds      []xPathPredicate
+}
+
+type xPathPredicate struct {
+	index  int  // 1-based position, 0 if unused
+	last   bool // [last()]
+	attr   string
+	child  string
+	text   bool
+	value  string
+	hasVal bool
+}
+
+func compileXPath(expr string) (*xPath, error) {
+	p := &xPath{expr: expr}
+
+	path := strings.TrimSpace(expr)
+	if strings.HasPrefix(path, "count(") && strings.HasSuffix(path, ")") {
+		p.count = true
+		path = strings.TrimSpace(path[len("count(") : len(path)-1])
+	}
+	if path == "" {
+		return nil, fmt.Errorf("empty XPath expression")
+	}
+
+	rawSteps, err := splitXPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid XPath '%v': %v", expr, err)
+	}
+
+	for i, raw := range rawSteps {
+		step, err := parseXPathStep(raw.text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid XPath '%v': %v", expr, err)
+		}
+		step.descendant = raw.descendant
+		if step.axis != xPathAxisChild && i != len(rawSteps)-1 {
+			return nil, fmt.Errorf("invalid XPath '%v': '%v' must be the last step", expr, raw.text)
+		}
+		p.steps = append(p.steps, step)
+	}
+
+	return p, nil
+}
+
+type rawXPathStep struct {
+	descendant bool
+	text       string
+}
+
+// splitXPath splits a location path into its steps, respecting quotes and predicates.
+func splitXPath(path string) ([]rawXPathStep, error) {
+	var steps []rawXPathStep
+	descendant := false
+	start := 0
+	depth := 0
+	var quote byte
+
+	flush := func(end int) error {
+		text := strings.TrimSpace(path[start:end])
+		if text == "" {
+			return fmt.Errorf("empty step")
+		}
+		steps = append(steps, rawXPathStep{descendant: descendant, text: text})
+		return nil
+	}
+
+	i := 0
+	if strings.HasPrefix(path, "//") {
+		descendant = true
+		i = 2
+	} else if strings.HasPrefix(path, "/") {
+		i = 1
+	}
+	start = i
+
+	for ; i < len(path); i++ {
+		c := path[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced ']'")
+			}
+		case c == '/' && depth == 0:
+			if err := flush(i); err != nil {
+				return nil, err
+			}
+			descendant = false
+			if i+1 < len(path) && path[i+1] == '/' {
+				descendant = true
+				i++
+			}
+			start = i + 1
+		}
+	}
+	if quote != 0 || depth != 0 {
+		return nil, fmt.Errorf("unterminated quote or predicate")
+	}
+	if err := flush(len(path)); err != nil {
+		return nil, err
+	}
+
+	return steps, nil
+}
+
+func parseXPathStep(text string) (xPathStep, error) {
+	step := xPathStep{axis: xPathAxisChild}
+
+	nameEnd := strings.IndexByte(text, '[')
+	if nameEnd < 0 {
+		nameEnd = len(text)
+	}
+	name := strings.TrimSpace(text[:nameEnd])
+
+	switch {
+	case name == "text()":
+		step.axis = xPathAxisText
+	case strings.HasPrefix(name, "@"):
+		step.axis = xPathAxisAttribute
+		name = name[1:]
+	}
+	if step.axis != xPathAxisText {
+		step.name = localName(name)
+		if step.name == "" {
+			return step, fmt.Errorf("missing name in step '%v'", text)
+		}
+	}
+
+	rest := text[nameEnd:]
+	for rest != "" {
+		if rest[0] != '[' {
+			return step, fmt.Errorf("unexpected '%v' in step '%v'", rest, text)
+		}
+		end := predicateEnd(rest)
+		if end < 0 {
+			return step, fmt.Errorf("unterminated predicate in step '%v'", text)
+		}
+		pred, err := parseXPathPredicate(strings.TrimSpace(rest[1:end]))
+		if err != nil {
+			return step, err
+		}
+		step.preds = append(step.preds, pred)
+		rest = strings.TrimSpace(rest[end+1:])
+	}
+	if len(step.preds) > 0 && step.axis != xPathAxisChild {
+		return step, fmt.Errorf("predicates are only supported on elements, got '%v'", text)
+	}
+
+	return step, nil
+}
+
+// predicateEnd returns the index of the ']' closing the predicate starting at s[0].
+func predicateEnd(s string) int {
+	var quote byte
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == ']':
+			return i
+		}
+	}
+	return -1
+}
+
+func parseXPathPredicate(text string) (xPathPredicate, error) {
+	pred := xPathPredicate{}
+
+	if text == "last()" {
+		pred.last = true
+		return pred, nil
+	}
+	if idx, err := strconv.Atoi(text); err == nil {
+		if idx < 1 {
+			return pred, fmt.Errorf("positions start at 1, got [%v]", text)
+		}
+		pred.index = idx
+		return pred, nil
+	}
+
+	lhs := text
+	if eq := strings.IndexByte(text, '='); eq >= 0 {
+		lhs = strings.TrimSpace(text[:eq])
+		rhs := strings.TrimSpace(text[eq+1:])
+		if len(rhs) < 2 || (rhs[0] != '\'' && rhs[0] != '"') || rhs[len(rhs)-1] != rhs[0] ||
+			strings.IndexByte(rhs[1:len(rhs)-1], rhs[0]) >= 0 {
+			return pred, fmt.Errorf("predicate value must be a quoted string, got [%v]", text)
+		}
+		pred.value = rhs[1 : len(rhs)-1]
+		pred.hasVal = true
+	}
+
+	// Only equality is supported, other operators like != or >= would be
+	// left in the name and never match.
+	switch {
+	case lhs == "text()":
+		if !pred.hasVal {
+			return pred, fmt.Errorf("unsupported predicate [%v]", text)
+		}
+		pred.text = true
+	case strings.HasPrefix(lhs, "@") && isXMLName(lhs[1:]):
+		pred.attr = localName(lhs[1:])
+	case pred.hasVal && isXMLName(lhs):
+		pred.child = localName(lhs)
+	default:
+		return pred, fmt.Errorf("unsupported predicate [%v]", text)
+	}
+	if pred.attr == "" && pred.child == "" && !pred.text {
+		return pred, fmt.Errorf("unsupported predicate [%v]", text)
+	}
+
+	return pred, nil
+}
+
+// isXMLName returns true if name is a plain, optionally prefixed, XML name.
+func isXMLName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		switch {
+		case r == '_' || unicode.IsLetter(r):
+		case i > 0 && (r == '-' || r == '.' || r == ':' || unicode.IsDigit(r)):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// localName strips any namespace prefix from name.
+func localName(name string) string {
+	if idx := strings.LastIndexByte(name, ':'); idx >= 0 {
+		return name[idx+1:]
+	}
+	return name
+}
+
+// eval returns the string values selected by the expression, or the number of
+// selected nodes for count() expressions.
+func (p *xPath) eval(doc *xmlNode) []interface{} {
+	nodes := []*xmlNode{doc}
+	var values []interface{}
+
+	for _, step := range p.steps {
+		var contexts []*xmlNode
+		for _, n := range nodes {
+			if step.descendant {
+				contexts = n.descendantsOrSelf(contexts)
+			} else {
+				contexts = append(contexts, n)
+			}
+		}
+
+		switch step.axis {
+		case xPathAxisAttribute:
+			for _, n := range dedupeXMLNodes(contexts) {
+				for _, a := range n.attrs {
+					if step.name == "*" || a.Name.Local == step.name {
+						values = append(values, a.Value)
+					}
+				}
+			}
+			nodes = nil
+		case xPathAxisText:
+			for _, n := range dedupeXMLNodes(contexts) {
+				for _, c := range n.children {
+					if c.isText {
+						values = append(values, c.text)
+					}
+				}
+			}
+			nodes = nil
+		default:
+			var next []*xmlNode
+			for _, n := range contexts {
+				next = append(next, step.selectChildren(n)...)
+			}
+			nodes = dedupeXMLNodes(next)
+		}
+	}
+
+	for _, n := range nodes {
+		values = append(values, n.value())
+	}
+
+	if p.count {
+		return []interface{}{int64(len(values))}
+	}
+	return values
+}
+
+func (s xPathStep) selectChildren(n *xmlNode) []*xmlNode {
+	var matched []*xmlNode
+	for _, c := range n.children {
+		if !c.isText && (s.name == "*" || c.name == s.name) {
+			matched = append(matched, c)
+		}
+	}
+
+	for _, pred := range s.preds {
+		matched = pred.filter(matched)
+	}
+	return matched
+}
+
+func (p xPathPredicate) filter(nodes []*xmlNode) []*xmlNode {
+	switch {
+	case p.last:
+		if len(nodes) == 0 {
+			return nil
+		}
+		return nodes[len(nodes)-1:]
+	case p.index > 0:
+		if p.index > len(nodes) {
+			return nil
+		}
+		return nodes[p.index-1 : p.index]
+	}
+
+	var res []*xmlNode
+	for _, n := range nodes {
+		if p.matches(n) {
+			res = append(res, n)
+		}
+	}
+	return res
+}
+
+func (p xPathPredicate) matches(n *xmlNode) bool {
+	switch {
+	case p.attr != "":
+		v, ok := n.attr(p.attr)
+		return ok && (!p.hasVal || v == p.value)
+	case p.text:
+		for _, c := range n.children {
+			if c.isText && c.text == p.value {
+				return true
+			}
+		}
+	default:
+		for _, c := range n.children {
+			if !c.isText && c.name == p.child && c.value() == p.value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func dedupeXMLNodes(nodes []*xmlNode) []*xmlNode {
+	seen := make(map[*xmlNode]bool, len(nodes))
+	res := nodes[:0:0]
+	for _, n := range nodes {
+		if !seen[n] {
+			seen[n] = true
+			res = append(res, n)
+		}
+	}
+	return res
+}
@@ -0,0 +1,68 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXPath(t *testing.T) {
+	doc, err := parseXML(`<root><item id="1" kind="a">one</item><item id="2">two <b>bold</b></item><group><item id="3">three</item></group><path href="/a/b"/></root>`)
+	require.NoError(t, err)
+
+	tests := []struct {
+		expr string
+		want []interface{}
+	}{
+		{"/root/item", []interface{}{"one", "two bold"}},
+		{"root/item[1]", []interface{}{"one"}},
+		{"/root/item[last()]/@id", []interface{}{"2"}},
+		{"//item/@id", []interface{}{"1", "2", "3"}},
+		{"//item[@kind]", []interface{}{"one"}},
+		{"//item[@id='3']", []interface{}{"three"}},
+		{"//item[b='bold']/@id", []interface{}{"2"}},
+		{"//item[text()='one']/@id", []interface{}{"1"}},
+		{"/root/item[2]/text()", []interface{}{"two "}},
+		{"/root/*[@href='/a/b']/@href", []interface{}{"/a/b"}},
+		{"count(//item)", []interface{}{int64(3)}},
+		{"count(/root/missing)", []interface{}{int64(0)}},
+		{"/root/missing", nil},
+		{"//x:group//item", []interface{}{"three"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			p, err := compileXPath(tt.expr)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, p.eval(doc))
+		})
+	}
+
+	for _, invalid := range []string{"", "/root/@id/x", "/root[", "//item[@id=3]", "//item[foo]", "/root//", "/root/item[0]",
+		"//item[@id!='1']", "//item[@id>='1']", "//item[@id<'2']", "//item[b!='bold']", "//item[@]", "//item[@id='1' or @id='2']"} {
+		t.Run("invalid "+invalid, func(t *testing.T) {
+			_, err := compileXPath(invalid)
+			require.Error(t, err)
+		})
+	}
+
+	_, err = parseXML("not xml")
+	require.Error(t, err)
+}
@@ -29,6 +29,22 @@ type Config struct {
 
 	Timeout time.Duration `config:"timeout"`
 	Wait    time.Duration `config:"wait"`
+
+	Traceroute TracerouteConfig `config:"traceroute"`
+}
+
+// TracerouteConfig configures tracing the network path to the pinged hosts.
+type TracerouteConfig struct {
+	Enabled bool `config:"enabled"`
+
+	// MaxHops is the maximum TTL, or hop limit, of the probes.
+	MaxHops int `config:"max_hops" validate:"min=1,max=255"`
+
+	// Probes is the number of echo requests sent per hop.
+	Probes int `config:"probes" validate:"min=1"`
+
+	// Wait is the duration to wait for the responses to the probes of a hop.
+	Wait time.Duration `config:"wait" validate:"positive,nonzero"`
 }
 
 var DefaultConfig = Config{
@@ -36,4 +52,10 @@ var DefaultConfig = Config{
 
 	Timeout: 16 * time.Second,
 	Wait:    1 * time.Second,
+
+	Traceroute: TracerouteConfig{
+		MaxHops: 30,
+		Probes:  3,
+		Wait:    1 * time.Second,
+	},
 }
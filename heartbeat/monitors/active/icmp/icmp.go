@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"time"
 
 	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
 
@@ -94,10 +95,16 @@ func (jf *jobFactory) makePlugin() (plugin2 plugin.Plugin, err error) {
 	if err := jf.loop.checkNetworkMode(jf.ipVersion); err != nil {
 		return plugin.Plugin{}, err
 	}
+	if jf.config.Traceroute.Enabled {
+		if err := jf.loop.checkTraceroute(); err != nil {
+			return plugin.Plugin{}, err
+		}
+	}
 
 	pingFactory := jf.pingIPFactory(&jf.config)
 
 	var j []jobs.Job
+	var keys []string
 	for _, host := range jf.config.Hosts {
 		job, err := monitors.MakeByHostJob(host, jf.config.Mode, monitors.NewStdResolver(), pingFactory)
 
@@ -111,14 +118,30 @@ func (jf *jobFactory) makePlugin() (plugin2 plugin.Plugin, err error) {
 		}
 
 		j = append(j, wrappers.WithURLField(u, job))
+		keys = append(keys, u.String())
 	}
 
-	return plugin.Plugin{Jobs: j, Close: nil, Endpoints: len(jf.config.Hosts)}, nil
+	return plugin.Plugin{Jobs: j, Close: nil, Endpoints: len(jf.config.Hosts), Keys: keys}, nil
 }
 
 func (jf *jobFactory) pingIPFactory(config *Config) func(*net.IPAddr) jobs.Job {
 	return monitors.MakePingIPFactory(func(event *beat.Event, ip *net.IPAddr) error {
+		// The trace runs alongside the ping, so that both end within the
+		// timeout of the monitor even if the host doesn't respond.
+		var traced chan struct{}
+		if config.Traceroute.Enabled {
+			traced = make(chan struct{})
+			deadline := time.Now().Add(config.Timeout)
+			go func() {
+				defer close(traced)
+				jf.addTraceroute(event, ip, config.Traceroute, deadline)
+			}()
+		}
+
 		rtt, n, err := jf.loop.ping(ip, config.Timeout, config.Wait)
+		if traced != nil {
+			<-traced
+		}
 		if err != nil {
 			return err
 		}
@@ -132,3 +155,17 @@ func (jf *jobFactory) pingIPFactory(config *Config) func(*net.IPAddr) jobs.Job {
 		return nil
 	})
 }
+
+// addTraceroute adds the network path to ip found until deadline to the event.
+// Failing traces do not affect the status of the monitor.
+func (jf *jobFactory) addTraceroute(event *beat.Event, ip *net.IPAddr, config TracerouteConfig, deadline time.Time) {
+	result, err := jf.loop.traceroute(ip, config, deadline)
+	if err != nil {
+		logp.Warn("Failed to trace route to %v: %v", ip, err)
+		return
+	}
+
+	eventext.MergeEventFields(event, common.MapStr{
+		"icmp": common.MapStr{"traceroute": result.fields()},
+	})
+}
@@ -18,12 +18,16 @@
 package icmp
 
 import (
+	"errors"
 	"net"
 	"net/url"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
 
 	"github.com/elastic/beats/v7/heartbeat/hbtest"
 	"github.com/elastic/beats/v7/heartbeat/look"
@@ -32,6 +36,7 @@ import (
 	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
 	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
 	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/go-lookslike"
 	"github.com/elastic/go-lookslike/testslike"
 )
@@ -61,8 +66,122 @@ func TestICMPFields(t *testing.T) {
 	testslike.Test(t, validator, e.Fields)
 }
 
+func TestICMPTraceroute(t *testing.T) {
+	cfg := DefaultConfig
+	cfg.Hosts = []string{"localhost"}
+	cfg.Mode = monitors.IPSettings{IPv4: true, IPv6: false, Mode: monitors.PingAny}
+	cfg.Traceroute.Enabled = true
+
+	_, e := execTestICMPCheck(t, cfg)
+
+	testslike.Test(t, lookslike.MustCompile(map[string]interface{}{
+		"monitor.status":               "up",
+		"icmp.traceroute.reached":      true,
+		"icmp.traceroute.last_hop.ttl": 2,
+		"icmp.traceroute.last_hop.ip":  []string{"127.0.0.1"},
+		"icmp.traceroute.hops": []common.MapStr{
+			{"ttl": 1, "ip": []string{"10.0.0.1", "10.0.0.2"}, "rtt": look.RTT(2 * time.Millisecond), "loss": common.MapStr{"pct": float64(1) / 3}},
+			{"ttl": 2, "ip": []string{"127.0.0.1"}, "rtt": look.RTT(time.Millisecond), "loss": common.MapStr{"pct": float64(0)}},
+		},
+	}), e.Fields)
+}
+
+func TestICMPTracerouteOnFailure(t *testing.T) {
+	cfg := DefaultConfig
+	cfg.Hosts = []string{"localhost"}
+	cfg.Mode = monitors.IPSettings{IPv4: true, IPv6: false, Mode: monitors.PingAny}
+	cfg.Traceroute.Enabled = true
+
+	tl := mockLoop{pingErr: timeoutError{}, trace: &traceResult{hops: []hop{{ttl: 1, sent: 3}}}}
+	e := execTestICMPCheckWithLoop(t, cfg, tl)
+
+	testslike.Test(t, lookslike.MustCompile(map[string]interface{}{
+		"monitor.status":          "down",
+		"icmp.traceroute.reached": false,
+		"icmp.traceroute.hops":    []common.MapStr{{"ttl": 1, "loss": common.MapStr{"pct": float64(1)}}},
+	}), e.Fields)
+}
+
+func TestICMPTracerouteWithinTimeout(t *testing.T) {
+	cfg := DefaultConfig
+	cfg.Hosts = []string{"localhost"}
+	cfg.Mode = monitors.IPSettings{IPv4: true, IPv6: false, Mode: monitors.PingAny}
+	cfg.Timeout = 5 * time.Second
+	cfg.Traceroute.Enabled = true
+
+	// The ping only completes once the trace has started, so it fails
+	// if the trace doesn't run alongside it.
+	var deadline time.Time
+	tl := mockLoop{
+		pingRtt:       time.Millisecond,
+		pingRequests:  1,
+		trace:         &traceResult{hops: []hop{{ttl: 1, sent: 3}}},
+		traceStarted:  make(chan struct{}),
+		traceDeadline: &deadline,
+	}
+	start := time.Now()
+	e := execTestICMPCheckWithLoop(t, cfg, tl)
+
+	testslike.Test(t, lookslike.MustCompile(map[string]interface{}{
+		"monitor.status":          "up",
+		"icmp.traceroute.reached": false,
+	}), e.Fields)
+	require.False(t, deadline.Before(start.Add(cfg.Timeout)))
+	require.False(t, deadline.After(time.Now().Add(cfg.Timeout)))
+}
+
+func TestTracerouteRequiresPrivileges(t *testing.T) {
+	cfg := DefaultConfig
+	cfg.Hosts = []string{"localhost"}
+	cfg.Traceroute.Enabled = true
+
+	tl := mockLoop{checkTracerouteErr: errors.New("unprivileged")}
+	jf, err := newJobFactory(cfg, monitors.NewStdResolver(), tl)
+	require.NoError(t, err)
+	_, err = jf.makePlugin()
+	require.Error(t, err)
+}
+
+func TestOriginalRequestID(t *testing.T) {
+	echo := []byte{8, 0, 0, 0, 0x12, 0x34, 0x00, 0x07}
+
+	ip4Header := make([]byte, ipv4.HeaderLen)
+	ip4Header[0] = 0x45
+	ip4Header[9] = protocolICMP
+	copy(ip4Header[16:20], net.ParseIP("192.0.2.1").To4())
+
+	id, ok := originalRequestID(protocolICMP, &icmp.TimeExceeded{Data: append(ip4Header, echo...)})
+	require.True(t, ok)
+	require.Equal(t, requestID{addr: "192.0.2.1", proto: protocolICMP, id: 0x1234, seq: 7}, id)
+
+	ip6Header := make([]byte, ipv6.HeaderLen)
+	ip6Header[6] = protocolIPv6ICMP
+	copy(ip6Header[24:40], net.ParseIP("2001:db8::1"))
+	echo6 := append([]byte{128}, echo[1:]...)
+
+	id, ok = originalRequestID(protocolIPv6ICMP, &icmp.DstUnreach{Data: append(ip6Header, echo6...)})
+	require.True(t, ok)
+	require.Equal(t, requestID{addr: "2001:db8::1", proto: protocolIPv6ICMP, id: 0x1234, seq: 7}, id)
+
+	// errors in response to other messages are ignored
+	_, ok = originalRequestID(protocolICMP, &icmp.TimeExceeded{Data: append(ip4Header, 0, 0, 0, 0, 0, 0, 0, 0)})
+	require.False(t, ok)
+	_, ok = originalRequestID(protocolICMP, &icmp.TimeExceeded{Data: ip4Header[:10]})
+	require.False(t, ok)
+}
+
 func execTestICMPCheck(t *testing.T, cfg Config) (mockLoop, *beat.Event) {
-	tl := mockLoop{pingRtt: time.Microsecond * 1000, pingRequests: 1}
+	tl := mockLoop{pingRtt: time.Microsecond * 1000, pingRequests: 1, trace: &traceResult{
+		reached: true,
+		hops: []hop{
+			{ttl: 1, sent: 3, ips: []string{"10.0.0.1", "10.0.0.2"}, rtts: []time.Duration{time.Millisecond, 3 * time.Millisecond}},
+			{ttl: 2, sent: 3, ips: []string{"127.0.0.1"}, rtts: []time.Duration{time.Millisecond, time.Millisecond, time.Millisecond}, reached: true},
+		},
+	}}
+	return tl, execTestICMPCheckWithLoop(t, cfg, tl)
+}
+
+func execTestICMPCheckWithLoop(t *testing.T, cfg Config, tl mockLoop) *beat.Event {
 	jf, err := newJobFactory(cfg, monitors.NewStdResolver(), tl)
 	require.NoError(t, err)
 	p, err := jf.makePlugin()
@@ -72,7 +191,7 @@ func execTestICMPCheck(t *testing.T, cfg Config) (mockLoop, *beat.Event) {
 	sched, _ := schedule.Parse("@every 1s")
 	wrapped := wrappers.WrapCommon(p.Jobs, stdfields.StdMonitorFields{ID: "test", Type: "icmp", Schedule: sched, Timeout: 1})
 	wrapped[0](e)
-	return tl, e
+	return e
 }
 
 type mockLoop struct {
@@ -80,6 +199,12 @@ type mockLoop struct {
 	pingRequests        int
 	pingErr             error
 	checkNetworkModeErr error
+	checkTracerouteErr  error
+	trace               *traceResult
+
+	// traceStarted, if set, is closed by traceroute and awaited by ping.
+	traceStarted  chan struct{}
+	traceDeadline *time.Time
 }
 
 func (t mockLoop) checkNetworkMode(mode string) error {
@@ -87,5 +212,26 @@ func (t mockLoop) checkNetworkMode(mode string) error {
 }
 
 func (t mockLoop) ping(addr *net.IPAddr, timeout time.Duration, interval time.Duration) (time.Duration, int, error) {
+	if t.traceStarted != nil {
+		select {
+		case <-t.traceStarted:
+		case <-time.After(timeout):
+			return 0, 1, timeoutError{}
+		}
+	}
 	return t.pingRtt, t.pingRequests, t.pingErr
 }
+
+func (t mockLoop) checkTraceroute() error {
+	return t.checkTracerouteErr
+}
+
+func (t mockLoop) traceroute(addr *net.IPAddr, config TracerouteConfig, deadline time.Time) (*traceResult, error) {
+	if t.traceDeadline != nil {
+		*t.traceDeadline = deadline
+	}
+	if t.traceStarted != nil {
+		close(t.traceStarted)
+	}
+	return t.trace, nil
+}
@@ -29,4 +29,6 @@ type ICMPLoop interface {
 		timeout time.Duration,
 		interval time.Duration,
 	) (time.Duration, int, error)
+	checkTraceroute() error
+	traceroute(addr *net.IPAddr, config TracerouteConfig, deadline time.Time) (*traceResult, error)
 }
@@ -40,6 +40,14 @@ type stdICMPLoop struct {
 
 	mutex    sync.Mutex
 	requests map[requestID]*requestContext
+
+	// sendMutex serializes writes, as probes temporarily change the TTL of
+	// the shared connections.
+	sendMutex sync.Mutex
+
+	// unprivileged is set if the loop uses unprivileged ICMP sockets, which
+	// do not receive ICMP errors.
+	unprivileged bool
 }
 
 type timeoutError struct {
@@ -73,6 +81,10 @@ type requestContext struct {
 	id     requestID
 	ts     time.Time
 	result chan requestResult
+
+	// probe requests also accept ICMP errors in response, such as time
+	// exceeded messages sent by routers on the path.
+	probe bool
 }
 
 type requestResult struct {
@@ -134,6 +146,7 @@ func newICMPLoop() (*stdICMPLoop, error) {
 			//This is non-privileged ICMP, not udp
 			l.conn4 = createListener("Unprivileged IPv4", "udp4")
 			l.conn6 = createListener("Unprivileged IPv6", "udp6")
+			l.unprivileged = true
 		}
 	}
 
@@ -204,24 +217,41 @@ func (l *stdICMPLoop) runICMPRecv(conn *icmp.PacketConn, proto int) {
 			continue
 		}
 
-		// process echo reply only
-		if m.Type != ipv4.ICMPTypeEchoReply && m.Type != ipv6.ICMPTypeEchoReply {
-			continue
-		}
-		echo, ok := m.Body.(*icmp.Echo)
-		if !ok {
-			continue
-		}
+		// process echo replies, and errors in response to probes
+		var id requestID
+		var echo icmp.Echo
+		isError := false
+		switch m.Type {
+		case ipv4.ICMPTypeEchoReply, ipv6.ICMPTypeEchoReply:
+			body, ok := m.Body.(*icmp.Echo)
+			if !ok {
+				continue
+			}
+			echo = *body
+			id = requestID{
+				addr:  addr.String(),
+				proto: proto,
+				id:    echo.ID,
+				seq:   echo.Seq,
+			}
 
-		id := requestID{
-			addr:  addr.String(),
-			proto: proto,
-			id:    echo.ID,
-			seq:   echo.Seq,
+		case ipv4.ICMPTypeTimeExceeded, ipv6.ICMPTypeTimeExceeded,
+			ipv4.ICMPTypeDestinationUnreachable, ipv6.ICMPTypeDestinationUnreachable:
+			var ok bool
+			if id, ok = originalRequestID(proto, m.Body); !ok {
+				continue
+			}
+			isError = true
+
+		default:
+			continue
 		}
 
 		l.mutex.Lock()
 		ctx := l.requests[id]
+		if ctx != nil && isError && !ctx.probe {
+			ctx = nil
+		}
 		if ctx != nil {
 			delete(l.requests, id)
 		}
@@ -240,7 +270,7 @@ func (l *stdICMPLoop) runICMPRecv(conn *icmp.PacketConn, proto int) {
 				Type:     m.Type,
 				Code:     m.Code,
 				Checksum: m.Checksum,
-				Echo:     *echo,
+				Echo:     echo,
 			},
 		}
 	}
@@ -287,7 +317,7 @@ func (l *stdICMPLoop) ping(
 
 	for !done {
 		var ctx *requestContext
-		ctx, err = l.sendEchoRequest(addr)
+		ctx, err = l.sendEchoRequest(addr, 0)
 		if err != nil {
 			close(doneSignal)
 			break
@@ -324,7 +354,9 @@ func (l *stdICMPLoop) ping(
 	return rtt, requests, nil
 }
 
-func (l *stdICMPLoop) sendEchoRequest(addr *net.IPAddr) (*requestContext, error) {
+// sendEchoRequest sends an echo request to addr. If ttl is set, the request
+// is sent as a probe with the given TTL, or hop limit.
+func (l *stdICMPLoop) sendEchoRequest(addr *net.IPAddr, ttl int) (*requestContext, error) {
 	var conn *icmp.PacketConn
 	var proto int
 	var typ icmp.Type
@@ -356,6 +388,7 @@ func (l *stdICMPLoop) sendEchoRequest(addr *net.IPAddr) (*requestContext, error)
 		l:      l,
 		id:     id,
 		result: make(chan requestResult, 1),
+		probe:  ttl > 0,
 	}
 
 	l.mutex.Lock()
@@ -377,8 +410,11 @@ func (l *stdICMPLoop) sendEchoRequest(addr *net.IPAddr) (*requestContext, error)
 	}
 	encoded, _ := msg.Marshal(nil)
 
-	_, err := conn.WriteTo(encoded, addr)
+	l.sendMutex.Lock()
+	err := writeTo(conn, proto, encoded, addr, ttl)
+	l.sendMutex.Unlock()
 	if err != nil {
+		ctx.Stop()
 		return nil, err
 	}
 
@@ -386,6 +422,83 @@ func (l *stdICMPLoop) sendEchoRequest(addr *net.IPAddr) (*requestContext, error)
 	return ctx, nil
 }
 
+// writeTo writes the message to addr. If ttl is set, the TTL, or hop limit,
+// of the connection is changed for this message only.
+func writeTo(conn *icmp.PacketConn, proto int, msg []byte, addr net.Addr, ttl int) error {
+	if ttl <= 0 {
+		_, err := conn.WriteTo(msg, addr)
+		return err
+	}
+
+	var get func() (int, error)
+	var set func(int) error
+	if proto == protocolICMP {
+		get, set = conn.IPv4PacketConn().TTL, conn.IPv4PacketConn().SetTTL
+	} else {
+		get, set = conn.IPv6PacketConn().HopLimit, conn.IPv6PacketConn().SetHopLimit
+	}
+
+	prev, err := get()
+	if err != nil {
+		return err
+	}
+	if err := set(ttl); err != nil {
+		return err
+	}
+	defer set(prev)
+
+	_, err = conn.WriteTo(msg, addr)
+	return err
+}
+
+// originalRequestID extracts the request an ICMP error was sent in response
+// to. ICMP errors include the IP header and the start of the original
+// message, which contains the id and sequence number of echo requests.
+func originalRequestID(proto int, body icmp.MessageBody) (requestID, bool) {
+	var data []byte
+	switch b := body.(type) {
+	case *icmp.TimeExceeded:
+		data = b.Data
+	case *icmp.DstUnreach:
+		data = b.Data
+	default:
+		return requestID{}, false
+	}
+
+	var dst net.IP
+	var msg []byte
+	var echoType byte
+	switch proto {
+	case protocolICMP:
+		if len(data) < ipv4.HeaderLen {
+			return requestID{}, false
+		}
+		headerLen := int(data[0]&0x0f) << 2
+		if data[9] != protocolICMP || len(data) < headerLen+8 {
+			return requestID{}, false
+		}
+		dst, msg, echoType = net.IP(data[16:20]), data[headerLen:], byte(ipv4.ICMPTypeEcho)
+	case protocolIPv6ICMP:
+		if len(data) < ipv6.HeaderLen+8 || data[6] != protocolIPv6ICMP {
+			return requestID{}, false
+		}
+		dst, msg, echoType = net.IP(data[24:40]), data[ipv6.HeaderLen:], byte(ipv6.ICMPTypeEchoRequest)
+	default:
+		return requestID{}, false
+	}
+
+	if msg[0] != echoType {
+		return requestID{}, false
+	}
+
+	return requestID{
+		addr:  (&net.IPAddr{IP: dst}).String(),
+		proto: proto,
+		id:    int(binary.BigEndian.Uint16(msg[4:6])),
+		seq:   int(binary.BigEndian.Uint16(msg[6:8])),
+	}, true
+}
+
 func createListener(name, network string) *icmp.PacketConn {
 	conn, err := icmp.ListenPacket(network, "")
 
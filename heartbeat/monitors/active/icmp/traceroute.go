@@ -0,0 +1,191 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package icmp
+
+import (
+	"errors"
+	"net"
+	"time"
+
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+
+	"github.com/elastic/beats/v7/heartbeat/look"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// traceResult is the network path to a host, as discovered by sending
+// probes with increasing TTL.
+type traceResult struct {
+	hops []hop
+
+	// reached is set if the host responded to a probe.
+	reached bool
+}
+
+// hop collects the responses to the probes sent with the same TTL.
+type hop struct {
+	ttl  int
+	sent int
+	ips  []string
+	rtts []time.Duration
+
+	// reached is set if the host responded, unreachable if a destination
+	// unreachable error was received.
+	reached     bool
+	unreachable bool
+}
+
+func (h *hop) add(addr net.Addr, rtt time.Duration) {
+	h.rtts = append(h.rtts, rtt)
+
+	ip := addr.String()
+	for _, known := range h.ips {
+		if known == ip {
+			return
+		}
+	}
+	h.ips = append(h.ips, ip)
+}
+
+func (h *hop) fields() common.MapStr {
+	fields := common.MapStr{"ttl": h.ttl}
+	if len(h.ips) > 0 {
+		fields["ip"] = h.ips
+
+		var total time.Duration
+		for _, rtt := range h.rtts {
+			total += rtt
+		}
+		fields["rtt"] = look.RTT(total / time.Duration(len(h.rtts)))
+	}
+	fields["loss"] = common.MapStr{"pct": float64(h.sent-len(h.rtts)) / float64(h.sent)}
+	return fields
+}
+
+func (r *traceResult) fields() common.MapStr {
+	hops := make([]common.MapStr, len(r.hops))
+	var last *hop
+	for i := range r.hops {
+		hops[i] = r.hops[i].fields()
+		if len(r.hops[i].ips) > 0 {
+			last = &r.hops[i]
+		}
+	}
+
+	fields := common.MapStr{
+		"reached": r.reached,
+		"hops":    hops,
+	}
+	if last != nil {
+		fields["last_hop"] = common.MapStr{"ttl": last.ttl, "ip": last.ips}
+	}
+	return fields
+}
+
+func (l *stdICMPLoop) checkTraceroute() error {
+	if l.unprivileged {
+		return errors.New("traceroute requires privileges to open raw ICMP sockets. Check log details for permission configuration")
+	}
+	return nil
+}
+
+// traceroute probes the hops to addr one after another, until addr responds,
+// a destination unreachable error is received, the maximum number of hops
+// is reached or the deadline expires.
+func (l *stdICMPLoop) traceroute(addr *net.IPAddr, config TracerouteConfig, deadline time.Time) (*traceResult, error) {
+	result := &traceResult{}
+	for ttl := 1; ttl <= config.MaxHops; ttl++ {
+		wait := config.Wait
+		if left := time.Until(deadline); left < wait {
+			wait = left
+		}
+		if wait <= 0 {
+			break
+		}
+
+		h, err := l.probeHop(addr, ttl, config.Probes, wait)
+		if err != nil {
+			return nil, err
+		}
+		result.hops = append(result.hops, h)
+
+		if h.reached || h.unreachable {
+			result.reached = h.reached
+			break
+		}
+	}
+	return result, nil
+}
+
+// probeHop sends all probes of a hop at once and collects the responses
+// received within wait.
+func (l *stdICMPLoop) probeHop(addr *net.IPAddr, ttl, probes int, wait time.Duration) (hop, error) {
+	h := hop{ttl: ttl}
+
+	ctxs := make([]*requestContext, 0, probes)
+	for i := 0; i < probes; i++ {
+		ctx, err := l.sendEchoRequest(addr, ttl)
+		if err != nil {
+			for _, ctx := range ctxs {
+				ctx.Stop()
+			}
+			return h, err
+		}
+		ctxs = append(ctxs, ctx)
+	}
+	h.sent = len(ctxs)
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	expired := false
+	for _, ctx := range ctxs {
+		var r requestResult
+		received := false
+		if !expired {
+			select {
+			case r = <-ctx.result:
+				received = true
+			case <-timer.C:
+				expired = true
+			}
+		}
+		if !received && expired {
+			select {
+			case r = <-ctx.result:
+				received = true
+			default:
+				ctx.Stop()
+			}
+		}
+		if !received {
+			continue
+		}
+
+		h.add(r.packet.addr, r.packet.ts.Sub(ctx.ts))
+		switch r.packet.Type {
+		case ipv4.ICMPTypeEchoReply, ipv6.ICMPTypeEchoReply:
+			h.reached = true
+		case ipv4.ICMPTypeDestinationUnreachable, ipv6.ICMPTypeDestinationUnreachable:
+			h.unreachable = true
+		}
+	}
+
+	return h, nil
+}
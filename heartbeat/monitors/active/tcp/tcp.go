@@ -27,6 +27,7 @@ import (
 	"github.com/elastic/beats/v7/heartbeat/look"
 	"github.com/elastic/beats/v7/heartbeat/monitors"
 	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlscheck"
 	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlsmeta"
 	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
 	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
@@ -63,12 +64,12 @@ func createWithResolver(
 		return plugin.Plugin{}, err
 	}
 
-	js, err := jc.makeJobs()
+	js, keys, err := jc.makeJobs()
 	if err != nil {
 		return plugin.Plugin{}, err
 	}
 
-	return plugin.Plugin{Jobs: js, Close: nil, Endpoints: len(jc.endpoints)}, nil
+	return plugin.Plugin{Jobs: js, Close: nil, Endpoints: len(jc.endpoints), Keys: keys}, nil
 }
 
 // jobFactory is where most of the logic here lives. It provides a common context around
@@ -76,6 +77,7 @@ func createWithResolver(
 type jobFactory struct {
 	config        config
 	tlsConfig     *tlscommon.TLSConfig
+	tlsChecker    *tlscheck.Checker
 	defaultScheme string
 	endpoints     []endpoint
 	dataCheck     dataCheck
@@ -104,6 +106,11 @@ func (jf *jobFactory) loadConfig(commonCfg *common.Config) error {
 		return err
 	}
 
+	jf.tlsChecker, err = tlscheck.New(jf.config.TLSCheck)
+	if err != nil {
+		return err
+	}
+
 	jf.defaultScheme = "tcp"
 	if jf.tlsConfig != nil {
 		jf.defaultScheme = "ssl"
@@ -119,21 +126,24 @@ func (jf *jobFactory) loadConfig(commonCfg *common.Config) error {
 	return nil
 }
 
-// makeJobs returns the actual schedulable jobs for this monitor.
-func (jf *jobFactory) makeJobs() ([]jobs.Job, error) {
+// makeJobs returns the actual schedulable jobs for this monitor, and the URL
+// each of them checks.
+func (jf *jobFactory) makeJobs() ([]jobs.Job, []string, error) {
 	var jobs []jobs.Job
+	var keys []string
 	for _, endpoint := range jf.endpoints {
 		for _, url := range endpoint.perPortURLs() {
 			endpointJob, err := jf.makeEndpointJob(url)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			jobs = append(jobs, wrappers.WithURLField(url, endpointJob))
+			keys = append(keys, url.String())
 		}
 
 	}
 
-	return jobs, nil
+	return jobs, keys, nil
 }
 
 // makeEndpointJob makes a job for a single check of a single scheme/host/port combo.
@@ -205,6 +215,9 @@ func (jf *jobFactory) dial(event *beat.Event, dialAddr string, canonicalURL *url
 	// try and directly match the IP from the prior ConstAddrLayer to the cert.
 	if canonicalURL.Scheme != "tcp" && canonicalURL.Scheme != "plain" {
 		dc.AddLayer(dialchain.TLSLayer(jf.tlsConfig, jf.config.Timeout))
+		if jf.tlsChecker != nil {
+			dc.AddLayer(dialchain.TLSCheckLayer(jf.tlsChecker))
+		}
 		dc.AddLayer(dialchain.ConstAddrLayer(canonicalURL.Host))
 	}
 
@@ -231,6 +244,10 @@ func (jf *jobFactory) execDialer(
 		if certErr, ok := err.(x509.CertificateInvalidError); ok {
 			tlsmeta.AddCertMetadata(event.Fields, []*x509.Certificate{certErr.Cert})
 		}
+		// failed TLS checks are reported as validation errors
+		if validateErr, ok := err.(reason.ValidateError); ok {
+			return validateErr
+		}
 		return reason.IOFailed(err)
 	}
 	defer conn.Close()
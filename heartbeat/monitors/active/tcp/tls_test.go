@@ -40,6 +40,7 @@ import (
 	"github.com/elastic/beats/v7/heartbeat/monitors"
 	"github.com/elastic/go-lookslike"
 	"github.com/elastic/go-lookslike/testslike"
+	"github.com/elastic/go-lookslike/validator"
 )
 
 // Tests that we can check a TLS connection with a cert for a SAN IP
@@ -143,6 +144,38 @@ func TestTLSExpiredCert(t *testing.T) {
 	)
 }
 
+func TestTLSChecks(t *testing.T) {
+	ip, port, cert, certFile, teardown := setupTLSTestServer(t)
+	defer teardown()
+
+	tests := []struct {
+		name     string
+		tlsCheck common.MapStr
+		status   string
+	}{
+		{"passing checks", common.MapStr{"min_version": "TLSv1.2", "certificate_authorities": certFile.Name()}, "up"},
+		// the test server certificate expires in 2084
+		{"expiring certificate", common.MapStr{"expires_within": "1000000h"}, "down"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := testTLSTCPCheck(t, ip, port, certFile.Name(), monitors.NewStdResolver(), common.MapStr{"check.tls": test.tlsCheck})
+
+			validators := []validator.Validator{
+				hbtest.TLSChecks(0, 0, cert),
+				hbtest.RespondingTCPChecks(),
+				hbtest.BaseChecks(ip, test.status, "tcp"),
+				hbtest.SimpleURLChecks(t, "ssl", ip, port),
+			}
+			if test.status == "down" {
+				validators = append(validators, hbtest.ErrorChecks("TLS check failed: certificate", "validate"))
+			}
+			testslike.Test(t, lookslike.Compose(validators...), event.Fields)
+		})
+	}
+}
+
 func setupTLSTestServer(t *testing.T) (ip string, port uint16, cert *x509.Certificate, certFile *os.File, teardown func()) {
 	// Start up a TLS Server
 	server, port, err := setupServer(t, func(handler http.Handler) (*httptest.Server, error) {
@@ -175,13 +208,17 @@ func setupTLSTestServer(t *testing.T) (ip string, port uint16, cert *x509.Certif
 	}
 }
 
-func testTLSTCPCheck(t *testing.T, host string, port uint16, certFileName string, resolver monitors.Resolver) *beat.Event {
-	config, err := common.NewConfigFrom(common.MapStr{
+func testTLSTCPCheck(t *testing.T, host string, port uint16, certFileName string, resolver monitors.Resolver, extraConfig ...common.MapStr) *beat.Event {
+	configSrc := common.MapStr{
 		"hosts":   host,
 		"ports":   int64(port),
 		"ssl":     common.MapStr{"certificate_authorities": certFileName},
 		"timeout": "1s",
-	})
+	}
+	for _, extra := range extraConfig {
+		configSrc.DeepUpdate(extra)
+	}
+	config, err := common.NewConfigFrom(configSrc)
 	require.NoError(t, err)
 
 	p, err := createWithResolver(config, resolver)
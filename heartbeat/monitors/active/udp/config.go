@@ -0,0 +1,138 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/monitors"
+)
+
+type config struct {
+	// check all ports if host does not contain port
+	Hosts []string `config:"hosts" validate:"required"`
+	Ports []uint16 `config:"ports"`
+
+	Mode monitors.IPSettings `config:",inline"`
+
+	Timeout time.Duration `config:"timeout"`
+	Wait    time.Duration `config:"wait"`
+
+	Check checkConfig `config:"check"`
+}
+
+type checkConfig struct {
+	// Send is the payload of every request.
+	Send string `config:"send"`
+
+	// Receive must be contained in the response if set.
+	Receive string `config:"receive"`
+
+	// Encoding of send and receive, either text or hex.
+	Encoding string `config:"encoding"`
+}
+
+func defaultConfig() config {
+	return config{
+		Mode:    monitors.DefaultIPSettings,
+		Timeout: 16 * time.Second,
+		Wait:    1 * time.Second,
+		Check: checkConfig{
+			Encoding: "text",
+		},
+	}
+}
+
+func (c *config) Validate() error {
+	if c.Timeout <= 0 {
+		return errors.New("timeout must be greater than 0")
+	}
+	if c.Wait <= 0 {
+		return errors.New("wait must be greater than 0")
+	}
+	if _, err := c.endpoints(); err != nil {
+		return err
+	}
+	_, _, err := c.Check.payloads()
+	return err
+}
+
+// endpoints returns one udp URL per host and port.
+func (c *config) endpoints() ([]*url.URL, error) {
+	var urls []*url.URL
+	for _, h := range c.Hosts {
+		host := h
+		if u, err := url.Parse(h); err == nil && u.Host != "" {
+			if u.Scheme != "udp" {
+				return nil, fmt.Errorf("'%s' is not a supported connection scheme in '%s', only udp is supported", u.Scheme, h)
+			}
+			host = u.Host
+		}
+
+		ports := c.Ports
+		hostname, port, err := net.SplitHostPort(host)
+		if err == nil {
+			p, err := strconv.ParseUint(port, 10, 16)
+			if err != nil {
+				return nil, fmt.Errorf("invalid port in udp host '%s'", h)
+			}
+			ports = []uint16{uint16(p)}
+		} else {
+			hostname = host
+		}
+
+		if hostname == "" {
+			return nil, fmt.Errorf("could not parse udp host '%s'", h)
+		}
+		if len(ports) == 0 {
+			return nil, fmt.Errorf("host '%s' missing port number", h)
+		}
+
+		for _, p := range ports {
+			urls = append(urls, &url.URL{
+				Scheme: "udp",
+				Host:   net.JoinHostPort(hostname, strconv.Itoa(int(p))),
+			})
+		}
+	}
+	return urls, nil
+}
+
+// payloads decodes the send and receive payloads.
+func (c *checkConfig) payloads() (send, receive []byte, err error) {
+	switch c.Encoding {
+	case "text":
+		return []byte(c.Send), []byte(c.Receive), nil
+	case "hex":
+		if send, err = hex.DecodeString(c.Send); err != nil {
+			return nil, nil, fmt.Errorf("invalid hex encoded check.send: %v", err)
+		}
+		if receive, err = hex.DecodeString(c.Receive); err != nil {
+			return nil, nil, fmt.Errorf("invalid hex encoded check.receive: %v", err)
+		}
+		return send, receive, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported check.encoding '%s', must be text or hex", c.Encoding)
+	}
+}
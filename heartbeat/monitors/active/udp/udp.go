@@ -0,0 +1,191 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/look"
+	"github.com/elastic/beats/v7/heartbeat/monitors"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/reason"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func init() {
+	plugin.Register("udp", create, "synthetics/udp")
+}
+
+var debugf = logp.MakeDebug("udp")
+
+var (
+	errNoResponse      = errors.New("no response received")
+	errReceiveMismatch = errors.New("received data mismatch")
+)
+
+// maxDatagramSize is the largest possible UDP payload.
+const maxDatagramSize = 65535
+
+func create(
+	name string,
+	cfg *common.Config,
+) (p plugin.Plugin, err error) {
+	return createWithResolver(cfg, monitors.NewStdResolver())
+}
+
+// createWithResolver allows tests to use a resolver not depending on the
+// hostnames of the test environment.
+func createWithResolver(
+	cfg *common.Config,
+	resolver monitors.Resolver,
+) (p plugin.Plugin, err error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	jf, err := newJobFactory(config, resolver)
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+	return jf.makePlugin()
+}
+
+// jobFactory creates one job per host and port, checking every resolved IP
+// depending on the configured mode.
+type jobFactory struct {
+	config    config
+	resolver  monitors.Resolver
+	endpoints []*url.URL
+	send      []byte
+	receive   []byte
+}
+
+func newJobFactory(config config, resolver monitors.Resolver) (*jobFactory, error) {
+	endpoints, err := config.endpoints()
+	if err != nil {
+		return nil, err
+	}
+
+	send, receive, err := config.Check.payloads()
+	if err != nil {
+		return nil, err
+	}
+
+	return &jobFactory{
+		config:    config,
+		resolver:  resolver,
+		endpoints: endpoints,
+		send:      send,
+		receive:   receive,
+	}, nil
+}
+
+func (jf *jobFactory) makePlugin() (plugin.Plugin, error) {
+	var js []jobs.Job
+	var keys []string
+	for _, u := range jf.endpoints {
+		port := u.Port()
+		job, err := monitors.MakeByHostJob(
+			u.Hostname(),
+			jf.config.Mode,
+			jf.resolver,
+			monitors.MakePingIPFactory(func(event *beat.Event, ip *net.IPAddr) error {
+				return jf.check(event, net.JoinHostPort(ip.String(), port))
+			}))
+		if err != nil {
+			return plugin.Plugin{}, err
+		}
+		js = append(js, wrappers.WithURLField(u, job))
+		keys = append(keys, u.String())
+	}
+
+	return plugin.Plugin{Jobs: js, Close: nil, Endpoints: len(js), Keys: keys}, nil
+}
+
+// check sends the payload to addr and waits for a response. As datagrams
+// may be lost, the payload is sent again every wait interval until a
+// response is received or the timeout expires. The RTT is measured from the
+// first request, as the response may answer any of them.
+func (jf *jobFactory) check(event *beat.Event, addr string) error {
+	start := time.Now()
+	deadline := start.Add(jf.config.Timeout)
+
+	conn, err := net.DialTimeout("udp", addr, jf.config.Timeout)
+	if err != nil {
+		debugf("dial failed with: %v", err)
+		return reason.IOFailed(err)
+	}
+	defer conn.Close()
+
+	buf := make([]byte, maxDatagramSize)
+	requests := 0
+	for {
+		sent := time.Now()
+		if _, err := conn.Write(jf.send); err != nil {
+			debugf("sending request failed with: %v", err)
+			return reason.IOFailed(err)
+		}
+		requests++
+
+		readDeadline := sent.Add(jf.config.Wait)
+		if readDeadline.After(deadline) {
+			readDeadline = deadline
+		}
+		if err := conn.SetReadDeadline(readDeadline); err != nil {
+			return reason.IOFailed(err)
+		}
+
+		n, err := conn.Read(buf)
+		if err != nil {
+			if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+				if time.Now().Before(deadline) {
+					continue
+				}
+				eventext.MergeEventFields(event, common.MapStr{"udp": common.MapStr{"requests": requests}})
+				return reason.IOFailed(errNoResponse)
+			}
+
+			// ICMP errors, e.g. port unreachable, are reported when reading
+			debugf("receiving response failed with: %v", err)
+			eventext.MergeEventFields(event, common.MapStr{"udp": common.MapStr{"requests": requests}})
+			return reason.IOFailed(err)
+		}
+
+		eventext.MergeEventFields(event, common.MapStr{
+			"udp": common.MapStr{
+				"requests": requests,
+				"rtt":      look.RTT(time.Since(start)),
+			},
+		})
+
+		if !bytes.Contains(buf[:n], jf.receive) {
+			return reason.ValidateFailed(errReceiveMismatch)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,267 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"bytes"
+	"net"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/heartbeat/hbtest"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/isdef"
+	"github.com/elastic/go-lookslike/testslike"
+	"github.com/elastic/go-lookslike/validator"
+)
+
+func TestUDPUp(t *testing.T) {
+	addr := serveUDP(t, echoHandler)
+	_, port, _ := net.SplitHostPort(addr)
+
+	event := execTestUDPCheck(t, common.MapStr{
+		"hosts":         []string{"127.0.0.1"},
+		"ports":         []string{port},
+		"check.send":    "PING",
+		"check.receive": "PONG",
+	})
+
+	testslike.Test(t, lookslike.Strict(lookslike.Compose(
+		hbtest.BaseChecks("127.0.0.1", "up", "udp"),
+		hbtest.SummaryChecks(1, 0),
+		hbtest.URLChecks(t, &url.URL{Scheme: "udp", Host: addr}),
+		lookslike.MustCompile(map[string]interface{}{
+			"udp.requests": 1,
+			"udp.rtt.us":   isdef.IsDuration,
+		}),
+	)), event.Fields)
+}
+
+func TestUDPChecks(t *testing.T) {
+	addr := serveUDP(t, echoHandler)
+
+	tests := []struct {
+		name    string
+		config  common.MapStr
+		status  string
+		errMsg  string
+		errType string
+	}{
+		{
+			"any response",
+			common.MapStr{"check.send": "hello"},
+			"up",
+			"",
+			"",
+		},
+		{
+			"matching response",
+			common.MapStr{"check.send": "PING", "check.receive": "ONG"},
+			"up",
+			"",
+			"",
+		},
+		{
+			"mismatching response",
+			common.MapStr{"check.send": "hello", "check.receive": "PONG"},
+			"down",
+			"received data mismatch",
+			"validate",
+		},
+		{
+			"hex encoded payloads",
+			common.MapStr{"check.encoding": "hex", "check.send": "50494e47", "check.receive": "504f4e47"},
+			"up",
+			"",
+			"",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := common.MapStr{"hosts": []string{addr}}
+			config.DeepUpdate(test.config)
+
+			event := execTestUDPCheck(t, config)
+
+			validators := []validator.Validator{
+				lookslike.MustCompile(map[string]interface{}{"monitor.status": test.status}),
+			}
+			if test.errMsg != "" {
+				validators = append(validators, hbtest.ErrorChecks(test.errMsg, test.errType))
+			}
+			testslike.Test(t, lookslike.Compose(validators...), event.Fields)
+		})
+	}
+}
+
+func TestUDPResendsUntilResponse(t *testing.T) {
+	var received int32
+	addr := serveUDP(t, func(conn net.PacketConn, from net.Addr, data []byte) {
+		// drop the first request
+		if atomic.AddInt32(&received, 1) > 1 {
+			echoHandler(conn, from, data)
+		}
+	})
+
+	event := execTestUDPCheck(t, common.MapStr{
+		"hosts":      []string{addr},
+		"check.send": "PING",
+		"wait":       "50ms",
+	})
+
+	testslike.Test(t, lookslike.MustCompile(map[string]interface{}{
+		"monitor.status": "up",
+		"udp.requests":   2,
+	}), event.Fields)
+
+	// the RTT includes the wait for the dropped request
+	rtt, err := event.Fields.GetValue("udp.rtt.us")
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, rtt, int64(50*time.Millisecond/time.Microsecond))
+}
+
+func TestUDPNoResponse(t *testing.T) {
+	addr := serveUDP(t, func(net.PacketConn, net.Addr, []byte) {})
+
+	event := execTestUDPCheck(t, common.MapStr{
+		"hosts":   []string{addr},
+		"timeout": "200ms",
+		"wait":    "50ms",
+	})
+
+	testslike.Test(t, lookslike.Compose(
+		hbtest.BaseChecks("127.0.0.1", "down", "udp"),
+		hbtest.ErrorChecks("no response received", "io"),
+		lookslike.MustCompile(map[string]interface{}{
+			"udp.requests": isdef.IsIntGt(1),
+		}),
+	), event.Fields)
+}
+
+func TestUDPPortUnreachable(t *testing.T) {
+	l, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.LocalAddr().String()
+	l.Close()
+
+	event := execTestUDPCheck(t, common.MapStr{
+		"hosts":   []string{addr},
+		"timeout": "200ms",
+		"wait":    "50ms",
+	})
+
+	testslike.Test(t, lookslike.Compose(
+		hbtest.BaseChecks("127.0.0.1", "down", "udp"),
+		hbtest.ErrorChecks("", "io"),
+	), event.Fields)
+}
+
+func TestConfigValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		config common.MapStr
+	}{
+		{"missing port", common.MapStr{"hosts": []string{"localhost"}}},
+		{"unsupported scheme", common.MapStr{"hosts": []string{"tcp://localhost:53"}}},
+		{"unsupported encoding", common.MapStr{"check.encoding": "base64"}},
+		{"invalid hex", common.MapStr{"check.encoding": "hex", "check.send": "zz"}},
+		{"zero timeout", common.MapStr{"timeout": "0s"}},
+		{"negative timeout", common.MapStr{"timeout": "-1s"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := common.MapStr{"hosts": []string{"localhost:53"}}
+			config.DeepUpdate(test.config)
+
+			_, err := create("udp", common.MustNewConfigFrom(config))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestEndpoints(t *testing.T) {
+	c := config{Hosts: []string{"localhost", "udp://example.com:161", "[::1]:53"}, Ports: []uint16{53, 123}}
+	urls, err := c.endpoints()
+	require.NoError(t, err)
+
+	var hosts []string
+	for _, u := range urls {
+		hosts = append(hosts, u.String())
+	}
+	require.Equal(t, []string{
+		"udp://localhost:53",
+		"udp://localhost:123",
+		"udp://example.com:161",
+		"udp://[::1]:53",
+	}, hosts)
+}
+
+func execTestUDPCheck(t *testing.T, config common.MapStr) *beat.Event {
+	p, err := create("udp", common.MustNewConfigFrom(config))
+	require.NoError(t, err)
+	require.Len(t, p.Jobs, 1)
+
+	sched, _ := schedule.Parse("@every 1s")
+	job := wrappers.WrapCommon(p.Jobs, stdfields.StdMonitorFields{ID: "test", Type: "udp", Schedule: sched, Timeout: 1})[0]
+
+	event := &beat.Event{}
+	_, err = job(event)
+	require.NoError(t, err)
+	return event
+}
+
+// serveUDP starts an in-process UDP server on an ephemeral port, passing
+// every received datagram to the handler.
+func serveUDP(t *testing.T, handler func(conn net.PacketConn, from net.Addr, data []byte)) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, maxDatagramSize)
+		for {
+			n, from, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			handler(conn, from, append([]byte{}, buf[:n]...))
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+// echoHandler answers PING with PONG, and echoes any other request.
+func echoHandler(conn net.PacketConn, from net.Addr, data []byte) {
+	if bytes.Equal(data, []byte("PING")) {
+		data = []byte("PONG")
+	}
+	conn.WriteTo(data, from)
+}
@@ -19,6 +19,7 @@ package monitors
 
 import (
 	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
+	"github.com/elastic/beats/v7/heartbeat/monitors/slo"
 	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
 	"github.com/elastic/beats/v7/heartbeat/scheduler"
 	"github.com/elastic/beats/v7/libbeat/beat"
@@ -36,6 +37,7 @@ import (
 type RunnerFactory struct {
 	info         beat.Info
 	sched        *scheduler.Scheduler
+	sloStore     *slo.Store
 	allowWatches bool
 }
 
@@ -59,8 +61,9 @@ type publishSettings struct {
 }
 
 // NewFactory takes a scheduler and creates a RunnerFactory that can create cfgfile.Runner(Monitor) objects.
-func NewFactory(info beat.Info, sched *scheduler.Scheduler, allowWatches bool) *RunnerFactory {
-	return &RunnerFactory{info, sched, allowWatches}
+// The SLO state of the monitors is persisted in sloStore, which can be nil.
+func NewFactory(info beat.Info, sched *scheduler.Scheduler, sloStore *slo.Store, allowWatches bool) *RunnerFactory {
+	return &RunnerFactory{info, sched, sloStore, allowWatches}
 }
 
 // Create makes a new Runner for a new monitor with the given Config.
@@ -76,7 +79,7 @@ func (f *RunnerFactory) Create(p beat.Pipeline, c *common.Config) (cfgfile.Runne
 	}
 
 	p = pipetool.WithClientConfigEdit(p, configEditor)
-	monitor, err := newMonitor(c, plugin.GlobalPluginsReg, p, f.sched, f.allowWatches)
+	monitor, err := newMonitor(c, plugin.GlobalPluginsReg, p, f.sched, f.sloStore, f.allowWatches)
 	return monitor, err
 }
 
@@ -28,6 +28,7 @@ import (
 
 	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
 	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
+	"github.com/elastic/beats/v7/heartbeat/monitors/slo"
 	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
 	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
 	"github.com/elastic/beats/v7/heartbeat/scheduler"
@@ -73,7 +74,7 @@ func (m *Monitor) String() string {
 }
 
 func checkMonitorConfig(config *common.Config, registrar *plugin.PluginsReg, allowWatches bool) error {
-	m, err := newMonitor(config, registrar, nil, nil, allowWatches)
+	m, err := newMonitor(config, registrar, nil, nil, nil, allowWatches)
 	if m != nil {
 		m.Stop() // Stop the monitor to free up the ID from uniqueness checks
 	}
@@ -100,9 +101,10 @@ func newMonitor(
 	registrar *plugin.PluginsReg,
 	pipelineConnector beat.PipelineConnector,
 	scheduler *scheduler.Scheduler,
+	sloStore *slo.Store,
 	allowWatches bool,
 ) (*Monitor, error) {
-	m, err := newMonitorUnsafe(config, registrar, pipelineConnector, scheduler, allowWatches)
+	m, err := newMonitorUnsafe(config, registrar, pipelineConnector, scheduler, sloStore, allowWatches)
 	if m != nil && err != nil {
 		m.Stop()
 	}
@@ -116,6 +118,7 @@ func newMonitorUnsafe(
 	registrar *plugin.PluginsReg,
 	pipelineConnector beat.PipelineConnector,
 	scheduler *scheduler.Scheduler,
+	sloStore *slo.Store,
 	allowWatches bool,
 ) (*Monitor, error) {
 	// Extract just the Id, Type, and Enabled fields from the config
@@ -159,14 +162,14 @@ func newMonitorUnsafe(
 
 	p, err := pluginFactory.Create(config)
 	m.close = p.Close
-	wrappedJobs := wrappers.WrapCommon(p.Jobs, m.stdFields)
+	wrappedJobs := wrappers.WrapSLO(wrappers.WrapCommon(p.Jobs, m.stdFields), m.stdFields, sloStore)
 	m.endpoints = p.Endpoints
 
 	if err != nil {
 		return m, fmt.Errorf("job err %v", err)
 	}
 
-	m.configuredJobs, err = m.makeTasks(config, wrappedJobs)
+	m.configuredJobs, err = m.makeTasks(config, wrappedJobs, p.Keys, "")
 	if err != nil {
 		return m, err
 	}
@@ -202,15 +205,34 @@ func (m *Monitor) configHash() (uint64, error) {
 	return hash, nil
 }
 
-func (m *Monitor) makeTasks(config *common.Config, jobs []jobs.Job) ([]*configuredJob, error) {
+// makeTasks creates a configuredJob for every job. Jobs are told apart in the scheduler
+// by the monitor ID, keyPrefix and a hash of their key, or their position if the plugin
+// doesn't provide a key for every job.
+func (m *Monitor) makeTasks(config *common.Config, jobs []jobs.Job, keys []string, keyPrefix string) ([]*configuredJob, error) {
 	mtConf := jobConfig{}
 	if err := config.Unpack(&mtConf); err != nil {
 		return nil, errors.Wrap(err, "invalid config, could not unpack monitor config")
 	}
 
+	seen := map[string]int{}
 	var mTasks []*configuredJob
-	for _, job := range jobs {
-		t, err := newConfiguredJob(job, mtConf, m)
+	for i, job := range jobs {
+		schedulerID := fmt.Sprintf("%s/%s%d", m.stdFields.ID, keyPrefix, i)
+		if len(keys) == len(jobs) {
+			hash, err := hashstructure.Hash(keys[i], nil)
+			if err != nil {
+				return nil, err
+			}
+			schedulerID = fmt.Sprintf("%s/%s%x", m.stdFields.ID, keyPrefix, hash)
+
+			// The same endpoint may be configured more than once
+			seen[schedulerID]++
+			if n := seen[schedulerID]; n > 1 {
+				schedulerID = fmt.Sprintf("%s-%d", schedulerID, n-1)
+			}
+		}
+
+		t, err := newConfiguredJob(job, mtConf, schedulerID, m)
 		if err != nil {
 			// Failure to compile monitor processors should not crash hb or prevent progress
 			if _, ok := err.(ProcessorsError); ok {
@@ -239,7 +261,7 @@ func (m *Monitor) makeWatchTasks(pluginFactory plugin.PluginFactory) error {
 			var newTasks []*configuredJob
 
 			dec := json.NewDecoder(bytes.NewBuffer(content))
-			for dec.More() {
+			for objIdx := 0; dec.More(); objIdx++ {
 				var obj map[string]interface{}
 				err = dec.Decode(&obj)
 				if err != nil {
@@ -266,7 +288,7 @@ func (m *Monitor) makeWatchTasks(pluginFactory plugin.PluginFactory) error {
 					logp.Err("Could not create job from watch file: %v", err)
 				}
 
-				watchTasks, err := m.makeTasks(merged, p.Jobs)
+				watchTasks, err := m.makeTasks(merged, p.Jobs, p.Keys, fmt.Sprintf("watch-%d-", objIdx))
 				if err != nil {
 					logp.Err("Could not make configuredJob for config: %v", err)
 					return
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
 	"github.com/elastic/beats/v7/heartbeat/scheduler"
 	"github.com/elastic/beats/v7/libbeat/monitoring"
 	"github.com/elastic/go-lookslike/testslike"
@@ -39,7 +40,7 @@ func TestMonitor(t *testing.T) {
 	require.NoError(t, err)
 	defer sched.Stop()
 
-	mon, err := newMonitor(serverMonConf, reg, pipelineConnector, sched, false)
+	mon, err := newMonitor(serverMonConf, reg, pipelineConnector, sched, nil, false)
 	require.NoError(t, err)
 
 	mon.Start()
@@ -90,11 +91,11 @@ func TestDuplicateMonitorIDs(t *testing.T) {
 	defer sched.Stop()
 
 	makeTestMon := func() (*Monitor, error) {
-		return newMonitor(serverMonConf, reg, pipelineConnector, sched, false)
+		return newMonitor(serverMonConf, reg, pipelineConnector, sched, nil, false)
 	}
 
 	// Ensure that an error is returned on a bad config
-	_, m0Err := newMonitor(badConf, reg, pipelineConnector, sched, false)
+	_, m0Err := newMonitor(badConf, reg, pipelineConnector, sched, nil, false)
 	require.Error(t, m0Err)
 
 	// Would fail if the previous newMonitor didn't free the monitor.id
@@ -125,7 +126,7 @@ func TestCheckInvalidConfig(t *testing.T) {
 	require.NoError(t, err)
 	defer sched.Stop()
 
-	m, err := newMonitor(serverMonConf, reg, pipelineConnector, sched, false)
+	m, err := newMonitor(serverMonConf, reg, pipelineConnector, sched, nil, false)
 	// This could change if we decide the contract for newMonitor should always return a monitor
 	require.Nil(t, m, "For this test to work we need a nil value for the monitor.")
 
@@ -135,3 +136,39 @@ func TestCheckInvalidConfig(t *testing.T) {
 
 	require.Error(t, checkMonitorConfig(serverMonConf, reg, false))
 }
+
+func TestJobsHaveDistinctSchedulerIDs(t *testing.T) {
+	conf := mockPluginConf(t, "myid", "@every 1s", "http://example.net")
+	m := &Monitor{stdFields: stdfields.StdMonitorFields{ID: "myid"}}
+
+	mockJobs, err := createMockJob()
+	require.NoError(t, err)
+	tasks, err := m.makeTasks(conf, append(mockJobs, mockJobs...), nil, "")
+	require.NoError(t, err)
+
+	require.Len(t, tasks, 2)
+	assert.Equal(t, "myid/0", tasks[0].schedulerID)
+	assert.Equal(t, "myid/1", tasks[1].schedulerID)
+}
+
+func TestJobSchedulerIDsFollowKeys(t *testing.T) {
+	conf := mockPluginConf(t, "myid", "@every 1s", "http://example.net")
+	m := &Monitor{stdFields: stdfields.StdMonitorFields{ID: "myid"}}
+
+	mockJobs, err := createMockJob()
+	require.NoError(t, err)
+	js := append(mockJobs, mockJobs[0], mockJobs[0])
+
+	tasks, err := m.makeTasks(conf, js, []string{"http://a", "http://b", "http://a"}, "")
+	require.NoError(t, err)
+	reordered, err := m.makeTasks(conf, js[:2], []string{"http://b", "http://a"}, "")
+	require.NoError(t, err)
+
+	require.Len(t, tasks, 3)
+	assert.NotEqual(t, tasks[0].schedulerID, tasks[1].schedulerID)
+	assert.Equal(t, tasks[0].schedulerID+"-1", tasks[2].schedulerID)
+
+	// Reordering the endpoints keeps the ID of every job
+	assert.Equal(t, tasks[1].schedulerID, reordered[0].schedulerID)
+	assert.Equal(t, tasks[0].schedulerID, reordered[1].schedulerID)
+}
@@ -42,6 +42,9 @@ type Plugin struct {
 	Jobs      []jobs.Job
 	Close     func() error
 	Endpoints int
+	// Keys optionally holds one key per job, usually the URL it checks, telling
+	// jobs apart even if the configured endpoints are reordered.
+	Keys []string
 }
 
 var pluginKey = "heartbeat.monitor"
@@ -0,0 +1,74 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package slo
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Config configures the tracking of the availability of a monitor over
+// rolling windows.
+type Config struct {
+	Enabled bool `config:"enabled"`
+
+	// Target is the percentage of checks that are expected to be up, it
+	// defines the error budget of each window.
+	Target float64 `config:"target"`
+
+	// Windows are the durations availability is computed over, they default
+	// to 1h, 24h and 30 days.
+	Windows []time.Duration `config:"windows"`
+}
+
+var defaultWindows = []time.Duration{time.Hour, 24 * time.Hour, 30 * 24 * time.Hour}
+
+// InitDefaults initializes the defaults used when the slo section is present.
+func (c *Config) InitDefaults() {
+	c.Enabled = true
+	c.Target = 99.9
+}
+
+// IsEnabled returns true if the availability of the monitor is tracked.
+func (c *Config) IsEnabled() bool {
+	return c != nil && c.Enabled
+}
+
+// Validate validates the Config object.
+func (c *Config) Validate() error {
+	if c.Target <= 0 || c.Target >= 100 {
+		return fmt.Errorf("target must be a percentage between 0 and 100, got %v", c.Target)
+	}
+	if len(c.Windows) == 0 {
+		c.Windows = append([]time.Duration(nil), defaultWindows...)
+	}
+
+	seen := map[time.Duration]bool{}
+	for _, w := range c.Windows {
+		if w < bucketsPerWindow*time.Second {
+			return fmt.Errorf("window %v is too short, it must be at least %v", w, bucketsPerWindow*time.Second)
+		}
+		if seen[w] {
+			return fmt.Errorf("duplicate window %v", w)
+		}
+		seen[w] = true
+	}
+	sort.Slice(c.Windows, func(i, j int) bool { return c.Windows[i] < c.Windows[j] })
+	return nil
+}
@@ -0,0 +1,109 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package slo
+
+import (
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/statestore"
+)
+
+const storeKeyPrefix = "heartbeat.slo::"
+
+// Store persists the state of the trackers, so the state and availability of
+// the monitors survive restarts. It can be shared by all the trackers.
+type Store struct {
+	mtx   sync.Mutex
+	store *statestore.Store
+}
+
+// trackerState is the persisted state of a tracker. Buckets are stored per
+// window size, so windows that are added or removed from the configuration
+// don't affect the others.
+type trackerState struct {
+	State   string              `struct:"state"`
+	Since   time.Time           `struct:"since"`
+	Windows map[string][]bucket `struct:"windows"`
+}
+
+// NewStore creates a Store on top of the given statestore.Store.
+func NewStore(store *statestore.Store) *Store {
+	return &Store{store: store}
+}
+
+// Close closes the underlying statestore.Store.
+func (s *Store) Close() error {
+	if s == nil {
+		return nil
+	}
+	return s.store.Close()
+}
+
+func (s *Store) load(key string, t *Tracker) error {
+	if s == nil {
+		return nil
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	found, err := s.store.Has(key)
+	if err != nil || !found {
+		return err
+	}
+
+	st := trackerState{Windows: map[string][]bucket{}}
+	if err := s.store.Get(key, &st); err != nil {
+		return err
+	}
+
+	t.state = st.State
+	t.since = st.Since
+	for _, w := range t.windows {
+		for _, b := range st.Windows[w.size.String()] {
+			w.buckets[b.Index%bucketsPerWindow] = b
+		}
+	}
+	return nil
+}
+
+func (s *Store) save(key string, t *Tracker) error {
+	if s == nil {
+		return nil
+	}
+
+	st := trackerState{
+		State:   t.state,
+		Since:   t.since,
+		Windows: make(map[string][]bucket, len(t.windows)),
+	}
+	for _, w := range t.windows {
+		var buckets []bucket
+		for _, b := range w.buckets {
+			if b.Total > 0 {
+				buckets = append(buckets, b)
+			}
+		}
+		st.Windows[w.size.String()] = buckets
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.store.Set(key, st)
+}
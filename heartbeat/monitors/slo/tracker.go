@@ -0,0 +1,209 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package slo
+
+import (
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/look"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// States a monitor can be in. A degraded monitor is up, but slower than its
+// configured latency thresholds.
+const (
+	StateUp       = "up"
+	StateDegraded = "degraded"
+	StateDown     = "down"
+)
+
+// bucketsPerWindow is the number of buckets the checks of a window are
+// counted in. The oldest bucket is dropped as a whole, so the counts of a
+// window may include checks up to 1/bucketsPerWindow of its size older than
+// the window.
+const bucketsPerWindow = 60
+
+// Tracker tracks the state of a monitor and its availability over the
+// configured windows. It is not thread-safe.
+type Tracker struct {
+	config  *Config
+	store   *Store
+	key     string
+	windows []*window
+
+	state string
+	since time.Time
+}
+
+// StateChange describes the transition of a monitor to a new state.
+type StateChange struct {
+	Previous      string
+	PreviousSince time.Time
+	Current       string
+	Since         time.Time
+}
+
+// Fields returns the event fields describing the state change.
+func (c *StateChange) Fields() common.MapStr {
+	return common.MapStr{
+		"state": common.MapStr{
+			"current":           c.Current,
+			"since":             look.Timestamp(c.Since),
+			"previous":          c.Previous,
+			"previous_duration": look.RTT(c.Since.Sub(c.PreviousSince)),
+		},
+	}
+}
+
+type window struct {
+	size    time.Duration
+	width   int64
+	buckets [bucketsPerWindow]bucket
+}
+
+type bucket struct {
+	Index int64  `struct:"index"`
+	Good  uint64 `struct:"good"`
+	Total uint64 `struct:"total"`
+}
+
+// NewTracker creates a tracker for the monitor with the given id, restoring
+// its state from the store. The store can be nil, in which case the state is
+// kept in memory only. The tracker is returned even if its state could not
+// be restored.
+func NewTracker(config *Config, store *Store, id string) (*Tracker, error) {
+	t := &Tracker{
+		config: config,
+		store:  store,
+		key:    storeKeyPrefix + id,
+	}
+	for _, size := range config.Windows {
+		t.windows = append(t.windows, &window{
+			size:  size,
+			width: int64(size / bucketsPerWindow),
+		})
+	}
+
+	return t, store.load(t.key, t)
+}
+
+// State returns the current state of the monitor, empty if no check has been
+// recorded yet.
+func (t *Tracker) State() string {
+	return t.state
+}
+
+// Record records the result of a check and persists the state of the tracker.
+// It returns the state change if the monitor flipped state. The previous
+// state of a monitor without any recorded check is unknown, so the first
+// check never changes state.
+func (t *Tracker) Record(ts time.Time, state string) (*StateChange, error) {
+	good := state != StateDown
+	for _, w := range t.windows {
+		w.record(ts, good)
+	}
+
+	var change *StateChange
+	if state != t.state {
+		if t.state != "" {
+			change = &StateChange{
+				Previous:      t.state,
+				PreviousSince: t.since,
+				Current:       state,
+				Since:         ts,
+			}
+		}
+		t.state = state
+		t.since = ts
+	}
+
+	return change, t.store.save(t.key, t)
+}
+
+// Fields returns the availability of the monitor over every window at the
+// given time, along with its current state.
+func (t *Tracker) Fields(ts time.Time) common.MapStr {
+	budget := 100 - t.config.Target
+
+	windows := make([]common.MapStr, 0, len(t.windows))
+	for _, w := range t.windows {
+		good, total := w.counts(ts)
+		availability := 100.0
+		if total > 0 {
+			availability = float64(good) / float64(total) * 100
+		}
+
+		windows = append(windows, common.MapStr{
+			"window":       formatWindow(w.size),
+			"availability": availability,
+			"checks": common.MapStr{
+				"good":  good,
+				"total": total,
+			},
+			"error_budget": common.MapStr{
+				"remaining": 100 - (100-availability)/budget*100,
+			},
+		})
+	}
+
+	return common.MapStr{
+		"target":  t.config.Target,
+		"windows": windows,
+		"state": common.MapStr{
+			"current": t.state,
+			"since":   look.Timestamp(t.since),
+		},
+	}
+}
+
+func (w *window) record(ts time.Time, good bool) {
+	idx := ts.UnixNano() / w.width
+	b := &w.buckets[idx%bucketsPerWindow]
+	if b.Index != idx {
+		*b = bucket{Index: idx}
+	}
+	b.Total++
+	if good {
+		b.Good++
+	}
+}
+
+func (w *window) counts(ts time.Time) (good, total uint64) {
+	idx := ts.UnixNano() / w.width
+	for _, b := range w.buckets {
+		if b.Index > idx-bucketsPerWindow && b.Index <= idx {
+			good += b.Good
+			total += b.Total
+		}
+	}
+	return good, total
+}
+
+// formatWindow formats the size of a window without its trailing zero units,
+// e.g. 24h instead of 24h0m0s.
+func formatWindow(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = s[:len(s)-2]
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = s[:len(s)-2]
+	}
+	return s
+}
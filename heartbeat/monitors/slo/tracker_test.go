@@ -0,0 +1,203 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package slo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/statestore"
+	"github.com/elastic/beats/v7/libbeat/statestore/storetest"
+)
+
+func testConfig(t *testing.T, settings map[string]interface{}) *Config {
+	var wrapper struct {
+		SLO *Config `config:"slo"`
+	}
+	require.NoError(t, common.MustNewConfigFrom(map[string]interface{}{"slo": settings}).Unpack(&wrapper))
+	return wrapper.SLO
+}
+
+func windowFields(t *testing.T, fields common.MapStr, idx int) common.MapStr {
+	windows, err := fields.GetValue("windows")
+	require.NoError(t, err)
+	return windows.([]common.MapStr)[idx]
+}
+
+func TestConfig(t *testing.T) {
+	c := testConfig(t, map[string]interface{}{})
+	assert.True(t, c.IsEnabled())
+	assert.Equal(t, 99.9, c.Target)
+	assert.Equal(t, []time.Duration{time.Hour, 24 * time.Hour, 720 * time.Hour}, c.Windows)
+
+	c = testConfig(t, map[string]interface{}{"windows": []string{"24h", "1h"}})
+	assert.Equal(t, []time.Duration{time.Hour, 24 * time.Hour}, c.Windows)
+
+	c = testConfig(t, map[string]interface{}{"windows": []string{}})
+	assert.Equal(t, defaultWindows, c.Windows)
+
+	var disabled *Config
+	assert.False(t, disabled.IsEnabled())
+
+	for name, settings := range map[string]map[string]interface{}{
+		"target too high":  {"target": 100},
+		"target too low":   {"target": 0},
+		"short window":     {"windows": []string{"30s"}},
+		"duplicate window": {"windows": []string{"1h", "60m"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var wrapper struct {
+				SLO *Config `config:"slo"`
+			}
+			err := common.MustNewConfigFrom(map[string]interface{}{"slo": settings}).Unpack(&wrapper)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTrackerAvailability(t *testing.T) {
+	config := testConfig(t, map[string]interface{}{
+		"target":  99,
+		"windows": []string{"1h", "24h"},
+	})
+	tracker, err := NewTracker(config, nil, "test")
+	require.NoError(t, err)
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ts := start
+	for i := 0; i < 100; i++ {
+		state := StateUp
+		if i%10 == 0 {
+			state = StateDown
+		} else if i%10 == 1 {
+			state = StateDegraded
+		}
+		_, err := tracker.Record(ts, state)
+		require.NoError(t, err)
+		ts = ts.Add(time.Minute)
+	}
+	ts = ts.Add(-time.Minute)
+
+	fields := tracker.Fields(ts)
+	assert.Equal(t, 99.0, fields["target"])
+
+	hour := windowFields(t, fields, 0)
+	assert.Equal(t, "1h", hour["window"])
+	good, total := hour["checks"].(common.MapStr)["good"].(uint64), hour["checks"].(common.MapStr)["total"].(uint64)
+	assert.Equal(t, uint64(60), total)
+	assert.Equal(t, uint64(54), good)
+
+	day := windowFields(t, fields, 1)
+	assert.Equal(t, "24h", day["window"])
+	assert.Equal(t, common.MapStr{"good": uint64(90), "total": uint64(100)}, day["checks"])
+	assert.InDelta(t, 90.0, day["availability"], 0.001)
+	assert.InDelta(t, -900.0, day["error_budget"].(common.MapStr)["remaining"], 0.001)
+
+	// Checks older than the window are dropped.
+	fields = tracker.Fields(ts.Add(2 * time.Hour))
+	assert.Equal(t, common.MapStr{"good": uint64(0), "total": uint64(0)}, windowFields(t, fields, 0)["checks"])
+	assert.Equal(t, 100.0, windowFields(t, fields, 0)["availability"])
+	assert.Equal(t, uint64(100), windowFields(t, fields, 1)["checks"].(common.MapStr)["total"])
+}
+
+func TestTrackerStateChanges(t *testing.T) {
+	tracker, err := NewTracker(testConfig(t, map[string]interface{}{}), nil, "test")
+	require.NoError(t, err)
+
+	ts := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	change, err := tracker.Record(ts, StateUp)
+	require.NoError(t, err)
+	assert.Nil(t, change, "the first check must not change state")
+
+	change, err = tracker.Record(ts.Add(time.Minute), StateUp)
+	require.NoError(t, err)
+	assert.Nil(t, change)
+
+	change, err = tracker.Record(ts.Add(2*time.Minute), StateDegraded)
+	require.NoError(t, err)
+	require.NotNil(t, change)
+	assert.Equal(t, &StateChange{
+		Previous:      StateUp,
+		PreviousSince: ts,
+		Current:       StateDegraded,
+		Since:         ts.Add(2 * time.Minute),
+	}, change)
+	assert.Equal(t, 2*time.Minute, time.Duration(change.Fields()["state"].(common.MapStr)["previous_duration"].(common.MapStr)["us"].(time.Duration))*time.Microsecond)
+
+	change, err = tracker.Record(ts.Add(3*time.Minute), StateDown)
+	require.NoError(t, err)
+	require.NotNil(t, change)
+	assert.Equal(t, StateDegraded, change.Previous)
+	assert.Equal(t, StateDown, tracker.State())
+}
+
+func TestTrackerPersistence(t *testing.T) {
+	registry := statestore.NewRegistry(storetest.NewMemoryStoreBackend())
+	defer registry.Close()
+
+	open := func() *Store {
+		s, err := registry.Get("slo")
+		require.NoError(t, err)
+		return NewStore(s)
+	}
+
+	config := testConfig(t, map[string]interface{}{"windows": []string{"1h", "24h"}})
+	ts := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	store := open()
+	tracker, err := NewTracker(config, store, "test")
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		state := StateUp
+		if i == 9 {
+			state = StateDown
+		}
+		_, err := tracker.Record(ts.Add(time.Duration(i)*time.Minute), state)
+		require.NoError(t, err)
+	}
+	require.NoError(t, store.Close())
+
+	// A tracker with the same id resumes from the persisted state, even if
+	// the configured windows changed.
+	store = open()
+	defer store.Close()
+	config = testConfig(t, map[string]interface{}{"windows": []string{"1h", "168h"}})
+	tracker, err = NewTracker(config, store, "test")
+	require.NoError(t, err)
+	assert.Equal(t, StateDown, tracker.State())
+
+	now := ts.Add(10 * time.Minute)
+	change, err := tracker.Record(now, StateUp)
+	require.NoError(t, err)
+	require.NotNil(t, change)
+	assert.Equal(t, StateDown, change.Previous)
+	assert.True(t, change.PreviousSince.Equal(ts.Add(9*time.Minute)))
+
+	fields := tracker.Fields(now)
+	assert.Equal(t, common.MapStr{"good": uint64(10), "total": uint64(11)}, windowFields(t, fields, 0)["checks"])
+	assert.Equal(t, common.MapStr{"good": uint64(1), "total": uint64(1)}, windowFields(t, fields, 1)["checks"])
+
+	// Other monitors don't share the state.
+	other, err := NewTracker(config, store, "other")
+	require.NoError(t, err)
+	assert.Equal(t, "", other.State())
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/elastic/beats/v7/heartbeat/monitors/slo"
 	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
 	"github.com/elastic/beats/v7/libbeat/common"
 )
@@ -33,6 +34,27 @@ type ServiceFields struct {
 	Name string `config:"name"`
 }
 
+// DegradedConfig configures the latency thresholds above which a check that
+// is up is reported as degraded.
+type DegradedConfig struct {
+	// Duration is the threshold for the monitor.duration of the check.
+	Duration time.Duration `config:"duration" validate:"min=0"`
+	// RTT are thresholds for the round trip times reported by the monitor,
+	// like http.rtt.total.
+	RTT []RTTThreshold `config:"rtt"`
+}
+
+// RTTThreshold is the latency threshold of a single round trip time field.
+type RTTThreshold struct {
+	Field string        `config:"field" validate:"required"`
+	Above time.Duration `config:"above" validate:"required"`
+}
+
+// IsEnabled returns true if any latency threshold is configured.
+func (c DegradedConfig) IsEnabled() bool {
+	return c.Duration > 0 || len(c.RTT) > 0
+}
+
 // StdMonitorFields represents the generic configuration options around a monitor plugin.
 type StdMonitorFields struct {
 	ID                string             `config:"id"`
@@ -43,6 +65,8 @@ type StdMonitorFields struct {
 	Service           ServiceFields      `config:"service"`
 	LegacyServiceName string             `config:"service_name"`
 	Enabled           bool               `config:"enabled"`
+	Degraded          DegradedConfig     `config:"degraded"`
+	SLO               *slo.Config        `config:"slo"`
 }
 
 func ConfigToStdMonitorFields(config *common.Config) (StdMonitorFields, error) {
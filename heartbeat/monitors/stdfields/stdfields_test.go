@@ -20,6 +20,7 @@ package stdfields
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -63,3 +64,36 @@ func TestLegacyServiceNameConfig(t *testing.T) {
 	}
 
 }
+
+func TestDegradedAndSLOConfig(t *testing.T) {
+	base := common.MapStr{
+		"type":     "http",
+		"id":       "myId",
+		"schedule": "@every 1s",
+	}
+
+	f, err := ConfigToStdMonitorFields(common.MustNewConfigFrom(base))
+	require.NoError(t, err)
+	require.False(t, f.Degraded.IsEnabled())
+	require.False(t, f.SLO.IsEnabled())
+
+	withThresholds := base.Clone()
+	withThresholds["degraded"] = common.MapStr{
+		"rtt": []common.MapStr{{"field": "http.rtt.total", "above": "500ms"}},
+	}
+	withThresholds["slo"] = common.MapStr{"target": 99}
+
+	f, err = ConfigToStdMonitorFields(common.MustNewConfigFrom(withThresholds))
+	require.NoError(t, err)
+	require.True(t, f.Degraded.IsEnabled())
+	require.Equal(t, []RTTThreshold{{Field: "http.rtt.total", Above: 500 * time.Millisecond}}, f.Degraded.RTT)
+	require.True(t, f.SLO.IsEnabled())
+	require.Equal(t, 99.0, f.SLO.Target)
+
+	missingField := base.Clone()
+	missingField["degraded"] = common.MapStr{
+		"rtt": []common.MapStr{{"above": "500ms"}},
+	}
+	_, err = ConfigToStdMonitorFields(common.MustNewConfigFrom(missingField))
+	require.Error(t, err)
+}
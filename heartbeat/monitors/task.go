@@ -33,18 +33,22 @@ import (
 // configuredJob represents a job combined with its config and any
 // subsequent processors.
 type configuredJob struct {
-	job      jobs.Job
-	config   jobConfig
-	monitor  *Monitor
-	cancelFn context.CancelFunc
-	client   beat.Client
+	job    jobs.Job
+	config jobConfig
+	// schedulerID identifies the job within the scheduler, it is unique across
+	// the jobs of a monitor, e.g. one per host.
+	schedulerID string
+	monitor     *Monitor
+	cancelFn    context.CancelFunc
+	client      beat.Client
 }
 
-func newConfiguredJob(job jobs.Job, config jobConfig, monitor *Monitor) (*configuredJob, error) {
+func newConfiguredJob(job jobs.Job, config jobConfig, schedulerID string, monitor *Monitor) (*configuredJob, error) {
 	return &configuredJob{
-		job:     job,
-		config:  config,
-		monitor: monitor,
+		job:         job,
+		config:      config,
+		schedulerID: schedulerID,
+		monitor:     monitor,
 	}, nil
 }
 
@@ -84,7 +88,7 @@ func (t *configuredJob) Start() {
 	}
 
 	tf := t.makeSchedulerTaskFunc()
-	t.cancelFn, err = t.monitor.scheduler.Add(t.config.Schedule, t.monitor.stdFields.ID, tf)
+	t.cancelFn, err = t.monitor.scheduler.Add(t.config.Schedule, t.schedulerID, tf)
 	if err != nil {
 		logp.Err("could not start monitor: %v", err)
 	}
@@ -29,6 +29,7 @@ import (
 	"github.com/elastic/beats/v7/heartbeat/eventext"
 	"github.com/elastic/beats/v7/heartbeat/look"
 	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/slo"
 	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
 	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
 	"github.com/elastic/beats/v7/libbeat/beat"
@@ -47,15 +48,20 @@ func WrapCommon(js []jobs.Job, stdMonFields stdfields.StdMonitorFields) []jobs.J
 
 // WrapLightweight applies to http/tcp/icmp, everything but journeys involving node
 func WrapLightweight(js []jobs.Job, stdMonFields stdfields.StdMonitorFields) []jobs.Job {
+	wrappers := []jobs.JobWrapper{
+		addMonitorMeta(stdMonFields, len(js) > 1),
+		addMonitorStatus(stdMonFields.Type),
+		addMonitorDuration,
+	}
+	trackDegraded := stdMonFields.Degraded.IsEnabled()
+	if trackDegraded {
+		wrappers = append(wrappers, addMonitorState(stdMonFields.Degraded))
+	}
+
 	return jobs.WrapAllSeparately(
-		jobs.WrapAll(
-			js,
-			addMonitorMeta(stdMonFields, len(js) > 1),
-			addMonitorStatus(stdMonFields.Type),
-			addMonitorDuration,
-		),
+		jobs.WrapAll(js, wrappers...),
 		func() jobs.JobWrapper {
-			return makeAddSummary(stdMonFields.Type)
+			return makeAddSummary(stdMonFields.Type, trackDegraded)
 		})
 }
 
@@ -182,8 +188,72 @@ func addMonitorDuration(job jobs.Job) jobs.Job {
 	}
 }
 
+// addMonitorState adds the state of the check, which is degraded if it is up
+// but exceeded any of the latency thresholds.
+func addMonitorState(config stdfields.DegradedConfig) jobs.JobWrapper {
+	return func(job jobs.Job) jobs.Job {
+		return func(event *beat.Event) ([]jobs.Job, error) {
+			cont, err := job(event)
+
+			if event != nil && !eventext.IsEventCancelled(event) {
+				state := slo.StateDown
+				if status, _ := event.GetValue("monitor.status"); status == slo.StateUp {
+					state = slo.StateUp
+					if isDegraded(event, config) {
+						state = slo.StateDegraded
+					}
+				}
+				eventext.MergeEventFields(event, common.MapStr{
+					"monitor": common.MapStr{
+						"state": state,
+					},
+				})
+			}
+
+			return cont, err
+		}
+	}
+}
+
+func isDegraded(event *beat.Event, config stdfields.DegradedConfig) bool {
+	if config.Duration > 0 && rttAbove(event, "monitor.duration", config.Duration) {
+		return true
+	}
+	for _, t := range config.RTT {
+		if rttAbove(event, t.Field, t.Above) {
+			return true
+		}
+	}
+	return false
+}
+
+// rttAbove checks if the round trip time stored in the given field, in the
+// format of look.RTT, is above the threshold. Missing fields never are.
+func rttAbove(event *beat.Event, field string, threshold time.Duration) bool {
+	v, err := event.GetValue(field + ".us")
+	if err != nil {
+		return false
+	}
+
+	var us int64
+	switch n := v.(type) {
+	case time.Duration:
+		us = int64(n)
+	case int64:
+		us = n
+	case int:
+		us = int64(n)
+	case float64:
+		us = int64(n)
+	default:
+		return false
+	}
+	return us > threshold.Microseconds()
+}
+
 // makeAddSummary summarizes the job, adding the `summary` field to the last event emitted.
-func makeAddSummary(monitorType string) jobs.JobWrapper {
+// If trackDegraded is set, the checks in degraded state are also counted.
+func makeAddSummary(monitorType string, trackDegraded bool) jobs.JobWrapper {
 	// This is a tricky method. The way this works is that we track the state across jobs in the
 	// state struct here.
 	state := struct {
@@ -192,6 +262,7 @@ func makeAddSummary(monitorType string) jobs.JobWrapper {
 		remaining  uint16
 		up         uint16
 		down       uint16
+		degraded   uint16
 		checkGroup string
 		generation uint64
 	}{
@@ -202,6 +273,7 @@ func makeAddSummary(monitorType string) jobs.JobWrapper {
 		state.remaining = 1
 		state.up = 0
 		state.down = 0
+		state.degraded = 0
 		state.generation++
 		u, err := uuid.NewV1()
 		if err != nil {
@@ -225,6 +297,9 @@ func makeAddSummary(monitorType string) jobs.JobWrapper {
 				eventStatus, _ = event.GetValue("monitor.status")
 				if eventStatus == "up" {
 					state.up++
+					if eventState, _ := event.GetValue("monitor.state"); eventState == slo.StateDegraded {
+						state.degraded++
+					}
 				} else {
 					state.down++
 				}
@@ -242,11 +317,16 @@ func makeAddSummary(monitorType string) jobs.JobWrapper {
 				up := state.up
 				down := state.down
 
+				summary := common.MapStr{
+					"up":   up,
+					"down": down,
+				}
+				if trackDegraded {
+					summary["degraded"] = state.degraded
+					summary["state"] = summaryState(down, state.degraded)
+				}
 				eventext.MergeEventFields(event, common.MapStr{
-					"summary": common.MapStr{
-						"up":   up,
-						"down": down,
-					},
+					"summary": summary,
 				})
 				resetState()
 			}
@@ -255,3 +335,16 @@ func makeAddSummary(monitorType string) jobs.JobWrapper {
 		}
 	}
 }
+
+// summaryState returns the overall state of a check: down if any endpoint is
+// down, degraded if any is degraded, up otherwise.
+func summaryState(down, degraded uint16) string {
+	switch {
+	case down > 0:
+		return slo.StateDown
+	case degraded > 0:
+		return slo.StateDegraded
+	default:
+		return slo.StateUp
+	}
+}
@@ -29,7 +29,9 @@ import (
 
 	"github.com/elastic/beats/v7/heartbeat/eventext"
 	"github.com/elastic/beats/v7/heartbeat/hbtestllext"
+	"github.com/elastic/beats/v7/heartbeat/look"
 	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/slo"
 	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
 	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
 	"github.com/elastic/beats/v7/libbeat/beat"
@@ -497,3 +499,131 @@ func TestSuiteBrowserJob(t *testing.T) {
 		nil,
 	})
 }
+
+func TestDegradedJob(t *testing.T) {
+	fields := testMonFields
+	fields.Degraded = stdfields.DegradedConfig{
+		RTT: []stdfields.RTTThreshold{{Field: "http.rtt.total", Above: time.Second}},
+	}
+
+	makeRTTJob := func(rtt time.Duration) jobs.Job {
+		return func(event *beat.Event) ([]jobs.Job, error) {
+			eventext.MergeEventFields(event, common.MapStr{
+				"url":  URLFields(&url.URL{Scheme: "http", Host: "foo.com"}),
+				"http": common.MapStr{"rtt": common.MapStr{"total": look.RTT(rtt)}},
+			})
+			return nil, nil
+		}
+	}
+
+	validatorMaker := func(state string, degraded int) validator.Validator {
+		return lookslike.Compose(
+			urlValidator(t, "http://foo.com"),
+			lookslike.MustCompile(map[string]interface{}{
+				"http.rtt.total.us": isdef.IsDuration,
+				"monitor": map[string]interface{}{
+					"duration.us": isdef.IsDuration,
+					"id":          testMonFields.ID,
+					"name":        testMonFields.Name,
+					"type":        testMonFields.Type,
+					"status":      "up",
+					"state":       state,
+					"check_group": isdef.IsString,
+				},
+				"summary": map[string]interface{}{
+					"up":       uint16(1),
+					"down":     uint16(0),
+					"degraded": uint16(degraded),
+					"state":    state,
+				},
+			}),
+			hbtestllext.MonitorTimespanValidator,
+		)
+	}
+
+	testCommonWrap(t, testDef{
+		"fast",
+		fields,
+		[]jobs.Job{makeRTTJob(time.Millisecond)},
+		[]validator.Validator{validatorMaker("up", 0)},
+		nil,
+	})
+	testCommonWrap(t, testDef{
+		"slow",
+		fields,
+		[]jobs.Job{makeRTTJob(2 * time.Second)},
+		[]validator.Validator{validatorMaker("degraded", 1)},
+		nil,
+	})
+}
+
+func TestSLOStateChanges(t *testing.T) {
+	fields := testMonFields
+	fields.SLO = &slo.Config{Enabled: true, Target: 50, Windows: []time.Duration{time.Hour}}
+
+	fail := false
+	job := func(event *beat.Event) ([]jobs.Job, error) {
+		eventext.MergeEventFields(event, common.MapStr{
+			"url": URLFields(&url.URL{Scheme: "tcp", Host: "foo.com:80"}),
+		})
+		if fail {
+			return nil, fmt.Errorf("myerror")
+		}
+		return nil, nil
+	}
+	wrapped := WrapSLO(WrapCommon([]jobs.Job{job}, fields), fields, nil)
+
+	sloValidator := func(state string, good, total int) validator.Validator {
+		return lookslike.MustCompile(map[string]interface{}{
+			"slo": map[string]interface{}{
+				"target": 50.0,
+				"state": map[string]interface{}{
+					"current": state,
+					"since":   isdef.KeyPresent,
+				},
+				"windows": isdef.IsSliceOf(lookslike.MustCompile(map[string]interface{}{
+					"window":       "1h",
+					"availability": float64(good) / float64(total) * 100,
+					"checks": map[string]interface{}{
+						"good":  uint64(good),
+						"total": uint64(total),
+					},
+					"error_budget.remaining": isdef.KeyPresent,
+				})),
+			},
+		})
+	}
+
+	results, err := jobs.ExecJobsAndConts(t, wrapped)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	testslike.Test(t, sloValidator("up", 1, 1), results[0].Fields)
+
+	fail = true
+	results, err = jobs.ExecJobsAndConts(t, wrapped)
+	require.NoError(t, err)
+	require.Len(t, results, 2, "expected the summary and the state change events")
+	testslike.Test(t, sloValidator("down", 1, 2), results[0].Fields)
+	testslike.Test(t, lookslike.Strict(lookslike.Compose(
+		urlValidator(t, "tcp://foo.com:80"),
+		lookslike.MustCompile(map[string]interface{}{
+			"monitor": map[string]interface{}{
+				"id":          testMonFields.ID,
+				"name":        testMonFields.Name,
+				"type":        testMonFields.Type,
+				"check_group": results[0].Fields["monitor"].(common.MapStr)["check_group"],
+			},
+			"slo.state": map[string]interface{}{
+				"current":              "down",
+				"since":                isdef.KeyPresent,
+				"previous":             "up",
+				"previous_duration.us": isdef.IsDuration,
+			},
+		}),
+	)), results[1].Fields)
+
+	// No state change event is emitted while the state is unchanged.
+	results, err = jobs.ExecJobsAndConts(t, wrapped)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+}
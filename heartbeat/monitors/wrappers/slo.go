@@ -0,0 +1,147 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wrappers
+
+import (
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/slo"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// WrapSLO tracks the availability of the monitor if it is configured to,
+// adding the `slo` fields to the summary events, and emitting a state change
+// event whenever the monitor flips state. The state is persisted in the given
+// store, which can be nil.
+func WrapSLO(js []jobs.Job, stdMonFields stdfields.StdMonitorFields, store *slo.Store) []jobs.Job {
+	if !stdMonFields.SLO.IsEnabled() {
+		return js
+	}
+
+	return jobs.WrapAllSeparately(js, func() jobs.JobWrapper {
+		return makeTrackSLO(stdMonFields.SLO, store)
+	})
+}
+
+// makeTrackSLO records the state of every check in a tracker. The tracker is
+// created on the first summary, since the ID of the monitor is only known
+// from the events.
+func makeTrackSLO(config *slo.Config, store *slo.Store) jobs.JobWrapper {
+	var mtx sync.Mutex
+	var tracker *slo.Tracker
+
+	return func(job jobs.Job) jobs.Job {
+		return func(event *beat.Event) ([]jobs.Job, error) {
+			cont, jobErr := job(event)
+			if event == nil || eventext.IsEventCancelled(event) {
+				return cont, jobErr
+			}
+			summary, _ := event.GetValue("summary")
+			if summary == nil {
+				return cont, jobErr
+			}
+
+			mtx.Lock()
+			defer mtx.Unlock()
+
+			if tracker == nil {
+				id, _ := event.GetValue("monitor.id")
+				idStr, _ := id.(string)
+
+				var err error
+				tracker, err = slo.NewTracker(config, store, idStr)
+				if err != nil {
+					logp.Warn("could not restore the SLO state of monitor %s: %v", idStr, err)
+				}
+			}
+
+			ts := event.Timestamp
+			if ts.IsZero() {
+				ts = time.Now()
+			}
+
+			change, err := tracker.Record(ts, eventState(event))
+			if err != nil {
+				logp.Warn("could not persist the SLO state: %v", err)
+			}
+			eventext.MergeEventFields(event, common.MapStr{"slo": tracker.Fields(ts)})
+
+			if change != nil {
+				cont = append(cont, makeStateChangeJob(event, ts, change))
+			}
+			return cont, jobErr
+		}
+	}
+}
+
+// eventState returns the state of a check from its summary.
+func eventState(event *beat.Event) string {
+	if state, _ := event.GetValue("summary.state"); state != nil {
+		if s, ok := state.(string); ok {
+			return s
+		}
+	}
+
+	down, _ := event.GetValue("summary.down")
+	switch n := down.(type) {
+	case uint16:
+		if n > 0 {
+			return slo.StateDown
+		}
+	case int:
+		if n > 0 {
+			return slo.StateDown
+		}
+	case int64:
+		if n > 0 {
+			return slo.StateDown
+		}
+	}
+	return slo.StateUp
+}
+
+// makeStateChangeJob creates the job emitting the state change event, which
+// identifies the monitor and the check like the summary event does.
+func makeStateChangeJob(summary *beat.Event, ts time.Time, change *slo.StateChange) jobs.Job {
+	fields := common.MapStr{"slo": change.Fields()}
+	for _, key := range []string{
+		"monitor.id",
+		"monitor.name",
+		"monitor.type",
+		"monitor.check_group",
+		"service.name",
+		"url",
+	} {
+		if v, err := summary.GetValue(key); err == nil {
+			fields.Put(key, v)
+		}
+	}
+	fields = fields.Clone()
+
+	return func(event *beat.Event) ([]jobs.Job, error) {
+		event.Timestamp = ts
+		eventext.MergeEventFields(event, fields)
+		return nil, nil
+	}
+}
@@ -0,0 +1,101 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package scheduler
+
+import (
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/statestore"
+)
+
+const runStoreKeyPrefix = "heartbeat.scheduler::"
+
+// runStore persists the time each job last ran at, keyed by job ID, so
+// schedules can resume where they left off after a restart. A nil runStore
+// doesn't persist anything.
+type runStore struct {
+	mtx   sync.Mutex
+	store *statestore.Store
+}
+
+type runState struct {
+	LastRun time.Time `struct:"last_run"`
+}
+
+func newRunStore(store *statestore.Store) *runStore {
+	if store == nil {
+		return nil
+	}
+	return &runStore{store: store}
+}
+
+// lastRun returns the time the job last ran at, and false if it never ran.
+func (s *runStore) lastRun(id string) (time.Time, bool) {
+	if s == nil {
+		return time.Time{}, false
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	key := runStoreKeyPrefix + id
+	found, err := s.store.Has(key)
+	if err != nil || !found {
+		if err != nil {
+			logp.Warn("could not read the last run of job '%v': %v", id, err)
+		}
+		return time.Time{}, false
+	}
+
+	var st runState
+	if err := s.store.Get(key, &st); err != nil {
+		logp.Warn("could not read the last run of job '%v': %v", id, err)
+		return time.Time{}, false
+	}
+	return st.LastRun, !st.LastRun.IsZero()
+}
+
+// setLastRun persists the time the job last ran at.
+func (s *runStore) setLastRun(id string, ranAt time.Time) {
+	if s == nil {
+		return
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if err := s.store.Set(runStoreKeyPrefix+id, runState{LastRun: ranAt}); err != nil {
+		logp.Warn("could not persist the last run of job '%v': %v", id, err)
+	}
+}
+
+// deleteLastRun removes the persisted run of the job.
+func (s *runStore) deleteLastRun(id string) {
+	if s == nil {
+		return
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if err := s.store.Remove(runStoreKeyPrefix + id); err != nil {
+		logp.Warn("could not remove the last run of job '%v': %v", id, err)
+	}
+}
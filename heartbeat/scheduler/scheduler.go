@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/common/atomic"
 	"github.com/elastic/beats/v7/libbeat/logp"
 	"github.com/elastic/beats/v7/libbeat/monitoring"
+	"github.com/elastic/beats/v7/libbeat/statestore"
 )
 
 const (
@@ -46,18 +48,47 @@ var ErrInvalidTransition = fmt.Errorf("invalid state transition")
 
 // Scheduler represents our async timer based scheduler.
 type Scheduler struct {
-	limit      int64
-	limitSem   *semaphore.Weighted
-	state      atomic.Int
-	location   *time.Location
-	timerQueue *timerqueue.TimerQueue
-	ctx        context.Context
-	cancelCtx  context.CancelFunc
-	stats      schedulerStats
+	limit         int64
+	limitSem      *semaphore.Weighted
+	jobLimitSem   *semaphore.Weighted
+	initialJitter time.Duration
+	runStore      *runStore
+	state         atomic.Int
+	location      *time.Location
+	timerQueue    *timerqueue.TimerQueue
+	ctx           context.Context
+	cancelCtx     context.CancelFunc
+	stats         schedulerStats
+}
+
+// Settings configures a Scheduler.
+type Settings struct {
+	// Limit is the maximum number of tasks running concurrently. There is no
+	// limit if it is lower than 1.
+	Limit int64
+
+	// MaxConcurrentJobs is the maximum number of jobs running concurrently,
+	// regardless of the number of tasks they are made of. There is no limit if
+	// it is lower than 1.
+	MaxConcurrentJobs int64
+
+	// InitialJitter is the maximum random delay of the first run of interval
+	// jobs, spreading them instead of starting them all at once. The delay
+	// is never longer than the interval of the job.
+	InitialJitter time.Duration
+
+	// Location is the time zone of the schedules, defaults to the local one.
+	Location *time.Location
+
+	// Store persists the time each job last ran at, so their schedules
+	// resume where they left off after a restart. Optional.
+	Store *statestore.Store
 }
 
 type schedulerStats struct {
 	activeJobs         *monitoring.Uint // gauge showing number of active jobs
+	waitingJobs        *monitoring.Uint // number of jobs due to run, but constrained by the max concurrent jobs
+	queuedJobs         *monitoring.Uint // number of jobs waiting in the timer queue for their next run
 	activeTasks        *monitoring.Uint // gauge showing number of active tasks
 	waitingTasks       *monitoring.Uint // number of tasks waiting to run, but constrained by scheduler limit
 	jobsPerSecond      *monitoring.Uint // rate of job processing computed over the past hour
@@ -84,29 +115,50 @@ func New(limit int64, registry *monitoring.Registry) *Scheduler {
 
 // NewWithLocation creates a new Scheduler using the given runAt zone.
 func NewWithLocation(limit int64, registry *monitoring.Registry, location *time.Location) *Scheduler {
+	return NewWithSettings(Settings{Limit: limit, Location: location}, registry)
+}
+
+// NewWithSettings creates a new Scheduler configured with the given settings.
+func NewWithSettings(settings Settings, registry *monitoring.Registry) *Scheduler {
 	ctx, cancelCtx := context.WithCancel(context.Background())
 
+	limit := settings.Limit
 	if limit < 1 {
 		limit = math.MaxInt64
 	}
+	jobLimit := settings.MaxConcurrentJobs
+	if jobLimit < 1 {
+		jobLimit = math.MaxInt64
+	}
+	location := settings.Location
+	if location == nil {
+		location = time.Local
+	}
 
 	jobsMissedDeadlineCounter := monitoring.NewUint(registry, "jobs.missed_deadline")
 	activeJobsGauge := monitoring.NewUint(registry, "jobs.active")
+	waitingJobsGauge := monitoring.NewUint(registry, "jobs.waiting")
+	queuedJobsGauge := monitoring.NewUint(registry, "jobs.queued")
 	activeTasksGauge := monitoring.NewUint(registry, "tasks.active")
 	waitingTasksGauge := monitoring.NewUint(registry, "tasks.waiting")
 
 	sched := &Scheduler{
-		limit:     limit,
-		location:  location,
-		state:     atomic.MakeInt(statePreRunning),
-		ctx:       ctx,
-		cancelCtx: cancelCtx,
-		limitSem:  semaphore.NewWeighted(limit),
+		limit:         limit,
+		location:      location,
+		state:         atomic.MakeInt(statePreRunning),
+		ctx:           ctx,
+		cancelCtx:     cancelCtx,
+		limitSem:      semaphore.NewWeighted(limit),
+		jobLimitSem:   semaphore.NewWeighted(jobLimit),
+		initialJitter: settings.InitialJitter,
+		runStore:      newRunStore(settings.Store),
 
 		timerQueue: timerqueue.NewTimerQueue(ctx),
 
 		stats: schedulerStats{
 			activeJobs:         activeJobsGauge,
+			waitingJobs:        waitingJobsGauge,
+			queuedJobs:         queuedJobsGauge,
 			activeTasks:        activeTasksGauge,
 			waitingTasks:       waitingTasksGauge,
 			jobsMissedDeadline: jobsMissedDeadlineCounter,
@@ -185,6 +237,9 @@ func (s *Scheduler) Add(sched Schedule, id string, entrypoint TaskFunc) (removeF
 	// The initial value is runAt.Now() because we use it to get the next runAt a job is scheduled to run
 	lastRanAt := time.Now().In(s.location)
 
+	// storeMtx keeps runs from persisting after the job has been removed
+	var storeMtx sync.Mutex
+
 	var taskFn timerqueue.TimerTaskFn
 
 	taskFn = func(_ time.Time) {
@@ -194,19 +249,35 @@ func (s *Scheduler) Add(sched Schedule, id string, entrypoint TaskFunc) (removeF
 			return
 		default:
 		}
+
+		// Acquire a job slot in keeping with heartbeat.scheduler.max_concurrent_jobs
+		s.stats.waitingJobs.Inc()
+		limitErr := s.jobLimitSem.Acquire(jobCtx, 1)
+		s.stats.waitingJobs.Dec()
+		if limitErr != nil {
+			debugf("Job '%v' canceled while waiting to run", id)
+			return
+		}
+
 		s.stats.activeJobs.Inc()
 		lastRanAt = s.runRecursiveJob(jobCtx, entrypoint)
 		s.stats.activeJobs.Dec()
+		s.jobLimitSem.Release(1)
+
+		// Only interval schedules depend on the last run, cron ones run at fixed times
+		if sched.RunOnInit() {
+			storeMtx.Lock()
+			if jobCtx.Err() == nil {
+				s.runStore.setLastRun(id, lastRanAt)
+			}
+			storeMtx.Unlock()
+		}
 		s.runOnce(sched.Next(lastRanAt), taskFn)
 		debugf("Job '%v' returned at %v", id, time.Now())
 	}
 
-	// We skip using the scheduler to execute the initial tasks for jobs that have RunOnInit returning true.
-	// You might think it'd be simpler to just invoke runOnce in either case with 0 as a lastRanAt value,
-	// however, that would caused the missed deadline stats to be incremented. Given that, it's easier
-	// and slightly more efficient to simply run these tasks immediately in a goroutine.
 	if sched.RunOnInit() {
-		go taskFn(time.Now())
+		s.runFirst(sched, id, taskFn)
 	} else {
 		s.runOnce(sched.Next(lastRanAt), taskFn)
 	}
@@ -214,9 +285,48 @@ func (s *Scheduler) Add(sched Schedule, id string, entrypoint TaskFunc) (removeF
 	return func() {
 		debugf("Remove scheduler job '%v'", id)
 		jobCtxCancel()
+
+		// Jobs removed while the scheduler runs belong to removed or changed monitors, so their
+		// persisted run is dropped. On shutdown the scheduler is stopped first, keeping it for the restart.
+		if s.state.Load() != stateStopped {
+			storeMtx.Lock()
+			s.runStore.deleteLastRun(id)
+			storeMtx.Unlock()
+		}
 	}, nil
 }
 
+// runFirst schedules the first run of a job with an interval schedule. If the
+// job ran before a restart it resumes its schedule, otherwise, or if the run
+// was missed, it runs right away, delayed by the initial jitter if any.
+func (s *Scheduler) runFirst(sched Schedule, id string, taskFn timerqueue.TimerTaskFn) {
+	now := time.Now().In(s.location)
+
+	if lastRan, ok := s.runStore.lastRun(id); ok {
+		if next := sched.Next(lastRan); next.After(now) {
+			debugf("Job '%v' resumes its schedule at %v", id, next)
+			s.runOnce(next, taskFn)
+			return
+		}
+	}
+
+	// The upper bound of the jitter is the interval, so jobs still run
+	// within their first interval.
+	jitter := s.initialJitter
+	if interval := sched.Next(now).Sub(now); interval < jitter {
+		jitter = interval
+	}
+	if jitter <= 0 {
+		// We skip using the scheduler to execute the initial tasks for jobs that run right away.
+		// You might think it'd be simpler to just invoke runOnce with 0 as a lastRanAt value,
+		// however, that would caused the missed deadline stats to be incremented. Given that, it's easier
+		// and slightly more efficient to simply run these tasks immediately in a goroutine.
+		go taskFn(now)
+		return
+	}
+	s.push(now.Add(time.Duration(rand.Int63n(int64(jitter)))), taskFn)
+}
+
 func (s *Scheduler) runOnce(runAt time.Time, taskFn timerqueue.TimerTaskFn) {
 	now := time.Now().In(s.location)
 	if runAt.Before(now) {
@@ -224,10 +334,21 @@ func (s *Scheduler) runOnce(runAt time.Time, taskFn timerqueue.TimerTaskFn) {
 		s.stats.jobsMissedDeadline.Inc()
 	}
 
+	s.push(runAt, taskFn)
+}
+
+// push adds the task to the timer queue, to run at the given time.
+func (s *Scheduler) push(runAt time.Time, taskFn timerqueue.TimerTaskFn) {
 	// Schedule task to run sometime in the future. Wrap the task in a go-routine so it doesn't
 	// block the timer thread.
-	asyncTask := func(now time.Time) { go taskFn(now) }
-	s.timerQueue.Push(runAt, asyncTask)
+	s.stats.queuedJobs.Inc()
+	asyncTask := func(now time.Time) {
+		s.stats.queuedJobs.Dec()
+		go taskFn(now)
+	}
+	if !s.timerQueue.Push(runAt, asyncTask) {
+		s.stats.queuedJobs.Dec()
+	}
 }
 
 // runRecursiveJob runs the entry point for a job, blocking until all subtasks are completed.
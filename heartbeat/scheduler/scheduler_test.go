@@ -30,6 +30,8 @@ import (
 
 	batomic "github.com/elastic/beats/v7/libbeat/common/atomic"
 	"github.com/elastic/beats/v7/libbeat/monitoring"
+	"github.com/elastic/beats/v7/libbeat/statestore"
+	"github.com/elastic/beats/v7/libbeat/statestore/storetest"
 )
 
 // The runAt in the island of tarawa 🏝. Good test TZ because it's pretty rare for a local box
@@ -240,6 +242,144 @@ func TestScheduler_runRecursiveTask(t *testing.T) {
 	}
 }
 
+func TestScheduler_ResumesPersistedSchedule(t *testing.T) {
+	registry := statestore.NewRegistry(storetest.NewMemoryStoreBackend())
+	defer registry.Close()
+	store, err := registry.Get("scheduler")
+	require.NoError(t, err)
+	defer store.Close()
+
+	// The job last ran half a second ago, and runs every 2 seconds.
+	lastRun := time.Now().Add(-500 * time.Millisecond)
+	newRunStore(store).setLastRun("resumed", lastRun)
+
+	s := NewWithSettings(Settings{Limit: 10, Location: tarawaTime(), Store: store}, monitoring.NewRegistry())
+	defer s.Stop()
+	require.NoError(t, s.Start())
+
+	executed := make(chan time.Time, 1)
+	_, err = s.Add(testSchedule{2 * time.Second}, "resumed", testTaskTimes(1, func(_ context.Context) []TaskFunc {
+		executed <- time.Now()
+		return nil
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), s.stats.queuedJobs.Get())
+
+	select {
+	case ranAt := <-executed:
+		assert.False(t, ranAt.Before(lastRun.Add(2*time.Second)), "the job must not run before its next scheduled run")
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for the resumed job to run")
+	}
+
+	// The run is persisted once the job returns.
+	require.Eventually(t, func() bool {
+		ranAt, ok := newRunStore(store).lastRun("resumed")
+		return ok && ranAt.After(lastRun)
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestScheduler_RemovedJobForgetsPersistedRun(t *testing.T) {
+	registry := statestore.NewRegistry(storetest.NewMemoryStoreBackend())
+	defer registry.Close()
+	store, err := registry.Get("scheduler")
+	require.NoError(t, err)
+	defer store.Close()
+
+	rs := newRunStore(store)
+	rs.setLastRun("removed", time.Now())
+	rs.setLastRun("kept", time.Now())
+
+	s := NewWithSettings(Settings{Limit: 10, Location: tarawaTime(), Store: store}, monitoring.NewRegistry())
+	require.NoError(t, s.Start())
+
+	noop := testTaskTimes(1, func(_ context.Context) []TaskFunc { return nil })
+	removeFn, err := s.Add(testSchedule{time.Hour}, "removed", noop)
+	require.NoError(t, err)
+	stopFn, err := s.Add(testSchedule{time.Hour}, "kept", noop)
+	require.NoError(t, err)
+
+	// Removing a job from a running scheduler drops its persisted run
+	removeFn()
+	_, ok := rs.lastRun("removed")
+	assert.False(t, ok)
+
+	// Jobs stopped on shutdown, after the scheduler, keep it
+	require.NoError(t, s.Stop())
+	stopFn()
+	_, ok = rs.lastRun("kept")
+	assert.True(t, ok)
+}
+
+func TestScheduler_InitialJitter(t *testing.T) {
+	// The jitter is bounded by the interval of the job.
+	s := NewWithSettings(Settings{Location: tarawaTime(), InitialJitter: time.Hour}, monitoring.NewRegistry())
+	defer s.Stop()
+	require.NoError(t, s.Start())
+
+	executed := make(chan struct{}, 1)
+	_, err := s.Add(testSchedule{200 * time.Millisecond}, "jittered", testTaskTimes(1, func(_ context.Context) []TaskFunc {
+		executed <- struct{}{}
+		return nil
+	}))
+	require.NoError(t, err)
+
+	select {
+	case <-executed:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for the jittered job to run")
+	}
+	assert.Equal(t, uint64(0), s.stats.jobsMissedDeadline.Get())
+}
+
+func TestScheduler_MaxConcurrentJobs(t *testing.T) {
+	s := NewWithSettings(Settings{Location: tarawaTime(), MaxConcurrentJobs: 1}, monitoring.NewRegistry())
+	defer s.Stop()
+	require.NoError(t, s.Start())
+
+	started := make(chan string, 2)
+	release := make(chan struct{})
+	blockingTask := func(id string) TaskFunc {
+		return testTaskTimes(1, func(_ context.Context) []TaskFunc {
+			started <- id
+			<-release
+			return nil
+		})
+	}
+
+	_, err := s.Add(testSchedule{time.Hour}, "first", blockingTask("first"))
+	require.NoError(t, err)
+	_, err = s.Add(testSchedule{time.Hour}, "second", blockingTask("second"))
+	require.NoError(t, err)
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for a job to start")
+	}
+
+	// The other job waits for the running one to finish.
+	require.Eventually(t, func() bool {
+		return s.stats.waitingJobs.Get() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, uint64(1), s.stats.activeJobs.Get())
+	select {
+	case id := <-started:
+		require.Fail(t, fmt.Sprintf("job %s started while the limit was reached", id))
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for the second job to start")
+	}
+	require.Eventually(t, func() bool {
+		return s.stats.waitingJobs.Get() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
 func BenchmarkScheduler(b *testing.B) {
 	s := NewWithLocation(0, monitoring.NewRegistry(), tarawaTime())
 
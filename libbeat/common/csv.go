@@ -20,6 +20,7 @@ package common
 import (
 	"bytes"
 	"encoding/csv"
+	"io"
 	"strings"
 )
 
@@ -49,3 +50,42 @@ func DumpInCSVFormat(fields []string, rows [][]string) string {
 	csv := buf.String()
 	return csv
 }
+
+// ReadCSVWithHeader reads all the records from r using the given separator.
+// The first record is used as header and each following record is returned
+// as a map from column name to value. Records with a different number of
+// fields than the header are reported as errors by the underlying reader.
+func ReadCSVWithHeader(r io.Reader, separator rune) ([]map[string]string, error) {
+	reader := csv.NewReader(r)
+	if separator != 0 {
+		reader.Comma = separator
+	}
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i, name := range header {
+		header[i] = strings.TrimSpace(name)
+	}
+
+	var rows []map[string]string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		row := make(map[string]string, len(header))
+		for i, name := range header {
+			row[name] = record[i]
+		}
+		rows = append(rows, row)
+	}
+}
@@ -20,6 +20,7 @@
 package common
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -63,3 +64,25 @@ func Test_CSVDump(t *testing.T) {
 		assert.Equal(t, test.Output, DumpInCSVFormat(test.Fields, test.Rows))
 	}
 }
+
+func TestReadCSVWithHeader(t *testing.T) {
+	rows, err := ReadCSVWithHeader(strings.NewReader("host, owner\nweb-1,team-a\n\"db,1\",team-b\n"), ',')
+	if assert.NoError(t, err) {
+		assert.Equal(t, []map[string]string{
+			{"host": "web-1", "owner": "team-a"},
+			{"host": "db,1", "owner": "team-b"},
+		}, rows)
+	}
+
+	rows, err = ReadCSVWithHeader(strings.NewReader("host;owner\nweb-1;team-a\n"), ';')
+	if assert.NoError(t, err) {
+		assert.Equal(t, []map[string]string{{"host": "web-1", "owner": "team-a"}}, rows)
+	}
+
+	rows, err = ReadCSVWithHeader(strings.NewReader(""), ',')
+	assert.NoError(t, err)
+	assert.Empty(t, rows)
+
+	_, err = ReadCSVWithHeader(strings.NewReader("host,owner\nweb-1\n"), ',')
+	assert.Error(t, err)
+}
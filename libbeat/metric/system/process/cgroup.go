@@ -27,6 +27,9 @@ import (
 // cgroupStatsToMap returns a MapStr containing the data from the stats object.
 // If stats is nil then nil is returned.
 func cgroupStatsToMap(stats *Process) common.MapStr {
+	if stats != nil && stats.RawStats == nil && stats.RawStatsV2 != nil {
+		return cgroupV2StatsToMap(stats)
+	}
 	if stats == nil || stats.RawStats == nil {
 		return nil
 	}
@@ -232,3 +235,205 @@ func cgroupBlockIOToMapStr(blockIO *cgroup.BlockIOSubsystem) common.MapStr {
 		},
 	}
 }
+
+// cgroupV2StatsToMap returns a MapStr containing the data of a cgroup v2.
+// Stats are reported in the same fields as their equivalents in cgroup v1,
+// io stats and pressure stall information are only available in cgroup v2.
+func cgroupV2StatsToMap(stats *Process) common.MapStr {
+	v2 := stats.RawStatsV2
+	cgroup := common.MapStr{
+		"id":   v2.ID,
+		"path": v2.Path,
+	}
+
+	if v2.CPU != nil {
+		cgroup["cpu"] = cgroupV2CPUToMapStr(v2)
+		cgroup["cpuacct"] = cgroupV2CPUAccountingToMapStr(stats)
+	}
+	if v2.Memory != nil {
+		cgroup["memory"] = cgroupV2MemoryToMapStr(v2)
+	}
+	if v2.IO != nil {
+		cgroup["blkio"] = common.MapStr{
+			"id":   v2.ID,
+			"path": v2.Path,
+			"total": common.MapStr{
+				"bytes": v2.IO.ReadBytes + v2.IO.WriteBytes,
+				"ios":   v2.IO.ReadIOs + v2.IO.WriteIOs,
+			},
+		}
+		io := common.MapStr{
+			"id":   v2.ID,
+			"path": v2.Path,
+			"read": common.MapStr{
+				"bytes": v2.IO.ReadBytes,
+				"ios":   v2.IO.ReadIOs,
+			},
+			"write": common.MapStr{
+				"bytes": v2.IO.WriteBytes,
+				"ios":   v2.IO.WriteIOs,
+			},
+			"discard": common.MapStr{
+				"bytes": v2.IO.DiscardBytes,
+				"ios":   v2.IO.DiscardIOs,
+			},
+		}
+		if pressure := pressureToMapStr(v2.IO.Pressure); pressure != nil {
+			io["pressure"] = pressure
+		}
+		cgroup["io"] = io
+	}
+
+	return cgroup
+}
+
+func cgroupV2CPUToMapStr(v2 *CgroupV2Stats) common.MapStr {
+	cpu := common.MapStr{
+		"id":   v2.ID,
+		"path": v2.Path,
+		"stats": common.MapStr{
+			"periods": v2.CPU.Periods,
+			"throttled": common.MapStr{
+				"periods": v2.CPU.ThrottledPeriods,
+				"ns":      v2.CPU.ThrottledMicros * 1000,
+			},
+		},
+	}
+	if v2.CPU.PeriodMicros > 0 {
+		cpu.Put("cfs.period.us", v2.CPU.PeriodMicros)
+		cpu.Put("cfs.quota.us", v2.CPU.QuotaMicros)
+	}
+	if v2.CPU.Weight > 0 {
+		cpu["weight"] = v2.CPU.Weight
+	}
+	if pressure := pressureToMapStr(v2.CPU.Pressure); pressure != nil {
+		cpu["pressure"] = pressure
+	}
+	return cpu
+}
+
+func cgroupV2CPUAccountingToMapStr(process *Process) common.MapStr {
+	v2 := process.RawStatsV2
+	return common.MapStr{
+		"id":   v2.ID,
+		"path": v2.Path,
+		"total": common.MapStr{
+			"ns":  v2.CPU.UsageMicros * 1000,
+			"pct": process.PctStats.CPUTotalPct,
+			"norm": common.MapStr{
+				"pct": process.PctStats.CPUTotalPctNorm,
+			},
+		},
+		"stats": common.MapStr{
+			"system": common.MapStr{
+				"ns":  v2.CPU.SystemMicros * 1000,
+				"pct": process.PctStats.CPUSystemPct,
+				"norm": common.MapStr{
+					"pct": process.PctStats.CPUSystemPctNorm,
+				},
+			},
+			"user": common.MapStr{
+				"ns":  v2.CPU.UserMicros * 1000,
+				"pct": process.PctStats.CPUUserPct,
+				"norm": common.MapStr{
+					"pct": process.PctStats.CPUUserPctNorm,
+				},
+			},
+		},
+	}
+}
+
+func cgroupV2MemoryToMapStr(v2 *CgroupV2Stats) common.MapStr {
+	memory := v2.Memory
+
+	bytes := func(v uint64) common.MapStr { return common.MapStr{"bytes": v} }
+
+	memMap := common.MapStr{
+		"id":   v2.ID,
+		"path": v2.Path,
+		"mem": common.MapStr{
+			"usage":    bytes(memory.Usage),
+			"limit":    bytes(memory.Max),
+			"low":      bytes(memory.Low),
+			"high":     bytes(memory.High),
+			"failures": memory.Events["max"],
+		},
+		"swap": common.MapStr{
+			"usage": bytes(memory.SwapUsage),
+			"limit": bytes(memory.SwapMax),
+		},
+		"events": common.MapStr{
+			"low":      memory.Events["low"],
+			"high":     memory.Events["high"],
+			"max":      memory.Events["max"],
+			"oom":      memory.Events["oom"],
+			"oom_kill": memory.Events["oom_kill"],
+		},
+	}
+
+	// memory.stat values in bytes, with the names of their cgroup v1
+	// equivalents when there is one
+	statsMap := common.MapStr{}
+	for v2Name, name := range map[string]string{
+		"anon":           "rss",
+		"anon_thp":       "rss_huge",
+		"file":           "cache",
+		"file_mapped":    "mapped_file",
+		"file_dirty":     "file_dirty",
+		"file_writeback": "file_writeback",
+		"active_anon":    "active_anon",
+		"inactive_anon":  "inactive_anon",
+		"active_file":    "active_file",
+		"inactive_file":  "inactive_file",
+		"unevictable":    "unevictable",
+		"kernel_stack":   "kernel_stack",
+		"slab":           "slab",
+		"sock":           "sock",
+		"shmem":          "shmem",
+	} {
+		if value, found := memory.Stats[v2Name]; found {
+			statsMap[name] = bytes(value)
+		}
+	}
+	for v2Name, name := range map[string]string{
+		"pgfault":    "page_faults",
+		"pgmajfault": "major_page_faults",
+	} {
+		if value, found := memory.Stats[v2Name]; found {
+			statsMap[name] = value
+		}
+	}
+	memMap["stats"] = statsMap
+
+	if pressure := pressureToMapStr(memory.Pressure); pressure != nil {
+		memMap["pressure"] = pressure
+	}
+
+	return memMap
+}
+
+// pressureToMapStr returns a MapStr containing pressure stall information.
+// If the pressure parameter is nil then nil is returned.
+func pressureToMapStr(pressure *Pressure) common.MapStr {
+	if pressure == nil {
+		return nil
+	}
+
+	statsToMapStr := func(stats *PressureStats) common.MapStr {
+		return common.MapStr{
+			"10":    common.MapStr{"pct": stats.Avg10},
+			"60":    common.MapStr{"pct": stats.Avg60},
+			"300":   common.MapStr{"pct": stats.Avg300},
+			"total": common.MapStr{"us": stats.TotalMicros},
+		}
+	}
+
+	m := common.MapStr{}
+	if pressure.Some != nil {
+		m["some"] = statsToMapStr(pressure.Some)
+	}
+	if pressure.Full != nil {
+		m["full"] = statsToMapStr(pressure.Full)
+	}
+	return m
+}
@@ -0,0 +1,484 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package process
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/gosigar/cgroup"
+)
+
+// CgroupV2Stats contains metrics and limits of the cgroup of a process in a
+// cgroup v2 (unified) hierarchy.
+type CgroupV2Stats struct {
+	ID   string // ID of the cgroup.
+	Path string // Path to the cgroup relative to the mountpoint of the hierarchy.
+
+	CPU    *CgroupV2CPU
+	Memory *CgroupV2Memory
+	IO     *CgroupV2IO
+}
+
+// CgroupV2CPU contains the stats of the cpu controller, and the CPU usage
+// stats that are always available.
+type CgroupV2CPU struct {
+	UsageMicros  uint64
+	UserMicros   uint64
+	SystemMicros uint64
+
+	Periods          uint64
+	ThrottledPeriods uint64
+	ThrottledMicros  uint64
+
+	// Limits from cpu.max, QuotaMicros is 0 if there is no limit.
+	QuotaMicros  uint64
+	PeriodMicros uint64
+	Weight       uint64
+
+	Pressure *Pressure
+}
+
+// CgroupV2Memory contains the stats of the memory controller.
+type CgroupV2Memory struct {
+	// Usage and limits, limits are 0 if there is no limit.
+	Usage     uint64
+	Low       uint64
+	High      uint64
+	Max       uint64
+	SwapUsage uint64
+	SwapMax   uint64
+
+	// Stats contains the values of memory.stat.
+	Stats map[string]uint64
+	// Events contains the values of memory.events.
+	Events map[string]uint64
+
+	Pressure *Pressure
+}
+
+// CgroupV2IO contains the stats of the io controller, summed for all the
+// devices.
+type CgroupV2IO struct {
+	ReadBytes    uint64
+	WriteBytes   uint64
+	ReadIOs      uint64
+	WriteIOs     uint64
+	DiscardBytes uint64
+	DiscardIOs   uint64
+
+	Pressure *Pressure
+}
+
+// Pressure contains pressure stall information (PSI) of a resource. Some
+// contains the share of time in which at least some tasks are stalled,
+// Full the share of time in which all non-idle tasks are stalled at the same
+// time.
+type Pressure struct {
+	Some *PressureStats
+	Full *PressureStats
+}
+
+// PressureStats contains the averages of stalled time over the last 10, 60
+// and 300 seconds as ratios, and the total stalled time in microseconds.
+type PressureStats struct {
+	Avg10       float64
+	Avg60       float64
+	Avg300      float64
+	TotalMicros uint64
+}
+
+// cgroupV2Reader reads the stats of processes in a cgroup v2 hierarchy.
+type cgroupV2Reader struct {
+	rootfsMountpoint         string
+	mountpoint               string
+	ignoreRootCgroups        bool
+	cgroupsHierarchyOverride string
+}
+
+// newCgroupV2Reader creates a reader for the cgroup v2 hierarchy mounted in
+// the root filesystem. It returns nil if there is no cgroup v2 hierarchy.
+func newCgroupV2Reader(opts cgroup.ReaderOptions) (*cgroupV2Reader, error) {
+	if opts.RootfsMountpoint == "" {
+		opts.RootfsMountpoint = "/"
+	}
+
+	mountpoint, err := cgroupV2Mountpoint(opts.RootfsMountpoint)
+	if err != nil || mountpoint == "" {
+		return nil, err
+	}
+
+	return &cgroupV2Reader{
+		rootfsMountpoint:         opts.RootfsMountpoint,
+		mountpoint:               mountpoint,
+		ignoreRootCgroups:        opts.IgnoreRootCgroups,
+		cgroupsHierarchyOverride: opts.CgroupsHierarchyOverride,
+	}, nil
+}
+
+// cgroupV2Mountpoint looks for the mountpoint of the cgroup2 filesystem in
+// /proc/self/mountinfo. Mountpoints inside the root filesystem are preferred,
+// if there is none the mountpoint is considered relative to the root
+// filesystem, as when the mountinfo of the host is read.
+func cgroupV2Mountpoint(rootfsMountpoint string) (string, error) {
+	f, err := os.Open(filepath.Join(rootfsMountpoint, "proc", "self", "mountinfo"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	defer f.Close()
+
+	var relative string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		// Example:
+		// 42 32 0:38 / /sys/fs/cgroup rw,nosuid,nodev,noexec,relatime - cgroup2 cgroup2 rw,nsdelegate
+		fields := strings.Fields(sc.Text())
+		if len(fields) < 10 {
+			continue
+		}
+		separator := 6
+		for separator < len(fields) && fields[separator] != "-" {
+			separator++
+		}
+		if separator+1 >= len(fields) || fields[separator+1] != "cgroup2" {
+			continue
+		}
+
+		mountpoint := fields[4]
+		if rootfsMountpoint == "/" || strings.HasPrefix(mountpoint, rootfsMountpoint) {
+			return mountpoint, nil
+		}
+		if relative == "" {
+			relative = filepath.Join(rootfsMountpoint, mountpoint)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return "", err
+	}
+
+	if relative != "" {
+		if info, err := os.Stat(relative); err == nil && info.IsDir() {
+			return relative, nil
+		}
+	}
+	return "", nil
+}
+
+// GetStatsForProcess returns the stats of the cgroup v2 of a process, or nil
+// if the process is not in a cgroup v2 or no stats are available.
+func (r *cgroupV2Reader) GetStatsForProcess(pid int) (*CgroupV2Stats, error) {
+	paths, err := cgroup.ProcessCgroupPaths(r.rootfsMountpoint, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	// The unified hierarchy is listed with an empty list of controllers,
+	// as "0::/path".
+	path, found := paths[""]
+	if !found || (path == "/" && r.ignoreRootCgroups) {
+		return nil, nil
+	}
+	id := filepath.Base(path)
+	if r.cgroupsHierarchyOverride != "" {
+		path = r.cgroupsHierarchyOverride
+	}
+
+	return getCgroupV2Stats(filepath.Join(r.mountpoint, path), id, path)
+}
+
+func getCgroupV2Stats(fullPath, id, path string) (*CgroupV2Stats, error) {
+	if _, err := os.Stat(fullPath); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	stats := CgroupV2Stats{ID: id, Path: path}
+
+	var err error
+	if stats.CPU, err = getCgroupV2CPU(fullPath); err != nil {
+		return nil, errors.Wrap(err, "reading cpu stats")
+	}
+	if stats.Memory, err = getCgroupV2Memory(fullPath); err != nil {
+		return nil, errors.Wrap(err, "reading memory stats")
+	}
+	if stats.IO, err = getCgroupV2IO(fullPath); err != nil {
+		return nil, errors.Wrap(err, "reading io stats")
+	}
+
+	if stats.CPU == nil && stats.Memory == nil && stats.IO == nil {
+		return nil, nil
+	}
+	return &stats, nil
+}
+
+// getCgroupV2CPU reads cpu.stat, which is always available, and the files
+// of the cpu controller if it is enabled.
+func getCgroupV2CPU(path string) (*CgroupV2CPU, error) {
+	stat, err := readCgroupKeyValues(filepath.Join(path, "cpu.stat"))
+	if err != nil || stat == nil {
+		return nil, err
+	}
+
+	cpu := CgroupV2CPU{
+		UsageMicros:      stat["usage_usec"],
+		UserMicros:       stat["user_usec"],
+		SystemMicros:     stat["system_usec"],
+		Periods:          stat["nr_periods"],
+		ThrottledPeriods: stat["nr_throttled"],
+		ThrottledMicros:  stat["throttled_usec"],
+	}
+
+	// Format: $MAX $PERIOD, with "max" as $MAX when there is no limit
+	if fields, err := readCgroupFields(filepath.Join(path, "cpu.max")); err != nil {
+		return nil, err
+	} else if len(fields) == 2 {
+		if cpu.QuotaMicros, err = parseCgroupLimit(fields[0]); err != nil {
+			return nil, err
+		}
+		if cpu.PeriodMicros, err = strconv.ParseUint(fields[1], 10, 64); err != nil {
+			return nil, err
+		}
+	}
+
+	if cpu.Weight, err = readCgroupUint(filepath.Join(path, "cpu.weight")); err != nil {
+		return nil, err
+	}
+
+	if cpu.Pressure, err = readPressure(filepath.Join(path, "cpu.pressure")); err != nil {
+		return nil, err
+	}
+
+	return &cpu, nil
+}
+
+// getCgroupV2Memory reads the files of the memory controller, it returns nil
+// if the controller is not enabled.
+func getCgroupV2Memory(path string) (*CgroupV2Memory, error) {
+	stats, err := readCgroupKeyValues(filepath.Join(path, "memory.stat"))
+	if err != nil || stats == nil {
+		return nil, err
+	}
+
+	memory := CgroupV2Memory{Stats: stats}
+	for file, value := range map[string]*uint64{
+		"memory.current":      &memory.Usage,
+		"memory.low":          &memory.Low,
+		"memory.high":         &memory.High,
+		"memory.max":          &memory.Max,
+		"memory.swap.current": &memory.SwapUsage,
+		"memory.swap.max":     &memory.SwapMax,
+	} {
+		if *value, err = readCgroupUint(filepath.Join(path, file)); err != nil {
+			return nil, err
+		}
+	}
+
+	if memory.Events, err = readCgroupKeyValues(filepath.Join(path, "memory.events")); err != nil {
+		return nil, err
+	}
+
+	if memory.Pressure, err = readPressure(filepath.Join(path, "memory.pressure")); err != nil {
+		return nil, err
+	}
+
+	return &memory, nil
+}
+
+// getCgroupV2IO reads the files of the io controller, it returns nil if the
+// controller is not enabled.
+func getCgroupV2IO(path string) (*CgroupV2IO, error) {
+	content, err := ioutil.ReadFile(filepath.Join(path, "io.stat"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var io CgroupV2IO
+	counters := map[string]*uint64{
+		"rbytes": &io.ReadBytes,
+		"wbytes": &io.WriteBytes,
+		"rios":   &io.ReadIOs,
+		"wios":   &io.WriteIOs,
+		"dbytes": &io.DiscardBytes,
+		"dios":   &io.DiscardIOs,
+	}
+
+	// Format: $MAJ:$MIN rbytes=$N wbytes=$N rios=$N wios=$N dbytes=$N dios=$N
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		for _, field := range fields[1:] {
+			parts := strings.SplitN(field, "=", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			counter, found := counters[parts[0]]
+			if !found {
+				continue
+			}
+			value, err := strconv.ParseUint(parts[1], 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "parsing io.stat line '%s'", line)
+			}
+			*counter += value
+		}
+	}
+
+	if io.Pressure, err = readPressure(filepath.Join(path, "io.pressure")); err != nil {
+		return nil, err
+	}
+
+	return &io, nil
+}
+
+// readPressure reads a file with pressure stall information, with the
+// format:
+//
+//  some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//  full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//
+// Averages are converted from percentages to ratios. It returns nil if the
+// file doesn't exist.
+func readPressure(path string) (*Pressure, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var pressure Pressure
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		var stats PressureStats
+		for _, field := range fields[1:] {
+			parts := strings.SplitN(field, "=", 2)
+			if len(parts) != 2 {
+				return nil, errors.Errorf("invalid pressure line '%s'", line)
+			}
+			var err error
+			switch parts[0] {
+			case "avg10":
+				stats.Avg10, err = parsePressureAvg(parts[1])
+			case "avg60":
+				stats.Avg60, err = parsePressureAvg(parts[1])
+			case "avg300":
+				stats.Avg300, err = parsePressureAvg(parts[1])
+			case "total":
+				stats.TotalMicros, err = strconv.ParseUint(parts[1], 10, 64)
+			}
+			if err != nil {
+				return nil, errors.Wrapf(err, "parsing pressure line '%s'", line)
+			}
+		}
+
+		switch fields[0] {
+		case "some":
+			pressure.Some = &stats
+		case "full":
+			pressure.Full = &stats
+		}
+	}
+	return &pressure, nil
+}
+
+// parsePressureAvg converts averages, that are percentages with two decimals,
+// to ratios
+func parsePressureAvg(value string) (float64, error) {
+	avg, err := strconv.ParseFloat(value, 64)
+	return common.Round(avg/100, 4), err
+}
+
+// readCgroupKeyValues reads files with lines with the format "key value",
+// it returns nil if the file doesn't exist.
+func readCgroupKeyValues(path string) (map[string]uint64, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	values := map[string]uint64{}
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing line '%s' of %s", line, path)
+		}
+		values[fields[0]] = value
+	}
+	return values, nil
+}
+
+// readCgroupFields reads the fields of a single line file, it returns nil if
+// the file doesn't exist.
+func readCgroupFields(path string) ([]string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return strings.Fields(string(content)), nil
+}
+
+// readCgroupUint reads a file with a single value, it returns 0 if the file
+// doesn't exist or the value is "max".
+func readCgroupUint(path string) (uint64, error) {
+	fields, err := readCgroupFields(path)
+	if err != nil || len(fields) == 0 {
+		return 0, err
+	}
+	return parseCgroupLimit(fields[0])
+}
+
+// parseCgroupLimit parses a value that can be "max" when there is no limit,
+// in that case 0 is returned.
+func parseCgroupLimit(value string) (uint64, error) {
+	if value == "max" {
+		return 0, nil
+	}
+	return strconv.ParseUint(value, 10, 64)
+}
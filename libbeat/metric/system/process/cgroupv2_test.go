@@ -0,0 +1,188 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+// +build darwin freebsd linux windows
+
+package process
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/gosigar/cgroup"
+)
+
+const testCgroupV2ID = "docker-fd1c5d7c7e7a13f21b8e0f6c5d4b86e6ba0a5c74e4a3a04e5db1c3b07e7cd2f1.scope"
+
+func newTestCgroupV2Reader(t *testing.T) *cgroupV2Reader {
+	reader, err := newCgroupV2Reader(cgroup.ReaderOptions{
+		RootfsMountpoint:  "testdata/cgroupv2",
+		IgnoreRootCgroups: true,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, reader)
+	return reader
+}
+
+func TestCgroupV2Reader(t *testing.T) {
+	reader := newTestCgroupV2Reader(t)
+	assert.Equal(t, "testdata/cgroupv2/sys/fs/cgroup", reader.mountpoint)
+
+	t.Run("container", func(t *testing.T) {
+		stats, err := reader.GetStatsForProcess(1234)
+		require.NoError(t, err)
+		require.NotNil(t, stats)
+
+		assert.Equal(t, testCgroupV2ID, stats.ID)
+		assert.Equal(t, "/system.slice/"+testCgroupV2ID, stats.Path)
+
+		assert.Equal(t, &CgroupV2CPU{
+			UsageMicros:      5366738,
+			UserMicros:       3970463,
+			SystemMicros:     1396275,
+			Periods:          1024,
+			ThrottledPeriods: 37,
+			ThrottledMicros:  1485913,
+			QuotaMicros:      150000,
+			PeriodMicros:     100000,
+			Weight:           100,
+			Pressure: &Pressure{
+				Some: &PressureStats{Avg10: 0.0035, Avg60: 0.0018, Avg300: 0.0005, TotalMicros: 2416353},
+				Full: &PressureStats{Avg10: 0.0012, Avg60: 0.0006, Avg300: 0.0001, TotalMicros: 1126409},
+			},
+		}, stats.CPU)
+
+		require.NotNil(t, stats.Memory)
+		assert.Equal(t, uint64(44183552), stats.Memory.Usage)
+		assert.Equal(t, uint64(0), stats.Memory.High)
+		assert.Equal(t, uint64(536870912), stats.Memory.Max)
+		assert.Equal(t, uint64(0), stats.Memory.SwapMax)
+		assert.Equal(t, uint64(27250688), stats.Memory.Stats["anon"])
+		assert.Equal(t, uint64(21597), stats.Memory.Stats["pgfault"])
+		assert.Equal(t, uint64(3), stats.Memory.Events["max"])
+		assert.Equal(t, uint64(1), stats.Memory.Events["oom_kill"])
+		require.NotNil(t, stats.Memory.Pressure)
+		assert.Equal(t, uint64(7902), stats.Memory.Pressure.Full.TotalMicros)
+
+		assert.Equal(t, &CgroupV2IO{
+			ReadBytes:  19189760 + 4096,
+			WriteBytes: 1613824 + 8192,
+			ReadIOs:    512 + 1,
+			WriteIOs:   131 + 2,
+			Pressure: &Pressure{
+				Some: &PressureStats{Avg10: 0.0125, Avg60: 0.004, Avg300: 0.0009, TotalMicros: 982716},
+				Full: &PressureStats{Avg10: 0.011, Avg60: 0.0036, Avg300: 0.0008, TotalMicros: 873105},
+			},
+		}, stats.IO)
+	})
+
+	t.Run("no controllers", func(t *testing.T) {
+		stats, err := reader.GetStatsForProcess(4321)
+		require.NoError(t, err)
+		require.NotNil(t, stats)
+
+		assert.Equal(t, &CgroupV2CPU{
+			UsageMicros:  902117,
+			UserMicros:   610822,
+			SystemMicros: 291295,
+		}, stats.CPU)
+		assert.Nil(t, stats.Memory)
+		assert.Nil(t, stats.IO)
+	})
+
+	t.Run("root cgroup", func(t *testing.T) {
+		stats, err := reader.GetStatsForProcess(5678)
+		require.NoError(t, err)
+		assert.Nil(t, stats)
+	})
+}
+
+func TestCgroupV2StatsToMap(t *testing.T) {
+	reader := newTestCgroupV2Reader(t)
+	stats, err := reader.GetStatsForProcess(1234)
+	require.NoError(t, err)
+
+	event := cgroupStatsToMap(&Process{RawStatsV2: stats})
+	require.NotNil(t, event)
+
+	expected := map[string]interface{}{
+		"id":                             testCgroupV2ID,
+		"cpu.cfs.quota.us":               uint64(150000),
+		"cpu.cfs.period.us":              uint64(100000),
+		"cpu.stats.throttled.ns":         uint64(1485913000),
+		"cpu.pressure.some.10.pct":       0.0035,
+		"cpu.pressure.full.total.us":     uint64(1126409),
+		"cpuacct.total.ns":               uint64(5366738000),
+		"cpuacct.stats.user.ns":          uint64(3970463000),
+		"memory.mem.usage.bytes":         uint64(44183552),
+		"memory.mem.limit.bytes":         uint64(536870912),
+		"memory.mem.failures":            uint64(3),
+		"memory.events.oom_kill":         uint64(1),
+		"memory.stats.rss.bytes":         uint64(27250688),
+		"memory.stats.cache.bytes":       uint64(13381632),
+		"memory.stats.mapped_file.bytes": uint64(9326592),
+		"memory.stats.major_page_faults": uint64(112),
+		"memory.pressure.some.total.us":  uint64(8437),
+		"blkio.total.bytes":              uint64(19189760 + 4096 + 1613824 + 8192),
+		"blkio.total.ios":                uint64(512 + 1 + 131 + 2),
+		"io.read.bytes":                  uint64(19189760 + 4096),
+		"io.write.ios":                   uint64(131 + 2),
+		"io.pressure.full.10.pct":        0.011,
+		"io.pressure.some.300.pct":       0.0009,
+	}
+	for key, value := range expected {
+		v, err := event.GetValue(key)
+		if assert.NoError(t, err, key) {
+			assert.Equal(t, value, v, key)
+		}
+	}
+
+	// Stats without cgroup v1 equivalents don't use v1 names
+	_, err = event.GetValue("memory.stats.anon")
+	assert.Equal(t, common.ErrKeyNotFound, err)
+}
+
+func TestGetCgroupV2Percentage(t *testing.T) {
+	now := time.Now()
+	s0 := &Process{
+		SampleTime: now,
+		RawStatsV2: &CgroupV2Stats{
+			CPU: &CgroupV2CPU{UsageMicros: 1000000, UserMicros: 700000, SystemMicros: 300000},
+		},
+	}
+	s1 := &Process{
+		SampleTime: now.Add(10 * time.Second),
+		RawStatsV2: &CgroupV2Stats{
+			CPU: &CgroupV2CPU{UsageMicros: 6000000, UserMicros: 4700000, SystemMicros: 1300000},
+		},
+	}
+
+	pct := GetCgroupPercentage(s0, s1)
+	assert.Equal(t, 0.5, pct.CPUTotalPct)
+	assert.Equal(t, 0.4, pct.CPUUserPct)
+	assert.Equal(t, 0.1, pct.CPUSystemPct)
+	assert.Equal(t, common.Round(0.5/float64(runtime.NumCPU()), common.DefaultDecimalPlacesCount), pct.CPUTotalPctNorm)
+
+	// Counters reset
+	assert.Equal(t, 0.0, GetCgroupPercentage(s1, s0).CPUTotalPct)
+}
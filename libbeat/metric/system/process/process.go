@@ -65,8 +65,9 @@ type Process struct {
 	cpuTotalPctNorm float64
 
 	// cgroup stats
-	RawStats *cgroup.Stats
-	PctStats CgroupPctStats
+	RawStats   *cgroup.Stats
+	RawStatsV2 *CgroupV2Stats
+	PctStats   CgroupPctStats
 }
 
 // CgroupPctStats stores rendered percent values from cgroup CPU data
@@ -93,6 +94,7 @@ type Stats struct {
 	procRegexps []match.Matcher // List of regular expressions used to whitelist processes.
 	envRegexps  []match.Matcher // List of regular expressions used to whitelist env vars.
 	cgroups     *cgroup.Reader
+	cgroupsV2   *cgroupV2Reader
 }
 
 // Ticks of CPU for a process
@@ -396,6 +398,9 @@ func GetProcCPUPercentage(s0, s1 *Process) (normalizedPct, pct, totalPct float64
 // as USER_HZ is less precise value that will get rounded up to nanseconds.
 // Because of that, `user` and `system` metrics reflect a precentage of overall CPU time, but can't be compared to the total pct values.
 func GetCgroupPercentage(s0, s1 *Process) CgroupPctStats {
+	if s0 != nil && s1 != nil && s0.RawStatsV2 != nil && s1.RawStatsV2 != nil {
+		return getCgroupV2Percentage(s0, s1)
+	}
 	if s0 == nil || s1 == nil || s0.RawStats == nil || s1.RawStats == nil || s0.RawStats.CPUAccounting == nil || s1.RawStats.CPUAccounting == nil {
 		return CgroupPctStats{}
 	}
@@ -435,6 +440,38 @@ func GetCgroupPercentage(s0, s1 *Process) CgroupPctStats {
 	return pctValues
 }
 
+// getCgroupV2Percentage returns CPU usage percentages for a cgroup v2, all
+// CPU times are reported in microseconds.
+func getCgroupV2Percentage(s0, s1 *Process) CgroupPctStats {
+	cpu0, cpu1 := s0.RawStatsV2.CPU, s1.RawStatsV2.CPU
+	timeDeltaMicros := float64(s1.SampleTime.Sub(s0.SampleTime) / time.Microsecond)
+	if cpu0 == nil || cpu1 == nil || timeDeltaMicros <= 0 {
+		return CgroupPctStats{}
+	}
+
+	pct := func(v0, v1 uint64) float64 {
+		if v1 < v0 {
+			return 0
+		}
+		return float64(v1-v0) / timeDeltaMicros
+	}
+	totalPct := pct(cpu0.UsageMicros, cpu1.UsageMicros)
+	userPct := pct(cpu0.UserMicros, cpu1.UserMicros)
+	systemPct := pct(cpu0.SystemMicros, cpu1.SystemMicros)
+
+	// Per-CPU usage is not available in cgroup v2
+	cpuCount := float64(runtime.NumCPU())
+
+	return CgroupPctStats{
+		CPUTotalPct:      common.Round(totalPct, common.DefaultDecimalPlacesCount),
+		CPUTotalPctNorm:  common.Round(totalPct/cpuCount, common.DefaultDecimalPlacesCount),
+		CPUUserPct:       common.Round(userPct, common.DefaultDecimalPlacesCount),
+		CPUUserPctNorm:   common.Round(userPct/cpuCount, common.DefaultDecimalPlacesCount),
+		CPUSystemPct:     common.Round(systemPct, common.DefaultDecimalPlacesCount),
+		CPUSystemPctNorm: common.Round(systemPct/cpuCount, common.DefaultDecimalPlacesCount),
+	}
+}
+
 // matchProcess checks if the provided process name matches any of the process regexes
 func (procStats *Stats) matchProcess(name string) bool {
 	for _, reg := range procStats.procRegexps {
@@ -480,6 +517,14 @@ func (procStats *Stats) Init() error {
 			return errors.Wrap(err, "error initializing cgroup reader")
 		}
 		procStats.cgroups = cgReader
+
+		// Hosts with a cgroup v2 hierarchy, stats of this hierarchy are
+		// collected for processes without cgroup v1 stats
+		cgReaderV2, err := newCgroupV2Reader(procStats.CgroupOpts)
+		if err != nil {
+			return errors.Wrap(err, "error initializing cgroup v2 reader")
+		}
+		procStats.cgroupsV2 = cgReaderV2
 	}
 
 	return nil
@@ -566,12 +611,22 @@ func (procStats *Stats) getSingleProcess(pid int, newProcs ProcsMap) *Process {
 	}
 
 	if procStats.EnableCgroups {
-		cgStats, err := procStats.cgroups.GetStatsForProcess(pid)
-		if err != nil {
-			logp.Debug("Error fetching cgroup data for process %s with pid=%d: %v", process.Name, process.Pid, err)
-			return nil
+		if procStats.cgroups != nil {
+			cgStats, err := procStats.cgroups.GetStatsForProcess(pid)
+			if err != nil {
+				logp.Debug("Error fetching cgroup data for process %s with pid=%d: %v", process.Name, process.Pid, err)
+				return nil
+			}
+			process.RawStats = cgStats
+		}
+		if process.RawStats == nil && procStats.cgroupsV2 != nil {
+			cgStats, err := procStats.cgroupsV2.GetStatsForProcess(pid)
+			if err != nil {
+				logp.Debug("processes", "Error fetching cgroup v2 data for process %s with pid=%d: %v", process.Name, process.Pid, err)
+				return nil
+			}
+			process.RawStatsV2 = cgStats
 		}
-		process.RawStats = cgStats
 		last := procStats.ProcsMap[process.Pid]
 		process.PctStats = GetCgroupPercentage(last, process)
 	}
@@ -0,0 +1,155 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/jsontransform"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// decodeBinaryFields contains the logic shared by the processors decoding
+// binary payloads, like decode_msgpack and decode_protobuf. It mirrors the
+// behaviour of decode_json_fields regarding targets, overwriting of keys and
+// error reporting.
+type decodeBinaryFields struct {
+	decodeBinaryConfig
+	name   string
+	decode func([]byte) (map[string]interface{}, error)
+	logger *logp.Logger
+}
+
+type decodeBinaryConfig struct {
+	Fields        []string       `config:"fields" validate:"required"`
+	Target        *string        `config:"target"`
+	Encoding      binaryEncoding `config:"encoding"`
+	OverwriteKeys bool           `config:"overwrite_keys"`
+	AddErrorKey   bool           `config:"add_error_key"`
+}
+
+// binaryEncoding is the encoding of the binary payloads in string fields.
+type binaryEncoding uint8
+
+const (
+	encodingBase64 binaryEncoding = iota
+	encodingHex
+	encodingNone
+)
+
+func (e *binaryEncoding) Unpack(v string) error {
+	switch strings.ToLower(v) {
+	case "", "base64":
+		*e = encodingBase64
+	case "hex":
+		*e = encodingHex
+	case "none":
+		*e = encodingNone
+	default:
+		return errors.Errorf("invalid encoding '%v' (valid values are: base64, hex, none)", v)
+	}
+	return nil
+}
+
+func (e binaryEncoding) decode(s string) ([]byte, error) {
+	switch e {
+	case encodingHex:
+		return hex.DecodeString(strings.TrimSpace(s))
+	case encodingNone:
+		return []byte(s), nil
+	default:
+		s = strings.TrimSpace(s)
+		if data, err := base64.StdEncoding.DecodeString(s); err == nil {
+			return data, nil
+		}
+		// Also accept unpadded and URL safe variants.
+		if data, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(s, "=")); err == nil {
+			return data, nil
+		}
+		return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	}
+}
+
+func (f *decodeBinaryFields) Run(event *beat.Event) (*beat.Event, error) {
+	var errs []string
+
+	for _, field := range f.Fields {
+		if err := f.decodeField(event, field); err != nil {
+			f.logger.Debugf("Error decoding field %s: %v", field, err)
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) > 0 {
+		err := errors.New(strings.Join(errs, ", "))
+		event.SetErrorWithOption(common.MapStr{"message": err.Error(), "type": f.name}, f.AddErrorKey)
+		return event, err
+	}
+	return event, nil
+}
+
+func (f *decodeBinaryFields) decodeField(event *beat.Event, field string) error {
+	value, err := event.GetValue(field)
+	if err != nil {
+		if errors.Cause(err) == common.ErrKeyNotFound {
+			return nil
+		}
+		return err
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case string:
+		if data, err = f.Encoding.decode(v); err != nil {
+			return errors.Wrapf(err, "failed to decode field %s", field)
+		}
+	case []byte:
+		data = v
+	default:
+		// ignore fields that can't hold binary payloads
+		return nil
+	}
+
+	output, err := f.decode(data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decode %s from field %s", f.name, field)
+	}
+
+	target := field
+	if f.Target != nil {
+		target = *f.Target
+	}
+	if target == "" {
+		jsontransform.WriteJSONKeys(event, output, false, f.OverwriteKeys, f.AddErrorKey)
+		return nil
+	}
+	if _, err = event.PutValue(target, common.MapStr(output)); err != nil {
+		return errors.Wrapf(err, "failed to write decoded %s to field %s", f.name, target)
+	}
+	return nil
+}
+
+func (f *decodeBinaryFields) String() string {
+	return f.name + "=" + strings.Join(f.Fields, ", ")
+}
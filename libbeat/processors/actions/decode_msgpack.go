@@ -0,0 +1,74 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"bytes"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	"github.com/elastic/beats/v7/libbeat/processors/checks"
+)
+
+func init() {
+	processors.RegisterPlugin("decode_msgpack",
+		checks.ConfigChecked(NewDecodeMsgpack,
+			checks.RequireFields("fields"),
+			checks.AllowedFields("fields", "target", "encoding", "overwrite_keys", "add_error_key", "when")))
+}
+
+// NewDecodeMsgpack constructs a new decode_msgpack processor.
+func NewDecodeMsgpack(c *common.Config) (processors.Processor, error) {
+	var config decodeBinaryConfig
+	if err := c.Unpack(&config); err != nil {
+		return nil, errors.Wrap(err, "fail to unpack the decode_msgpack configuration")
+	}
+
+	handle := &codec.MsgpackHandle{}
+	handle.RawToString = true
+	handle.WriteExt = true
+	handle.MapType = reflect.TypeOf(map[string]interface{}(nil))
+
+	return &decodeBinaryFields{
+		decodeBinaryConfig: config,
+		name:               "msgpack",
+		decode: func(data []byte) (map[string]interface{}, error) {
+			return decodeMsgpack(handle, data)
+		},
+		logger: logp.NewLogger("decode_msgpack"),
+	}, nil
+}
+
+func decodeMsgpack(handle *codec.MsgpackHandle, data []byte) (map[string]interface{}, error) {
+	var output interface{}
+	dec := codec.NewDecoder(bytes.NewReader(data), handle)
+	if err := dec.Decode(&output); err != nil {
+		return nil, err
+	}
+
+	m, ok := output.(map[string]interface{})
+	if !ok {
+		return nil, errors.Errorf("expected a map, found %T", output)
+	}
+	return m, nil
+}
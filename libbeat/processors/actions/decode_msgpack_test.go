@@ -0,0 +1,145 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ugorji/go/codec"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func encodeMsgpack(t *testing.T, v interface{}) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, codec.NewEncoder(&buf, &codec.MsgpackHandle{WriteExt: true}).Encode(v))
+	return buf.Bytes()
+}
+
+func TestDecodeMsgpack(t *testing.T) {
+	payload := encodeMsgpack(t, map[string]interface{}{
+		"user":  map[string]interface{}{"name": "alice", "id": 42},
+		"tags":  []string{"a", "b"},
+		"valid": true,
+	})
+
+	var testCases = []struct {
+		description string
+		config      common.MapStr
+		input       common.MapStr
+		output      common.MapStr
+		error       bool
+	}{
+		{
+			description: "decode base64 in place",
+			config:      common.MapStr{"fields": []string{"payload"}},
+			input:       common.MapStr{"payload": base64.StdEncoding.EncodeToString(payload)},
+			output: common.MapStr{
+				"payload": common.MapStr{
+					"user":  map[string]interface{}{"name": "alice", "id": int64(42)},
+					"tags":  []interface{}{"a", "b"},
+					"valid": true,
+				},
+			},
+		},
+		{
+			description: "decode hex to target",
+			config:      common.MapStr{"fields": []string{"payload"}, "encoding": "hex", "target": "decoded"},
+			input:       common.MapStr{"payload": hex.EncodeToString(payload)},
+			output: common.MapStr{
+				"payload": hex.EncodeToString(payload),
+				"decoded": common.MapStr{
+					"user":  map[string]interface{}{"name": "alice", "id": int64(42)},
+					"tags":  []interface{}{"a", "b"},
+					"valid": true,
+				},
+			},
+		},
+		{
+			description: "decode raw bytes to root without overwriting",
+			config:      common.MapStr{"fields": []string{"payload"}, "target": ""},
+			input:       common.MapStr{"payload": payload, "valid": false},
+			output: common.MapStr{
+				"payload": payload,
+				"user":    common.MapStr{"name": "alice", "id": int64(42)},
+				"tags":    []interface{}{"a", "b"},
+				"valid":   false,
+			},
+		},
+		{
+			description: "decode to root overwriting keys",
+			config:      common.MapStr{"fields": []string{"payload"}, "target": "", "overwrite_keys": true},
+			input:       common.MapStr{"payload": payload, "valid": false},
+			output: common.MapStr{
+				"payload": payload,
+				"user":    common.MapStr{"name": "alice", "id": int64(42)},
+				"tags":    []interface{}{"a", "b"},
+				"valid":   true,
+			},
+		},
+		{
+			description: "missing field is ignored",
+			config:      common.MapStr{"fields": []string{"missing"}},
+			input:       common.MapStr{"message": "hello"},
+			output:      common.MapStr{"message": "hello"},
+		},
+		{
+			description: "invalid payload with error key",
+			config:      common.MapStr{"fields": []string{"payload"}, "add_error_key": true},
+			input:       common.MapStr{"payload": "not base64!"},
+			output: common.MapStr{
+				"payload": "not base64!",
+				"error": common.MapStr{
+					"message": "failed to decode field payload: illegal base64 data at input byte 3",
+					"type":    "msgpack",
+				},
+			},
+			error: true,
+		},
+		{
+			description: "payload is not a map",
+			config:      common.MapStr{"fields": []string{"payload"}},
+			input:       common.MapStr{"payload": base64.StdEncoding.EncodeToString(encodeMsgpack(t, "text"))},
+			output:      common.MapStr{"payload": base64.StdEncoding.EncodeToString(encodeMsgpack(t, "text"))},
+			error:       true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.description, func(t *testing.T) {
+			p, err := NewDecodeMsgpack(common.MustNewConfigFrom(test.config))
+			require.NoError(t, err)
+
+			evt, err := p.Run(&beat.Event{Fields: test.input})
+			if test.error {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.output, evt.Fields)
+		})
+	}
+}
@@ -0,0 +1,169 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/paths"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	"github.com/elastic/beats/v7/libbeat/processors/checks"
+)
+
+type decodeProtobufConfig struct {
+	Binary decodeBinaryConfig `config:",inline"`
+
+	// DescriptorSet is the path to a FileDescriptorSet, as written by
+	// protoc --descriptor_set_out --include_imports.
+	DescriptorSet string `config:"descriptor_set" validate:"required"`
+
+	// MessageType is the fully qualified name of the message to decode.
+	MessageType string `config:"message_type" validate:"required"`
+
+	// UseJSONNames selects the JSON names of the fields (lowerCamelCase)
+	// instead of the names used in the .proto file.
+	UseJSONNames bool `config:"use_json_names"`
+}
+
+func init() {
+	processors.RegisterPlugin("decode_protobuf",
+		checks.ConfigChecked(NewDecodeProtobuf,
+			checks.RequireFields("fields", "descriptor_set", "message_type"),
+			checks.AllowedFields("fields", "target", "encoding", "overwrite_keys", "add_error_key",
+				"descriptor_set", "message_type", "use_json_names", "when")))
+}
+
+// NewDecodeProtobuf constructs a new decode_protobuf processor.
+func NewDecodeProtobuf(c *common.Config) (processors.Processor, error) {
+	var config decodeProtobufConfig
+	if err := c.Unpack(&config); err != nil {
+		return nil, errors.Wrap(err, "fail to unpack the decode_protobuf configuration")
+	}
+
+	desc, err := loadMessageDescriptor(paths.Resolve(paths.Config, config.DescriptorSet), config.MessageType)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load protobuf descriptor")
+	}
+
+	return &decodeBinaryFields{
+		decodeBinaryConfig: config.Binary,
+		name:               "protobuf",
+		decode: func(data []byte) (map[string]interface{}, error) {
+			msg := dynamicpb.NewMessage(desc)
+			if err := proto.Unmarshal(data, msg); err != nil {
+				return nil, err
+			}
+			return protoMessageToMap(msg, config.UseJSONNames), nil
+		},
+		logger: logp.NewLogger("decode_protobuf"),
+	}, nil
+}
+
+// loadMessageDescriptor reads a serialized FileDescriptorSet and returns the
+// descriptor of the given message type.
+func loadMessageDescriptor(path, messageType string) (protoreflect.MessageDescriptor, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var set descriptorpb.FileDescriptorSet
+	if err = proto.Unmarshal(data, &set); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse descriptor set %v", path)
+	}
+	files, err := protodesc.NewFiles(&set)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid descriptor set %v", path)
+	}
+
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(messageType))
+	if err != nil {
+		return nil, errors.Wrapf(err, "message type %v not found in %v", messageType, path)
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, errors.Errorf("%v is not a message type", messageType)
+	}
+	return md, nil
+}
+
+// protoMessageToMap converts the populated fields of a message into a map.
+func protoMessageToMap(msg protoreflect.Message, jsonNames bool) map[string]interface{} {
+	out := map[string]interface{}{}
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		name := string(fd.Name())
+		if jsonNames {
+			name = fd.JSONName()
+		}
+
+		switch {
+		case fd.IsList():
+			list := v.List()
+			values := make([]interface{}, list.Len())
+			for i := 0; i < list.Len(); i++ {
+				values[i] = protoValue(fd, list.Get(i), jsonNames)
+			}
+			out[name] = values
+		case fd.IsMap():
+			m := common.MapStr{}
+			v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				m[k.String()] = protoValue(fd.MapValue(), mv, jsonNames)
+				return true
+			})
+			out[name] = m
+		default:
+			out[name] = protoValue(fd, v, jsonNames)
+		}
+		return true
+	})
+	return out
+}
+
+func protoValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, jsonNames bool) interface{} {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		msg := v.Message()
+		if fd.Message().FullName() == "google.protobuf.Timestamp" {
+			fields := msg.Descriptor().Fields()
+			seconds := msg.Get(fields.ByName("seconds")).Int()
+			nanos := msg.Get(fields.ByName("nanos")).Int()
+			return time.Unix(seconds, nanos).UTC()
+		}
+		return common.MapStr(protoMessageToMap(msg, jsonNames))
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return int32(v.Enum())
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.Bytes())
+	default:
+		return v.Interface()
+	}
+}
@@ -0,0 +1,199 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// writeTestDescriptorSet writes a descriptor set equivalent to:
+//
+//	syntax = "proto3";
+//	package test;
+//	import "google/protobuf/timestamp.proto";
+//	message Request {
+//	  enum Method { GET = 0; POST = 1; }
+//	  string user_name = 1;
+//	  int64 bytes = 2;
+//	  Method method = 3;
+//	  repeated string tags = 4;
+//	  map<string, string> labels = 5;
+//	  google.protobuf.Timestamp created = 6;
+//	}
+func writeTestDescriptorSet(t *testing.T, dir string) (string, protoreflect.MessageDescriptor) {
+	t.Helper()
+
+	label := func(l descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto_Label { return &l }
+	typ := func(t descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto_Type { return &t }
+	optional := label(descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL)
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("test.proto"),
+		Package:    proto.String("test"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/timestamp.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Request"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: proto.String("user_name"), JsonName: proto.String("userName"), Number: proto.Int32(1), Label: optional, Type: typ(descriptorpb.FieldDescriptorProto_TYPE_STRING)},
+				{Name: proto.String("bytes"), JsonName: proto.String("bytes"), Number: proto.Int32(2), Label: optional, Type: typ(descriptorpb.FieldDescriptorProto_TYPE_INT64)},
+				{Name: proto.String("method"), JsonName: proto.String("method"), Number: proto.Int32(3), Label: optional, Type: typ(descriptorpb.FieldDescriptorProto_TYPE_ENUM), TypeName: proto.String(".test.Request.Method")},
+				{Name: proto.String("tags"), JsonName: proto.String("tags"), Number: proto.Int32(4), Label: label(descriptorpb.FieldDescriptorProto_LABEL_REPEATED), Type: typ(descriptorpb.FieldDescriptorProto_TYPE_STRING)},
+				{Name: proto.String("labels"), JsonName: proto.String("labels"), Number: proto.Int32(5), Label: label(descriptorpb.FieldDescriptorProto_LABEL_REPEATED), Type: typ(descriptorpb.FieldDescriptorProto_TYPE_MESSAGE), TypeName: proto.String(".test.Request.LabelsEntry")},
+				{Name: proto.String("created"), JsonName: proto.String("created"), Number: proto.Int32(6), Label: optional, Type: typ(descriptorpb.FieldDescriptorProto_TYPE_MESSAGE), TypeName: proto.String(".google.protobuf.Timestamp")},
+			},
+			NestedType: []*descriptorpb.DescriptorProto{{
+				Name: proto.String("LabelsEntry"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: proto.String("key"), JsonName: proto.String("key"), Number: proto.Int32(1), Label: optional, Type: typ(descriptorpb.FieldDescriptorProto_TYPE_STRING)},
+					{Name: proto.String("value"), JsonName: proto.String("value"), Number: proto.Int32(2), Label: optional, Type: typ(descriptorpb.FieldDescriptorProto_TYPE_STRING)},
+				},
+				Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+			}},
+			EnumType: []*descriptorpb.EnumDescriptorProto{{
+				Name: proto.String("Method"),
+				Value: []*descriptorpb.EnumValueDescriptorProto{
+					{Name: proto.String("GET"), Number: proto.Int32(0)},
+					{Name: proto.String("POST"), Number: proto.Int32(1)},
+				},
+			}},
+		}},
+	}
+
+	set := &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{
+		protodesc.ToFileDescriptorProto(timestamppb.File_google_protobuf_timestamp_proto),
+		file,
+	}}
+	files, err := protodesc.NewFiles(set)
+	require.NoError(t, err)
+	desc, err := files.FindDescriptorByName("test.Request")
+	require.NoError(t, err)
+
+	data, err := proto.Marshal(set)
+	require.NoError(t, err)
+	path := filepath.Join(dir, "test.desc")
+	require.NoError(t, ioutil.WriteFile(path, data, 0644))
+	return path, desc.(protoreflect.MessageDescriptor)
+}
+
+func TestDecodeProtobuf(t *testing.T) {
+	dir, err := ioutil.TempDir("", "decode_protobuf")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path, desc := writeTestDescriptorSet(t, dir)
+
+	created := time.Date(2021, 6, 1, 12, 30, 0, 5000, time.UTC)
+	msg := dynamicpb.NewMessage(desc)
+	fields := desc.Fields()
+	msg.Set(fields.ByName("user_name"), protoreflect.ValueOfString("alice"))
+	msg.Set(fields.ByName("bytes"), protoreflect.ValueOfInt64(1024))
+	msg.Set(fields.ByName("method"), protoreflect.ValueOfEnum(1))
+	tags := msg.Mutable(fields.ByName("tags")).List()
+	tags.Append(protoreflect.ValueOfString("a"))
+	tags.Append(protoreflect.ValueOfString("b"))
+	labels := msg.Mutable(fields.ByName("labels")).Map()
+	labels.Set(protoreflect.ValueOfString("env").MapKey(), protoreflect.ValueOfString("prod"))
+	ts := msg.Mutable(fields.ByName("created")).Message()
+	ts.Set(ts.Descriptor().Fields().ByName("seconds"), protoreflect.ValueOfInt64(created.Unix()))
+	ts.Set(ts.Descriptor().Fields().ByName("nanos"), protoreflect.ValueOfInt32(int32(created.Nanosecond())))
+	payload, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	encoded := base64.StdEncoding.EncodeToString(payload)
+
+	t.Run("proto names", func(t *testing.T) {
+		p, err := NewDecodeProtobuf(common.MustNewConfigFrom(common.MapStr{
+			"fields":         []string{"payload"},
+			"descriptor_set": path,
+			"message_type":   "test.Request",
+			"target":         "request",
+		}))
+		require.NoError(t, err)
+
+		evt, err := p.Run(&beat.Event{Fields: common.MapStr{"payload": encoded}})
+		require.NoError(t, err)
+		assert.Equal(t, common.MapStr{
+			"payload": encoded,
+			"request": common.MapStr{
+				"user_name": "alice",
+				"bytes":     int64(1024),
+				"method":    "POST",
+				"tags":      []interface{}{"a", "b"},
+				"labels":    common.MapStr{"env": "prod"},
+				"created":   created,
+			},
+		}, evt.Fields)
+	})
+
+	t.Run("json names to root", func(t *testing.T) {
+		p, err := NewDecodeProtobuf(common.MustNewConfigFrom(common.MapStr{
+			"fields":         []string{"payload"},
+			"descriptor_set": path,
+			"message_type":   "test.Request",
+			"target":         "",
+			"use_json_names": true,
+		}))
+		require.NoError(t, err)
+
+		evt, err := p.Run(&beat.Event{Fields: common.MapStr{"payload": encoded}})
+		require.NoError(t, err)
+		v, _ := evt.GetValue("userName")
+		assert.Equal(t, "alice", v)
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		p, err := NewDecodeProtobuf(common.MustNewConfigFrom(common.MapStr{
+			"fields":         []string{"payload"},
+			"descriptor_set": path,
+			"message_type":   "test.Request",
+			"add_error_key":  true,
+		}))
+		require.NoError(t, err)
+
+		evt, err := p.Run(&beat.Event{Fields: common.MapStr{"payload": base64.StdEncoding.EncodeToString([]byte{0xff, 0xff})}})
+		assert.Error(t, err)
+		errType, _ := evt.GetValue("error.type")
+		assert.Equal(t, "protobuf", errType)
+	})
+
+	t.Run("unknown message type", func(t *testing.T) {
+		_, err := NewDecodeProtobuf(common.MustNewConfigFrom(common.MapStr{
+			"fields":         []string{"payload"},
+			"descriptor_set": path,
+			"message_type":   "test.Response",
+		}))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,143 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package lookup
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+type config struct {
+	// File is the path of the CSV or JSON file holding the lookup table.
+	File string `config:"file" validate:"required"`
+
+	// Format of the file, csv or json. It is derived from the file extension
+	// when not set.
+	Format string `config:"format"`
+
+	// Separator is the column separator used for CSV files.
+	Separator string `config:"separator"`
+
+	// Keys lists the event fields matched against the key columns of the table.
+	Keys []keyConfig `config:"keys" validate:"required"`
+
+	// Columns maps table columns to the event fields they are copied to.
+	Columns common.MapStr `config:"columns" validate:"required"`
+
+	// ReloadPeriod is how often the file is checked for changes. Reloading is
+	// disabled when set to 0.
+	ReloadPeriod time.Duration `config:"reload_period" validate:"min=0"`
+
+	IgnoreMissing bool   `config:"ignore_missing"`
+	OverwriteKeys bool   `config:"overwrite_keys"`
+	TagOnMiss     string `config:"tag_on_miss"`
+	ID            string `config:"id"`
+
+	separator rune
+	columns   map[string]string
+}
+
+type keyConfig struct {
+	// Field is the event field holding the value to look up.
+	Field string `config:"field" validate:"required"`
+
+	// Column is the table column the value is matched against.
+	Column string `config:"column" validate:"required"`
+
+	// Match selects how column values are compared with the event value.
+	Match matchType `config:"match"`
+}
+
+type matchType uint8
+
+const (
+	matchExact matchType = iota
+	matchCIDR
+	matchWildcard
+)
+
+var matchTypeNames = map[matchType]string{
+	matchExact:    "exact",
+	matchCIDR:     "cidr",
+	matchWildcard: "wildcard",
+}
+
+func (m matchType) String() string {
+	return matchTypeNames[m]
+}
+
+func (m *matchType) Unpack(v string) error {
+	switch strings.ToLower(v) {
+	case "", "exact":
+		*m = matchExact
+	case "cidr":
+		*m = matchCIDR
+	case "wildcard":
+		*m = matchWildcard
+	default:
+		return errors.Errorf("invalid match type '%v' (valid values are: exact, cidr, wildcard)", v)
+	}
+	return nil
+}
+
+func defaultConfig() config {
+	return config{
+		Separator:    ",",
+		ReloadPeriod: 10 * time.Second,
+	}
+}
+
+func (c *config) Validate() error {
+	c.Format = strings.ToLower(c.Format)
+	if c.Format == "" {
+		c.Format = strings.TrimPrefix(strings.ToLower(filepath.Ext(c.File)), ".")
+	}
+	switch c.Format {
+	case "csv", "json":
+	default:
+		return errors.Errorf("unsupported lookup file format '%v' (valid values are: csv, json)", c.Format)
+	}
+
+	runes := []rune(c.Separator)
+	if len(runes) != 1 {
+		return errors.Errorf("separator must be a single character, got '%v'", c.Separator)
+	}
+	c.separator = runes[0]
+
+	if len(c.Keys) == 0 {
+		return errors.New("at least one key must be configured")
+	}
+
+	c.columns = map[string]string{}
+	for column, v := range c.Columns.Flatten() {
+		target, ok := v.(string)
+		if !ok {
+			return errors.Errorf("target field for column %v must be a string but got %T", column, v)
+		}
+		c.columns[column] = target
+	}
+	if len(c.columns) == 0 {
+		return errors.New("at least one column must be configured")
+	}
+	return nil
+}
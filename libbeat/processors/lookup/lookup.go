@@ -0,0 +1,211 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package lookup
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/processors"
+)
+
+const (
+	procName = "lookup"
+	logName  = "processor." + procName
+)
+
+func init() {
+	processors.RegisterPlugin(procName, New)
+}
+
+type processor struct {
+	config
+	log *logp.Logger
+
+	mu    sync.RWMutex
+	table *table
+
+	// modTime and size identify the version of the file currently loaded.
+	modTime time.Time
+	size    int64
+
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// New constructs a new lookup processor.
+func New(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrap(err, "fail to unpack the "+procName+" processor configuration")
+	}
+
+	return newLookup(c)
+}
+
+func newLookup(c config) (*processor, error) {
+	log := logp.NewLogger(logName)
+	if c.ID != "" {
+		log = log.With("instance_id", c.ID)
+	}
+
+	p := &processor{config: c, log: log, done: make(chan struct{})}
+	if _, err := p.reload(); err != nil {
+		return nil, errors.Wrapf(err, "failed to load lookup file %v", c.File)
+	}
+
+	if c.ReloadPeriod > 0 {
+		p.wg.Add(1)
+		go p.watch()
+	}
+	return p, nil
+}
+
+// watch periodically checks the lookup file for changes and swaps in the new
+// table. Events keep being enriched with the previous table while the file is
+// loading or when it fails to load.
+func (p *processor) watch() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.ReloadPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+			reloaded, err := p.reload()
+			if err != nil {
+				p.log.Errorf("Failed to reload lookup file %v, keeping previous version: %v", p.File, err)
+			} else if reloaded {
+				p.log.Infof("Reloaded lookup file %v", p.File)
+			}
+		}
+	}
+}
+
+// reload loads the lookup file if it has changed since the last load.
+func (p *processor) reload() (bool, error) {
+	info, err := os.Stat(p.File)
+	if err != nil {
+		return false, err
+	}
+	if info.ModTime().Equal(p.modTime) && info.Size() == p.size {
+		return false, nil
+	}
+
+	t, err := loadTable(&p.config)
+	if err != nil {
+		return false, err
+	}
+	p.modTime, p.size = info.ModTime(), info.Size()
+
+	p.mu.Lock()
+	p.table = t
+	p.mu.Unlock()
+
+	p.log.Debugf("Loaded %d rows from lookup file %v", t.len(), p.File)
+	return true, nil
+}
+
+func (p *processor) currentTable() *table {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.table
+}
+
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	values := make([]string, len(p.Keys))
+	for i, k := range p.Keys {
+		v, err := event.GetValue(k.Field)
+		if err != nil {
+			if p.IgnoreMissing && errors.Cause(err) == common.ErrKeyNotFound {
+				return event, nil
+			}
+			return event, errors.Wrapf(err, "lookup key field [%v] not found", k.Field)
+		}
+		values[i] = toString(v)
+	}
+
+	r := p.currentTable().find(values)
+	if r == nil {
+		if p.TagOnMiss != "" {
+			if err := common.AddTags(event.Fields, []string{p.TagOnMiss}); err != nil {
+				return event, err
+			}
+		}
+		return event, nil
+	}
+
+	// Check all targets before writing any, so failed events are left unchanged.
+	if !p.OverwriteKeys {
+		for column, target := range p.columns {
+			if _, found := r.values[column]; !found {
+				continue
+			}
+			if _, err := event.GetValue(target); err == nil {
+				return event, errors.Errorf("target field [%v] already exists and overwrite_keys is false", target)
+			}
+		}
+	}
+
+	for column, target := range p.columns {
+		v, found := r.values[column]
+		if !found {
+			continue
+		}
+		if n, ok := v.(json.Number); ok {
+			v = numberValue(n)
+		}
+		if _, err := event.PutValue(target, v); err != nil {
+			return event, errors.Wrapf(err, "failed to write column %v to target field [%v]", column, target)
+		}
+	}
+	return event, nil
+}
+
+// Close stops reloading the lookup file.
+func (p *processor) Close() error {
+	p.closeOnce.Do(func() { close(p.done) })
+	p.wg.Wait()
+	return nil
+}
+
+func (p *processor) String() string {
+	json, _ := json.Marshal(p.config)
+	return procName + "=" + string(json)
+}
+
+func numberValue(n json.Number) interface{} {
+	if i, err := n.Int64(); err == nil {
+		return i
+	}
+	if f, err := n.Float64(); err == nil {
+		return f
+	}
+	return n.String()
+}
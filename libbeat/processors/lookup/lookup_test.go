@@ -0,0 +1,259 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package lookup
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func newTestLookup(t *testing.T, cfg common.MapStr) *processor {
+	t.Helper()
+
+	p, err := New(common.MustNewConfigFrom(cfg))
+	require.NoError(t, err)
+	t.Cleanup(func() { p.(*processor).Close() })
+	return p.(*processor)
+}
+
+func TestLookupCSV(t *testing.T) {
+	p := newTestLookup(t, common.MapStr{
+		"file": "testdata/services.csv",
+		"keys": []common.MapStr{
+			{"field": "host.name", "column": "host", "match": "wildcard"},
+		},
+		"columns": common.MapStr{
+			"owner":       "service.owner",
+			"criticality": "asset.criticality",
+		},
+		"tag_on_miss": "lookup_miss",
+	})
+
+	var testCases = []struct {
+		host     string
+		expected common.MapStr
+	}{
+		{
+			host: "web-17",
+			expected: common.MapStr{
+				"host":    common.MapStr{"name": "web-17"},
+				"service": common.MapStr{"owner": "team-web"},
+				"asset":   common.MapStr{"criticality": "high"},
+			},
+		},
+		{
+			host: "db-01",
+			expected: common.MapStr{
+				"host":    common.MapStr{"name": "db-01"},
+				"service": common.MapStr{"owner": "team-data"},
+				"asset":   common.MapStr{"criticality": "critical"},
+			},
+		},
+		{
+			host: "db-02",
+			expected: common.MapStr{
+				"host": common.MapStr{"name": "db-02"},
+				"tags": []string{"lookup_miss"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.host, func(t *testing.T) {
+			evt, err := p.Run(&beat.Event{Fields: common.MapStr{"host": common.MapStr{"name": tc.host}}})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, evt.Fields)
+		})
+	}
+}
+
+func TestLookupJSONCIDR(t *testing.T) {
+	p := newTestLookup(t, common.MapStr{
+		"file": "testdata/networks.json",
+		"keys": []common.MapStr{
+			{"field": "source.ip", "column": "network", "match": "cidr"},
+		},
+		"columns": common.MapStr{
+			"zone": "network.zone",
+			"vlan": "network.vlan.id",
+		},
+	})
+
+	evt, err := p.Run(&beat.Event{Fields: common.MapStr{"source": common.MapStr{"ip": "10.1.2.3"}}})
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{
+		"source":  common.MapStr{"ip": "10.1.2.3"},
+		"network": common.MapStr{"zone": "internal", "vlan": common.MapStr{"id": int64(10)}},
+	}, evt.Fields)
+
+	evt, err = p.Run(&beat.Event{Fields: common.MapStr{"source": common.MapStr{"ip": "192.168.1.10"}}})
+	require.NoError(t, err)
+	zone, _ := evt.GetValue("network.zone")
+	assert.Equal(t, "dmz", zone)
+
+	evt, err = p.Run(&beat.Event{Fields: common.MapStr{"source": common.MapStr{"ip": "192.168.1.11"}}})
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{"source": common.MapStr{"ip": "192.168.1.11"}}, evt.Fields)
+}
+
+func TestLookupMultipleKeys(t *testing.T) {
+	tbl, err := newTable([]keyConfig{
+		{Column: "env", Match: matchExact},
+		{Column: "net", Match: matchCIDR},
+	}, []map[string]interface{}{
+		{"env": "prod", "net": "10.0.0.0/16", "site": "a"},
+		{"env": "prod", "net": "10.1.0.0/16", "site": "b"},
+		{"env": "dev", "net": "10.0.0.0/8", "site": "c"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "b", tbl.find([]string{"prod", "10.1.4.4"}).values["site"])
+	assert.Equal(t, "c", tbl.find([]string{"dev", "10.1.4.4"}).values["site"])
+	assert.Nil(t, tbl.find([]string{"prod", "10.2.4.4"}))
+	assert.Nil(t, tbl.find([]string{"test", "10.0.0.1"}))
+}
+
+func TestLookupOverwriteKeys(t *testing.T) {
+	cfg := common.MapStr{
+		"file":    "testdata/services.csv",
+		"keys":    []common.MapStr{{"field": "host.name", "column": "host"}},
+		"columns": common.MapStr{"owner": "service.owner"},
+	}
+	event := func() *beat.Event {
+		return &beat.Event{Fields: common.MapStr{
+			"host":    common.MapStr{"name": "db-01"},
+			"service": common.MapStr{"owner": "nobody"},
+		}}
+	}
+
+	_, err := newTestLookup(t, cfg).Run(event())
+	assert.Error(t, err)
+
+	// Failed events are left unchanged, whatever the order columns are written in
+	cfg["columns"] = common.MapStr{"owner": "service.owner", "criticality": "asset.criticality"}
+	p := newTestLookup(t, cfg)
+	for i := 0; i < 20; i++ {
+		evt, err := p.Run(event())
+		assert.Error(t, err)
+		assert.Equal(t, event().Fields, evt.Fields)
+	}
+
+	cfg["overwrite_keys"] = true
+	evt, err := newTestLookup(t, cfg).Run(event())
+	require.NoError(t, err)
+	owner, _ := evt.GetValue("service.owner")
+	assert.Equal(t, "team-data", owner)
+}
+
+func TestLookupMissingField(t *testing.T) {
+	cfg := common.MapStr{
+		"file":    "testdata/services.csv",
+		"keys":    []common.MapStr{{"field": "host.name", "column": "host"}},
+		"columns": common.MapStr{"owner": "service.owner"},
+	}
+
+	_, err := newTestLookup(t, cfg).Run(&beat.Event{Fields: common.MapStr{}})
+	assert.Error(t, err)
+
+	cfg["ignore_missing"] = true
+	_, err = newTestLookup(t, cfg).Run(&beat.Event{Fields: common.MapStr{}})
+	assert.NoError(t, err)
+}
+
+func TestLookupReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lookup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "owners.csv")
+	require.NoError(t, ioutil.WriteFile(file, []byte("host,owner\nweb-01,team-a\n"), 0644))
+
+	p := newTestLookup(t, common.MapStr{
+		"file":          file,
+		"keys":          []common.MapStr{{"field": "host.name", "column": "host"}},
+		"columns":       common.MapStr{"owner": "service.owner"},
+		"reload_period": "10ms",
+	})
+
+	owner := func() interface{} {
+		evt, err := p.Run(&beat.Event{Fields: common.MapStr{"host": common.MapStr{"name": "web-01"}}})
+		require.NoError(t, err)
+		v, _ := evt.GetValue("service.owner")
+		return v
+	}
+	assert.Equal(t, "team-a", owner())
+
+	// An invalid file keeps the previous table.
+	require.NoError(t, ioutil.WriteFile(file, []byte("host,owner\nweb-01\n"), 0644))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, "team-a", owner())
+
+	require.NoError(t, ioutil.WriteFile(file, []byte("host,owner\nweb-01,team-b\n"), 0644))
+	assert.Eventually(t, func() bool { return owner() == "team-b" }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestLookupCloseTwice(t *testing.T) {
+	p := newTestLookup(t, common.MapStr{
+		"file":          "testdata/services.csv",
+		"keys":          []common.MapStr{{"field": "host.name", "column": "host"}},
+		"columns":       common.MapStr{"owner": "service.owner"},
+		"reload_period": "10ms",
+	})
+
+	require.NoError(t, p.Close())
+	require.NoError(t, p.Close())
+}
+
+func TestLookupInvalidConfig(t *testing.T) {
+	for name, cfg := range map[string]common.MapStr{
+		"unknown format": {
+			"file":    "testdata/services.txt",
+			"keys":    []common.MapStr{{"field": "host.name", "column": "host"}},
+			"columns": common.MapStr{"owner": "service.owner"},
+		},
+		"unknown match": {
+			"file":    "testdata/services.csv",
+			"keys":    []common.MapStr{{"field": "host.name", "column": "host", "match": "fuzzy"}},
+			"columns": common.MapStr{"owner": "service.owner"},
+		},
+		"missing key column": {
+			"file":    "testdata/services.csv",
+			"keys":    []common.MapStr{{"field": "host.name", "column": "hostname"}},
+			"columns": common.MapStr{"owner": "service.owner"},
+		},
+		"invalid cidr": {
+			"file":    "testdata/services.csv",
+			"keys":    []common.MapStr{{"field": "host.name", "column": "host", "match": "cidr"}},
+			"columns": common.MapStr{"owner": "service.owner"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(common.MustNewConfigFrom(cfg))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,228 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package lookup
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// table is an immutable, indexed view of the rows of a lookup file.
+type table struct {
+	// index holds the rows by the value of the first exact match key. It is
+	// nil when no key uses exact matching.
+	index    map[string][]*row
+	indexKey int
+	rows     []*row
+}
+
+type row struct {
+	keys   []keyMatcher
+	values map[string]interface{}
+}
+
+type keyMatcher interface {
+	Match(value string) bool
+}
+
+type exactMatcher string
+
+func (m exactMatcher) Match(value string) bool { return string(m) == value }
+
+type cidrMatcher struct{ net *net.IPNet }
+
+func (m cidrMatcher) Match(value string) bool {
+	ip := net.ParseIP(value)
+	return ip != nil && m.net.Contains(ip)
+}
+
+type wildcardMatcher struct{ re *regexp.Regexp }
+
+func (m wildcardMatcher) Match(value string) bool { return m.re.MatchString(value) }
+
+// loadTable reads and indexes the lookup file configured in c.
+func loadTable(c *config) (*table, error) {
+	data, err := ioutil.ReadFile(c.File)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []map[string]interface{}
+	switch c.Format {
+	case "csv":
+		rows, err := common.ReadCSVWithHeader(bytes.NewReader(data), c.separator)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse CSV file %v", c.File)
+		}
+		records = make([]map[string]interface{}, len(rows))
+		for i, r := range rows {
+			records[i] = make(map[string]interface{}, len(r))
+			for k, v := range r {
+				records[i][k] = v
+			}
+		}
+	case "json":
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		if err := dec.Decode(&records); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse JSON file %v, expected an array of objects", c.File)
+		}
+	}
+
+	return newTable(c.Keys, records)
+}
+
+func newTable(keys []keyConfig, records []map[string]interface{}) (*table, error) {
+	indexKey := -1
+	for i, k := range keys {
+		if k.Match == matchExact {
+			indexKey = i
+			break
+		}
+	}
+
+	t := &table{indexKey: indexKey}
+	if indexKey >= 0 {
+		t.index = map[string][]*row{}
+	}
+	for n, record := range records {
+		r := &row{values: record, keys: make([]keyMatcher, len(keys))}
+		for i, k := range keys {
+			v, found := record[k.Column]
+			if !found || v == nil {
+				return nil, errors.Errorf("row %d has no value for key column %v", n+1, k.Column)
+			}
+			m, err := newKeyMatcher(k.Match, toString(v))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid value in row %d for key column %v", n+1, k.Column)
+			}
+			r.keys[i] = m
+		}
+
+		if indexKey >= 0 {
+			key := string(r.keys[indexKey].(exactMatcher))
+			t.index[key] = append(t.index[key], r)
+		} else {
+			t.rows = append(t.rows, r)
+		}
+	}
+	return t, nil
+}
+
+func newKeyMatcher(m matchType, value string) (keyMatcher, error) {
+	switch m {
+	case matchCIDR:
+		if !strings.Contains(value, "/") {
+			ip := net.ParseIP(value)
+			if ip == nil {
+				return nil, errors.Errorf("'%v' is not an IP address or CIDR", value)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			return cidrMatcher{&net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}}, nil
+		}
+		_, ipNet, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, err
+		}
+		return cidrMatcher{ipNet}, nil
+	case matchWildcard:
+		re, err := compileWildcard(value)
+		if err != nil {
+			return nil, err
+		}
+		return wildcardMatcher{re}, nil
+	default:
+		return exactMatcher(value), nil
+	}
+}
+
+// compileWildcard converts a pattern where '*' matches any sequence of
+// characters and '?' matches a single character into an anchored regexp.
+func compileWildcard(pattern string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.Compile(sb.String())
+}
+
+// find returns the first row whose keys all match the given values.
+func (t *table) find(values []string) *row {
+	candidates := t.rows
+	if t.index != nil {
+		candidates = t.index[values[t.indexKey]]
+	}
+	for _, r := range candidates {
+		if r.matches(values) {
+			return r
+		}
+	}
+	return nil
+}
+
+func (r *row) matches(values []string) bool {
+	for i, m := range r.keys {
+		if !m.Match(values[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *table) len() int {
+	if t.index == nil {
+		return len(t.rows)
+	}
+	n := 0
+	for _, rows := range t.index {
+		n += len(rows)
+	}
+	return n
+}
+
+func toString(v interface{}) string {
+	switch s := v.(type) {
+	case string:
+		return s
+	case json.Number:
+		return s.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
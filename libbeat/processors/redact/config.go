@@ -0,0 +1,120 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redact
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type config struct {
+	// Fields lists the event fields that are scanned for sensitive data.
+	Fields []string `config:"fields" validate:"required"`
+
+	// Detectors lists the names of the built-in detectors to apply.
+	Detectors []string `config:"detectors"`
+
+	// Patterns defines custom detectors based on regular expressions.
+	Patterns []patternConfig `config:"patterns"`
+
+	// Mode defines how detected values are redacted.
+	Mode mode `config:"mode"`
+
+	// Replacement is the text used in place of detected values by the mask mode.
+	Replacement string `config:"replacement"`
+
+	// HashKey is the secret used by the hash mode. It is intended to be
+	// referenced from the keystore, e.g. hash_key: ${REDACT_HASH_KEY}.
+	HashKey string `config:"hash_key"`
+
+	IgnoreMissing bool   `config:"ignore_missing"`
+	Tag           string `config:"tag"`
+	ID            string `config:"id"`
+}
+
+type patternConfig struct {
+	Name    string `config:"name" validate:"required"`
+	Pattern string `config:"pattern" validate:"required"`
+}
+
+type mode uint8
+
+const (
+	modeMask mode = iota
+	modeHash
+	modeDrop
+)
+
+var modeNames = map[mode]string{
+	modeMask: "mask",
+	modeHash: "hash",
+	modeDrop: "drop",
+}
+
+func (m mode) String() string {
+	return modeNames[m]
+}
+
+func (m mode) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *mode) Unpack(v string) error {
+	switch strings.ToLower(v) {
+	case "", "mask":
+		*m = modeMask
+	case "hash":
+		*m = modeHash
+	case "drop":
+		*m = modeDrop
+	default:
+		return errors.Errorf("invalid redact mode '%v' (valid values are: mask, hash, drop)", v)
+	}
+	return nil
+}
+
+func defaultConfig() config {
+	return config{
+		Replacement: "[REDACTED]",
+	}
+}
+
+func (c *config) Validate() error {
+	if len(c.Detectors) == 0 && len(c.Patterns) == 0 {
+		return errors.New("at least one detector or pattern must be configured")
+	}
+	for _, name := range c.Detectors {
+		if _, found := builtinDetectors[name]; !found {
+			return errors.Errorf("unknown detector '%v' (valid values are: %v)", name, strings.Join(builtinDetectorNames(), ", "))
+		}
+	}
+	for _, p := range c.Patterns {
+		if _, found := builtinDetectors[p.Name]; found {
+			return errors.Errorf("pattern name '%v' conflicts with a built-in detector", p.Name)
+		}
+		if _, err := regexp.Compile(p.Pattern); err != nil {
+			return errors.Wrapf(err, "invalid pattern '%v'", p.Name)
+		}
+	}
+	if c.Mode == modeHash && c.HashKey == "" {
+		return errors.New("hash_key is required when mode is hash")
+	}
+	return nil
+}
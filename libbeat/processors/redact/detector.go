@@ -0,0 +1,110 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redact
+
+import (
+	"net"
+	"regexp"
+	"sort"
+)
+
+// detector finds sensitive values in a string.
+type detector struct {
+	name string
+	re   *regexp.Regexp
+
+	// group is the index of the capture group holding the sensitive value.
+	// The whole match is used when it is 0.
+	group int
+
+	// valid, if set, filters out false positives of the regular expression.
+	valid func(string) bool
+}
+
+var builtinDetectors = map[string]detector{
+	"credit_card": {
+		re:    regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`),
+		valid: luhnValid,
+	},
+	"email": {
+		re: regexp.MustCompile(`\b[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}\b`),
+	},
+	"ip": {
+		re:    regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b|\b(?:[0-9A-Fa-f]{0,4}:){2,7}[0-9A-Fa-f]{0,4}\b`),
+		valid: func(s string) bool { return net.ParseIP(s) != nil },
+	},
+	"jwt": {
+		re: regexp.MustCompile(`\beyJ[A-Za-z0-9_\-]+\.eyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]*`),
+	},
+	"bearer_token": {
+		re:    regexp.MustCompile(`(?i)\bbearer\s+([A-Za-z0-9\-._~+/]+=*)`),
+		group: 1,
+	},
+	"aws_access_key": {
+		re: regexp.MustCompile(`\b(?:AKIA|ASIA|AGPA|AIDA|AROA|ANPA|ANVA|AIPA)[0-9A-Z]{16}\b`),
+	},
+}
+
+func builtinDetectorNames() []string {
+	names := make([]string, 0, len(builtinDetectors))
+	for name := range builtinDetectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// find returns the start and end offsets of the sensitive values in s.
+func (d *detector) find(s string) [][2]int {
+	var found [][2]int
+	for _, m := range d.re.FindAllStringSubmatchIndex(s, -1) {
+		start, end := m[2*d.group], m[2*d.group+1]
+		if start < 0 {
+			continue
+		}
+		if d.valid != nil && !d.valid(s[start:end]) {
+			continue
+		}
+		found = append(found, [2]int{start, end})
+	}
+	return found
+}
+
+// luhnValid reports whether the digits in s pass the Luhn checksum used by
+// payment card numbers. Separators are ignored.
+func luhnValid(s string) bool {
+	var sum, n int
+	double := false
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c == ' ' || c == '-' {
+			continue
+		}
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+		n++
+	}
+	return n >= 13 && n <= 19 && sum%10 == 0
+}
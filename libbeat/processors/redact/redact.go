@@ -0,0 +1,244 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redact
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"regexp"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/atomic"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+	"github.com/elastic/beats/v7/libbeat/processors"
+)
+
+const (
+	procName = "redact"
+	logName  = "processor." + procName
+)
+
+// instanceID is used to tell instances apart in the logs.
+var instanceID = atomic.MakeUint32(0)
+
+func init() {
+	processors.RegisterPlugin(procName, New)
+}
+
+type processor struct {
+	config
+	log       *logp.Logger
+	detectors []detector
+
+	// redactions counts the redacted values per detector name.
+	redactions map[string]*monitoring.Int
+}
+
+// New constructs a new redact processor.
+func New(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrap(err, "fail to unpack the "+procName+" processor configuration")
+	}
+
+	id := int(instanceID.Inc())
+	return newRedact(c, id, getRegistry(c.ID, monitoring.Default))
+}
+
+// getRegistry returns the monitoring registry for the processor with the given
+// id. Processors without an id are not reported.
+func getRegistry(id string, reg *monitoring.Registry) *monitoring.Registry {
+	if id == "" {
+		return monitoring.NewRegistry()
+	}
+
+	namespace := logName + "." + id
+	processorReg := reg.GetRegistry(namespace)
+	if processorReg != nil {
+		// If a config is reloaded then the namespace could already exist.
+		processorReg.Clear()
+		return processorReg
+	}
+	return reg.NewRegistry(namespace, monitoring.DoNotReport)
+}
+
+func newRedact(c config, id int, reg *monitoring.Registry) (*processor, error) {
+	log := logp.NewLogger(logName).With("instance_id", id)
+	if c.ID != "" {
+		log = log.With("id", c.ID)
+	}
+
+	p := &processor{
+		config:     c,
+		log:        log,
+		redactions: map[string]*monitoring.Int{},
+	}
+
+	redactionsReg := reg.NewRegistry("redactions")
+	for _, name := range c.Detectors {
+		d := builtinDetectors[name]
+		d.name = name
+		p.detectors = append(p.detectors, d)
+	}
+	for _, pattern := range c.Patterns {
+		p.detectors = append(p.detectors, detector{
+			name: pattern.Name,
+			re:   regexp.MustCompile(pattern.Pattern),
+		})
+	}
+	for _, d := range p.detectors {
+		if _, found := p.redactions[d.name]; !found {
+			p.redactions[d.name] = monitoring.NewInt(redactionsReg, d.name)
+		}
+	}
+	return p, nil
+}
+
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	redacted := false
+	for _, field := range p.Fields {
+		v, err := event.GetValue(field)
+		if err != nil {
+			if p.IgnoreMissing && errors.Cause(err) == common.ErrKeyNotFound {
+				continue
+			}
+			return event, errors.Wrapf(err, "redact field [%v] not found", field)
+		}
+
+		v, changed, drop := p.redactValue(v)
+		switch {
+		case drop:
+			if err := event.Delete(field); err != nil {
+				return event, errors.Wrapf(err, "failed to drop field [%v]", field)
+			}
+		case changed:
+			if _, err := event.PutValue(field, v); err != nil {
+				return event, errors.Wrapf(err, "failed to write redacted value to field [%v]", field)
+			}
+		}
+		redacted = redacted || drop || changed
+	}
+
+	if redacted && p.Tag != "" {
+		if err := common.AddTags(event.Fields, []string{p.Tag}); err != nil {
+			return event, err
+		}
+	}
+	return event, nil
+}
+
+// redactValue redacts strings in v, recursing into arrays and objects as
+// decoded from JSON. It returns the redacted value, whether it was changed, and
+// whether the field must be dropped.
+func (p *processor) redactValue(v interface{}) (interface{}, bool, bool) {
+	switch value := v.(type) {
+	case string:
+		return p.redact(value)
+	case []string:
+		values := make([]string, len(value))
+		changed := false
+		for i, s := range value {
+			var c, drop bool
+			values[i], c, drop = p.redact(s)
+			if drop {
+				return nil, false, true
+			}
+			changed = changed || c
+		}
+		return values, changed, false
+	case []interface{}:
+		values := make([]interface{}, len(value))
+		changed := false
+		for i, elem := range value {
+			var c, drop bool
+			values[i], c, drop = p.redactValue(elem)
+			if drop {
+				return nil, false, true
+			}
+			changed = changed || c
+		}
+		return values, changed, false
+	case map[string]interface{}:
+		return p.redactObject(value)
+	case common.MapStr:
+		return p.redactObject(value)
+	default:
+		return v, false, false
+	}
+}
+
+func (p *processor) redactObject(m map[string]interface{}) (common.MapStr, bool, bool) {
+	obj := make(common.MapStr, len(m))
+	changed := false
+	for k, elem := range m {
+		var c, drop bool
+		obj[k], c, drop = p.redactValue(elem)
+		if drop {
+			return nil, false, true
+		}
+		changed = changed || c
+	}
+	return obj, changed, false
+}
+
+// redact applies all the detectors to s and returns the redacted string,
+// whether it was changed, and whether the field must be dropped.
+func (p *processor) redact(s string) (string, bool, bool) {
+	changed := false
+	for i := range p.detectors {
+		d := &p.detectors[i]
+		matches := d.find(s)
+		if len(matches) == 0 {
+			continue
+		}
+		p.redactions[d.name].Add(int64(len(matches)))
+		if p.Mode == modeDrop {
+			return "", false, true
+		}
+
+		// Replace from the end so that earlier offsets remain valid.
+		for j := len(matches) - 1; j >= 0; j-- {
+			start, end := matches[j][0], matches[j][1]
+			s = s[:start] + p.replacement(s[start:end]) + s[end:]
+		}
+		changed = true
+	}
+	return s, changed, false
+}
+
+func (p *processor) replacement(value string) string {
+	if p.Mode == modeHash {
+		mac := hmac.New(sha256.New, []byte(p.HashKey))
+		mac.Write([]byte(value))
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+	return p.Replacement
+}
+
+func (p *processor) String() string {
+	c := p.config
+	c.HashKey = ""
+	json, _ := json.Marshal(c)
+	return procName + "=" + string(json)
+}
@@ -0,0 +1,51 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wasm
+
+import (
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+)
+
+// wasmPageSize is the size of a WebAssembly memory page.
+const wasmPageSize = 64 * 1024
+
+// Config defines the configuration options for the wasm script processor.
+type Config struct {
+	Tag               string                 `config:"tag"`                                  // Processor ID for debug and metrics.
+	File              string                 `config:"file" validate:"required"`             // WebAssembly module to load.
+	Params            map[string]interface{} `config:"params"`                               // Parameters to pass to the module.
+	Timeout           time.Duration          `config:"timeout" validate:"min=0"`             // Execution timeout.
+	MaxMemory         cfgtype.ByteSize       `config:"max_memory" validate:"min=65536"`      // Max. linear memory of each instance.
+	TagOnException    string                 `config:"tag_on_exception"`                     // Tag to add to events when an exception happens.
+	MaxCachedSessions int                    `config:"max_cached_sessions" validate:"min=0"` // Max. number of cached module instances.
+}
+
+func defaultConfig() Config {
+	return Config{
+		MaxMemory:         16 * 1024 * 1024,
+		TagOnException:    "_wasm_exception",
+		MaxCachedSessions: 4,
+	}
+}
+
+// memoryLimitPages returns MaxMemory as a number of WebAssembly pages.
+func (c Config) memoryLimitPages() uint32 {
+	return uint32(c.MaxMemory / wasmPageSize)
+}
@@ -0,0 +1,304 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wasm
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// hostModule is the name of the module providing the host functions to the
+// WebAssembly modules.
+const hostModule = "beat"
+
+// Status codes returned by the host functions.
+const (
+	statusOK       int32 = 0
+	statusNotFound int32 = -1
+	statusError    int32 = -2
+)
+
+// Log levels accepted by the log host function.
+const (
+	logDebug int32 = iota
+	logInfo
+	logWarn
+	logError
+)
+
+type sessionKey struct{}
+
+// sessionFrom returns the session processing the current event.
+func sessionFrom(ctx context.Context) *session {
+	return ctx.Value(sessionKey{}).(*session)
+}
+
+// instantiateHostModule registers the version 0 of the host ABI in the
+// runtime. It mirrors the event API available to the javascript processor.
+// Strings are passed as (pointer, length) pairs into the module memory and
+// values are exchanged as JSON.
+func instantiateHostModule(ctx context.Context, r wazero.Runtime) error {
+	_, err := r.NewHostModuleBuilder(hostModule).
+		NewFunctionBuilder().WithFunc(eventGet).Export("event_get").
+		NewFunctionBuilder().WithFunc(eventPut).Export("event_put").
+		NewFunctionBuilder().WithFunc(eventRename).Export("event_rename").
+		NewFunctionBuilder().WithFunc(eventDelete).Export("event_delete").
+		NewFunctionBuilder().WithFunc(eventTag).Export("event_tag").
+		NewFunctionBuilder().WithFunc(eventAppendTo).Export("event_append_to").
+		NewFunctionBuilder().WithFunc(eventCancel).Export("event_cancel").
+		NewFunctionBuilder().WithFunc(paramsGet).Export("params_get").
+		NewFunctionBuilder().WithFunc(logMessage).Export("log").
+		Instantiate(ctx)
+	return err
+}
+
+// eventGet writes the JSON encoded value of a field into the buffer. It
+// returns the length of the value, or statusNotFound if the field doesn't
+// exist. When the buffer is too small nothing is written and the required
+// length is returned, so the module can retry with a larger buffer. The whole
+// event is returned when the key is empty.
+//
+//	i32 event_get(key_ptr, key_len, buf_ptr, buf_cap)
+func eventGet(ctx context.Context, m api.Module, keyPtr, keyLen, bufPtr, bufCap uint32) int32 {
+	s := sessionFrom(ctx)
+	key, ok := readString(m, keyPtr, keyLen)
+	if !ok {
+		return statusError
+	}
+
+	var v interface{} = s.evt.Fields
+	if key != "" {
+		var err error
+		if v, err = s.evt.GetValue(key); err != nil {
+			return statusNotFound
+		}
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		s.log.Debugf("Failed to encode field %v: %v", key, err)
+		return statusError
+	}
+	return writeBytes(m, bufPtr, bufCap, data)
+}
+
+// eventPut writes a JSON encoded value to a field.
+//
+//	i32 event_put(key_ptr, key_len, value_ptr, value_len)
+func eventPut(ctx context.Context, m api.Module, keyPtr, keyLen, valuePtr, valueLen uint32) int32 {
+	s := sessionFrom(ctx)
+	key, ok := readString(m, keyPtr, keyLen)
+	if !ok {
+		return statusError
+	}
+	data, ok := m.Memory().Read(valuePtr, valueLen)
+	if !ok {
+		return statusError
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		s.log.Debugf("Failed to decode value for field %v: %v", key, err)
+		return statusError
+	}
+	if obj, ok := v.(map[string]interface{}); ok {
+		v = common.MapStr(obj)
+	}
+	if _, err := s.evt.PutValue(key, v); err != nil {
+		return statusError
+	}
+	return statusOK
+}
+
+// eventRename moves a value from one field to another. Existing fields are
+// not overwritten.
+//
+//	i32 event_rename(from_ptr, from_len, to_ptr, to_len)
+func eventRename(ctx context.Context, m api.Module, fromPtr, fromLen, toPtr, toLen uint32) int32 {
+	s := sessionFrom(ctx)
+	from, ok := readString(m, fromPtr, fromLen)
+	if !ok {
+		return statusError
+	}
+	to, ok := readString(m, toPtr, toLen)
+	if !ok {
+		return statusError
+	}
+
+	if _, err := s.evt.GetValue(to); err == nil {
+		return statusError
+	}
+	value, err := s.evt.GetValue(from)
+	if err != nil {
+		return statusNotFound
+	}
+
+	// Deletion must happen first to support cases where a becomes a.b.
+	if err = s.evt.Delete(from); err != nil {
+		return statusError
+	}
+	if _, err = s.evt.PutValue(to, value); err != nil {
+		// Undo
+		s.evt.PutValue(from, value)
+		return statusError
+	}
+	return statusOK
+}
+
+// eventDelete deletes a field.
+//
+//	i32 event_delete(key_ptr, key_len)
+func eventDelete(ctx context.Context, m api.Module, keyPtr, keyLen uint32) int32 {
+	s := sessionFrom(ctx)
+	key, ok := readString(m, keyPtr, keyLen)
+	if !ok {
+		return statusError
+	}
+	if err := s.evt.Delete(key); err != nil {
+		return statusNotFound
+	}
+	return statusOK
+}
+
+// eventTag adds a tag to the event if it's not already present.
+//
+//	i32 event_tag(tag_ptr, tag_len)
+func eventTag(ctx context.Context, m api.Module, tagPtr, tagLen uint32) int32 {
+	s := sessionFrom(ctx)
+	tag, ok := readString(m, tagPtr, tagLen)
+	if !ok {
+		return statusError
+	}
+	if err := appendString(s.evt.Fields, "tags", tag, true); err != nil {
+		return statusError
+	}
+	return statusOK
+}
+
+// eventAppendTo appends a string to a field, converting any existing value to
+// an array.
+//
+//	i32 event_append_to(key_ptr, key_len, value_ptr, value_len)
+func eventAppendTo(ctx context.Context, m api.Module, keyPtr, keyLen, valuePtr, valueLen uint32) int32 {
+	s := sessionFrom(ctx)
+	key, ok := readString(m, keyPtr, keyLen)
+	if !ok {
+		return statusError
+	}
+	value, ok := readString(m, valuePtr, valueLen)
+	if !ok {
+		return statusError
+	}
+	if err := appendString(s.evt.Fields, key, value, false); err != nil {
+		return statusError
+	}
+	return statusOK
+}
+
+// eventCancel marks the event as cancelled such that it will be dropped.
+//
+//	event_cancel()
+func eventCancel(ctx context.Context) {
+	sessionFrom(ctx).cancelled = true
+}
+
+// paramsGet writes the JSON encoded params of the processor into the buffer,
+// following the same conventions as event_get.
+//
+//	i32 params_get(buf_ptr, buf_cap)
+func paramsGet(ctx context.Context, m api.Module, bufPtr, bufCap uint32) int32 {
+	return writeBytes(m, bufPtr, bufCap, sessionFrom(ctx).params)
+}
+
+// logMessage writes a message to the processor logger.
+//
+//	log(level, msg_ptr, msg_len)
+func logMessage(ctx context.Context, m api.Module, level int32, msgPtr, msgLen uint32) {
+	s := sessionFrom(ctx)
+	msg, ok := readString(m, msgPtr, msgLen)
+	if !ok {
+		return
+	}
+	switch level {
+	case logDebug:
+		s.log.Debug(msg)
+	case logInfo:
+		s.log.Info(msg)
+	case logWarn:
+		s.log.Warn(msg)
+	default:
+		s.log.Error(msg)
+	}
+}
+
+func readString(m api.Module, ptr, length uint32) (string, bool) {
+	data, ok := m.Memory().Read(ptr, length)
+	if !ok {
+		return "", false
+	}
+	return string(data), true
+}
+
+func writeBytes(m api.Module, ptr, capacity uint32, data []byte) int32 {
+	if uint32(len(data)) > capacity {
+		return int32(len(data))
+	}
+	if !m.Memory().Write(ptr, data) {
+		return statusError
+	}
+	return int32(len(data))
+}
+
+func appendString(m common.MapStr, field, value string, alwaysArray bool) error {
+	list, _ := m.GetValue(field)
+	switch v := list.(type) {
+	case nil:
+		if alwaysArray {
+			m.Put(field, []string{value})
+		} else {
+			m.Put(field, value)
+		}
+	case string:
+		if value != v {
+			m.Put(field, []string{v, value})
+		}
+	case []string:
+		for _, existing := range v {
+			if value == existing {
+				return nil
+			}
+		}
+		m.Put(field, append(v, value))
+	case []interface{}:
+		for _, existing := range v {
+			if value == existing {
+				return nil
+			}
+		}
+		m.Put(field, append(v, value))
+	default:
+		return errors.Errorf("unexpected type %T found for %v field", list, field)
+	}
+	return nil
+}
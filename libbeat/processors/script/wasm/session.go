@@ -0,0 +1,206 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wasm
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"go.uber.org/zap"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+const (
+	logName = "processor.wasm"
+
+	entryPointFunction = "process"
+
+	// reactorInitFunction is the function exported by WASI reactors that must
+	// be called before any other export.
+	reactorInitFunction = "_initialize"
+)
+
+// session is an instance of the WebAssembly module. Each session processes a
+// single event at a time.
+type session struct {
+	mod            api.Module
+	processFunc    api.Function
+	log            *logp.Logger
+	params         []byte
+	timeout        time.Duration
+	tagOnException string
+
+	// State of the event being processed.
+	evt       *beat.Event
+	cancelled bool
+
+	// broken is set when the instance can't be reused, e.g. because it has
+	// been closed after a timeout.
+	broken bool
+}
+
+func newSession(r wazero.Runtime, compiled wazero.CompiledModule, params []byte, conf Config) (*session, error) {
+	logger := logp.NewLogger(logName)
+	if conf.Tag != "" {
+		logger = logger.With("instance_id", conf.Tag)
+	}
+
+	start := time.Now()
+	defer func() {
+		logger.Debugf("Instantiation of wasm module took %v", time.Since(start))
+	}()
+
+	mod, err := r.InstantiateModule(context.Background(), compiled, wazero.NewModuleConfig().
+		WithName("").
+		WithStartFunctions(reactorInitFunction))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to instantiate module")
+	}
+
+	processFunc := mod.ExportedFunction(entryPointFunction)
+	if processFunc == nil {
+		mod.Close(context.Background())
+		return nil, errors.New("process function not found")
+	}
+	if results := processFunc.Definition().ResultTypes(); len(processFunc.Definition().ParamTypes()) != 0 ||
+		len(results) != 1 || results[0] != api.ValueTypeI32 {
+		mod.Close(context.Background())
+		return nil, errors.New("process function must have the signature () -> i32")
+	}
+
+	return &session{
+		mod:            mod,
+		processFunc:    processFunc,
+		log:            logger,
+		params:         params,
+		timeout:        conf.Timeout,
+		tagOnException: conf.TagOnException,
+	}, nil
+}
+
+// runProcessFunc executes process() from the module.
+func (s *session) runProcessFunc(b *beat.Event) (out *beat.Event, err error) {
+	s.evt, s.cancelled = b, false
+	defer func() {
+		s.evt = nil
+	}()
+
+	defer func() {
+		if r := recover(); r != nil {
+			s.log.Errorw("The wasm processor caused an unexpected panic "+
+				"while processing an event. Recovering, but please report this.",
+				"event", common.MapStr{"original": b.Fields.String()},
+				"panic", r,
+				zap.Stack("stack"))
+			s.broken = true
+			out = b
+			err = errors.Errorf("unexpected panic in wasm processor: %v", r)
+			s.tagException(b, err)
+		}
+	}()
+
+	ctx := context.WithValue(context.Background(), sessionKey{}, s)
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	results, err := s.processFunc.Call(ctx)
+	if err != nil {
+		// Traps and timeouts leave the instance in an unknown state.
+		s.broken = true
+		s.tagException(b, err)
+		return b, errors.Wrap(err, "failed in process function")
+	}
+	if status := int32(results[0]); status != 0 {
+		err = errors.Errorf("process function returned status %d", status)
+		s.tagException(b, err)
+		return b, err
+	}
+
+	if s.cancelled {
+		return nil, nil
+	}
+	return b, nil
+}
+
+func (s *session) tagException(b *beat.Event, err error) {
+	if s.tagOnException != "" {
+		common.AddTags(b.Fields, []string{s.tagOnException})
+	}
+	appendString(b.Fields, "error.message", err.Error(), false)
+}
+
+func (s *session) close() {
+	s.mod.Close(context.Background())
+}
+
+type sessionPool struct {
+	New func() (*session, error)
+	C   chan *session
+}
+
+func newSessionPool(r wazero.Runtime, compiled wazero.CompiledModule, params []byte, c Config) (*sessionPool, error) {
+	// Instantiate once to validate the module.
+	s, err := newSession(r, compiled, params, c)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := sessionPool{
+		New: func() (*session, error) {
+			return newSession(r, compiled, params, c)
+		},
+		C: make(chan *session, c.MaxCachedSessions),
+	}
+	pool.Put(s)
+
+	return &pool, nil
+}
+
+func (p *sessionPool) Get() (*session, error) {
+	select {
+	case s := <-p.C:
+		return s, nil
+	default:
+		return p.New()
+	}
+}
+
+// Put returns a session to the pool. Sessions that can't be reused or that
+// don't fit in the pool are closed.
+func (p *sessionPool) Put(s *session) {
+	if s == nil {
+		return
+	}
+	if !s.broken {
+		select {
+		case p.C <- s:
+			return
+		default:
+		}
+	}
+	s.close()
+}
@@ -0,0 +1,48 @@
+;; Test module for the wasm script processor.
+;;
+;; It copies the "message" field to "copy" and tags the event with "wasm". The
+;; event is dropped when it has a "drop" field, execution never ends when it
+;; has a "spin" field, and 100 memory pages are requested when it has a "grow"
+;; field. process returns 1 when "message" is missing and 2 when memory can't
+;; be grown.
+;;
+;; Build with: wat2wasm process.wat -o process.wasm
+(module
+  (import "beat" "event_get" (func $get (param i32 i32 i32 i32) (result i32)))
+  (import "beat" "event_put" (func $put (param i32 i32 i32 i32) (result i32)))
+  (import "beat" "event_tag" (func $tag (param i32 i32) (result i32)))
+  (import "beat" "event_cancel" (func $cancel))
+
+  (memory (export "memory") 1)
+
+  (data (i32.const 0) "message")
+  (data (i32.const 16) "copy")
+  (data (i32.const 32) "wasm")
+  (data (i32.const 48) "drop")
+  (data (i32.const 64) "spin")
+  (data (i32.const 80) "grow")
+
+  (func (export "process") (result i32)
+    (local $n i32)
+
+    (if (i32.ge_s (call $get (i32.const 48) (i32.const 4) (i32.const 1024) (i32.const 1024)) (i32.const 0))
+      (then
+        (call $cancel)
+        (return (i32.const 0))))
+
+    (if (i32.ge_s (call $get (i32.const 64) (i32.const 4) (i32.const 1024) (i32.const 1024)) (i32.const 0))
+      (then
+        (loop $spin (br $spin))))
+
+    (if (i32.ge_s (call $get (i32.const 80) (i32.const 4) (i32.const 1024) (i32.const 1024)) (i32.const 0))
+      (then
+        (if (i32.eq (memory.grow (i32.const 100)) (i32.const -1))
+          (then (return (i32.const 2))))))
+
+    (local.set $n (call $get (i32.const 0) (i32.const 7) (i32.const 1024) (i32.const 1024)))
+    (if (i32.lt_s (local.get $n) (i32.const 0))
+      (then (return (i32.const 1))))
+
+    (drop (call $put (i32.const 16) (i32.const 4) (i32.const 1024) (local.get $n)))
+    (drop (call $tag (i32.const 32) (i32.const 4)))
+    (i32.const 0)))
@@ -0,0 +1,200 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wasm
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rcrowley/go-metrics"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+	"github.com/elastic/beats/v7/libbeat/monitoring/adapter"
+	"github.com/elastic/beats/v7/libbeat/paths"
+	"github.com/elastic/beats/v7/libbeat/processors"
+)
+
+type wasmProcessor struct {
+	Config
+	runtime     wazero.Runtime
+	sessionPool *sessionPool
+	sourceFile  string
+	stats       *processorStats
+}
+
+// New constructs a new wasm processor.
+func New(c *common.Config) (processors.Processor, error) {
+	conf := defaultConfig()
+	if err := c.Unpack(&conf); err != nil {
+		return nil, err
+	}
+
+	return NewFromConfig(conf, monitoring.Default)
+}
+
+// NewFromConfig constructs a new wasm processor from the given config
+// object. It loads and compiles the module and creates a first instance of
+// it to validate it.
+func NewFromConfig(c Config, reg *monitoring.Registry) (processors.Processor, error) {
+	sourceFile := paths.Resolve(paths.Config, c.File)
+	code, err := loadModule(sourceFile)
+	if err != nil {
+		return nil, annotateError(c.Tag, err)
+	}
+
+	params, err := json.Marshal(c.Params)
+	if err != nil {
+		return nil, annotateError(c.Tag, errors.Wrap(err, "failed to encode params"))
+	}
+
+	ctx := context.Background()
+	r := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithCloseOnContextDone(true).
+		WithMemoryLimitPages(c.memoryLimitPages()))
+
+	p, err := func() (*wasmProcessor, error) {
+		// WASI is provided for modules built with toolchains that depend on it,
+		// like TinyGo or Rust's wasm32-wasi target.
+		if _, err := wasi_snapshot_preview1.Instantiate(ctx, r); err != nil {
+			return nil, errors.Wrap(err, "failed to instantiate WASI")
+		}
+		if err := instantiateHostModule(ctx, r); err != nil {
+			return nil, errors.Wrap(err, "failed to instantiate host module")
+		}
+
+		compiled, err := r.CompileModule(ctx, code)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to compile module %v", sourceFile)
+		}
+
+		pool, err := newSessionPool(r, compiled, params, c)
+		if err != nil {
+			return nil, err
+		}
+
+		return &wasmProcessor{
+			Config:      c,
+			runtime:     r,
+			sessionPool: pool,
+			sourceFile:  sourceFile,
+			stats:       getStats(c.Tag, reg),
+		}, nil
+	}()
+	if err != nil {
+		r.Close(ctx)
+		return nil, annotateError(c.Tag, err)
+	}
+	return p, nil
+}
+
+func loadModule(path string) ([]byte, error) {
+	if common.IsStrictPerms() {
+		if err := common.OwnerHasExclusiveWritePerms(path); err != nil {
+			return nil, err
+		}
+	}
+
+	code, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read file %v", path)
+	}
+	return code, nil
+}
+
+func annotateError(id string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if id != "" {
+		return errors.Wrapf(err, "failed in processor.wasm with id=%v", id)
+	}
+	return errors.Wrap(err, "failed in processor.wasm")
+}
+
+func (p *wasmProcessor) Run(event *beat.Event) (*beat.Event, error) {
+	s, err := p.sessionPool.Get()
+	if err != nil {
+		return event, annotateError(p.Tag, err)
+	}
+	defer p.sessionPool.Put(s)
+
+	var rtn *beat.Event
+	if p.stats == nil {
+		rtn, err = s.runProcessFunc(event)
+	} else {
+		rtn, err = p.runWithStats(s, event)
+	}
+	return rtn, annotateError(p.Tag, err)
+}
+
+func (p *wasmProcessor) runWithStats(s *session, event *beat.Event) (*beat.Event, error) {
+	start := time.Now()
+	event, err := s.runProcessFunc(event)
+	elapsed := time.Since(start)
+
+	p.stats.processTime.Update(int64(elapsed))
+	if err != nil {
+		p.stats.exceptions.Inc()
+	}
+	return event, err
+}
+
+// Close releases all the module instances and the runtime.
+func (p *wasmProcessor) Close() error {
+	return p.runtime.Close(context.Background())
+}
+
+func (p *wasmProcessor) String() string {
+	return "script=[type=wasm, id=" + p.Tag + ", sources=" + p.sourceFile + "]"
+}
+
+type processorStats struct {
+	exceptions  *monitoring.Int
+	processTime metrics.Sample
+}
+
+func getStats(id string, reg *monitoring.Registry) *processorStats {
+	if id == "" || reg == nil {
+		return nil
+	}
+
+	namespace := logName + "." + id
+	processorReg := reg.GetRegistry(namespace)
+	if processorReg != nil {
+		// If a module is reloaded then the namespace could already exist.
+		processorReg.Clear()
+	} else {
+		processorReg = reg.NewRegistry(namespace, monitoring.DoNotReport)
+	}
+
+	stats := &processorStats{
+		exceptions:  monitoring.NewInt(processorReg, "exceptions"),
+		processTime: metrics.NewUniformSample(2048),
+	}
+	adapter.NewGoMetrics(processorReg, "histogram", adapter.Accept).
+		Register("process_time", metrics.NewHistogram(stats.processTime))
+
+	return stats
+}
@@ -0,0 +1,150 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wasm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+func newTestProcessor(t *testing.T, cfg common.MapStr) *wasmProcessor {
+	t.Helper()
+
+	cfg["file"] = "testdata/process.wasm"
+	conf := defaultConfig()
+	require.NoError(t, common.MustNewConfigFrom(cfg).Unpack(&conf))
+
+	p, err := NewFromConfig(conf, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { p.(*wasmProcessor).Close() })
+	return p.(*wasmProcessor)
+}
+
+func TestProcess(t *testing.T) {
+	p := newTestProcessor(t, common.MapStr{})
+
+	evt, err := p.Run(&beat.Event{Fields: common.MapStr{
+		"message": common.MapStr{"text": "hello", "count": 2},
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{
+		"message": common.MapStr{"text": "hello", "count": 2},
+		"copy":    common.MapStr{"text": "hello", "count": float64(2)},
+		"tags":    []string{"wasm"},
+	}, evt.Fields)
+}
+
+func TestCancel(t *testing.T) {
+	p := newTestProcessor(t, common.MapStr{})
+
+	evt, err := p.Run(&beat.Event{Fields: common.MapStr{"drop": true}})
+	assert.NoError(t, err)
+	assert.Nil(t, evt)
+}
+
+func TestErrorStatus(t *testing.T) {
+	p := newTestProcessor(t, common.MapStr{})
+
+	evt, err := p.Run(&beat.Event{Fields: common.MapStr{}})
+	assert.Error(t, err)
+	assert.Equal(t, common.MapStr{
+		"tags":  []string{"_wasm_exception"},
+		"error": common.MapStr{"message": "process function returned status 1"},
+	}, evt.Fields)
+}
+
+func TestTimeout(t *testing.T) {
+	p := newTestProcessor(t, common.MapStr{"timeout": "100ms"})
+
+	start := time.Now()
+	evt, err := p.Run(&beat.Event{Fields: common.MapStr{"spin": true}})
+	assert.Error(t, err)
+	assert.Less(t, int64(time.Since(start)), int64(5*time.Second))
+	tags, _ := evt.GetValue("tags")
+	assert.Equal(t, []string{"_wasm_exception"}, tags)
+
+	// The instance that timed out is discarded and a new one is used.
+	evt, err = p.Run(&beat.Event{Fields: common.MapStr{"message": "ok"}})
+	require.NoError(t, err)
+	assert.Equal(t, "ok", evt.Fields["copy"])
+}
+
+func TestMemoryLimit(t *testing.T) {
+	p := newTestProcessor(t, common.MapStr{})
+	_, err := p.Run(&beat.Event{Fields: common.MapStr{"grow": true, "message": "ok"}})
+	assert.NoError(t, err)
+
+	p = newTestProcessor(t, common.MapStr{"max_memory": "1MiB"})
+	_, err = p.Run(&beat.Event{Fields: common.MapStr{"grow": true, "message": "ok"}})
+	assert.EqualError(t, err, "failed in processor.wasm: process function returned status 2")
+}
+
+func TestSessionPool(t *testing.T) {
+	p := newTestProcessor(t, common.MapStr{"max_cached_sessions": 2})
+
+	const workers = 8
+	errs := make(chan error, workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			var err error
+			for j := 0; j < 50 && err == nil; j++ {
+				_, err = p.Run(&beat.Event{Fields: common.MapStr{"message": j}})
+			}
+			errs <- err
+		}()
+	}
+	for i := 0; i < workers; i++ {
+		assert.NoError(t, <-errs)
+	}
+	assert.LessOrEqual(t, len(p.sessionPool.C), 2)
+}
+
+func TestStats(t *testing.T) {
+	conf := defaultConfig()
+	conf.File = "testdata/process.wasm"
+	conf.Tag = "wasm-test"
+	reg := monitoring.NewRegistry()
+
+	p, err := NewFromConfig(conf, reg)
+	require.NoError(t, err)
+	defer p.(*wasmProcessor).Close()
+
+	p.Run(&beat.Event{Fields: common.MapStr{}})
+	p.Run(&beat.Event{Fields: common.MapStr{"message": "ok"}})
+
+	snapshot := monitoring.CollectFlatSnapshot(reg, monitoring.Full, false)
+	assert.Equal(t, int64(1), snapshot.Ints[logName+".wasm-test.exceptions"])
+}
+
+func TestInvalidModule(t *testing.T) {
+	conf := defaultConfig()
+	conf.File = "testdata/process.wat"
+	_, err := NewFromConfig(conf, nil)
+	assert.Error(t, err)
+
+	conf.File = "testdata/missing.wasm"
+	_, err = NewFromConfig(conf, nil)
+	assert.Error(t, err)
+}
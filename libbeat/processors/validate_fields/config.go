@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package validate_fields
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type config struct {
+	// Beat is the name of the beat whose embedded fields.yml is used. It
+	// defaults to the running beat.
+	Beat string `config:"beat"`
+
+	// Fields is the path to a fields.yml file to use instead of the fields
+	// embedded in the beat. Relative paths are resolved against path.config.
+	Fields string `config:"fields"`
+
+	// Action defines what happens to fields that don't match their mapping.
+	Action action `config:"action"`
+
+	// Target is the object offending fields are moved to by the move action.
+	Target string `config:"target"`
+
+	// Tag is added to events containing offending fields. It is not added
+	// when empty.
+	Tag string `config:"tag"`
+
+	ID string `config:"id"`
+}
+
+type action uint8
+
+const (
+	actionTag action = iota
+	actionDrop
+	actionMove
+)
+
+var actionNames = map[action]string{
+	actionTag:  "tag",
+	actionDrop: "drop",
+	actionMove: "move",
+}
+
+func (a action) String() string {
+	return actionNames[a]
+}
+
+func (a action) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *action) Unpack(v string) error {
+	switch strings.ToLower(v) {
+	case "", "tag":
+		*a = actionTag
+	case "drop":
+		*a = actionDrop
+	case "move":
+		*a = actionMove
+	default:
+		return errors.Errorf("invalid action '%v' (valid values are: tag, drop, move)", v)
+	}
+	return nil
+}
+
+func defaultConfig() config {
+	return config{
+		Target: "_invalid",
+		Tag:    "_invalid_fields",
+	}
+}
+
+func (c *config) Validate() error {
+	if c.Action == actionMove && c.Target == "" {
+		return errors.New("target is required when action is move")
+	}
+	return nil
+}
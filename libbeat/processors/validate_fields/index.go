@@ -0,0 +1,239 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package validate_fields
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/mapping"
+)
+
+// index maps the full dotted names of the fields defined in fields.yml to
+// their types. Definitions whose name contains a wildcard are kept apart and
+// matched segment by segment.
+type index struct {
+	types     map[string]string
+	wildcards []wildcardField
+}
+
+type wildcardField struct {
+	segments []string
+	typ      string
+}
+
+func newIndex(fields mapping.Fields) *index {
+	idx := &index{types: map[string]string{}}
+	idx.add("", fields)
+	return idx
+}
+
+func (idx *index) add(prefix string, fields mapping.Fields) {
+	for _, f := range fields {
+		name := f.Name
+		if prefix != "" {
+			name = prefix + "." + f.Name
+		}
+
+		typ := f.Type
+		switch {
+		case typ == "" && len(f.Fields) > 0:
+			typ = "group"
+		case typ == "":
+			// keyword is the default type of fields.yml entries.
+			typ = "keyword"
+		case typ == "object" && f.ObjectType != "":
+			// Objects with an object_type hold dynamic keys of that type.
+			idx.set(name+".*", f.ObjectType)
+		}
+		idx.set(name, typ)
+
+		if len(f.Fields) > 0 {
+			idx.add(name, f.Fields)
+		}
+	}
+}
+
+func (idx *index) set(name, typ string) {
+	if strings.Contains(name, "*") {
+		idx.wildcards = append(idx.wildcards, wildcardField{strings.Split(name, "."), typ})
+		return
+	}
+	// Groups can be defined in more than one fields.yml, never let a group
+	// override the type of a leaf field.
+	if existing, found := idx.types[name]; found && typ == "group" && existing != "group" {
+		return
+	}
+	idx.types[name] = typ
+}
+
+// lookup returns the type of the given field, or false if the field is not
+// defined.
+func (idx *index) lookup(name string) (string, bool) {
+	if typ, found := idx.types[name]; found {
+		return typ, true
+	}
+	if len(idx.wildcards) == 0 {
+		return "", false
+	}
+	segments := strings.Split(name, ".")
+	for _, w := range idx.wildcards {
+		if matchSegments(w.segments, segments) {
+			return w.typ, true
+		}
+	}
+	return "", false
+}
+
+func matchSegments(pattern, segments []string) bool {
+	if len(pattern) != len(segments) {
+		return false
+	}
+	for i, p := range pattern {
+		if p != "*" && p != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// checkValue verifies that a value can be indexed as the given type. Values
+// Elasticsearch coerces by default, like numbers in strings, are accepted.
+func checkValue(typ string, v interface{}) error {
+	if v == nil {
+		return nil
+	}
+
+	// Arrays are valid when all of their elements are.
+	switch arr := v.(type) {
+	case []interface{}:
+		for _, e := range arr {
+			if err := checkValue(typ, e); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []string:
+		for _, e := range arr {
+			if err := checkValue(typ, e); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []common.MapStr:
+		for _, e := range arr {
+			if err := checkValue(typ, e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch typ {
+	case "keyword", "text", "wildcard", "constant_keyword", "match_only_text", "version":
+		if isObject(v) {
+			return fmt.Errorf("expected %v, found object", typ)
+		}
+	case "long", "integer", "short", "byte", "unsigned_long":
+		if !isInteger(v) {
+			return fmt.Errorf("expected %v, found %T", typ, v)
+		}
+	case "float", "double", "half_float", "scaled_float":
+		if !isNumber(v) {
+			return fmt.Errorf("expected %v, found %T", typ, v)
+		}
+	case "boolean":
+		switch b := v.(type) {
+		case bool:
+		case string:
+			if b != "true" && b != "false" && b != "" {
+				return fmt.Errorf("expected boolean, found string %q", b)
+			}
+		default:
+			return fmt.Errorf("expected boolean, found %T", v)
+		}
+	case "date", "date_nanos":
+		switch v.(type) {
+		case time.Time, common.Time, string:
+		default:
+			if !isInteger(v) {
+				return fmt.Errorf("expected %v, found %T", typ, v)
+			}
+		}
+	case "ip":
+		switch ip := v.(type) {
+		case net.IP:
+		case string:
+			if net.ParseIP(ip) == nil {
+				return fmt.Errorf("expected ip, found string %q", ip)
+			}
+		default:
+			return fmt.Errorf("expected ip, found %T", v)
+		}
+	case "group", "object", "nested":
+		if !isObject(v) {
+			return fmt.Errorf("expected %v, found %T", typ, v)
+		}
+	}
+	return nil
+}
+
+func isObject(v interface{}) bool {
+	switch v.(type) {
+	case common.MapStr, map[string]interface{}:
+		return true
+	}
+	return false
+}
+
+func isInteger(v interface{}) bool {
+	switch n := v.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return true
+	case float32:
+		return float64(n) == float64(int64(n))
+	case float64:
+		return n == float64(int64(n))
+	case json.Number:
+		_, err := n.Int64()
+		return err == nil
+	case string:
+		_, err := strconv.ParseInt(strings.TrimSpace(n), 10, 64)
+		return err == nil
+	}
+	return false
+}
+
+func isNumber(v interface{}) bool {
+	switch n := v.(type) {
+	case float32, float64:
+		return true
+	case json.Number:
+		_, err := n.Float64()
+		return err == nil
+	case string:
+		_, err := strconv.ParseFloat(strings.TrimSpace(n), 64)
+		return err == nil
+	}
+	return isInteger(v)
+}
@@ -0,0 +1,195 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package validate_fields
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/asset"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/mapping"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+	"github.com/elastic/beats/v7/libbeat/paths"
+	"github.com/elastic/beats/v7/libbeat/processors"
+)
+
+const (
+	procName = "validate_fields"
+	logName  = "processor." + procName
+)
+
+func init() {
+	processors.RegisterPlugin(procName, New)
+}
+
+type processor struct {
+	config
+	log   *logp.Logger
+	index *index
+}
+
+type violation struct {
+	field string
+	err   error
+}
+
+// New constructs a new validate_fields processor.
+func New(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrap(err, "fail to unpack the "+procName+" processor configuration")
+	}
+
+	fields, err := loadFields(c)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load field definitions")
+	}
+	return newValidateFields(c, fields), nil
+}
+
+func newValidateFields(c config, fields mapping.Fields) *processor {
+	log := logp.NewLogger(logName)
+	if c.ID != "" {
+		log = log.With("instance_id", c.ID)
+	}
+	return &processor{config: c, log: log, index: newIndex(fields)}
+}
+
+// loadFields loads the fields.yml configured, or the fields embedded in the
+// beat otherwise.
+func loadFields(c config) (mapping.Fields, error) {
+	if c.Fields != "" {
+		return mapping.LoadFieldsYaml(paths.Resolve(paths.Config, c.Fields))
+	}
+
+	name := c.Beat
+	if name == "" {
+		name = runningBeat()
+	}
+	if name == "" {
+		return nil, errors.New("the running beat is unknown, set the beat or fields option")
+	}
+	data, err := asset.GetFields(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.Errorf("no fields found for beat %v", name)
+	}
+	return mapping.LoadFields(data)
+}
+
+// runningBeat returns the name of the beat as reported in the info
+// monitoring namespace.
+func runningBeat() string {
+	reg := monitoring.GetNamespace("info").GetRegistry()
+	if v, ok := reg.Get("beat").(*monitoring.String); ok {
+		return v.Get()
+	}
+	return ""
+}
+
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	var violations []violation
+	p.walk("", event.Fields, &violations)
+	if len(violations) == 0 {
+		return event, nil
+	}
+
+	// Apply in a stable order so that nested offending fields are handled
+	// consistently.
+	sort.Slice(violations, func(i, j int) bool { return violations[i].field < violations[j].field })
+	for _, v := range violations {
+		p.log.Debugf("Field %v doesn't match its definition: %v", v.field, v.err)
+
+		switch p.Action {
+		case actionDrop:
+			if err := event.Delete(v.field); err != nil && err != common.ErrKeyNotFound {
+				return event, errors.Wrapf(err, "failed to drop field [%v]", v.field)
+			}
+		case actionMove:
+			value, err := event.GetValue(v.field)
+			if err != nil {
+				continue
+			}
+			if err := event.Delete(v.field); err != nil {
+				return event, errors.Wrapf(err, "failed to move field [%v]", v.field)
+			}
+			if _, err := event.Fields.Put(p.Target+"."+v.field, value); err != nil {
+				return event, errors.Wrapf(err, "failed to move field [%v]", v.field)
+			}
+		}
+	}
+
+	if p.Tag != "" {
+		if err := common.AddTags(event.Fields, []string{p.Tag}); err != nil {
+			return event, err
+		}
+	}
+	return event, nil
+}
+
+func (p *processor) walk(prefix string, fields common.MapStr, violations *[]violation) {
+	for key, value := range fields {
+		name := key
+		if prefix != "" {
+			name = prefix + "." + key
+		}
+		if prefix == "" && key == p.Target {
+			continue
+		}
+
+		typ, found := p.index.lookup(name)
+		if !found || typ == "group" || typ == "object" {
+			if found {
+				if err := checkValue(typ, value); err != nil {
+					*violations = append(*violations, violation{name, err})
+					continue
+				}
+			}
+			if m, ok := tryToMapStr(value); ok {
+				p.walk(name, m, violations)
+			}
+			continue
+		}
+
+		if err := checkValue(typ, value); err != nil {
+			*violations = append(*violations, violation{name, err})
+		}
+	}
+}
+
+func tryToMapStr(v interface{}) (common.MapStr, bool) {
+	switch m := v.(type) {
+	case common.MapStr:
+		return m, true
+	case map[string]interface{}:
+		return common.MapStr(m), true
+	}
+	return nil, false
+}
+
+func (p *processor) String() string {
+	json, _ := json.Marshal(p.config)
+	return procName + "=" + string(json)
+}
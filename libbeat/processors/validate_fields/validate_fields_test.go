@@ -0,0 +1,146 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package validate_fields
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/asset"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func newTestValidateFields(t *testing.T, cfg common.MapStr) *processor {
+	t.Helper()
+
+	cfg["fields"] = "testdata/fields.yml"
+	p, err := New(common.MustNewConfigFrom(cfg))
+	require.NoError(t, err)
+	return p.(*processor)
+}
+
+func TestValidEvent(t *testing.T) {
+	p := newTestValidateFields(t, common.MapStr{})
+
+	fields := common.MapStr{
+		"message": "hello",
+		"source":  common.MapStr{"ip": "10.0.0.1", "port": 443, "bytes": "1024"},
+		"event": common.MapStr{
+			"duration":   int64(12),
+			"risk_score": 1.5,
+			"created":    time.Now(),
+		},
+		"labels":  common.MapStr{"env": "prod"},
+		"http":    common.MapStr{"response": common.MapStr{"status_code": 200}},
+		"process": common.MapStr{"args": []string{"ls", "-l"}},
+		"enabled": true,
+		"custom":  common.MapStr{"anything": []int{1, 2}},
+	}
+	evt, err := p.Run(&beat.Event{Fields: fields.Clone()})
+	require.NoError(t, err)
+	assert.Equal(t, fields, evt.Fields)
+}
+
+func TestActions(t *testing.T) {
+	event := func() *beat.Event {
+		return &beat.Event{Fields: common.MapStr{
+			"message": common.MapStr{"text": "nested"},
+			"source":  common.MapStr{"ip": "not-an-ip", "port": 443},
+			"event":   common.MapStr{"duration": "slow"},
+			"labels":  common.MapStr{"env": common.MapStr{"name": "prod"}},
+			"enabled": "yes",
+		}}
+	}
+
+	t.Run("tag", func(t *testing.T) {
+		p := newTestValidateFields(t, common.MapStr{})
+		evt, err := p.Run(event())
+		require.NoError(t, err)
+
+		expected := event().Fields
+		expected["tags"] = []string{"_invalid_fields"}
+		assert.Equal(t, expected, evt.Fields)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		p := newTestValidateFields(t, common.MapStr{"action": "drop", "tag": ""})
+		evt, err := p.Run(event())
+		require.NoError(t, err)
+		assert.Equal(t, common.MapStr{
+			"source": common.MapStr{"port": 443},
+			"event":  common.MapStr{},
+			"labels": common.MapStr{},
+		}, evt.Fields)
+	})
+
+	t.Run("move", func(t *testing.T) {
+		p := newTestValidateFields(t, common.MapStr{"action": "move"})
+		evt, err := p.Run(event())
+		require.NoError(t, err)
+		assert.Equal(t, common.MapStr{
+			"source": common.MapStr{"port": 443},
+			"event":  common.MapStr{},
+			"labels": common.MapStr{},
+			"_invalid": common.MapStr{
+				"message": common.MapStr{"text": "nested"},
+				"source":  common.MapStr{"ip": "not-an-ip"},
+				"event":   common.MapStr{"duration": "slow"},
+				"labels":  common.MapStr{"env": common.MapStr{"name": "prod"}},
+				"enabled": "yes",
+			},
+			"tags": []string{"_invalid_fields"},
+		}, evt.Fields)
+	})
+}
+
+func TestGroupConflict(t *testing.T) {
+	p := newTestValidateFields(t, common.MapStr{"action": "move", "target": "invalid"})
+	evt, err := p.Run(&beat.Event{Fields: common.MapStr{"source": "10.0.0.1"}})
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{
+		"invalid": common.MapStr{"source": "10.0.0.1"},
+		"tags":    []string{"_invalid_fields"},
+	}, evt.Fields)
+}
+
+func TestEmbeddedFields(t *testing.T) {
+	asset.SetFields("validatebeat", "test", asset.BeatFieldsPri, func() string {
+		data, err := asset.EncodeData(`
+- key: test
+  title: Test
+  fields:
+    - name: count
+      type: long
+`)
+		require.NoError(t, err)
+		return data
+	})
+
+	p, err := New(common.MustNewConfigFrom(common.MapStr{"beat": "validatebeat", "action": "drop"}))
+	require.NoError(t, err)
+	evt, err := p.Run(&beat.Event{Fields: common.MapStr{"count": "many"}})
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{"tags": []string{"_invalid_fields"}}, evt.Fields)
+
+	_, err = New(common.MustNewConfigFrom(common.MapStr{"beat": "unknownbeat"}))
+	assert.Error(t, err)
+}
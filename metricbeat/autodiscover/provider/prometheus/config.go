@@ -0,0 +1,118 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"errors"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+var (
+	defaultFileRefreshInterval = 30 * time.Second
+	defaultHTTPRefreshInterval = 60 * time.Second
+	defaultHTTPTimeout         = 10 * time.Second
+)
+
+// Config for the prometheus autodiscover provider
+type Config struct {
+	// Files in the file_sd format listing the targets
+	FileSD *FileSDConfig `config:"file_sd"`
+
+	// HTTP endpoints in the http_sd format listing the targets
+	HTTPSD []HTTPSDConfig `config:"http_sd"`
+
+	// DefaultConfig is merged with the configuration of the collector
+	// started for targets not matching any template
+	DefaultConfig *common.Config `config:"default_config"`
+
+	Builders  []*common.Config        `config:"builders"`
+	Appenders []*common.Config        `config:"appenders"`
+	Templates template.MapperSettings `config:"templates"`
+}
+
+// FileSDConfig contains the settings to discover targets from files
+type FileSDConfig struct {
+	// Paths of the files, they can contain glob patterns
+	Files []string `config:"files" validate:"required"`
+
+	// Time between checks for changes in the files
+	RefreshInterval time.Duration `config:"refresh_interval" validate:"positive,nonzero"`
+}
+
+// HTTPSDConfig contains the settings to discover targets from an HTTP endpoint
+type HTTPSDConfig struct {
+	URL string `config:"url" validate:"required"`
+
+	// Time between requests to the endpoint
+	RefreshInterval time.Duration `config:"refresh_interval" validate:"positive,nonzero"`
+
+	// Timeout of the requests
+	Timeout time.Duration `config:"timeout" validate:"positive,nonzero"`
+
+	// Headers added to the requests
+	Headers map[string]string `config:"headers"`
+
+	TLS *tlscommon.Config `config:"ssl"`
+}
+
+// Validate checks that at least one source of targets is configured
+func (c *Config) Validate() error {
+	if c.FileSD == nil && len(c.HTTPSD) == 0 {
+		return errors.New("no file_sd or http_sd configured for prometheus autodiscover provider")
+	}
+	return nil
+}
+
+func (c *FileSDConfig) Unpack(from *common.Config) error {
+	// Overriding Unpack just to set defaults
+	// See https://github.com/elastic/go-ucfg/issues/104
+	type tmpConfig FileSDConfig
+	tmp := tmpConfig{
+		RefreshInterval: defaultFileRefreshInterval,
+	}
+
+	err := from.Unpack(&tmp)
+	if err != nil {
+		return err
+	}
+
+	*c = FileSDConfig(tmp)
+	return nil
+}
+
+func (c *HTTPSDConfig) Unpack(from *common.Config) error {
+	// Overriding Unpack just to set defaults
+	// See https://github.com/elastic/go-ucfg/issues/104
+	type tmpConfig HTTPSDConfig
+	tmp := tmpConfig{
+		RefreshInterval: defaultHTTPRefreshInterval,
+		Timeout:         defaultHTTPTimeout,
+	}
+
+	err := from.Unpack(&tmp)
+	if err != nil {
+		return err
+	}
+
+	*c = HTTPSDConfig(tmp)
+	return nil
+}
@@ -0,0 +1,247 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/keystore"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func init() {
+	autodiscover.Registry.AddProvider("prometheus", AutodiscoverBuilder)
+}
+
+// Provider is the Prometheus autodiscover provider, it discovers targets
+// listed in files and HTTP endpoints in the formats used by the Prometheus
+// file and HTTP service discovery mechanisms.
+type Provider struct {
+	log           *logp.Logger
+	uuid          uuid.UUID
+	bus           bus.Bus
+	builders      autodiscover.Builders
+	appenders     autodiscover.Appenders
+	templates     template.Mapper
+	defaultConfig *common.Config
+	sources       []source
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// AutodiscoverBuilder builds a Prometheus autodiscover provider, it fails if
+// there is some problem with the configuration
+func AutodiscoverBuilder(
+	beatName string,
+	bus bus.Bus,
+	uuid uuid.UUID,
+	c *common.Config,
+	keystore keystore.Keystore,
+) (autodiscover.Provider, error) {
+	errWrap := func(err error) error {
+		return errors.Wrap(err, "error setting up prometheus autodiscover provider")
+	}
+
+	var config Config
+	err := c.Unpack(&config)
+	if err != nil {
+		return nil, errWrap(err)
+	}
+
+	log := logp.NewLogger("autodiscover.prometheus")
+
+	var sources []source
+	if config.FileSD != nil {
+		s, err := newFileSource(log, config.FileSD)
+		if err != nil {
+			return nil, errWrap(err)
+		}
+		sources = append(sources, s)
+	}
+	for i := range config.HTTPSD {
+		s, err := newHTTPSource(&config.HTTPSD[i])
+		if err != nil {
+			return nil, errWrap(err)
+		}
+		sources = append(sources, s)
+	}
+
+	mapper, err := template.NewConfigMapper(config.Templates, keystore, nil)
+	if err != nil {
+		return nil, errWrap(err)
+	}
+
+	builders, err := autodiscover.NewBuilders(config.Builders, nil, nil)
+	if err != nil {
+		return nil, errWrap(err)
+	}
+
+	appenders, err := autodiscover.NewAppenders(config.Appenders)
+	if err != nil {
+		return nil, errWrap(err)
+	}
+
+	return &Provider{
+		log:           log,
+		uuid:          uuid,
+		bus:           bus,
+		templates:     mapper,
+		builders:      builders,
+		appenders:     appenders,
+		defaultConfig: config.DefaultConfig,
+		sources:       sources,
+	}, nil
+}
+
+// Start starts autodiscover provider
+func (p *Provider) Start() {
+	p.done = make(chan struct{})
+	for _, s := range p.sources {
+		p.wg.Add(1)
+		go p.watch(s)
+	}
+}
+
+// watch periodically checks a source, publishing start events for new
+// targets and stop events for targets not listed anymore.
+func (p *Provider) watch(s source) {
+	defer p.wg.Done()
+
+	known := map[string]target{}
+	defer func() {
+		for id, t := range known {
+			p.publish(p.busEvent("stop", id, t))
+		}
+	}()
+
+	ticker := time.NewTicker(s.Interval())
+	defer ticker.Stop()
+
+	for {
+		p.refresh(s, known)
+
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Provider) refresh(s source, known map[string]target) {
+	targets, err := s.Targets()
+	if err != nil {
+		p.log.Errorf("Error obtaining targets from %s, keeping previous targets: %v", s, err)
+		return
+	}
+
+	current := make(map[string]target, len(targets))
+	for _, t := range targets {
+		current[t.ID()] = t
+	}
+
+	for id, t := range known {
+		if _, found := current[id]; !found {
+			p.log.Debugf("Target %s not listed anymore in %s", t.address, s)
+			p.publish(p.busEvent("stop", id, t))
+			delete(known, id)
+		}
+	}
+	for id, t := range current {
+		if _, found := known[id]; !found {
+			p.log.Debugf("New target %s found in %s", t.address, s)
+			p.publish(p.busEvent("start", id, t))
+			known[id] = t
+		}
+	}
+}
+
+func (p *Provider) busEvent(eventType string, id string, t target) bus.Event {
+	message := t.message()
+	event := bus.Event{
+		eventType:    true,
+		"provider":   p.uuid,
+		"id":         id,
+		"prometheus": message,
+	}
+
+	host, port := t.hostPort()
+	event["host"] = host
+	if port != 0 {
+		event["port"] = port
+	}
+
+	if labels, found := message["labels"]; found {
+		event["meta"] = common.MapStr{
+			"prometheus": common.MapStr{
+				"labels": labels,
+			},
+		}
+	}
+	return event
+}
+
+func (p *Provider) publish(event bus.Event) {
+	if config := p.templates.GetConfig(event); len(config) > 0 {
+		event["config"] = config
+	} else if config := p.builders.GetConfig(event); len(config) > 0 {
+		event["config"] = config
+	} else if config, err := p.collectorConfig(event); err != nil {
+		p.log.Errorf("Error building configuration for target %v: %v", event["id"], err)
+	} else {
+		event["config"] = []*common.Config{config}
+	}
+
+	p.appenders.Append(event)
+	p.bus.Publish(event)
+}
+
+// collectorConfig builds the configuration of the collector metricset used
+// for targets not matching any template.
+func (p *Provider) collectorConfig(event bus.Event) (*common.Config, error) {
+	message := event["prometheus"].(common.MapStr)
+	config := common.MustNewConfigFrom(common.MapStr{
+		"module":     "prometheus",
+		"metricsets": []string{"collector"},
+		"hosts":      []string{message["url"].(string)},
+	})
+	if p.defaultConfig == nil {
+		return config, nil
+	}
+	return common.MergeConfigs(p.defaultConfig, config)
+}
+
+// Stop stops autodiscover provider
+func (p *Provider) Stop() {
+	close(p.done)
+	p.wg.Wait()
+}
+
+// String returns the name of the provider
+func (p *Provider) String() string {
+	return "prometheus"
+}
@@ -0,0 +1,321 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package prometheus
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func TestConfigValidation(t *testing.T) {
+	cases := []struct {
+		Description string
+		Config      map[string]interface{}
+		Valid       bool
+	}{
+		{
+			Description: "no sources",
+			Config:      map[string]interface{}{},
+			Valid:       false,
+		},
+		{
+			Description: "file_sd without files",
+			Config: map[string]interface{}{
+				"file_sd": map[string]interface{}{},
+			},
+			Valid: false,
+		},
+		{
+			Description: "http_sd without url",
+			Config: map[string]interface{}{
+				"http_sd": []map[string]interface{}{{}},
+			},
+			Valid: false,
+		},
+		{
+			Description: "http_sd with zero refresh interval",
+			Config: map[string]interface{}{
+				"http_sd": []map[string]interface{}{{
+					"url":              "http://localhost/targets",
+					"refresh_interval": "0s",
+				}},
+			},
+			Valid: false,
+		},
+		{
+			Description: "file_sd",
+			Config: map[string]interface{}{
+				"file_sd": map[string]interface{}{
+					"files": []string{"/etc/targets/*.json"},
+				},
+			},
+			Valid: true,
+		},
+		{
+			Description: "http_sd",
+			Config: map[string]interface{}{
+				"http_sd": []map[string]interface{}{{
+					"url": "http://localhost/targets",
+				}},
+			},
+			Valid: true,
+		},
+	}
+
+	for _, c := range cases {
+		var config Config
+		err := common.MustNewConfigFrom(c.Config).Unpack(&config)
+		if c.Valid {
+			assert.NoError(t, err, c.Description)
+		} else {
+			assert.Error(t, err, c.Description)
+		}
+	}
+}
+
+func TestConfigDefaults(t *testing.T) {
+	var config Config
+	err := common.MustNewConfigFrom(map[string]interface{}{
+		"file_sd": map[string]interface{}{
+			"files": []string{"targets.yml"},
+		},
+		"http_sd": []map[string]interface{}{{
+			"url": "http://localhost/targets",
+		}},
+	}).Unpack(&config)
+	require.NoError(t, err)
+
+	assert.Equal(t, defaultFileRefreshInterval, config.FileSD.RefreshInterval)
+	require.Len(t, config.HTTPSD, 1)
+	assert.Equal(t, defaultHTTPRefreshInterval, config.HTTPSD[0].RefreshInterval)
+	assert.Equal(t, defaultHTTPTimeout, config.HTTPSD[0].Timeout)
+}
+
+func TestTarget(t *testing.T) {
+	targets, err := parseTargetGroups("test", []byte(`[
+		{
+			"targets": ["10.0.0.1:9100"],
+			"labels": {
+				"env": "prod",
+				"__scheme__": "https",
+				"__metrics_path__": "/custom/metrics",
+				"__param_module": "node"
+			}
+		},
+		{
+			"targets": ["node.local"]
+		}
+	]`), false)
+	require.NoError(t, err)
+	require.Len(t, targets, 2)
+
+	target := targets[0]
+	assert.Equal(t, "https://10.0.0.1:9100/custom/metrics?module=node", target.URL())
+	assert.Equal(t, common.MapStr{"env": "prod"}, target.publicLabels())
+	host, port := target.hostPort()
+	assert.Equal(t, "10.0.0.1", host)
+	assert.Equal(t, 9100, port)
+
+	target = targets[1]
+	assert.Equal(t, "http://node.local/metrics", target.URL())
+	host, port = target.hostPort()
+	assert.Equal(t, "node.local", host)
+	assert.Equal(t, 0, port)
+
+	// Same target with other labels is a different target
+	other := targets[0]
+	other.labels = map[string]string{"env": "dev"}
+	assert.NotEqual(t, targets[0].ID(), other.ID())
+	assert.Equal(t, targets[0].ID(), targets[0].ID())
+}
+
+func TestParseTargetGroupsInvalid(t *testing.T) {
+	_, err := parseTargetGroups("test", []byte(`{"targets": "foo"}`), false)
+	assert.Error(t, err)
+
+	_, err = parseTargetGroups("test", []byte(`[{"targets": [""]}]`), false)
+	assert.Error(t, err)
+}
+
+func TestFileSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "prometheus-file-sd")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeFile(t, filepath.Join(dir, "a.json"), `[{"targets": ["host1:9100", "host2:9100"], "labels": {"job": "node"}}]`)
+	writeFile(t, filepath.Join(dir, "b.yml"), "- targets: ['host3:8080']\n  labels:\n    job: app\n")
+	writeFile(t, filepath.Join(dir, "ignored.txt"), "foo")
+
+	s, err := newFileSource(logp.NewLogger("test"), &FileSDConfig{
+		Files: []string{filepath.Join(dir, "*.json"), filepath.Join(dir, "*.yml")},
+	})
+	require.NoError(t, err)
+
+	targets, err := s.Targets()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"host1:9100", "host2:9100", "host3:8080"}, addresses(targets))
+
+	// Invalid files keep their last valid targets
+	writeFile(t, filepath.Join(dir, "b.yml"), "- targets: [[\n")
+	targets, err = s.Targets()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"host1:9100", "host2:9100", "host3:8080"}, addresses(targets))
+
+	// Targets of removed files are removed
+	require.NoError(t, os.Remove(filepath.Join(dir, "a.json")))
+	targets, err = s.Targets()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"host3:8080"}, addresses(targets))
+}
+
+func TestHTTPSource(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(`[{"targets": ["host1:9100"], "labels": {"job": "node"}}]`))
+	}))
+	defer server.Close()
+
+	var config HTTPSDConfig
+	err := common.MustNewConfigFrom(map[string]interface{}{
+		"url":     server.URL,
+		"headers": map[string]string{"Authorization": "Bearer secret"},
+	}).Unpack(&config)
+	require.NoError(t, err)
+
+	s, err := newHTTPSource(&config)
+	require.NoError(t, err)
+
+	targets, err := s.Targets()
+	require.NoError(t, err)
+	require.Len(t, targets, 1)
+	assert.Equal(t, "host1:9100", targets[0].address)
+	assert.Equal(t, server.URL, targets[0].source)
+
+	status = http.StatusInternalServerError
+	_, err = s.Targets()
+	assert.Error(t, err)
+}
+
+func TestProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "prometheus-file-sd")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "targets.json")
+	writeFile(t, path, `[{"targets": ["host1:9100"], "labels": {"job": "node"}}]`)
+
+	config := common.MustNewConfigFrom(map[string]interface{}{
+		"file_sd": map[string]interface{}{
+			"files":            []string{path},
+			"refresh_interval": "10ms",
+		},
+		"default_config": map[string]interface{}{
+			"period": "30s",
+		},
+	})
+
+	b := bus.New(logp.NewLogger("bus"), "test")
+	listener := b.Subscribe()
+	defer listener.Stop()
+
+	provider, err := AutodiscoverBuilder("metricbeat", b, uuid.Must(uuid.NewV4()), config, nil)
+	require.NoError(t, err)
+	provider.Start()
+
+	event := nextEvent(t, listener)
+	assert.Equal(t, true, event["start"])
+	assert.Equal(t, "host1", event["host"])
+	assert.Equal(t, 9100, event["port"])
+	assert.Equal(t, common.MapStr{"prometheus": common.MapStr{"labels": common.MapStr{"job": "node"}}}, event["meta"])
+
+	configs := event["config"].([]*common.Config)
+	require.Len(t, configs, 1)
+	var collector struct {
+		Module     string   `config:"module"`
+		Metricsets []string `config:"metricsets"`
+		Hosts      []string `config:"hosts"`
+		Period     string   `config:"period"`
+	}
+	require.NoError(t, configs[0].Unpack(&collector))
+	assert.Equal(t, "prometheus", collector.Module)
+	assert.Equal(t, []string{"collector"}, collector.Metricsets)
+	assert.Equal(t, []string{"http://host1:9100/metrics"}, collector.Hosts)
+	assert.Equal(t, "30s", collector.Period)
+
+	// Changing the labels of a target replaces it
+	writeFile(t, path, `[{"targets": ["host1:9100"], "labels": {"job": "other"}}]`)
+	var started, stopped bus.Event
+	for started == nil || stopped == nil {
+		event := nextEvent(t, listener)
+		if _, ok := event["start"]; ok {
+			started = event
+		} else {
+			stopped = event
+		}
+	}
+	assert.Equal(t, common.MapStr{"prometheus": common.MapStr{"labels": common.MapStr{"job": "other"}}}, started["meta"])
+	assert.Equal(t, common.MapStr{"prometheus": common.MapStr{"labels": common.MapStr{"job": "node"}}}, stopped["meta"])
+
+	// Known targets are stopped with the provider
+	provider.Stop()
+	event = nextEvent(t, listener)
+	assert.Equal(t, true, event["stop"])
+	assert.Equal(t, started["id"], event["id"])
+}
+
+func nextEvent(t *testing.T, listener bus.Listener) bus.Event {
+	t.Helper()
+	select {
+	case event := <-listener.Events():
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for event")
+	}
+	return nil
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+}
+
+func addresses(targets []target) []string {
+	var result []string
+	for _, t := range targets {
+		result = append(result, t.address)
+	}
+	return result
+}
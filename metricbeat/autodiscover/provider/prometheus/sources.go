@@ -0,0 +1,235 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// source is a source of targets that is periodically checked
+type source interface {
+	// String returns the name of the source, for logging purposes
+	String() string
+
+	// Interval is the time between checks of the source
+	Interval() time.Duration
+
+	// Targets returns the targets currently listed by the source. If an
+	// error is returned, previous targets should be kept.
+	Targets() ([]target, error)
+}
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+	targets []target
+}
+
+// fileSource discovers targets from files in the file_sd format
+type fileSource struct {
+	log      *logp.Logger
+	patterns []string
+	interval time.Duration
+
+	files map[string]fileState
+}
+
+func newFileSource(log *logp.Logger, config *FileSDConfig) (*fileSource, error) {
+	for _, pattern := range config.Files {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return nil, errors.Wrapf(err, "invalid file pattern '%s'", pattern)
+		}
+	}
+	return &fileSource{
+		log:      log,
+		patterns: config.Files,
+		interval: config.RefreshInterval,
+		files:    map[string]fileState{},
+	}, nil
+}
+
+func (s *fileSource) String() string {
+	return "file_sd " + strings.Join(s.patterns, ",")
+}
+
+func (s *fileSource) Interval() time.Duration {
+	return s.interval
+}
+
+// Targets reads the targets from the files matching the configured patterns.
+// Files are only parsed again if they have changed, and the last valid
+// targets of a file are kept if it cannot be parsed.
+func (s *fileSource) Targets() ([]target, error) {
+	paths := map[string]bool{}
+	for _, pattern := range s.patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range matches {
+			paths[path] = true
+		}
+	}
+
+	for path := range s.files {
+		if !paths[path] {
+			delete(s.files, path)
+		}
+	}
+
+	sorted := make([]string, 0, len(paths))
+	for path := range paths {
+		sorted = append(sorted, path)
+	}
+	sort.Strings(sorted)
+
+	var targets []target
+	for _, path := range sorted {
+		state, err := s.readFile(path)
+		if err != nil {
+			s.log.Errorf("Error reading targets from %s, keeping previous targets: %v", path, err)
+		}
+		targets = append(targets, state.targets...)
+	}
+	return targets, nil
+}
+
+func (s *fileSource) readFile(path string) (fileState, error) {
+	prev := s.files[path]
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return prev, err
+	}
+	if info.IsDir() {
+		return prev, fmt.Errorf("%s is a directory", path)
+	}
+	if info.ModTime().Equal(prev.modTime) && info.Size() == prev.size {
+		return prev, nil
+	}
+
+	var yamlFormat bool
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+	case ".yml", ".yaml":
+		yamlFormat = true
+	default:
+		return prev, fmt.Errorf("unsupported file extension '%s'", ext)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return prev, err
+	}
+	targets, err := parseTargetGroups(path, data, yamlFormat)
+	if err != nil {
+		return prev, err
+	}
+
+	state := fileState{
+		modTime: info.ModTime(),
+		size:    info.Size(),
+		targets: targets,
+	}
+	s.files[path] = state
+	return state, nil
+}
+
+// httpSource discovers targets from an endpoint in the http_sd format
+type httpSource struct {
+	url      string
+	headers  map[string]string
+	interval time.Duration
+	client   *http.Client
+}
+
+func newHTTPSource(config *HTTPSDConfig) (*httpSource, error) {
+	tlsConfig, err := tlscommon.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return nil, errors.Wrapf(err, "loading TLS configuration for %s", config.URL)
+	}
+
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+	}
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig.ToConfig()
+	}
+
+	return &httpSource{
+		url:      config.URL,
+		headers:  config.Headers,
+		interval: config.RefreshInterval,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   config.Timeout,
+		},
+	}, nil
+}
+
+func (s *httpSource) String() string {
+	return "http_sd " + s.url
+}
+
+func (s *httpSource) Interval() time.Duration {
+	return s.interval
+}
+
+// Targets requests the list of targets to the endpoint
+func (s *httpSource) Targets() ([]target, error) {
+	req, err := http.NewRequest("GET", s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	for name, value := range s.headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, s.url)
+	}
+	if mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type")); err != nil || mediaType != "application/json" {
+		return nil, fmt.Errorf("unexpected content type '%s' from %s", resp.Header.Get("Content-Type"), s.url)
+	}
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading response from %s", s.url)
+	}
+	return parseTargetGroups(s.url, data, false)
+}
@@ -0,0 +1,192 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// Labels with special meaning in target groups, as in Prometheus
+const (
+	metricsPathLabel = "__metrics_path__"
+	schemeLabel      = "__scheme__"
+	paramLabelPrefix = "__param_"
+
+	defaultMetricsPath = "/metrics"
+	defaultScheme      = "http"
+)
+
+// targetGroup is a group of targets sharing the same labels, in the format
+// used by the file and HTTP service discovery mechanisms of Prometheus.
+type targetGroup struct {
+	Targets []string          `json:"targets" yaml:"targets"`
+	Labels  map[string]string `json:"labels" yaml:"labels"`
+}
+
+// target is an endpoint discovered by some source
+type target struct {
+	// source is the file or URL where the target was found
+	source  string
+	address string
+	labels  map[string]string
+}
+
+// parseTargetGroups parses a list of target groups, in YAML if yamlFormat is
+// set, or in JSON otherwise.
+func parseTargetGroups(source string, data []byte, yamlFormat bool) ([]target, error) {
+	var groups []targetGroup
+	var err error
+	if yamlFormat {
+		err = yaml.Unmarshal(data, &groups)
+	} else {
+		err = json.Unmarshal(data, &groups)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing target groups from %s: %v", source, err)
+	}
+
+	var targets []target
+	for i, group := range groups {
+		for _, address := range group.Targets {
+			if address == "" {
+				return nil, fmt.Errorf("empty target in group %d of %s", i, source)
+			}
+			targets = append(targets, target{
+				source:  source,
+				address: address,
+				labels:  group.Labels,
+			})
+		}
+	}
+	return targets, nil
+}
+
+// ID identifies the target, targets with the same address found in
+// different sources or with different labels are considered different
+// targets.
+func (t *target) ID() string {
+	h := fnv.New64a()
+	h.Write([]byte(t.source))
+	h.Write([]byte{0})
+	h.Write([]byte(t.address))
+	for _, name := range t.sortedLabelNames() {
+		h.Write([]byte{0})
+		h.Write([]byte(name))
+		h.Write([]byte{0})
+		h.Write([]byte(t.labels[name]))
+	}
+	return fmt.Sprintf("%s-%x", t.address, h.Sum64())
+}
+
+func (t *target) sortedLabelNames() []string {
+	names := make([]string, 0, len(t.labels))
+	for name := range t.labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (t *target) scheme() string {
+	if scheme := t.labels[schemeLabel]; scheme != "" {
+		return scheme
+	}
+	return defaultScheme
+}
+
+func (t *target) metricsPath() string {
+	if path := t.labels[metricsPathLabel]; path != "" {
+		return path
+	}
+	return defaultMetricsPath
+}
+
+// query returns the query parameters set with __param_<name> labels
+func (t *target) query() url.Values {
+	query := url.Values{}
+	for name, value := range t.labels {
+		if strings.HasPrefix(name, paramLabelPrefix) {
+			query.Set(strings.TrimPrefix(name, paramLabelPrefix), value)
+		}
+	}
+	return query
+}
+
+// publicLabels returns the labels of the target that are not used
+// internally, these are the labels added to the events.
+func (t *target) publicLabels() common.MapStr {
+	labels := common.MapStr{}
+	for name, value := range t.labels {
+		if !strings.HasPrefix(name, "__") {
+			labels[name] = value
+		}
+	}
+	return labels
+}
+
+// URL returns the URL where metrics of the target are exposed
+func (t *target) URL() string {
+	u := url.URL{
+		Scheme:   t.scheme(),
+		Host:     t.address,
+		Path:     t.metricsPath(),
+		RawQuery: t.query().Encode(),
+	}
+	return u.String()
+}
+
+// message returns the information of the target available for templates
+// and conditions.
+func (t *target) message() common.MapStr {
+	m := common.MapStr{
+		"address":      t.address,
+		"url":          t.URL(),
+		"scheme":       t.scheme(),
+		"metrics_path": t.metricsPath(),
+		"source":       t.source,
+	}
+	if labels := t.publicLabels(); len(labels) > 0 {
+		m["labels"] = labels
+	}
+	return m
+}
+
+// hostPort splits the address of the target, port is zero if it cannot be
+// obtained.
+func (t *target) hostPort() (string, int) {
+	host, port, err := net.SplitHostPort(t.address)
+	if err != nil {
+		return t.address, 0
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		return host, 0
+	}
+	return host, p
+}
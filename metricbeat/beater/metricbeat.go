@@ -33,6 +33,9 @@ import (
 	"github.com/elastic/beats/v7/metricbeat/mb"
 	"github.com/elastic/beats/v7/metricbeat/mb/module"
 
+	// include all metricbeat specific providers
+	_ "github.com/elastic/beats/v7/metricbeat/autodiscover/provider/prometheus"
+
 	// include all metricbeat specific builders
 	_ "github.com/elastic/beats/v7/metricbeat/autodiscover/builder/hints"
 
@@ -32,13 +32,18 @@ import (
 )
 
 type HttpServer struct {
-	server     *http.Server
-	ctx        context.Context
-	stop       context.CancelFunc
-	done       chan struct{}
-	eventQueue chan server.Event
+	server       *http.Server
+	ctx          context.Context
+	stop         context.CancelFunc
+	done         chan struct{}
+	eventQueue   chan server.Event
+	authenticate Authenticator
 }
 
+// Authenticator validates a request with its body, requests for which it
+// returns an error are rejected.
+type Authenticator func(req *http.Request, body []byte) error
+
 type HttpEvent struct {
 	event common.MapStr
 	meta  server.Meta
@@ -53,7 +58,10 @@ func (h *HttpEvent) GetMeta() server.Meta {
 }
 
 func getDefaultHttpServer(mb mb.BaseMetricSet) (*HttpServer, error) {
-	config := defaultHttpConfig()
+	return getHttpServer(mb, defaultHttpConfig())
+}
+
+func getHttpServer(mb mb.BaseMetricSet, config HttpConfig) (*HttpServer, error) {
 	err := mb.Module().UnpackConfig(&config)
 	if err != nil {
 		return nil, err
@@ -92,6 +100,19 @@ func NewHttpServer(mb mb.BaseMetricSet) (server.Server, error) {
 	return h, nil
 }
 
+// NewHttpServerWithAuthenticator creates a server that only accepts the data
+// of the requests validated by the given authenticator.
+func NewHttpServerWithAuthenticator(mb mb.BaseMetricSet, authenticate Authenticator) (server.Server, error) {
+	h, err := getDefaultHttpServer(mb)
+	if err != nil {
+		return nil, err
+	}
+	h.server.Handler = http.HandlerFunc(h.handleFunc)
+	h.authenticate = authenticate
+
+	return h, nil
+}
+
 func NewHttpServerWithHandler(mb mb.BaseMetricSet, handlerFunc http.HandlerFunc) (server.Server, error) {
 	h, err := getDefaultHttpServer(mb)
 	if err != nil {
@@ -102,6 +123,18 @@ func NewHttpServerWithHandler(mb mb.BaseMetricSet, handlerFunc http.HandlerFunc)
 	return h, nil
 }
 
+// NewHttpServerWithDefaults creates a server with the given handler, the
+// settings of the metricset are applied over the given defaults.
+func NewHttpServerWithDefaults(mb mb.BaseMetricSet, defaults HttpConfig, handler http.Handler) (server.Server, error) {
+	h, err := getHttpServer(mb, defaults)
+	if err != nil {
+		return nil, err
+	}
+	h.server.Handler = handler
+
+	return h, nil
+}
+
 func (h *HttpServer) Start() error {
 	go func() {
 		if h.server.TLSConfig != nil {
@@ -154,6 +187,14 @@ func (h *HttpServer) handleFunc(writer http.ResponseWriter, req *http.Request) {
 			return
 		}
 
+		if h.authenticate != nil {
+			if err := h.authenticate(req, body); err != nil {
+				logp.Debug("http", "Rejected request from %s: %v", req.RemoteAddr, err)
+				http.Error(writer, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+		}
+
 		payload := common.MapStr{
 			server.EventDataKey: body,
 		}
@@ -27,11 +27,13 @@ import (
 	"io/ioutil"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/elastic/beats/v7/metricbeat/helper/server"
@@ -88,6 +90,34 @@ func TestHTTPServers(t *testing.T) {
 	}
 }
 
+func TestHTTPServerAuthenticator(t *testing.T) {
+	h := &HttpServer{
+		eventQueue: make(chan server.Event, 1),
+		authenticate: func(req *http.Request, body []byte) error {
+			if req.Header.Get("Authorization") != "Bearer "+string(body) {
+				return errors.New("invalid token")
+			}
+			return nil
+		},
+	}
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader("secret"))
+	rec := httptest.NewRecorder()
+	h.handleFunc(rec, req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Len(t, h.eventQueue, 0)
+
+	req = httptest.NewRequest("POST", "/", strings.NewReader("secret"))
+	req.Header.Set("Authorization", "Bearer secret")
+	rec = httptest.NewRecorder()
+	h.handleFunc(rec, req)
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	if assert.Len(t, h.eventQueue, 1) {
+		msg := <-h.eventQueue
+		assert.Equal(t, []byte("secret"), msg.GetEvent()[server.EventDataKey])
+	}
+}
+
 func checkServerReady(host string, port int) error {
 
 	const (
@@ -59,8 +59,9 @@ func NewDBClient(driver, uri string, l *logp.Logger) (*DbClient, error) {
 }
 
 // fetchTableMode scan the rows and publishes the event for querys that return the response in a table format.
-func (d *DbClient) FetchTableMode(ctx context.Context, q string) ([]common.MapStr, error) {
-	rows, err := d.QueryContext(ctx, q)
+// Optional args are passed to the driver as query parameters.
+func (d *DbClient) FetchTableMode(ctx context.Context, q string, args ...interface{}) ([]common.MapStr, error) {
+	rows, err := d.QueryContext(ctx, q, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -111,8 +112,9 @@ func (d *DbClient) fetchTableMode(rows sqlRow) ([]common.MapStr, error) {
 }
 
 // fetchTableMode scan the rows and publishes the event for querys that return the response in a table format.
-func (d *DbClient) FetchVariableMode(ctx context.Context, q string) (common.MapStr, error) {
-	rows, err := d.QueryContext(ctx, q)
+// Optional args are passed to the driver as query parameters.
+func (d *DbClient) FetchVariableMode(ctx context.Context, q string, args ...interface{}) (common.MapStr, error) {
+	rows, err := d.QueryContext(ctx, q, args...)
 	if err != nil {
 		return nil, err
 	}
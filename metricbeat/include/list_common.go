@@ -98,7 +98,10 @@ import (
 	_ "github.com/elastic/beats/v7/metricbeat/module/linux/iostat"
 	_ "github.com/elastic/beats/v7/metricbeat/module/linux/ksm"
 	_ "github.com/elastic/beats/v7/metricbeat/module/linux/memory"
+	_ "github.com/elastic/beats/v7/metricbeat/module/linux/netstat"
 	_ "github.com/elastic/beats/v7/metricbeat/module/linux/pageinfo"
+	_ "github.com/elastic/beats/v7/metricbeat/module/linux/pressure"
+	_ "github.com/elastic/beats/v7/metricbeat/module/linux/vmstat"
 	_ "github.com/elastic/beats/v7/metricbeat/module/logstash"
 	_ "github.com/elastic/beats/v7/metricbeat/module/logstash/node"
 	_ "github.com/elastic/beats/v7/metricbeat/module/logstash/node_stats"
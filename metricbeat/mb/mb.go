@@ -379,22 +379,25 @@ func (b *BaseMetricSet) Registration() MetricSetRegistration {
 // the metricset fetches not only the predefined fields but add alls raw data under
 // the raw namespace to the event.
 type ModuleConfig struct {
-	Hosts       []string      `config:"hosts"`
-	Period      time.Duration `config:"period"     validate:"positive"`
-	Timeout     time.Duration `config:"timeout"    validate:"positive"`
-	Module      string        `config:"module"     validate:"required"`
-	MetricSets  []string      `config:"metricsets"`
-	Enabled     bool          `config:"enabled"`
-	Raw         bool          `config:"raw"`
-	Query       QueryParams   `config:"query"`
-	ServiceName string        `config:"service.name"`
+	Hosts        []string      `config:"hosts"`
+	Period       time.Duration `config:"period"        validate:"positive"`
+	Timeout      time.Duration `config:"timeout"       validate:"positive"`
+	FetchTimeout time.Duration `config:"fetch_timeout" validate:"positive"` // Hard limit for each fetch, 0 to disable.
+	Jitter       time.Duration `config:"jitter"        validate:"positive"` // Upper bound of the random startup delay of each metricset.
+	Module       string        `config:"module"        validate:"required"`
+	MetricSets   []string      `config:"metricsets"`
+	Enabled      bool          `config:"enabled"`
+	Raw          bool          `config:"raw"`
+	Query        QueryParams   `config:"query"`
+	ServiceName  string        `config:"service.name"`
 }
 
 func (c ModuleConfig) String() string {
 	return fmt.Sprintf(`{Module:"%v", MetricSets:%v, Enabled:%v, `+
-		`Hosts:[%v hosts], Period:"%v", Timeout:"%v", Raw:%v, Query:%v}`,
+		`Hosts:[%v hosts], Period:"%v", Timeout:"%v", FetchTimeout:"%v", `+
+		`Jitter:"%v", Raw:%v, Query:%v}`,
 		c.Module, c.MetricSets, c.Enabled, len(c.Hosts), c.Period, c.Timeout,
-		c.Raw, c.Query)
+		c.FetchTimeout, c.Jitter, c.Raw, c.Query)
 }
 
 func (c ModuleConfig) GoString() string { return c.String() }
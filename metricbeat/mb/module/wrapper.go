@@ -24,19 +24,27 @@ import (
 	"sync"
 	"time"
 
+	"github.com/pkg/errors"
+	"github.com/rcrowley/go-metrics"
+
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/atomic"
 	"github.com/elastic/beats/v7/libbeat/logp"
 	"github.com/elastic/beats/v7/libbeat/monitoring"
+	"github.com/elastic/beats/v7/libbeat/monitoring/adapter"
 	"github.com/elastic/beats/v7/libbeat/testing"
 	"github.com/elastic/beats/v7/metricbeat/mb"
 )
 
 // Expvar metric names.
 const (
-	successesKey = "success"
-	failuresKey  = "failures"
-	eventsKey    = "events"
+	successesKey     = "success"
+	failuresKey      = "failures"
+	eventsKey        = "events"
+	skippedKey       = "skipped"
+	timeoutsKey      = "timeouts"
+	fetchDurationKey = "fetch_duration"
 )
 
 var (
@@ -67,15 +75,20 @@ type metricSetWrapper struct {
 	stats  *stats   // stats for this MetricSet.
 
 	periodic bool // Set to true if this metricset is a periodic fetcher
+
+	running atomic.Bool // Set while a periodic fetch is in progress
 }
 
 // stats bundles common metricset stats.
 type stats struct {
-	key      string          // full stats key
-	ref      uint32          // number of modules/metricsets reusing stats instance
-	success  *monitoring.Int // Total success events.
-	failures *monitoring.Int // Total error events.
-	events   *monitoring.Int // Total events published.
+	key           string          // full stats key
+	ref           uint32          // number of modules/metricsets reusing stats instance
+	success       *monitoring.Int // Total success events.
+	failures      *monitoring.Int // Total error events.
+	events        *monitoring.Int // Total events published.
+	skipped       *monitoring.Int // Total fetches skipped because the previous one was still running.
+	timeouts      *monitoring.Int // Total fetches that exceeded the fetch timeout.
+	fetchDuration metrics.Sample  // Duration of fetches in nanoseconds.
 }
 
 // NewWrapper creates a new module and its associated metricsets based on the given configuration.
@@ -175,9 +188,14 @@ func (msw *metricSetWrapper) run(done <-chan struct{}, out chan<- beat.Event) {
 	defer logp.Recover(fmt.Sprintf("recovered from panic while fetching "+
 		"'%s/%s' for host '%s'", msw.module.Name(), msw.Name(), msw.Host()))
 
-	// Start each metricset randomly over a period of MaxDelayPeriod.
-	if msw.module.maxStartDelay > 0 {
-		delay := time.Duration(rand.Int63n(int64(msw.module.maxStartDelay)))
+	// Start each metricset randomly over a period of MaxDelayPeriod, or of
+	// the jitter of the module if it is configured.
+	maxStartDelay := msw.module.maxStartDelay
+	if jitter := msw.Module().Config().Jitter; jitter > 0 {
+		maxStartDelay = jitter
+	}
+	if maxStartDelay > 0 {
+		delay := time.Duration(rand.Int63n(int64(maxStartDelay)))
 		debugf("%v/%v will start after %v", msw.module.Name(), msw.Name(), delay)
 		select {
 		case <-done:
@@ -214,14 +232,18 @@ func (msw *metricSetWrapper) run(done <-chan struct{}, out chan<- beat.Event) {
 }
 
 // startPeriodicFetching performs an immediate fetch for the MetricSet then it
-// begins a continuous timer scheduled loop to fetch data. To stop the loop the
-// done channel should be closed.
+// begins a continuous timer scheduled loop to fetch data. Fetches are skipped
+// while the previous one is still running. To stop the loop the done channel
+// should be closed, it returns after the running fetch finishes.
 func (msw *metricSetWrapper) startPeriodicFetching(ctx context.Context, reporter reporter) {
 	// Indicate that it has been started as periodic fetcher
 	msw.periodic = true
 
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
 	// Fetch immediately.
-	msw.fetch(ctx, reporter)
+	msw.startFetch(ctx, reporter, &wg)
 
 	// Start timer for future fetches.
 	t := time.NewTicker(msw.Module().Config().Period)
@@ -231,11 +253,60 @@ func (msw *metricSetWrapper) startPeriodicFetching(ctx context.Context, reporter
 		case <-reporter.V2().Done():
 			return
 		case <-t.C:
-			msw.fetch(ctx, reporter)
+			msw.startFetch(ctx, reporter, &wg)
 		}
 	}
 }
 
+// startFetch runs a fetch in the background, unless the previous one is still
+// running, in which case the fetch is skipped.
+func (msw *metricSetWrapper) startFetch(ctx context.Context, reporter reporter, wg *sync.WaitGroup) {
+	if !msw.running.CAS(false, true) {
+		msw.stats.skipped.Inc()
+		debugf("Skipping fetch of %s, the previous one is still running", msw)
+		return
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer msw.running.Store(false)
+		defer logp.Recover(fmt.Sprintf("recovered from panic while fetching "+
+			"'%s/%s' for host '%s'", msw.module.Name(), msw.Name(), msw.Host()))
+
+		msw.fetchWithTimeout(ctx, reporter)
+	}()
+}
+
+// fetchWithTimeout invokes fetch and records its duration. If the module has
+// a fetch timeout, the context passed to the fetch is cancelled when it
+// expires, and fetch reports the timeout. Fetchers that don't receive a context
+// cannot be interrupted, but next fetches are skipped until they finish.
+func (msw *metricSetWrapper) fetchWithTimeout(ctx context.Context, reporter reporter) {
+	start := time.Now()
+	defer func() {
+		msw.stats.fetchDuration.Update(int64(time.Since(start)))
+	}()
+
+	timeout := msw.Module().Config().FetchTimeout
+	if timeout <= 0 {
+		msw.fetch(ctx, reporter)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Only accounts for the timeout, the context takes care of cancelling the fetch
+	timer := time.AfterFunc(timeout, func() {
+		msw.stats.timeouts.Inc()
+		logp.Info("Fetch of metricset %s.%s timed out after %v", msw.module.Name(), msw.Name(), timeout)
+	})
+	defer timer.Stop()
+
+	msw.fetch(ctx, reporter)
+}
+
 // fetch invokes the appropriate Fetch method for the MetricSet and publishes
 // the result using the publisher client. This method will recover from panics
 // and log a stack track if one occurs.
@@ -262,6 +333,9 @@ func (msw *metricSetWrapper) fetch(ctx context.Context, reporter reporter) {
 		reporter.StartFetchTimer()
 		err := fetcher.Fetch(ctx, reporter.V2())
 		if err != nil {
+			if ctx.Err() == context.DeadlineExceeded {
+				err = errors.Errorf("timeout fetching data after %v", msw.Module().Config().FetchTimeout)
+			}
 			reporter.V2().Error(err)
 			logp.Info("Error fetching data for metricset %s.%s: %s", msw.module.Name(), msw.Name(), err)
 		}
@@ -437,12 +511,17 @@ func getMetricSetStats(module, name string) *stats {
 
 	reg := monitoring.Default.NewRegistry(key)
 	s := &stats{
-		key:      key,
-		ref:      1,
-		success:  monitoring.NewInt(reg, successesKey),
-		failures: monitoring.NewInt(reg, failuresKey),
-		events:   monitoring.NewInt(reg, eventsKey),
+		key:           key,
+		ref:           1,
+		success:       monitoring.NewInt(reg, successesKey),
+		failures:      monitoring.NewInt(reg, failuresKey),
+		events:        monitoring.NewInt(reg, eventsKey),
+		skipped:       monitoring.NewInt(reg, skippedKey),
+		timeouts:      monitoring.NewInt(reg, timeoutsKey),
+		fetchDuration: metrics.NewUniformSample(1024),
 	}
+	adapter.NewGoMetrics(reg, "histogram", adapter.Accept).
+		Register(fetchDurationKey, metrics.NewHistogram(s.fetchDuration))
 
 	fetches[key] = s
 	return s
@@ -20,6 +20,7 @@
 package module_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
 	"github.com/elastic/beats/v7/metricbeat/mb"
 	"github.com/elastic/beats/v7/metricbeat/mb/module"
 )
@@ -36,6 +38,7 @@ const (
 	eventFetcherName     = "EventFetcher"
 	reportingFetcherName = "ReportingFetcher"
 	pushMetricSetName    = "PushMetricSet"
+	blockingFetcherName  = "BlockingFetcher"
 )
 
 // fakeMetricSet
@@ -86,6 +89,21 @@ func newFakeReportingFetcher(base mb.BaseMetricSet) (mb.MetricSet, error) {
 	return &fakeReportingFetcher{BaseMetricSet: base}, nil
 }
 
+// BlockingFetcher
+
+type fakeBlockingFetcher struct {
+	mb.BaseMetricSet
+}
+
+func (ms *fakeBlockingFetcher) Fetch(ctx context.Context, r mb.ReporterV2) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func newFakeBlockingFetcher(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	return &fakeBlockingFetcher{BaseMetricSet: base}, nil
+}
+
 // PushMetricSet
 
 type fakePushMetricSet struct {
@@ -114,6 +132,8 @@ func newTestRegistry(t testing.TB) *mb.Register {
 	require.NoError(t, err)
 	err = r.AddMetricSet(moduleName, pushMetricSetName, newFakePushMetricSet)
 	require.NoError(t, err)
+	err = r.AddMetricSet(moduleName, blockingFetcherName, newFakeBlockingFetcher)
+	require.NoError(t, err)
 	return r
 }
 
@@ -287,3 +307,42 @@ func TestNewWrapperForMetricSet(t *testing.T) {
 		assert.Fail(t, "received unexpected event")
 	}
 }
+
+func TestWrapperFetchTimeout(t *testing.T) {
+	hosts := []string{"alpha"}
+	c := newConfig(t, map[string]interface{}{
+		"module":        moduleName,
+		"metricsets":    []string{blockingFetcherName},
+		"hosts":         hosts,
+		"period":        "10ms",
+		"fetch_timeout": "100ms",
+	})
+
+	m, err := module.NewWrapper(c, newTestRegistry(t))
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	output := m.Start(done)
+
+	event := <-output
+	message, err := event.Fields.GetValue("error.message")
+	require.NoError(t, err)
+	assert.Contains(t, message, "timeout fetching data after 100ms")
+
+	// The timeout is reported only once
+	select {
+	case event := <-output:
+		assert.Fail(t, "unexpected event after timeout", "%+v", event.Fields)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Fetches are skipped while the blocked one is running
+	stats := monitoring.Default.GetRegistry("metricbeat." + moduleName + "." + m.MetricSets()[0].Name())
+	require.NotNil(t, stats)
+	assert.True(t, stats.Get("skipped").(*monitoring.Int).Get() > 0)
+	assert.Equal(t, int64(1), stats.Get("timeouts").(*monitoring.Int).Get())
+
+	close(done)
+	for range output {
+	}
+}
@@ -306,6 +306,58 @@ func TestGetBlkioStatsList(t *testing.T) {
 		stats.queued)
 }
 
+// TestGetBlkioStatsListCgroupV2 tests stats of hosts with cgroup v2, that
+// only report reads and writes, in lowercase
+func TestGetBlkioStatsListCgroupV2(t *testing.T) {
+	start := time.Now()
+	later := start.Add(10 * time.Second)
+
+	blkioService := BlkioService{
+		map[string]BlkioRaw{
+			"cebada": {Time: start, reads: 100, writes: 200, totals: 300},
+		},
+	}
+
+	dockerStats := []docker.Stat{{
+		Container: &types.Container{
+			ID:    "cebada",
+			Names: []string{"test"},
+		},
+		Stats: types.StatsJSON{Stats: types.Stats{
+			Read: later,
+			BlkioStats: types.BlkioStats{
+				IoServicedRecursive: []types.BlkioStatEntry{
+					{Major: 259, Minor: 0, Op: "read", Value: 100},
+					{Major: 259, Minor: 0, Op: "write", Value: 200},
+					{Major: 253, Minor: 0, Op: "read", Value: 50},
+					{Major: 253, Minor: 0, Op: "write", Value: 100},
+				},
+				IoServiceBytesRecursive: []types.BlkioStatEntry{
+					{Major: 259, Minor: 0, Op: "read", Value: 1000},
+					{Major: 259, Minor: 0, Op: "write", Value: 2000},
+					{Major: 253, Minor: 0, Op: "read", Value: 500},
+					{Major: 253, Minor: 0, Op: "write", Value: 1000},
+				},
+			},
+		}},
+	}}
+
+	statsList := blkioService.getBlkioStatsList(dockerStats, true)
+	stats := statsList[0]
+	assert.Equal(t, float64(5), stats.reads)
+	assert.Equal(t, float64(10), stats.writes)
+	assert.Equal(t, float64(15), stats.totals)
+	assert.Equal(t,
+		BlkioRaw{Time: later, reads: 150, writes: 300, totals: 450},
+		stats.serviced)
+	assert.Equal(t,
+		BlkioRaw{Time: later, reads: 1500, writes: 3000, totals: 4500},
+		stats.servicedBytes)
+	assert.Equal(t,
+		BlkioRaw{Time: later},
+		stats.servicedTime)
+}
+
 func TestGetBlkioStatsListWindows(t *testing.T) {
 	start := time.Now()
 	later := start.Add(10 * time.Second)
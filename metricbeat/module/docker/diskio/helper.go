@@ -18,6 +18,7 @@
 package diskio
 
 import (
+	"strings"
 	"time"
 
 	"github.com/docker/docker/api/types"
@@ -155,16 +156,23 @@ func (io *BlkioService) getNewStats(time time.Time, blkioEntry []types.BlkioStat
 		totals: 0,
 	}
 
+	// Hosts with cgroup v2 report operations in lowercase and without
+	// totals, so they are calculated in that case
+	hasTotals := false
 	for _, myEntry := range blkioEntry {
-		switch myEntry.Op {
-		case "Write":
+		switch strings.ToLower(myEntry.Op) {
+		case "write":
 			stats.writes += myEntry.Value
-		case "Read":
+		case "read":
 			stats.reads += myEntry.Value
-		case "Total":
+		case "total":
 			stats.totals += myEntry.Value
+			hasTotals = true
 		}
 	}
+	if !hasTotals {
+		stats.totals = stats.reads + stats.writes
+	}
 	return stats
 }
 
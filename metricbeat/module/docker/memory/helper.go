@@ -61,7 +61,11 @@ func (s *MemoryService) getMemoryStatsList(containers []docker.Stat, dedot bool)
 }
 
 func (s *MemoryService) getMemoryStats(myRawStat docker.Stat, dedot bool) MemoryData {
-	totalRSS := myRawStat.Stats.MemoryStats.Stats["total_rss"]
+	totalRSS, found := myRawStat.Stats.MemoryStats.Stats["total_rss"]
+	if !found {
+		// Hosts with cgroup v2 report anonymous memory as anon
+		totalRSS = myRawStat.Stats.MemoryStats.Stats["anon"]
+	}
 	return MemoryData{
 		Time:      common.Time(myRawStat.Stats.Read),
 		Container: docker.NewContainer(myRawStat.Container, dedot),
@@ -109,6 +109,26 @@ func TestMemoryService_GetMemoryStats(t *testing.T) {
 	assert.Equal(t, expectedFields, event.MetricSetFields)
 }
 
+// TestMemoryServiceCgroupV2 tests stats of hosts with cgroup v2, where the
+// RSS is reported as anonymous memory
+func TestMemoryServiceCgroupV2(t *testing.T) {
+	memorystats := getMemoryStats(time.Now(), 1)
+	memorystats.MemoryStats.Stats = map[string]uint64{
+		"anon":          2,
+		"file":          1,
+		"inactive_file": 1,
+	}
+
+	memoryService := &MemoryService{}
+	rawStats := memoryService.getMemoryStats(docker.Stat{
+		Container: &types.Container{ID: "containerID", Names: []string{"/name1"}},
+		Stats:     memorystats,
+	}, false)
+
+	assert.Equal(t, uint64(2), rawStats.TotalRss)
+	assert.Equal(t, 0.5, rawStats.TotalRssP)
+}
+
 func TestMemoryServiceBadData(t *testing.T) {
 
 	badMemStats := types.StatsJSON{
@@ -27,6 +27,7 @@ import (
 	"github.com/elastic/beats/v7/metricbeat/helper"
 	"github.com/elastic/beats/v7/metricbeat/mb"
 	"github.com/elastic/beats/v7/metricbeat/mb/parse"
+	"github.com/elastic/beats/v7/metricbeat/module/http/mapping"
 )
 
 // init registers the MetricSet with the central registry.
@@ -67,6 +68,7 @@ type MetricSet struct {
 	responseEnabled bool
 	jsonIsArray     bool
 	deDotEnabled    bool
+	mapper          *mapping.Mapper
 }
 
 // New create a new instance of the MetricSet
@@ -82,6 +84,8 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 		ResponseEnabled bool   `config:"response.enabled"`
 		JSONIsArray     bool   `config:"json.is_array"`
 		DeDotEnabled    bool   `config:"dedot.enabled"`
+
+		Mapping mapping.Config `config:",inline"`
 	}{
 		Method:          "GET",
 		Body:            "",
@@ -102,6 +106,17 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 	http.SetMethod(config.Method)
 	http.SetBody([]byte(config.Body))
 
+	var mapper *mapping.Mapper
+	if config.Mapping.IsEnabled() {
+		if config.JSONIsArray && config.Mapping.Split == "" {
+			config.Mapping.Split = "$[*]"
+		}
+		mapper, err = mapping.New(config.Mapping)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &MetricSet{
 		BaseMetricSet:   base,
 		namespace:       config.Namespace,
@@ -112,6 +127,7 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 		responseEnabled: config.ResponseEnabled,
 		jsonIsArray:     config.JSONIsArray,
 		deDotEnabled:    config.DeDotEnabled,
+		mapper:          mapper,
 	}, nil
 }
 
@@ -134,7 +150,24 @@ func (m *MetricSet) Fetch(reporter mb.ReporterV2) error {
 		return err
 	}
 
-	if m.jsonIsArray {
+	if m.mapper != nil {
+		var jsonBody interface{}
+		if err = json.Unmarshal(body, &jsonBody); err != nil {
+			return err
+		}
+
+		// Errors are reported after the events that could be mapped
+		objs, mapErr := m.mapper.Map(jsonBody)
+		for _, obj := range objs {
+			event := m.processBody(response, obj)
+
+			if reported := reporter.Event(event); !reported {
+				m.Logger().Debug(errors.Errorf("error reporting event: %#v", event))
+				return nil
+			}
+		}
+		return mapErr
+	} else if m.jsonIsArray {
 		var jsonBodyArr []common.MapStr
 		if err = json.Unmarshal(body, &jsonBodyArr); err != nil {
 			return err
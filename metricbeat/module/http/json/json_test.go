@@ -20,8 +20,15 @@
 package json
 
 import (
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
 	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
 
 	_ "github.com/elastic/beats/v7/metricbeat/module/http"
@@ -30,3 +37,37 @@ import (
 func TestData(t *testing.T) {
 	mbtest.TestDataFiles(t, "http", "json")
 }
+
+func TestFetchWithMapping(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"service": "api", "items": [{"id": "a", "requests": "10"}, {"id": "b", "requests": 20}, {"requests": 30}]}`)
+	}))
+	defer server.Close()
+
+	config := map[string]interface{}{
+		"module":     "http",
+		"metricsets": []string{"json"},
+		"hosts":      []string{server.URL},
+		"namespace":  "test",
+		"split":      "$.items[*]",
+		"schema.fields": []map[string]interface{}{
+			{"name": "name", "path": "id", "required": true},
+			{"name": "requests.total", "path": "requests", "type": "long"},
+			{"name": "service", "path": "$.service"},
+		},
+	}
+
+	f := mbtest.NewReportingMetricSetV2Error(t, config)
+	events, errs := mbtest.ReportingFetchV2Error(f)
+	require.Len(t, errs, 1, "element without required field must be reported as error")
+	require.Len(t, events, 2)
+
+	assert.Equal(t, "http.test", events[0].Namespace)
+	assert.Equal(t, common.MapStr{
+		"name":     "a",
+		"requests": common.MapStr{"total": int64(10)},
+		"service":  "api",
+	}, events[0].MetricSetFields)
+	assert.Equal(t, "b", events[1].MetricSetFields["name"])
+}
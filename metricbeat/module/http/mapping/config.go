@@ -0,0 +1,70 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mapping
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Config is the configuration of how JSON documents are converted to events.
+type Config struct {
+	// Split is the path of an array whose elements are reported as
+	// separate events, as `$.items[*]`, or `$[*]` for documents that are
+	// arrays.
+	Split string `config:"split"`
+
+	// Schema selects and converts the fields of the reported events.
+	Schema *SchemaConfig `config:"schema"`
+}
+
+// SchemaConfig is the configuration of the fields of the reported events.
+type SchemaConfig struct {
+	Fields []FieldConfig `config:"fields" validate:"required"`
+}
+
+// FieldConfig is the configuration of a field of the reported events.
+type FieldConfig struct {
+	// Name of the field in the event, dots create nested objects.
+	Name string `config:"name" validate:"required"`
+
+	// Path of the value in the document, it is the name of the field if not
+	// set. When splitting documents it is relative to each element. Paths
+	// starting with `$.` are always relative to the root of the document.
+	Path string `config:"path"`
+
+	// Type the value is converted to, by default the value is kept as is.
+	Type string `config:"type"`
+
+	// Required fields make the document to be reported as an error if they
+	// are missing.
+	Required bool `config:"required"`
+}
+
+// IsEnabled returns true if documents have to be split or mapped.
+func (c Config) IsEnabled() bool {
+	return c.Split != "" || c.Schema != nil
+}
+
+// Validate validates the configuration of a field.
+func (c FieldConfig) Validate() error {
+	if _, found := converters[strings.ToLower(c.Type)]; !found {
+		return fmt.Errorf("unsupported type '%s' for field '%s'", c.Type, c.Name)
+	}
+	return nil
+}
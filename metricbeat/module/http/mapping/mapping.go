@@ -0,0 +1,308 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mapping
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/joeshaw/multierror"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/schema"
+)
+
+// rootKey is the key under which the root of the document is available to
+// the schema of each element when splitting documents.
+const rootKey = "$"
+
+var converters = map[string]schema.Converter{
+	"":        toRaw,
+	"raw":     toRaw,
+	"long":    toLong,
+	"integer": toLong,
+	"float":   toFloat,
+	"double":  toFloat,
+	"string":  toString,
+	"keyword": toString,
+	"boolean": toBool,
+}
+
+// Mapper converts JSON documents to the fields of events, according to its
+// configuration.
+type Mapper struct {
+	split  []string
+	schema schema.Schema
+}
+
+// New creates a Mapper from its configuration.
+func New(config Config) (*Mapper, error) {
+	m := &Mapper{}
+	if config.Split != "" {
+		split, err := parseSplit(config.Split)
+		if err != nil {
+			return nil, err
+		}
+		m.split = split
+	}
+	if config.Schema != nil {
+		s, err := buildSchema(config.Schema.Fields)
+		if err != nil {
+			return nil, err
+		}
+		m.schema = s
+	}
+	return m, nil
+}
+
+// Map converts a decoded JSON document to the fields of one or more events.
+// Elements that cannot be converted are reported as errors, without
+// preventing the conversion of the rest.
+func (m *Mapper) Map(doc interface{}) ([]common.MapStr, error) {
+	elements := []interface{}{doc}
+	if m.split != nil {
+		var err error
+		elements, err = splitDocument(doc, m.split)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var errs multierror.Errors
+	events := make([]common.MapStr, 0, len(elements))
+	for i, element := range elements {
+		data, ok := toMap(element)
+		if !ok {
+			errs = append(errs, errors.Errorf("element %d is not an object, found %T", i, element))
+			continue
+		}
+		if m.schema == nil {
+			events = append(events, data)
+			continue
+		}
+
+		// Copy the element to don't modify the original document
+		element := make(common.MapStr, len(data)+1)
+		for k, v := range data {
+			element[k] = v
+		}
+		element[rootKey] = doc
+		data = element
+
+		event, err := m.schema.Apply(data, schema.FailOnRequired)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "mapping element %d", i))
+			continue
+		}
+		removeEmptyObjects(event)
+		events = append(events, event)
+	}
+	return events, errs.Err()
+}
+
+// removeEmptyObjects removes the objects left empty by optional fields that
+// were not found.
+func removeEmptyObjects(event common.MapStr) {
+	for k, v := range event {
+		if m, ok := v.(common.MapStr); ok {
+			removeEmptyObjects(m)
+			if len(m) == 0 {
+				delete(event, k)
+			}
+		}
+	}
+}
+
+// parseSplit parses a path in the forms `$.a.b[*]`, `a.b` or `$[*]`.
+func parseSplit(split string) ([]string, error) {
+	path := strings.TrimSuffix(split, "[*]")
+	path = strings.TrimPrefix(path, rootKey)
+	path = strings.TrimPrefix(path, ".")
+	if path == "" {
+		if split != rootKey+"[*]" {
+			return nil, errors.Errorf("invalid split path '%s'", split)
+		}
+		return []string{}, nil
+	}
+	if strings.ContainsAny(path, "[]*") {
+		return nil, errors.Errorf("invalid split path '%s', only a single array at the end of the path is supported", split)
+	}
+	return strings.Split(path, "."), nil
+}
+
+func splitDocument(doc interface{}, path []string) ([]interface{}, error) {
+	value := doc
+	for i, key := range path {
+		data, ok := toMap(value)
+		if !ok {
+			return nil, errors.Errorf("'%s' is not an object", strings.Join(path[:i], "."))
+		}
+		value, ok = data[key]
+		if !ok {
+			return nil, errors.Errorf("array '%s' not found", strings.Join(path, "."))
+		}
+	}
+	elements, ok := value.([]interface{})
+	if !ok {
+		return nil, errors.Errorf("'%s' is not an array, found %T", strings.Join(path, "."), value)
+	}
+	return elements, nil
+}
+
+// buildSchema builds a schema from the configuration of the fields, with
+// nested objects for the names with dots.
+func buildSchema(fields []FieldConfig) (schema.Schema, error) {
+	s := schema.Schema{}
+	for _, field := range fields {
+		path := field.Path
+		if path == "" {
+			path = field.Name
+		}
+		conv := schema.Conv{
+			Key:      path,
+			Func:     converters[strings.ToLower(field.Type)],
+			Optional: !field.Required,
+			Required: field.Required,
+		}
+
+		parts := strings.Split(field.Name, ".")
+		current := map[string]schema.Mapper(s)
+		for _, part := range parts[:len(parts)-1] {
+			switch m := current[part].(type) {
+			case nil:
+				object := schema.Object{}
+				current[part] = object
+				current = object
+			case schema.Object:
+				current = m
+			default:
+				return nil, errors.Errorf("field '%s' conflicts with field '%s'", field.Name, part)
+			}
+		}
+		last := parts[len(parts)-1]
+		if _, found := current[last]; found {
+			return nil, errors.Errorf("field '%s' is defined more than once", field.Name)
+		}
+		current[last] = conv
+	}
+	return s, nil
+}
+
+func toMap(v interface{}) (common.MapStr, bool) {
+	switch m := v.(type) {
+	case common.MapStr:
+		return m, true
+	case map[string]interface{}:
+		return common.MapStr(m), true
+	}
+	return nil, false
+}
+
+func getValue(key string, data map[string]interface{}) (interface{}, error) {
+	value, err := common.MapStr(data).GetValue(key)
+	if err != nil {
+		e := schema.NewKeyNotFoundError(key)
+		e.Err = err
+		return nil, e
+	}
+	return value, nil
+}
+
+func toRaw(key string, data map[string]interface{}) (interface{}, error) {
+	return getValue(key, data)
+}
+
+func toLong(key string, data map[string]interface{}) (interface{}, error) {
+	value, err := getValue(key, data)
+	if err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case float64:
+		return int64(v), nil
+	case int:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case string:
+		i, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if err != nil {
+			return nil, schema.NewWrongFormatError(key, err.Error())
+		}
+		return i, nil
+	}
+	return nil, schema.NewWrongFormatError(key, fmt.Sprintf("expected integer, found %T", value))
+}
+
+func toFloat(key string, data map[string]interface{}) (interface{}, error) {
+	value, err := getValue(key, data)
+	if err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return nil, schema.NewWrongFormatError(key, err.Error())
+		}
+		return f, nil
+	}
+	return nil, schema.NewWrongFormatError(key, fmt.Sprintf("expected float, found %T", value))
+}
+
+func toString(key string, data map[string]interface{}) (interface{}, error) {
+	value, err := getValue(key, data)
+	if err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	}
+	return nil, schema.NewWrongFormatError(key, fmt.Sprintf("expected string, found %T", value))
+}
+
+func toBool(key string, data map[string]interface{}) (interface{}, error) {
+	value, err := getValue(key, data)
+	if err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		b, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return nil, schema.NewWrongFormatError(key, err.Error())
+		}
+		return b, nil
+	}
+	return nil, schema.NewWrongFormatError(key, fmt.Sprintf("expected boolean, found %T", value))
+}
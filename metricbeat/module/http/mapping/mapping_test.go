@@ -0,0 +1,173 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package mapping
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+const testDocument = `{
+	"service": "api",
+	"items": [
+		{"id": "a", "stats": {"requests": 10, "latency": "1.5", "healthy": "true"}},
+		{"id": "b", "stats": {"requests": "20"}},
+		{"stats": {"requests": 30}},
+		"invalid"
+	]
+}`
+
+func decode(t *testing.T, doc string) interface{} {
+	var result interface{}
+	require.NoError(t, json.Unmarshal([]byte(doc), &result))
+	return result
+}
+
+func newTestMapper(t *testing.T, config map[string]interface{}) *Mapper {
+	c, err := common.NewConfigFrom(config)
+	require.NoError(t, err)
+
+	var mappingConfig Config
+	require.NoError(t, c.Unpack(&mappingConfig))
+
+	m, err := New(mappingConfig)
+	require.NoError(t, err)
+	return m
+}
+
+func TestMapSplitWithSchema(t *testing.T) {
+	m := newTestMapper(t, map[string]interface{}{
+		"split": "$.items[*]",
+		"schema.fields": []map[string]interface{}{
+			{"name": "name", "path": "id", "type": "keyword", "required": true},
+			{"name": "requests.total", "path": "stats.requests", "type": "long"},
+			{"name": "requests.latency", "path": "stats.latency", "type": "float"},
+			{"name": "healthy", "path": "stats.healthy", "type": "boolean"},
+			{"name": "service", "path": "$.service"},
+		},
+	})
+
+	events, err := m.Map(decode(t, testDocument))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "mapping element 2")
+		assert.Contains(t, err.Error(), "element 3 is not an object")
+	}
+
+	expected := []common.MapStr{
+		{
+			"name":     "a",
+			"requests": common.MapStr{"total": int64(10), "latency": 1.5},
+			"healthy":  true,
+			"service":  "api",
+		},
+		{
+			"name":     "b",
+			"requests": common.MapStr{"total": int64(20)},
+			"service":  "api",
+		},
+	}
+	assert.Equal(t, expected, events)
+}
+
+func TestMapSplitWithoutSchema(t *testing.T) {
+	m := newTestMapper(t, map[string]interface{}{
+		"split": "$[*]",
+	})
+
+	events, err := m.Map(decode(t, `[{"a": 1}, {"b": 2}]`))
+	require.NoError(t, err)
+	assert.Equal(t, []common.MapStr{{"a": 1.0}, {"b": 2.0}}, events)
+
+	_, err = m.Map(decode(t, `{"a": 1}`))
+	assert.Error(t, err)
+}
+
+func TestMapSchemaRootPathWithoutSplit(t *testing.T) {
+	m := newTestMapper(t, map[string]interface{}{
+		"schema.fields": []map[string]interface{}{
+			{"name": "name", "path": "service"},
+			{"name": "service", "path": "$.service", "required": true},
+		},
+	})
+
+	doc := decode(t, `{"service": "api"}`)
+	events, err := m.Map(doc)
+	require.NoError(t, err)
+	assert.Equal(t, []common.MapStr{{"name": "api", "service": "api"}}, events)
+	assert.Equal(t, map[string]interface{}{"service": "api"}, doc)
+}
+
+func TestMapSchemaWrongFormat(t *testing.T) {
+	m := newTestMapper(t, map[string]interface{}{
+		"schema.fields": []map[string]interface{}{
+			{"name": "count", "type": "long"},
+		},
+	})
+
+	events, err := m.Map(decode(t, `{"count": "many"}`))
+	assert.Error(t, err)
+	assert.Empty(t, events)
+}
+
+func TestConfigErrors(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"unsupported type": {
+			"schema.fields": []map[string]interface{}{{"name": "a", "type": "histogram"}},
+		},
+		"missing name": {
+			"schema.fields": []map[string]interface{}{{"path": "a"}},
+		},
+	}
+
+	for title, config := range cases {
+		t.Run(title, func(t *testing.T) {
+			c, err := common.NewConfigFrom(config)
+			require.NoError(t, err)
+
+			var mappingConfig Config
+			assert.Error(t, c.Unpack(&mappingConfig))
+		})
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	cases := map[string]Config{
+		"invalid split":      {Split: "$.a[*].b[*]"},
+		"root without array": {Split: "$"},
+		"conflicting fields": {Schema: &SchemaConfig{Fields: []FieldConfig{
+			{Name: "a"}, {Name: "a.b"},
+		}}},
+		"duplicated fields": {Schema: &SchemaConfig{Fields: []FieldConfig{
+			{Name: "a.b"}, {Name: "a.b"},
+		}}},
+	}
+
+	for title, config := range cases {
+		t.Run(title, func(t *testing.T) {
+			_, err := New(config)
+			assert.Error(t, err)
+		})
+	}
+}
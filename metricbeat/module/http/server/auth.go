@@ -0,0 +1,102 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"net/http"
+	"strings"
+
+	httpserver "github.com/elastic/beats/v7/metricbeat/helper/server/http"
+)
+
+const defaultHMACHeader = "X-Signature"
+
+var hmacAlgorithms = map[string]func() hash.Hash{
+	"":       sha256.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// newAuthenticator returns an authenticator that validates the requests
+// according to the configuration, all the configured methods must succeed.
+func newAuthenticator(config AuthConfig) httpserver.Authenticator {
+	var checks []httpserver.Authenticator
+	if config.BearerToken != "" {
+		checks = append(checks, bearerTokenAuthenticator(config.BearerToken))
+	}
+	if config.HMAC != nil {
+		checks = append(checks, hmacAuthenticator(*config.HMAC))
+	}
+	return func(req *http.Request, body []byte) error {
+		for _, check := range checks {
+			if err := check(req, body); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func bearerTokenAuthenticator(token string) httpserver.Authenticator {
+	expected := []byte("Bearer " + token)
+	return func(req *http.Request, _ []byte) error {
+		if subtle.ConstantTimeCompare([]byte(req.Header.Get("Authorization")), expected) != 1 {
+			return errors.New("invalid bearer token")
+		}
+		return nil
+	}
+}
+
+func hmacAuthenticator(config HMACConfig) httpserver.Authenticator {
+	header := config.Header
+	if header == "" {
+		header = defaultHMACHeader
+	}
+	newHash := hmacAlgorithms[strings.ToLower(config.Algorithm)]
+	secret := []byte(config.Secret)
+
+	return func(req *http.Request, body []byte) error {
+		value := req.Header.Get(header)
+		if value == "" {
+			return errors.New("missing signature header " + header)
+		}
+		if !strings.HasPrefix(value, config.Prefix) {
+			return errors.New("invalid signature format")
+		}
+		signature, err := hex.DecodeString(strings.TrimPrefix(value, config.Prefix))
+		if err != nil {
+			return errors.New("invalid signature format")
+		}
+
+		mac := hmac.New(newHash, secret)
+		mac.Write(body)
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	}
+}
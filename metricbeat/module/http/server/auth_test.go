@@ -0,0 +1,117 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func TestBearerTokenAuthenticator(t *testing.T) {
+	authenticate := newAuthenticator(AuthConfig{BearerToken: "secret"})
+
+	req := httptest.NewRequest("POST", "/", nil)
+	assert.Error(t, authenticate(req, nil))
+
+	req.Header.Set("Authorization", "Bearer other")
+	assert.Error(t, authenticate(req, nil))
+
+	req.Header.Set("Authorization", "Bearer secret")
+	assert.NoError(t, authenticate(req, nil))
+}
+
+func TestHMACAuthenticator(t *testing.T) {
+	body := []byte(`{"hello": "world"}`)
+	mac := hmac.New(sha1.New, []byte("secret"))
+	mac.Write(body)
+	signature := "sha1=" + hex.EncodeToString(mac.Sum(nil))
+
+	authenticate := newAuthenticator(AuthConfig{HMAC: &HMACConfig{
+		Secret:    "secret",
+		Header:    "X-Hub-Signature",
+		Algorithm: "sha1",
+		Prefix:    "sha1=",
+	}})
+
+	req := httptest.NewRequest("POST", "/", nil)
+	assert.Error(t, authenticate(req, body), "missing signature")
+
+	req.Header.Set("X-Hub-Signature", strings.TrimPrefix(signature, "sha1="))
+	assert.Error(t, authenticate(req, body), "missing prefix")
+
+	req.Header.Set("X-Hub-Signature", signature)
+	assert.NoError(t, authenticate(req, body))
+	assert.Error(t, authenticate(req, []byte(`{"hello": "other"}`)), "modified body")
+}
+
+func TestAuthConfig(t *testing.T) {
+	cases := map[string]struct {
+		config  map[string]interface{}
+		enabled bool
+		err     bool
+	}{
+		"no auth": {
+			config: map[string]interface{}{},
+		},
+		"bearer token": {
+			config:  map[string]interface{}{"server.auth.bearer_token": "secret"},
+			enabled: true,
+		},
+		"hmac": {
+			config:  map[string]interface{}{"server.auth.hmac.secret": "secret"},
+			enabled: true,
+		},
+		"hmac without secret": {
+			config: map[string]interface{}{"server.auth.hmac.header": "X-Signature"},
+			err:    true,
+		},
+		"hmac with unsupported algorithm": {
+			config: map[string]interface{}{
+				"server.auth.hmac.secret":    "secret",
+				"server.auth.hmac.algorithm": "md5",
+			},
+			err: true,
+		},
+	}
+
+	for title, c := range cases {
+		t.Run(title, func(t *testing.T) {
+			cfg, err := common.NewConfigFrom(c.config)
+			if !assert.NoError(t, err) {
+				return
+			}
+			config := defaultHttpServerConfig()
+			err = cfg.Unpack(&config)
+			if c.err {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, c.enabled, config.Auth.IsEnabled())
+			}
+		})
+	}
+}
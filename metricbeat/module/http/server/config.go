@@ -19,19 +19,52 @@ package server
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/module/http/mapping"
 )
 
 type HttpServerConfig struct {
 	Paths       []PathConfig `config:"server.paths"`
 	DefaultPath PathConfig   `config:"server.default_path"`
+	Auth        AuthConfig   `config:"server.auth"`
 }
 
 type PathConfig struct {
 	Path      string        `config:"path"`
 	Fields    common.MapStr `config:"fields"`
 	Namespace string        `config:"namespace"`
+
+	Mapping mapping.Config `config:",inline"`
+}
+
+// AuthConfig configures the validation of incoming requests, with a bearer
+// token or with an HMAC signature of the body.
+type AuthConfig struct {
+	BearerToken string      `config:"bearer_token"`
+	HMAC        *HMACConfig `config:"hmac"`
+}
+
+// HMACConfig configures the validation of HMAC signatures of the body of the
+// requests, sent in a header as hexadecimal, optionally with a prefix. By
+// default signatures are SHA256 HMACs sent in the X-Signature header.
+type HMACConfig struct {
+	Secret    string `config:"secret" validate:"required"`
+	Header    string `config:"header"`
+	Algorithm string `config:"algorithm"`
+	Prefix    string `config:"prefix"`
+}
+
+func (c AuthConfig) IsEnabled() bool {
+	return c.BearerToken != "" || c.HMAC != nil
+}
+
+func (c *HMACConfig) Validate() error {
+	if _, found := hmacAlgorithms[strings.ToLower(c.Algorithm)]; !found {
+		return errors.New("unsupported HMAC algorithm '" + c.Algorithm + "'")
+	}
+	return nil
 }
 
 func defaultHttpServerConfig() HttpServerConfig {
@@ -52,5 +85,9 @@ func (p PathConfig) Validate() error {
 		return errors.New("`path` can not be empty in path configuration")
 	}
 
+	if _, err := mapping.New(p.Mapping); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -27,39 +27,61 @@ import (
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/metricbeat/helper/server"
 	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/module/http/mapping"
 )
 
 type metricProcessor struct {
-	paths       map[string]PathConfig
-	defaultPath PathConfig
+	paths         map[string]PathConfig
+	mappers       map[string]*mapping.Mapper
+	defaultPath   PathConfig
+	defaultMapper *mapping.Mapper
 	sync.RWMutex
 }
 
 func NewMetricProcessor(paths []PathConfig, defaultPath PathConfig) *metricProcessor {
-	pathMap := map[string]PathConfig{}
+	p := &metricProcessor{
+		paths:         map[string]PathConfig{},
+		mappers:       map[string]*mapping.Mapper{},
+		defaultPath:   defaultPath,
+		defaultMapper: newMapper(defaultPath),
+	}
 	for _, path := range paths {
-		pathMap[path.Path] = path
+		p.AddPath(path)
 	}
+	return p
+}
 
-	return &metricProcessor{
-		paths:       pathMap,
-		defaultPath: defaultPath,
+// newMapper returns the mapper of a path, or nil if it doesn't need to map
+// the payloads. Configurations are validated when unpacked, so errors are not
+// expected here.
+func newMapper(path PathConfig) *mapping.Mapper {
+	if !path.Mapping.IsEnabled() {
+		return nil
+	}
+	mapper, err := mapping.New(path.Mapping)
+	if err != nil {
+		return nil
 	}
+	return mapper
 }
 
 func (m *metricProcessor) AddPath(path PathConfig) {
 	m.Lock()
 	m.paths[path.Path] = path
+	m.mappers[path.Path] = newMapper(path)
 	m.Unlock()
 }
 
 func (m *metricProcessor) RemovePath(path PathConfig) {
 	m.Lock()
 	delete(m.paths, path.Path)
+	delete(m.mappers, path.Path)
 	m.Unlock()
 }
 
-func (p *metricProcessor) Process(event server.Event) (common.MapStr, error) {
+// Process decodes the payload of a request into the fields of one or more
+// events, documents are split and mapped if configured for their path.
+func (p *metricProcessor) Process(event server.Event) ([]common.MapStr, error) {
 	urlRaw, ok := event.GetMeta()["path"]
 	if !ok {
 		return nil, errors.New("Malformed HTTP event. Path missing.")
@@ -72,6 +94,7 @@ func (p *metricProcessor) Process(event server.Event) (common.MapStr, error) {
 	}
 	contentType := typeRaw.(string)
 	pathConf := p.findPath(url)
+	mapper := p.findMapper(pathConf)
 
 	bytesRaw, ok := event.GetEvent()[server.EventDataKey]
 	if !ok {
@@ -83,26 +106,44 @@ func (p *metricProcessor) Process(event server.Event) (common.MapStr, error) {
 		return nil, errors.New("Request has no data")
 	}
 
-	out := common.MapStr{}
+	var events []common.MapStr
+	var mapErr error
 	switch contentType {
 	case "application/json":
-		err := json.Unmarshal(bytes, &out)
-		if err != nil {
-			return nil, err
+		if mapper == nil {
+			out := common.MapStr{}
+			err := json.Unmarshal(bytes, &out)
+			if err != nil {
+				return nil, err
+			}
+			events = []common.MapStr{out}
+		} else {
+			var doc interface{}
+			err := json.Unmarshal(bytes, &doc)
+			if err != nil {
+				return nil, err
+			}
+			// Errors are returned with the events that could be mapped
+			events, mapErr = mapper.Map(doc)
 		}
 	default:
 		return nil, errors.New(fmt.Sprintf("Unsupported Content-Type: %s", contentType))
 	}
 
-	out[mb.NamespaceKey] = pathConf.Namespace
-	if len(pathConf.Fields) != 0 {
-		// Overwrite any keys that are present in the incoming payload
-		common.MergeFields(out, pathConf.Fields, true)
+	for _, out := range events {
+		out[mb.NamespaceKey] = pathConf.Namespace
+		if len(pathConf.Fields) != 0 {
+			// Overwrite any keys that are present in the incoming payload
+			common.MergeFields(out, pathConf.Fields, true)
+		}
 	}
-	return out, nil
+	return events, mapErr
 }
 
 func (p *metricProcessor) findPath(url string) *PathConfig {
+	p.RLock()
+	defer p.RUnlock()
+
 	for path, conf := range p.paths {
 		if strings.Index(url, path) == 0 {
 			return &conf
@@ -111,3 +152,13 @@ func (p *metricProcessor) findPath(url string) *PathConfig {
 
 	return &p.defaultPath
 }
+
+func (p *metricProcessor) findMapper(conf *PathConfig) *mapping.Mapper {
+	p.RLock()
+	defer p.RUnlock()
+
+	if mapper, found := p.mappers[conf.Path]; found {
+		return mapper
+	}
+	return p.defaultMapper
+}
@@ -22,8 +22,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/helper/server"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/module/http/mapping"
 )
 
 func GetMetricProcessor() *metricProcessor {
@@ -94,3 +98,53 @@ func TestFindPath(t *testing.T) {
 		})
 	}
 }
+
+type testEvent struct {
+	event common.MapStr
+	meta  server.Meta
+}
+
+func (e *testEvent) GetEvent() common.MapStr { return e.event }
+func (e *testEvent) GetMeta() server.Meta    { return e.meta }
+
+func newTestEvent(path, body string) server.Event {
+	return &testEvent{
+		event: common.MapStr{server.EventDataKey: []byte(body)},
+		meta: server.Meta{
+			"path":         path,
+			"Content-Type": "application/json",
+		},
+	}
+}
+
+func TestProcessWithMapping(t *testing.T) {
+	processor := GetMetricProcessor()
+	processor.AddPath(PathConfig{
+		Namespace: "items",
+		Path:      "/items",
+		Fields:    common.MapStr{"source": "push"},
+		Mapping: mapping.Config{
+			Split: "$.items[*]",
+			Schema: &mapping.SchemaConfig{Fields: []mapping.FieldConfig{
+				{Name: "name", Path: "id", Required: true},
+				{Name: "count", Type: "long"},
+			}},
+		},
+	})
+
+	events, err := processor.Process(newTestEvent("/items", `{"items": [{"id": "a", "count": "1"}, {"count": 2}, {"id": "c", "count": 3}]}`))
+	assert.Error(t, err, "element without required field must be reported")
+	require.Len(t, events, 2)
+	assert.Equal(t, common.MapStr{
+		"name":          "a",
+		"count":         int64(1),
+		"source":        "push",
+		mb.NamespaceKey: "items",
+	}, events[0])
+	assert.Equal(t, "c", events[1]["name"])
+
+	// Payloads of other paths are not mapped
+	events, err = processor.Process(newTestEvent("/foo", `{"id": "a"}`))
+	require.NoError(t, err)
+	assert.Equal(t, []common.MapStr{{"id": "a", "a": "b", mb.NamespaceKey: "foo"}}, events)
+}
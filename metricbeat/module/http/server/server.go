@@ -52,7 +52,13 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 		return nil, err
 	}
 
-	svc, err := http.NewHttpServer(base)
+	var svc serverhelper.Server
+	var err error
+	if config.Auth.IsEnabled() {
+		svc, err = http.NewHttpServerWithAuthenticator(base, newAuthenticator(config.Auth))
+	} else {
+		svc, err = http.NewHttpServer(base)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -76,11 +82,9 @@ func (m *MetricSet) Run(reporter mb.PushReporterV2) {
 			m.server.Stop()
 			return
 		case msg := <-m.server.GetEvents():
-			fields, err := m.processor.Process(msg)
-			if err != nil {
-				reporter.Error(err)
-			} else {
-				meta := msg.GetMeta()
+			events, err := m.processor.Process(msg)
+			meta := msg.GetMeta()
+			for _, fields := range events {
 				event := mb.Event{
 					Host: meta["address"].(string),
 				}
@@ -93,6 +97,9 @@ func (m *MetricSet) Run(reporter mb.PushReporterV2) {
 				event.Namespace = ns
 				reporter.Event(event)
 			}
+			if err != nil {
+				reporter.Error(err)
+			}
 
 		}
 	}
@@ -22,7 +22,9 @@ import (
 	"fmt"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/pkg/errors"
 
@@ -34,12 +36,126 @@ type JMXMapping struct {
 	MBean      string
 	Attributes []Attribute
 	Target     Target
+
+	// Event and Naming are used when no attributes are defined, in that case
+	// all the attributes of the MBean are read and their field names are
+	// generated following the naming rules
+	Event  string
+	Naming NamingConfig
 }
 
 type Attribute struct {
 	Attr  string
 	Field string
 	Event string
+
+	// naming is set for attributes mapped automatically
+	naming *NamingConfig
+}
+
+// Cases supported in naming rules
+const (
+	snakeCase    = "snake"
+	lowerCase    = "lower"
+	originalCase = "original"
+)
+
+// NamingConfig contains the rules used to name the fields of the attributes
+// that are mapped automatically
+type NamingConfig struct {
+	// Case of the field names, "snake" (default) converts names like
+	// HeapMemoryUsage to heap_memory_usage, "lower" lowercases them and
+	// "original" keeps them as they are
+	Case string `config:"case"`
+
+	// Prefix prepended to all field names, dots can be used to nest them
+	Prefix string `config:"prefix"`
+
+	// Properties of wildcard MBean names whose values are included in the
+	// field names, after the prefix. If set, the attributes of all the
+	// matching MBeans are reported in the same event
+	Properties []string `config:"properties"`
+}
+
+// Validate checks the naming rules
+func (c *NamingConfig) Validate() error {
+	switch c.Case {
+	case "", snakeCase, lowerCase, originalCase:
+		return nil
+	default:
+		return errors.Errorf("unknown naming case '%s', expected one of: %s, %s or %s",
+			c.Case, snakeCase, lowerCase, originalCase)
+	}
+}
+
+// fieldName builds the field name for an attribute, including the values of
+// the configured properties of the MBean
+func (c *NamingConfig) fieldName(mbean *MBeanName, attr string) string {
+	var parts []string
+	if c.Prefix != "" {
+		parts = append(parts, c.Prefix)
+	}
+	if mbean != nil {
+		for _, property := range c.Properties {
+			if value, found := mbean.Properties[property]; found {
+				parts = append(parts, c.key(unquotePropertyValue(value)))
+			}
+		}
+	}
+	parts = append(parts, c.key(attr))
+	return strings.Join(parts, ".")
+}
+
+// key converts a name following the configured case, dots are never kept
+// so the name is always a single key
+func (c *NamingConfig) key(name string) string {
+	switch c.Case {
+	case lowerCase:
+		return strings.ToLower(common.DeDot(name))
+	case originalCase:
+		return common.DeDot(name)
+	default:
+		return toSnakeCase(name)
+	}
+}
+
+// toSnakeCase converts camel case names to snake case, any other character
+// that is not a letter or a digit is replaced by an underscore, e.g.
+// HeapMemoryUsage -> heap_memory_usage, CPUTime -> cpu_time,
+// PS Eden Space -> ps_eden_space
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	underscore := false
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			underscore = b.Len() > 0
+			continue
+		}
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				underscore = b.Len() > 0
+			}
+		}
+		if underscore {
+			b.WriteRune('_')
+			underscore = false
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// unquotePropertyValue removes the quotes of quoted values in MBean names
+func unquotePropertyValue(value string) string {
+	if len(value) > 1 && strings.HasPrefix(value, "\"") {
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			return unquoted
+		}
+	}
+	return value
 }
 
 // Target inputs the value you want to set for jolokia target block
@@ -76,7 +192,7 @@ type Target struct {
 type RequestBlock struct {
 	Type      string                 `json:"type"`
 	MBean     string                 `json:"mbean"`
-	Attribute []string               `json:"attribute"`
+	Attribute []string               `json:"attribute,omitempty"`
 	Config    map[string]interface{} `json:"config"`
 	Target    *TargetBlock           `json:"target,omitempty"`
 }
@@ -99,13 +215,31 @@ type attributeMappingKey struct {
 }
 
 // AttributeMapping contains the mapping information between attributes in Jolokia
-// responses and fields in metricbeat events
+// responses and fields in metricbeat events. MBeans whose attributes are mapped
+// automatically are stored with an empty attribute name.
 type AttributeMapping map[attributeMappingKey]Attribute
 
 // Get the mapping options for the attribute of an mbean
 func (m AttributeMapping) Get(mbean, attr string) (Attribute, bool) {
-	a, found := m[attributeMappingKey{mbean, attr}]
-	return a, found
+	if a, found := m[attributeMappingKey{mbean, attr}]; found {
+		return a, found
+	}
+	a, found := m[attributeMappingKey{mbean, ""}]
+	if !found || a.naming == nil {
+		return Attribute{}, false
+	}
+	a.Attr = attr
+	return a, true
+}
+
+// autoAttribute builds the mapping of an MBean whose attributes are mapped
+// automatically
+func autoAttribute(mapping JMXMapping) Attribute {
+	naming := mapping.Naming
+	return Attribute{
+		Event:  mapping.Event,
+		naming: &naming,
+	}
 }
 
 // MBeanName is an internal struct used to store
@@ -281,6 +415,8 @@ func (pc *JolokiaHTTPGetFetcher) BuildRequestsAndMappings(configMappings []JMXMa
 // Builds a GET URI which will have the following format:
 //
 // /read/<mbean>/<attribute>/[path]?ignoreErrors=true&canonicalNaming=false
+//
+// If no attributes are given, the attribute part is omitted so all of them are read.
 func (pc *JolokiaHTTPGetFetcher) buildJolokiaGETUri(mbean string, attr []Attribute) string {
 	initialURI := "/read/%s?ignoreErrors=true&canonicalNaming=false"
 
@@ -289,7 +425,10 @@ func (pc *JolokiaHTTPGetFetcher) buildJolokiaGETUri(mbean string, attr []Attribu
 		attrList = append(attrList, attribute.Attr)
 	}
 
-	tmpURL := mbean + "/" + strings.Join(attrList, ",")
+	tmpURL := mbean
+	if len(attrList) > 0 {
+		tmpURL += "/" + strings.Join(attrList, ",")
+	}
 
 	tmpURL = fmt.Sprintf(initialURI, tmpURL)
 
@@ -327,7 +466,11 @@ func (pc *JolokiaHTTPGetFetcher) buildGetRequestURIs(mappings []JMXMapping) ([]s
 			return urls, nil, err
 		}
 
-		// For every attribute we will build a response mapping
+		// For every attribute we will build a response mapping, if there are
+		// no attributes all of them are mapped automatically
+		if len(mapping.Attributes) == 0 {
+			responseMapping[attributeMappingKey{mbean.Canonicalize(true), ""}] = autoAttribute(mapping)
+		}
 		for _, attribute := range mapping.Attributes {
 			responseMapping[attributeMappingKey{mbean.Canonicalize(true), attribute.Attr}] = attribute
 		}
@@ -458,6 +601,11 @@ func (pc *JolokiaHTTPPostFetcher) buildRequestBodyAndMapping(mappings []JMXMappi
 			rb.Target.Password = mapping.Target.Password
 		}
 
+		// Without attributes Jolokia reads all of them, and they are
+		// mapped automatically
+		if len(mapping.Attributes) == 0 {
+			responseMapping[attributeMappingKey{mbean, ""}] = autoAttribute(mapping)
+		}
 		for _, attribute := range mapping.Attributes {
 			rb.Attribute = append(rb.Attribute, attribute.Attr)
 			responseMapping[attributeMappingKey{mbean, attribute.Attr}] = attribute
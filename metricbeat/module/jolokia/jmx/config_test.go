@@ -21,6 +21,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/libbeat/common"
 )
 
 func TestBuildJolokiaGETUri(t *testing.T) {
@@ -733,3 +735,138 @@ func TestNewJolokiaHTTPClient(t *testing.T) {
 		assert.Equal(t, c.expected, jolokiaGETClient, "httpMethod: "+c.httpMethod)
 	}
 }
+
+func TestBuildRequestsAndMappingsAutoMapping(t *testing.T) {
+	mappings := []JMXMapping{
+		{
+			MBean:  "java.lang:type=Memory",
+			Event:  "memory",
+			Naming: NamingConfig{Prefix: "memory"},
+		},
+		{
+			MBean: "java.lang:type=Runtime",
+			Attributes: []Attribute{
+				{
+					Attr:  "Uptime",
+					Field: "uptime",
+				},
+			},
+		},
+	}
+
+	expectedNaming := NamingConfig{Prefix: "memory"}
+
+	t.Run("GET", func(t *testing.T) {
+		httpReqs, attrMaps, err := (&JolokiaHTTPGetFetcher{}).BuildRequestsAndMappings(mappings)
+		assert.NoError(t, err)
+
+		if assert.Len(t, httpReqs, 2) {
+			assert.Equal(t, "/read/java.lang:type=Memory?ignoreErrors=true&canonicalNaming=false", httpReqs[0].URI)
+			assert.Equal(t, "/read/java.lang:type=Runtime/Uptime?ignoreErrors=true&canonicalNaming=false", httpReqs[1].URI)
+		}
+
+		assert.Equal(t, AttributeMapping{
+			attributeMappingKey{"java.lang:type=Memory", ""}: Attribute{
+				Event:  "memory",
+				naming: &expectedNaming,
+			},
+			attributeMappingKey{"java.lang:type=Runtime", "Uptime"}: Attribute{
+				Attr:  "Uptime",
+				Field: "uptime",
+			},
+		}, attrMaps)
+	})
+
+	t.Run("POST", func(t *testing.T) {
+		httpReqs, attrMaps, err := (&JolokiaHTTPPostFetcher{}).BuildRequestsAndMappings(mappings)
+		assert.NoError(t, err)
+
+		// All mappings are read with a single bulk request
+		if assert.Len(t, httpReqs, 1) {
+			assert.Equal(t, `[{"type":"read","mbean":"java.lang:type=Memory","config":{"canonicalNaming":true,"ignoreErrors":true}},{"type":"read","mbean":"java.lang:type=Runtime","attribute":["Uptime"],"config":{"canonicalNaming":true,"ignoreErrors":true}}]`, string(httpReqs[0].Body))
+		}
+
+		attr, found := attrMaps.Get("java.lang:type=Memory", "HeapMemoryUsage")
+		assert.True(t, found)
+		assert.Equal(t, Attribute{Attr: "HeapMemoryUsage", Event: "memory", naming: &expectedNaming}, attr)
+
+		_, found = attrMaps.Get("java.lang:type=Runtime", "StartTime")
+		assert.False(t, found)
+	})
+}
+
+func TestNamingFieldName(t *testing.T) {
+	mbean, err := ParseMBeanName(`java.lang:name="G1 Young Generation",type=GarbageCollector`)
+	assert.NoError(t, err)
+
+	cases := []struct {
+		naming   NamingConfig
+		mbean    *MBeanName
+		attr     string
+		expected string
+	}{
+		{
+			naming:   NamingConfig{},
+			attr:     "HeapMemoryUsage",
+			expected: "heap_memory_usage",
+		},
+		{
+			naming:   NamingConfig{Case: snakeCase},
+			attr:     "CPUTime",
+			expected: "cpu_time",
+		},
+		{
+			naming:   NamingConfig{},
+			attr:     "PS Eden Space",
+			expected: "ps_eden_space",
+		},
+		{
+			naming:   NamingConfig{},
+			attr:     "used_memory",
+			expected: "used_memory",
+		},
+		{
+			naming:   NamingConfig{},
+			attr:     "Http2Enabled",
+			expected: "http2_enabled",
+		},
+		{
+			naming:   NamingConfig{Case: lowerCase},
+			attr:     "HeapMemoryUsage",
+			expected: "heapmemoryusage",
+		},
+		{
+			naming:   NamingConfig{Case: originalCase},
+			attr:     "java.version",
+			expected: "java_version",
+		},
+		{
+			naming:   NamingConfig{Prefix: "jvm.gc"},
+			mbean:    mbean,
+			attr:     "CollectionCount",
+			expected: "jvm.gc.collection_count",
+		},
+		{
+			naming:   NamingConfig{Prefix: "gc", Properties: []string{"type", "name", "missing"}},
+			mbean:    mbean,
+			attr:     "CollectionCount",
+			expected: "gc.garbage_collector.g1_young_generation.collection_count",
+		},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.expected, c.naming.fieldName(c.mbean, c.attr))
+	}
+}
+
+func TestNamingConfigValidate(t *testing.T) {
+	for _, c := range []string{"", "snake", "lower", "original"} {
+		var naming NamingConfig
+		err := common.MustNewConfigFrom(map[string]interface{}{"case": c}).Unpack(&naming)
+		assert.NoError(t, err, "case: "+c)
+	}
+
+	var naming NamingConfig
+	err := common.MustNewConfigFrom(map[string]interface{}{"case": "camel"}).Unpack(&naming)
+	assert.Error(t, err)
+}
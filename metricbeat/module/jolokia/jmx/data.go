@@ -18,6 +18,8 @@
 package jmx
 
 import (
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/joeshaw/multierror"
@@ -107,6 +109,10 @@ type Entry struct {
 //       "status":200
 //    }
 // ]
+//
+// When attributes are mapped automatically the request doesn't contain any
+// attribute, and the value contains all the attributes of the MBean, or of
+// each matching MBean if there are wildcards.
 type eventKey struct {
 	mbean, event string
 }
@@ -119,7 +125,7 @@ func eventMapping(entries []Entry, mapping AttributeMapping) ([]common.MapStr, e
 	var errs multierror.Errors
 
 	for _, v := range entries {
-		if v.Value == nil || v.Request.Attribute == nil {
+		if v.Value == nil {
 			continue
 		}
 
@@ -132,11 +138,15 @@ func eventMapping(entries []Entry, mapping AttributeMapping) ([]common.MapStr, e
 					errs = append(errs, err)
 				}
 			case map[string]interface{}:
-				constructEvents(entryValues, v, mbeanEvents, mapping, errs)
+				constructEvents(entryValues, v, mbeanEvents, mapping, &errs)
+			}
+		case []interface{}, nil:
+			entryValues, ok := v.Value.(map[string]interface{})
+			if !ok {
+				errs = append(errs, errors.Errorf("expected map of values for %s", v.Request.Mbean))
+				continue
 			}
-		case []interface{}:
-			entryValues := v.Value.(map[string]interface{})
-			constructEvents(entryValues, v, mbeanEvents, mapping, errs)
+			constructEvents(entryValues, v, mbeanEvents, mapping, &errs)
 		}
 	}
 
@@ -148,13 +158,13 @@ func eventMapping(entries []Entry, mapping AttributeMapping) ([]common.MapStr, e
 	return events, errs.Err()
 }
 
-func constructEvents(entryValues map[string]interface{}, v Entry, mbeanEvents map[eventKey]common.MapStr, mapping AttributeMapping, errs multierror.Errors) {
+func constructEvents(entryValues map[string]interface{}, v Entry, mbeanEvents map[eventKey]common.MapStr, mapping AttributeMapping, errs *multierror.Errors) {
 	hasWildcard := strings.Contains(v.Request.Mbean, "*")
 	for attribute, value := range entryValues {
 		if !hasWildcard {
 			err := parseResponseEntry(v.Request.Mbean, v.Request.Mbean, attribute, value, mbeanEvents, mapping)
 			if err != nil {
-				errs = append(errs, err)
+				*errs = append(*errs, err)
 			}
 			continue
 		}
@@ -164,7 +174,7 @@ func constructEvents(entryValues map[string]interface{}, v Entry, mbeanEvents ma
 		// to be actually the matching mbean name
 		values, ok := value.(map[string]interface{})
 		if !ok {
-			errs = append(errs, errors.Errorf("expected map of values for %s", v.Request.Mbean))
+			*errs = append(*errs, errors.Errorf("expected map of values for %s", v.Request.Mbean))
 			continue
 		}
 
@@ -172,7 +182,7 @@ func constructEvents(entryValues map[string]interface{}, v Entry, mbeanEvents ma
 		for attribute, value := range values {
 			err := parseResponseEntry(v.Request.Mbean, responseMbean, attribute, value, mbeanEvents, mapping)
 			if err != nil {
-				errs = append(errs, err)
+				*errs = append(*errs, err)
 			}
 		}
 	}
@@ -206,6 +216,10 @@ func parseResponseEntry(
 		return errors.Errorf("metric key '%v' for mbean '%s' not found in mapping", attributeName, requestMbeanName)
 	}
 
+	if field.naming != nil {
+		return parseAutoMappedEntry(field, requestMbeanName, responseMbeanName, attributeValue, events)
+	}
+
 	var key eventKey
 	key.event = field.Event
 	if responseMbeanName != requestMbeanName {
@@ -228,3 +242,114 @@ func parseResponseEntry(
 	_, err := event.Put(field.Field, data)
 	return err
 }
+
+// parseAutoMappedEntry stores the value of an attribute mapped automatically,
+// with a field name generated by the naming rules
+func parseAutoMappedEntry(
+	field Attribute,
+	requestMbeanName string,
+	responseMbeanName string,
+	attributeValue interface{},
+	events map[eventKey]common.MapStr,
+) error {
+	// Attributes that cannot be read are reported as error messages
+	// because of the ignoreErrors option
+	if s, ok := attributeValue.(string); ok && strings.HasPrefix(s, "ERROR:") {
+		logp.Debug("jolokia.jmx", "ignoring attribute '%s' of mbean '%s': %s", field.Attr, responseMbeanName, s)
+		return nil
+	}
+	if attributeValue == nil {
+		return nil
+	}
+
+	var key eventKey
+	key.event = field.Event
+
+	var mbean *MBeanName
+	if responseMbeanName != requestMbeanName {
+		if len(field.naming.Properties) > 0 {
+			var err error
+			mbean, err = ParseMBeanName(responseMbeanName)
+			if err != nil {
+				return err
+			}
+		} else {
+			key.mbean = responseMbeanName
+		}
+	}
+	event := selectEvent(events, key)
+
+	_, err := event.Put(field.naming.fieldName(mbean, field.Attr), convertOpenType(field.naming, attributeValue))
+	return err
+}
+
+// convertOpenType converts values of JMX open types as serialized by Jolokia:
+//   - CompositeData is serialized as an object, it is converted to an object
+//     with keys named following the naming rules.
+//   - TabularData is serialized as an object with its rows indexed by the values
+//     of their index columns, it is converted to a list of rows so index values
+//     don't end up in field names.
+//   - Arrays are converted element by element.
+//   - Simple types are kept as they are.
+func convertOpenType(naming *NamingConfig, value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if isTabularData(v) {
+			keys := make([]string, 0, len(v))
+			for k := range v {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			rows := make([]interface{}, 0, len(v))
+			for _, k := range keys {
+				rows = append(rows, convertOpenType(naming, v[k]))
+			}
+			return rows
+		}
+
+		composite := common.MapStr{}
+		for k, item := range v {
+			composite[naming.key(k)] = convertOpenType(naming, item)
+		}
+		return composite
+	case []interface{}:
+		values := make([]interface{}, len(v))
+		for i, item := range v {
+			values[i] = convertOpenType(naming, item)
+		}
+		return values
+	default:
+		return value
+	}
+}
+
+// isTabularData checks if an object is TabularData, whose values are rows that
+// contain the index value used as key in one of their columns
+func isTabularData(value map[string]interface{}) bool {
+	if len(value) == 0 {
+		return false
+	}
+	for index, item := range value {
+		row, ok := item.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		found := false
+		for _, column := range row {
+			switch c := column.(type) {
+			case string:
+				found = c == index
+			case float64:
+				found = strconv.FormatFloat(c, 'f', -1, 64) == index
+			}
+			if found {
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
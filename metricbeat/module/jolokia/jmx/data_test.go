@@ -345,3 +345,133 @@ func TestEventGroupingMapperWithWildcard(t *testing.T) {
 
 	require.ElementsMatch(t, expected, events)
 }
+
+// TestEventMapperAutoMapping tests responses of requests reading all the
+// attributes of MBeans, that are mapped automatically.
+func TestEventMapperAutoMapping(t *testing.T) {
+	absPath, err := filepath.Abs("./_meta/test")
+
+	require.NotNil(t, absPath)
+	require.NoError(t, err)
+
+	jolokiaResponse, err := ioutil.ReadFile(absPath + "/jolokia_response_automapping.json")
+
+	require.NoError(t, err)
+
+	var mapping = AttributeMapping{
+		attributeMappingKey{"java.lang:type=Memory", ""}: autoAttribute(JMXMapping{
+			Naming: NamingConfig{Prefix: "memory"}}),
+		attributeMappingKey{"java.lang:type=Runtime", ""}: autoAttribute(JMXMapping{
+			Event: "runtime", Naming: NamingConfig{Prefix: "runtime"}}),
+		attributeMappingKey{"java.lang:name=*,type=GarbageCollector", ""}: autoAttribute(JMXMapping{
+			Naming: NamingConfig{Prefix: "gc", Properties: []string{"name"}}}),
+	}
+
+	// Construct a new POST response event mapper
+	eventMapper := NewJolokiaHTTPRequestFetcher("POST")
+
+	// Map response to Metricbeat events
+	events, err := eventMapper.EventMapping(jolokiaResponse, mapping)
+
+	require.NoError(t, err)
+
+	expected := []common.MapStr{
+		{
+			"memory": common.MapStr{
+				"object_pending_finalization_count": float64(0),
+				"verbose":                           false,
+				"heap_memory_usage": common.MapStr{
+					"init":      float64(1073741824),
+					"committed": float64(1037959168),
+					"max":       float64(1037959168),
+					"used":      float64(227420472),
+				},
+				"object_name": common.MapStr{
+					"object_name": "java.lang:type=Memory",
+				},
+			},
+			"gc": common.MapStr{
+				"g1_young_generation": common.MapStr{
+					"collection_count": float64(12),
+					"collection_time":  float64(86),
+					"last_gc_info": common.MapStr{
+						"gc_thread_count": float64(4),
+						"duration":        float64(3),
+						"memory_usage_after_gc": common.MapStr{
+							"g1_eden_space": common.MapStr{
+								"init": float64(56623104),
+								"used": float64(0),
+							},
+							"g1_old_gen": common.MapStr{
+								"init": float64(1017118720),
+								"used": float64(17391616),
+							},
+						},
+					},
+				},
+				"g1_old_generation": common.MapStr{
+					"collection_count": float64(0),
+					"collection_time":  float64(0),
+				},
+			},
+		},
+		{
+			"runtime": common.MapStr{
+				"uptime":          float64(47283),
+				"vm_name":         "OpenJDK 64-Bit Server VM",
+				"input_arguments": []interface{}{"-Xmx1g", "-Xms1g"},
+				"system_properties": []interface{}{
+					common.MapStr{"key": "file.encoding", "value": "UTF-8"},
+					common.MapStr{"key": "java.version", "value": "11.0.8"},
+				},
+			},
+		},
+	}
+
+	require.ElementsMatch(t, expected, events)
+}
+
+// TestEventMapperAutoMappingGetRequest tests responses of GET requests
+// reading all the attributes of wildcard MBeans.
+func TestEventMapperAutoMappingGetRequest(t *testing.T) {
+	absPath, err := filepath.Abs("./_meta/test")
+
+	require.NotNil(t, absPath)
+	require.NoError(t, err)
+
+	jolokiaResponse, err := ioutil.ReadFile(absPath + "/jolokia_get_response_automapping.json")
+
+	require.NoError(t, err)
+
+	var mapping = AttributeMapping{
+		attributeMappingKey{"Catalina:name=*,type=ThreadPool", ""}: autoAttribute(JMXMapping{
+			Naming: NamingConfig{Case: "lower", Prefix: "thread_pool"}}),
+	}
+
+	// Construct a new GET response event mapper
+	eventMapper := NewJolokiaHTTPRequestFetcher("GET")
+
+	// Map response to Metricbeat events
+	events, err := eventMapper.EventMapping(jolokiaResponse, mapping)
+
+	require.NoError(t, err)
+
+	expected := []common.MapStr{
+		{
+			"mbean": "Catalina:name=\"http-nio-8080\",type=ThreadPool",
+			"thread_pool": common.MapStr{
+				"currentthreadcount": float64(10),
+				"maxthreads":         float64(200),
+			},
+		},
+		{
+			"mbean": "Catalina:name=\"ajp-nio-8009\",type=ThreadPool",
+			"thread_pool": common.MapStr{
+				"currentthreadcount": float64(4),
+				"maxthreads":         float64(200),
+			},
+		},
+	}
+
+	require.ElementsMatch(t, expected, events)
+}
@@ -32,5 +32,5 @@ func init() {
 // AssetLinux returns asset data.
 // This is the base64 encoded gzipped contents of module/linux.
 func AssetLinux() string {
-	return "eJzEmd9v2zgSx9/zVwwCHNAWVzfp7/rhgNylOBR3uQu27csudoUxObK5pkiVP+y6f/2CpBXLtmQrcaT6pWgkz3z4neHMkH4Oc1qNQQrlv58BOOEkjeE8/v/8DMCQJLQ0hgk5PAPgZJkRpRNajeEfZwCQvguF5l7SGUAuSHI7jo+eg8KCNubDx61KGsPUaF+u/9Jgc2PXrqyjAgpyRjC7flj3UffDtFLOIJvfPWnyFz6766o+LSzh02R8F6QOY31RoFltPWvDOeI6fNbmQOeg8uwOBqxDJ6wTzP49vkMckBltLfzr9iswbcju2GqCroNzo3fZNuRSq2nDwyPw4VMim5Oz0XxJHLgncHojK+QopPCGWsEIjVxlPeFtOEg5I2gD6jQUOCcwWheQawOKlqDVnq410GShB8qKTShwM6pBO5zIduVy7RXvAcd6xsja3Eu5Akto2Ix46/IrGjFVuiHMj5dilkgBSkPIV1EjYi4FEnfivLs9a5DKknFZSErqQ7r/+WJCJmznKqbLGRkCKaxbO6/+SQxwAHWBUpwIuW9+T1E3QwcMldIOJgRRxQZt7gpgzIfMkHVoXA8SxpwHqfXcl0E+wWYwwxjnCcHa76bSpNcNWfGjlpx3IupQSftoHHuWD3WNkLMjQ988WTcqyEzJZiWZzBJr7CS51Lir7VHpvswI1F3+BZewdmkh+uRQkgFLTCuewr4MufnNk0/7KBQfTgvBaNS4jKURjgZeR/T52AvZiseggdjQCmv3aGvr6hCAYZU/jTwqvgYeTVZur5E8GvY/g/Gkem500cw42p2etCnQjWGfbGsBuESxi3ciOC7I4JTAiYLAlqRcnEa2s6ZR8VQQLZkF8UP7dUDVU8o8puxpCcPpvpP0DxS+2qG4mGahMfWDHizDE6GSfE9DGAJlxx3bTB5raM/c0QdIUlM3exToIbflaYkRnglGWTDbU1IkDwk8JEchpBRp+9mncRGfXvz/NL0n3jafgB9Ef0uGkXIBXufxfBvZuTdCTdcD4BZyexN6MkHFl4K7GXgnpPiBwW1c9OatpyO4Tq9bdN6kVzRj3sRpPUzEwsICpQ9egEltY2gvLy7+ttFjb9Sc26KPOXPb7MGrCYeuub4/4GLiP59vapcQ97xrKDEMhnaGppdD1udoOHkJZ2ZvabexNLGIRn8nw4jQ+Nb2k7djMF71Js1XJb55OoBR4B3GQkt0ouF+4XSM2xgaNkM1Dao4rSFH66oCGVffrlLupcwsQ9XHXcvmhB6qTDpQxCNkSqcZLggm4VwcANQhTBuPnpnSnDI2Q9ELblIyFumIZgjjbUyB37NAXGV2N0zuy3415b6UgmE4n4cKspOHFVJBhd66pHm0avnfdG0d7QPHra8dqp1JyocX0LXHMnbgB5fNaci5bG6XWPJR1O6+waoG6jSYH4tmSvl1osNkBcn1MUAuDDE3PGDyK9tv98ppboiGAwveom5huEi/aRzSzhHKAaO7OZwlWkNMoii6RjrSDhfqdtqjYU8vZJTngglSbDUqWfvNpGUoiWdNo2qdukxT6THs5LuihQ1DZQCnNIIrkHpJpvY3EIrHQmlryRPGTeuMn05lapt3dlN9aS/yKZw/R4Lk+6dIUC1/5qfUlKPt1bs0lIvvYzj/Larw+/mh6v4lHAiiFWBaudDqa1U+9Clc/xIRQNYJ7G080aja4vauP440BKcdyn633bGGXlvQ+tepUmvZnojeEm+8aerM3fblDtg3qQsHhkCLUuo0i2xWcYT80K45wt1xt7SMeVtn4K0sOjgpG7r3+P64+YELFDLOz/fNFEPpouTn8lcUMPEOlHaNSdNtQdabUvqe2+Sx9egFGaaLQnRNe045eumarvs6o5+wZa+T+3SXmWvTyFyf0oXKdR8nhwbbhw4ME8/5Ktv5QjtQBzWu0WG6On9RGs1eRA/BQTIWumEsbCkjJyvQhu/ly7GOcn1z1RrkJuYO3JH95iqdfa63j1zHsOpo5xe7PXgbryUHOxJGCkMEbObV3Iat8vKPi2e3V//+mH3+9OvHw2iXg6NddkV7OTjay65orwZHe9UV7fXgaK+7or0ZHO1NV7S3g6O97Yr2bnC0d13R3g+O9r4r2ofB0T50LrnDt4PLtn5QQSnNyY6eNXZ8PfmT9g4PHUh+wWU1cwqtIDb82hQQumpwsDVpnP0VAAD//2DzKvg="
+	return "eJzkm19v2zgSwN/9KQYFDtguUjdpd7u7eTigl/QOwTVN0D/7cIc7h6bGNs8UqfKPXe+nPwwpxbItWYoTKxu3NVDUkmd+MxwNh0PqBUxxcQpSKP+tB+CEk3gKz8L/n/UADEpkFk9hiI71ABK03IjMCa1O4a89AIi/hVQnXmIPYCRQJvY0XHoBiqW4FE9/3SLDUxgb7bP8mwqZS7l2YR2mkKIzgtv8YllHWQ/XSjnD+PT2SpU+gE27ALay0KdK+DpIGcb6NGVmsXKtDqdBNX1ycaBHoEaDWxiwjjlhneD2KNyDCTButLVwdv0FuDZoeyuCKqHL4InR62xLcqnVuOJiAzx9Msan6GwQn2ECiUdweulWGDEhhTdYC4bMyMVgT3hLDlTOCFyCOg0pmyIYrVMYaQMK56AV2nrQKGEPlAWbUOAmWHKeY0NZ77mR9irZA471nKO1Iy/lAiwywyeY1Jpf0Iix0gb3gFOEmEVUwKRBliyCj5C7OJCs5DLCXNRDKovGDSgocR+u++DTIRp6nIsxnU/QIEhhXa68+CcywBbUGZPinpDQ7FE3YQ44U0o7GCKEpwWTWqwYDwOD1jHj7kdXcQPEmAep9dRn5D7BJzBhYZyHCLneZaaJtxu04o9ScN46UVMm7TVl6h0mjg3J22YNitm+wa8ereunaMZoBxmagUXeq/LeSOoV2a1c93mCoG7jj1RCrtJC0JlAhgYscq2SOOxzis2vHn18jij5JDgTHPuVZsyNcNixHUHnQxuyMh6dDsSSVli7QVuyq5J7dQC69fz9yIPHc+D+cOHQ7gv7byQ8en1kdFrNuBoWQAVAytwpbJKtGMDmTLiHBWczNGyM4ESKYDNUjmDWoqbS4zEhWjQzTPqVzDFcOvR6UPigbg8SO/T7WtDv6Pj89302Gw9oYtoPOkmGH4SK7ntOwe8mJfjtT2w1ecihe+YOOkCiGrvJg0B3+VjmmDsGBj2tguOAnvb9AOcaIjgFRyqkFDHr2ef0xMHFy6v7+Xvo7eLh6K/RcFSO4PUorG8De+KNUOO8AFxBrqWFH4ZMJXORuAl4J6T4g5HTgtHLu5734TzebpnzJt6iOfeGys1QEQsLMyY9+QS41DasaU+Oj/+y9Edv3SlTm/bW/fEAdeaq2G1FJtWj1fl9HaPFsPzz02WpCbF2uYqiTJIxKgzthJm9LLI+BcFRCwgF3mK/BYtQ433ACCo3cvlRWxOMV3tzzRclvnrcgpGyW4yZlswJiXvAuCYFwCdMjckrTmsYMeuKBBmsr/cSNR4GljNl94C2XKFTlokLirCEDD6DCZshDKnTQABqG6YNS8+B0gkO+ISJveBGT4YkHdAMstCNSdm3AREXkd0OM/HZfn2a+EwKzqgvQxlkLQ4LpBRTbRb7yJbvY9s6yIeEOdYydxLo4B4JNNdIYu6RNscUc4OpnbMs6ZOsdQmNg1UU1HE5VHHDCnVQUQQ6DBcQVTcBJsIgd90DRr1ysYVvZBC7AyNtwW9UXMQ9jS1s1iGTHY7ucnEWlIFBLplI2450oO1uqOtpG4c93jDA0UhwgYov+hl3tbSWM4nJoKpULVNnsSptwo66C1pYMhQC2Bj78BaknqMpfQdCJSFR2lLwULlpnfHjsYzT5q3cmF/qk3wczsdxQdT9KC4ozJ/4MVbFaH32zgyOxLdTePbvEAj/edbbYuHnibBxdqKNBkdTfSnL0zzF8p0IAskD2FsaZq1KxvXvOCE47ZisHcUHeeyaJvSSQfnuVKa17Ncie4tJZaepNXfdj1tgX4YQofVAQusCJqWmRywpWdFAvu2paeDOA3s38tU1cMnpgaqWmSagXXkfJj7YjAlJBeadI8UgtSoweVz+ggKG3gFtgVUFTTuDrDeZ9PZx7dEzNFynqWgb9gmOmJeuqt3XxSN7HtUDqSd5lcwFq0K3r+28D+jm2kzLaT3vTE60dUexp/0yM5q/VOheWpVmwFRS+iqHW/X0u28OVYJJWS4z1O7MtKEhmgs3CWqGzAoeDiDkioWBzGinuZZHKzJvf2JZisEzoYZYxLVrDFWLMBJyddyrZpvCsyLr/9irGnk9/B9u5MT45aA2NhrG/eIauPbKobGVMI53SfP5bIlzBFJMEW4+ojNM2U84tjegDdy8F9ahupqhGUk9tzeV3GnWLfnldZm9EsknXQJ9OW/GGUja0OmW6T3t6WwFEzzt0lEXZ5cNnso37VrXsw0KP4Yye7kRVyS3ggGsULSBQbO2wZnQ3sIIHZ8cRW00QW4uwIr81IcPGkxQsZLcKJ+HPnrewaKLNMcWWuPjtiH2hh6jM2/MO+pdxQeQvrpk3860Ujd3LaMrklvD8G4McaJ91UmsRr/n6S74ppbP8ccF/HzWRJhmj814ed1M6ZPHZfxy3oKwOv91jRky4nbWypTYJWdIkquMBRvVh0KNdK8pNe5QCVbI3lY5DX2SLAZrP6gHamH7OXOsXG8GDaQgWkdtEVoV5kuT4QK0SdDcMSeeX77duLaNuQU3fc4v3wYuOF/tvTdhldGeHa83Y4o/tdPtHQjpQ8tm4BOvpqHKf/Xf4x+v3/7j3eDTxb/ebUc76RztpC3aq87RXrVFe9052uu2aD91jvZTW7SfO0f7uS3am87R3rRF+6VztF/aov3aOdqvbdF+6xztt7ZoJ91PByd180EBRXv+dqM42lIYtSD5yOZF85EOJIUJv1QF0KxKClYqjd46WGbQ2tW3bR6sMLrOZVMvS0ogEOrEEmxdq6wCp6oCKOh55itdWlWQNHiUjpFltcB9+LuXMn4fG3JaycVy4Vqx1p2iUSjzhaz1GS1xyej48hDP/F1XpVan2D857nCjbstpquI8DHW644k75oAixAVOcMxO84N3wWmYgFZ0Vu8odJzJESDp7pPjvMNg+9stf3Nolr9pa/nr40Mz/fVxW9vDjmb/7pskLUz6TKJv7UkFvS4ZI/HO9jUYkd/85AexrudXbz6dzPsz5ywpQWn1QiTyDtHbJmUFw98cmOFtMlYw/PXxgVneKmEF0x87YbUyr8GG/OanPoTt0lXtGdN7lZP5yZItFeX3V/1FD++STQ+gAKw2/nupAautP6gysOnE5aFUgsVQfo/F4O4Z7OnXg7snsAMoCe+Rv55IVdiUvg6kMLxb9iqMFw+2V3txta3L+P3VhBdXu2TTA6gHL652SaWHUAteXO2UR59KHXhx1WBDfu9TH8J2GfSw6r/dstXTr/12S1YHUPftmKueSM13cXXw9V67TFUYPUv39brI78I4z2S+imrz1sgGS1U1uHb6+y4nDJqPXe54RDyCN58U34y93+PR7/iqZfGuy40yg5uw5c5SEmlvX/k9AqtBlQ6SbwhcOVhOe+91Q77iuBqnrThs7dndJSKisbeIQq0M/FHDaztuggb7vf8PAGOQygg="
 }
@@ -0,0 +1,108 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package netstat
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// protocols maps the prefixes of the lines in /proc/net/snmp and
+// /proc/net/netstat to the protocols their stats are reported under, extended
+// stats are reported with the basic ones.
+var protocols = map[string]string{
+	"Ip":       "ip",
+	"IpExt":    "ip",
+	"Icmp":     "icmp",
+	"IcmpMsg":  "icmp",
+	"Tcp":      "tcp",
+	"TcpExt":   "tcp",
+	"MPTcpExt": "mptcp",
+	"Udp":      "udp",
+	"UdpLite":  "udp_lite",
+}
+
+// gauges are the stats that report current values or settings instead of
+// counters of events since boot.
+var gauges = map[string]bool{
+	"ip.Forwarding":    true,
+	"ip.DefaultTTL":    true,
+	"tcp.RtoAlgorithm": true,
+	"tcp.RtoMin":       true,
+	"tcp.RtoMax":       true,
+	"tcp.MaxConn":      true,
+	"tcp.CurrEstab":    true,
+}
+
+type netStat struct {
+	protocol string
+	name     string
+	value    int64
+}
+
+func (s netStat) isGauge() bool {
+	return gauges[s.protocol+"."+s.name]
+}
+
+// readNetStats reads files in the format of /proc/net/snmp and
+// /proc/net/netstat, where the stats of each protocol are reported in a line
+// with their names followed by a line with their values.
+func readNetStats(path string) ([]netStat, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening file %s", path)
+	}
+	defer file.Close()
+
+	var stats []netStat
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		names := strings.Fields(scanner.Text())
+		if len(names) == 0 {
+			continue
+		}
+		if !scanner.Scan() {
+			return nil, errors.Errorf("missing values for %s in %s", names[0], path)
+		}
+		values := strings.Fields(scanner.Text())
+		if len(names) != len(values) || names[0] != values[0] {
+			return nil, errors.Errorf("unexpected format of %s", path)
+		}
+
+		prefix := strings.TrimSuffix(names[0], ":")
+		protocol, found := protocols[prefix]
+		if !found {
+			protocol = strings.ToLower(strings.TrimSuffix(prefix, "Ext"))
+		}
+		for i := 1; i < len(names); i++ {
+			value, err := strconv.ParseInt(values[i], 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error parsing value of %s %s", prefix, names[i])
+			}
+			stats = append(stats, netStat{protocol: protocol, name: names[i], value: value})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "error reading file %s", path)
+	}
+	return stats, nil
+}
@@ -0,0 +1,131 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pressure
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/procfs"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/module/linux"
+)
+
+// resources are the resources with pressure stall information in /proc/pressure
+var resources = []string{"cpu", "memory", "io"}
+
+// init registers the MetricSet with the central registry as soon as the program
+// starts. The New function will be called later to instantiate an instance of
+// the MetricSet for each host defined in the module's configuration. After the
+// MetricSet has been created then Fetch will begin to be called periodically.
+func init() {
+	mb.Registry.MustAddMetricSet("linux", "pressure", New)
+}
+
+// MetricSet holds any configuration or state information. It must implement
+// the mb.MetricSet interface. And this is best achieved by embedding
+// mb.BaseMetricSet because it implements all of the required mb.MetricSet
+// interface methods except for Fetch.
+type MetricSet struct {
+	mb.BaseMetricSet
+	fs    procfs.FS
+	rates linux.CounterRates
+}
+
+// New creates a new instance of the MetricSet. New is responsible for unpacking
+// any MetricSet specific configuration options if there are any.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The linux pressure metricset is beta.")
+	linuxModule, ok := base.Module().(*linux.Module)
+	if !ok {
+		return nil, errors.New("unexpected module type")
+	}
+
+	path := filepath.Join(linuxModule.HostFS, "proc")
+	newFS, err := procfs.NewFS(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating new Host FS at %s", path)
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		fs:            newFS,
+	}, nil
+}
+
+// Fetch methods implements the data gathering and data conversion to the right
+// format. It publishes the event which is then forwarded to the output. In case
+// of an error set the Error field of mb.Event or simply call report.Error().
+func (m *MetricSet) Fetch(report mb.ReporterV2) error {
+	stats := make(map[string]procfs.PSIStats, len(resources))
+	totals := map[string]uint64{}
+	for _, resource := range resources {
+		psi, err := m.fs.PSIStatsForResource(resource)
+		if err != nil {
+			return errors.Wrap(err, "error fetching pressure stall information, it requires a kernel with CONFIG_PSI")
+		}
+		stats[resource] = psi
+		if psi.Some != nil {
+			totals[resource+".some"] = psi.Some.Total
+		}
+		if psi.Full != nil {
+			totals[resource+".full"] = psi.Full.Total
+		}
+	}
+
+	// Totals are in microseconds, so their rates per second divided by 1e6
+	// are the share of time stalled since the previous fetch
+	rates := m.rates.Update(time.Now(), totals)
+
+	event := common.MapStr{}
+	for resource, psi := range stats {
+		data := common.MapStr{}
+		if psi.Some != nil {
+			data["some"] = psiLineToMapStr(psi.Some, rates, resource+".some")
+		}
+		if psi.Full != nil {
+			data["full"] = psiLineToMapStr(psi.Full, rates, resource+".full")
+		}
+		event[resource] = data
+	}
+
+	report.Event(mb.Event{
+		MetricSetFields: event,
+	})
+
+	return nil
+}
+
+// psiLineToMapStr converts a line of pressure stall information, averages are
+// reported as ratios like other percentages.
+func psiLineToMapStr(line *procfs.PSILine, rates map[string]float64, key string) common.MapStr {
+	data := common.MapStr{
+		"10":    common.MapStr{"pct": common.Round(line.Avg10/100, common.DefaultDecimalPlacesCount)},
+		"60":    common.MapStr{"pct": common.Round(line.Avg60/100, common.DefaultDecimalPlacesCount)},
+		"300":   common.MapStr{"pct": common.Round(line.Avg300/100, common.DefaultDecimalPlacesCount)},
+		"total": common.MapStr{"us": line.Total},
+	}
+	if rate, found := rates[key]; found {
+		data["stalled"] = common.MapStr{"pct": common.Round(rate/1e6, common.DefaultDecimalPlacesCount)}
+	}
+	return data
+}
@@ -0,0 +1,82 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pressure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+func TestData(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2Error(t, getConfig())
+	err := mbtest.WriteEventsReporterV2Error(f, t, ".")
+	if err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2Error(t, getConfig())
+	events, errs := mbtest.ReportingFetchV2Error(f)
+
+	assert.Empty(t, errs)
+	require.Len(t, events, 1)
+
+	rawEvent := events[0].BeatEvent("linux", "pressure").Fields["linux"].(common.MapStr)["pressure"].(common.MapStr)
+
+	expectedMemory := common.MapStr{
+		"some": common.MapStr{
+			"10":    common.MapStr{"pct": 0.0125},
+			"60":    common.MapStr{"pct": 0.0052},
+			"300":   common.MapStr{"pct": 0.0011},
+			"total": common.MapStr{"us": uint64(1212999)},
+		},
+		"full": common.MapStr{
+			"10":    common.MapStr{"pct": 0.005},
+			"60":    common.MapStr{"pct": 0.002},
+			"300":   common.MapStr{"pct": 0.0004},
+			"total": common.MapStr{"us": uint64(746557)},
+		},
+	}
+	assert.Equal(t, expectedMemory, rawEvent["memory"])
+
+	// Older kernels don't report full stalls for cpu
+	assert.NotContains(t, rawEvent["cpu"], "full")
+	assert.Contains(t, rawEvent, "io")
+
+	events, errs = mbtest.ReportingFetchV2Error(f)
+	assert.Empty(t, errs)
+	require.Len(t, events, 1)
+
+	stalled, err := events[0].MetricSetFields.GetValue("cpu.some.stalled.pct")
+	require.NoError(t, err, "stalled time must be reported after the first fetch")
+	assert.Equal(t, 0.0, stalled)
+}
+
+func getConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"module":     "linux",
+		"metricsets": []string{"pressure"},
+		"hostfs":     "./_meta/testdata",
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"io/ioutil"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -43,3 +44,34 @@ func ReadIntFromFile(path string, base int) (int64, error) {
 
 	return intval, nil
 }
+
+// CounterRates calculates the rates per second of monotonic counters read from
+// procfs between consecutive fetches.
+type CounterRates struct {
+	previous map[string]uint64
+	time     time.Time
+}
+
+// Update stores the current values of the counters and returns their rates
+// per second since the previous update. Nothing is returned on the first
+// update, and counters that decreased since the previous update, because they
+// were reset or overflowed, are left out.
+func (r *CounterRates) Update(now time.Time, counters map[string]uint64) map[string]float64 {
+	previous, previousTime := r.previous, r.time
+	r.previous, r.time = counters, now
+
+	elapsed := now.Sub(previousTime).Seconds()
+	if previous == nil || elapsed <= 0 {
+		return nil
+	}
+
+	rates := make(map[string]float64, len(counters))
+	for name, value := range counters {
+		last, found := previous[name]
+		if !found || value < last {
+			continue
+		}
+		rates[name] = float64(value-last) / elapsed
+	}
+	return rates
+}
@@ -0,0 +1,45 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounterRates(t *testing.T) {
+	var rates CounterRates
+	now := time.Now()
+
+	assert.Nil(t, rates.Update(now, map[string]uint64{"a": 10, "b": 100}))
+
+	now = now.Add(10 * time.Second)
+	assert.Equal(t,
+		map[string]float64{"a": 2, "b": 0},
+		rates.Update(now, map[string]uint64{"a": 30, "b": 100, "c": 5}),
+	)
+
+	// Reset counters and counters without previous values are left out
+	now = now.Add(5 * time.Second)
+	assert.Equal(t,
+		map[string]float64{"c": 1},
+		rates.Update(now, map[string]uint64{"a": 3, "c": 10}),
+	)
+}
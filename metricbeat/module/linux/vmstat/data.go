@@ -0,0 +1,70 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package vmstat
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// gaugePrefix is the prefix of the vmstat values that report current amounts
+// of pages, values without it are counters of events since boot.
+const gaugePrefix = "nr_"
+
+// readVMStat reads all the values reported in /proc/vmstat
+func readVMStat(path string) (map[string]int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening file %s", path)
+	}
+	defer file.Close()
+
+	values := map[string]int64{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		value, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing value of %s", fields[0])
+		}
+		values[fields[0]] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "error reading file %s", path)
+	}
+	return values, nil
+}
+
+// counters returns the values that are monotonic counters
+func counters(values map[string]int64) map[string]uint64 {
+	result := make(map[string]uint64, len(values))
+	for name, value := range values {
+		if strings.HasPrefix(name, gaugePrefix) || value < 0 {
+			continue
+		}
+		result[name] = uint64(value)
+	}
+	return result
+}
@@ -0,0 +1,67 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package vmstat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+func TestData(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2Error(t, getConfig())
+	err := mbtest.WriteEventsReporterV2Error(f, t, ".")
+	if err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2Error(t, getConfig())
+	events, errs := mbtest.ReportingFetchV2Error(f)
+
+	assert.Empty(t, errs)
+	require.Len(t, events, 1)
+
+	rawEvent := events[0].BeatEvent("linux", "vmstat").Fields["linux"].(common.MapStr)["vmstat"].(common.MapStr)
+	assert.Equal(t, int64(646327), rawEvent["nr_free_pages"])
+	assert.Equal(t, int64(2369), rawEvent["pgmajfault"])
+	assert.NotContains(t, rawEvent, "per_sec", "rates are not reported on the first fetch")
+
+	events, errs = mbtest.ReportingFetchV2Error(f)
+	assert.Empty(t, errs)
+	require.Len(t, events, 1)
+
+	rawEvent = events[0].BeatEvent("linux", "vmstat").Fields["linux"].(common.MapStr)["vmstat"].(common.MapStr)
+	perSec, ok := rawEvent["per_sec"].(common.MapStr)
+	require.True(t, ok, "rates must be reported after the first fetch")
+	assert.Equal(t, 0.0, perSec["pgmajfault"])
+	assert.NotContains(t, perSec, "nr_free_pages", "gauges must not have rates")
+}
+
+func getConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"module":     "linux",
+		"metricsets": []string{"vmstat"},
+		"hostfs":     "./_meta/testdata",
+	}
+}
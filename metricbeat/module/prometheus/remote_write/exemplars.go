@@ -0,0 +1,174 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package remote_write
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/common/model"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Exemplar is an exemplar sent with a series, it usually contains the trace ID
+// of one of the observations.
+type Exemplar struct {
+	Labels    model.LabelSet
+	Value     model.SampleValue
+	Timestamp model.Time
+}
+
+// Exemplars are the exemplars of a write request, by the fingerprint of the
+// series they belong to.
+type Exemplars map[model.Fingerprint][]Exemplar
+
+// Field numbers in the remote write protocol. Exemplars were added to the
+// protocol after the version of prompb used to decode the requests, so they
+// are decoded from the raw message.
+const (
+	writeRequestTimeseriesField = 1
+
+	timeSeriesLabelsField    = 1
+	timeSeriesExemplarsField = 3
+
+	exemplarLabelsField    = 1
+	exemplarValueField     = 2
+	exemplarTimestampField = 3
+
+	labelNameField  = 1
+	labelValueField = 2
+)
+
+// protoToExemplars decodes the exemplars of a serialized write request.
+func protoToExemplars(buf []byte) (Exemplars, error) {
+	exemplars := Exemplars{}
+	err := forEachField(buf, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		if num != writeRequestTimeseriesField || typ != protowire.BytesType {
+			return nil
+		}
+
+		metric := model.Metric{}
+		var series []Exemplar
+		err := forEachField(value, func(num protowire.Number, typ protowire.Type, value []byte) error {
+			if typ != protowire.BytesType {
+				return nil
+			}
+			switch num {
+			case timeSeriesLabelsField:
+				return decodeLabel(value, model.LabelSet(metric))
+			case timeSeriesExemplarsField:
+				e, err := decodeExemplar(value)
+				if err != nil {
+					return err
+				}
+				series = append(series, e)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(series) > 0 {
+			fingerprint := metric.Fingerprint()
+			exemplars[fingerprint] = append(exemplars[fingerprint], series...)
+		}
+		return nil
+	})
+	return exemplars, err
+}
+
+func decodeExemplar(buf []byte) (Exemplar, error) {
+	e := Exemplar{Labels: model.LabelSet{}}
+	err := forEachField(buf, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		switch {
+		case num == exemplarLabelsField && typ == protowire.BytesType:
+			return decodeLabel(value, e.Labels)
+		case num == exemplarValueField && typ == protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(value)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			e.Value = model.SampleValue(math.Float64frombits(v))
+		case num == exemplarTimestampField && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(value)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			e.Timestamp = model.Time(int64(v))
+		}
+		return nil
+	})
+	return e, errors.Wrap(err, "decoding exemplar")
+}
+
+func decodeLabel(buf []byte, labels model.LabelSet) error {
+	var name, value string
+	err := forEachField(buf, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		switch num {
+		case labelNameField:
+			name = string(v)
+		case labelValueField:
+			value = string(v)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "decoding label")
+	}
+	labels[model.LabelName(name)] = model.LabelValue(value)
+	return nil
+}
+
+// forEachField calls fn for each field of a serialized message. The value
+// passed for length-delimited fields is their content, and for other types
+// the raw encoded value.
+func forEachField(buf []byte, fn func(protowire.Number, protowire.Type, []byte) error) error {
+	for len(buf) > 0 {
+		num, typ, n := protowire.ConsumeTag(buf)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		buf = buf[n:]
+
+		var value []byte
+		if typ == protowire.BytesType {
+			v, n := protowire.ConsumeBytes(buf)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			value = v
+			buf = buf[n:]
+		} else {
+			n := protowire.ConsumeFieldValue(num, typ, buf)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			value = buf[:n]
+			buf = buf[n:]
+		}
+
+		if err := fn(num, typ, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
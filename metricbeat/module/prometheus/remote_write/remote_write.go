@@ -51,6 +51,15 @@ type RemoteWriteEventsGenerator interface {
 	Stop()
 }
 
+// RemoteWriteExemplarsEventsGenerator is implemented by the generators that also
+// keep the exemplars sent with the series
+type RemoteWriteExemplarsEventsGenerator interface {
+	RemoteWriteEventsGenerator
+
+	// converts Prometheus Samples and the exemplars of their series to a map of mb.Event
+	GenerateEventsWithExemplars(metrics model.Samples, exemplars Exemplars) map[string]mb.Event
+}
+
 // RemoteWriteEventsGeneratorFactory creates a RemoteWriteEventsGenerator when instanciating a metricset
 type RemoteWriteEventsGeneratorFactory func(ms mb.BaseMetricSet) (RemoteWriteEventsGenerator, error)
 
@@ -171,7 +180,19 @@ func (m *MetricSet) handleFunc(writer http.ResponseWriter, req *http.Request) {
 	}
 
 	samples := protoToSamples(&protoReq)
-	events := m.promEventsGen.GenerateEvents(samples)
+
+	var events map[string]mb.Event
+	if gen, ok := m.promEventsGen.(RemoteWriteExemplarsEventsGenerator); ok {
+		exemplars, err := protoToExemplars(reqBuf)
+		if err != nil {
+			m.Logger().Errorf("Unmarshal error %v", err)
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+		events = gen.GenerateEventsWithExemplars(samples, exemplars)
+	} else {
+		events = m.promEventsGen.GenerateEvents(samples)
+	}
 
 	for _, e := range events {
 		select {
@@ -18,10 +18,12 @@
 package remote_write
 
 import (
+	"math"
 	"testing"
 
 	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
 
 	"github.com/elastic/beats/v7/libbeat/common"
 )
@@ -78,3 +80,60 @@ func TestGenerateEventsCounter(t *testing.T) {
 	assert.EqualValues(t, e.ModuleFields, expected1)
 	assert.EqualValues(t, e.Timestamp, timestamp1.Time())
 }
+
+func TestProtoToExemplars(t *testing.T) {
+	label := func(name, value string) []byte {
+		var b []byte
+		b = protowire.AppendTag(b, labelNameField, protowire.BytesType)
+		b = protowire.AppendString(b, name)
+		b = protowire.AppendTag(b, labelValueField, protowire.BytesType)
+		b = protowire.AppendString(b, value)
+		return b
+	}
+
+	var exemplar []byte
+	exemplar = protowire.AppendTag(exemplar, exemplarLabelsField, protowire.BytesType)
+	exemplar = protowire.AppendBytes(exemplar, label("trace_id", "4bf92f3577b34da6a3ce929d0e0e4736"))
+	exemplar = protowire.AppendTag(exemplar, exemplarValueField, protowire.Fixed64Type)
+	exemplar = protowire.AppendFixed64(exemplar, math.Float64bits(0.67))
+	exemplar = protowire.AppendTag(exemplar, exemplarTimestampField, protowire.VarintType)
+	exemplar = protowire.AppendVarint(exemplar, 424242)
+
+	var series []byte
+	series = protowire.AppendTag(series, timeSeriesLabelsField, protowire.BytesType)
+	series = protowire.AppendBytes(series, label("__name__", "http_request_duration_seconds_bucket"))
+	series = protowire.AppendTag(series, timeSeriesLabelsField, protowire.BytesType)
+	series = protowire.AppendBytes(series, label("le", "1"))
+	// samples are ignored
+	series = protowire.AppendTag(series, 2, protowire.BytesType)
+	series = protowire.AppendBytes(series, []byte{})
+	series = protowire.AppendTag(series, timeSeriesExemplarsField, protowire.BytesType)
+	series = protowire.AppendBytes(series, exemplar)
+
+	var withoutExemplars []byte
+	withoutExemplars = protowire.AppendTag(withoutExemplars, timeSeriesLabelsField, protowire.BytesType)
+	withoutExemplars = protowire.AppendBytes(withoutExemplars, label("__name__", "up"))
+
+	var req []byte
+	req = protowire.AppendTag(req, writeRequestTimeseriesField, protowire.BytesType)
+	req = protowire.AppendBytes(req, series)
+	req = protowire.AppendTag(req, writeRequestTimeseriesField, protowire.BytesType)
+	req = protowire.AppendBytes(req, withoutExemplars)
+
+	exemplars, err := protoToExemplars(req)
+	assert.NoError(t, err)
+
+	fingerprint := model.Metric{"__name__": "http_request_duration_seconds_bucket", "le": "1"}.Fingerprint()
+	assert.Equal(t, Exemplars{
+		fingerprint: {
+			{
+				Labels:    model.LabelSet{"trace_id": "4bf92f3577b34da6a3ce929d0e0e4736"},
+				Value:     0.67,
+				Timestamp: 424242,
+			},
+		},
+	}, exemplars)
+
+	_, err = protoToExemplars(req[:len(req)-1])
+	assert.Error(t, err)
+}
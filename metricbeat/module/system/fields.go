@@ -32,5 +32,5 @@ func init() {
 // AssetSystem returns asset data.
 // This is the base64 encoded gzipped contents of module/system.
 func AssetSystem() string {
-	return "eJzsff+PGzey5+/5KwgfFhm/m5E93mzevvnhAMd+uRvAjgf+gveAw0GmuksSd9hkh2RLVv76A4vs72x1t9TSyEEGi2wyI5GfKhaLVcVi1Q15hN0d0TttIPmBEMMMhzvy7BP+4tkPhMSgI8VSw6S4I//rB0IIcX8k2lCTaZKAUSzS14SzRyBvHr4QKmKSQCLVjmSaruCamDU1hCogkeQcIgMxWSqZELMGIlNQ1DCx8ihmPxCi11KZeSTFkq3uiFEZ/ECIAg5Uwx1Z0R8IWTLgsb5DQDdE0ATuSKpkBFrj7wgxu9R+WMks9b8J0GJ/HtzXckpm/g/VGaqzWLqh+G0+zyPstlLFld93zGZ/Pq8hB+uGm5FfpSLwjSYp8l9lQjCxejZrzR6l2SyNTGt+HVEO8XzJJa3+cSlVQs0dSUFFIMwIeO4LdAVELnFZDUuA6BSEIYsdLl1BAhMR4G841YbABoSZNUZkmmwoz4AwTYQFxdkfEOcjiSxZgMpniqQCjWLEDFFUrEDXRkPZeUmMJLdhBmlDlZlbwC0+xfXF6+EC0rxdg6jRu6W4bMpA3J7fSf4TrJHfclWgMoqylEFMmCAJtf9wn7n6+Pr981lt7xQqgIzZOl/d176SSApDmdCEy4hyP9rQHWXXu8Ws6uw9vPAobuw4FShWlDwCy2NCraCuOOB8lmOUJBk3DL9X0T75T13hFKvVIKJKCItrv85J4VKsGn/YQ439sdDfWFRuY5Soap/8H+ShkAAdBJRpUA1RJH3iSPaK5ADwveqDCQRGdEoj6KCtRoFh0aOehrUWHE1kJsyRwLy8XCJzH0EJ4GOomJDBvRwegU6wCC6Pw1IQLrc3qWJSMbPLtS3oIdScjdOHomQxv0CeI6oBwM8nyAMAyS1l5gJ5KYgFRq6kIDHTj8+H0XFOHTEOn/r98pisQW1YZN0aa8euqYi5/Y81VfHWekJMGFAqS03vflS/n4/1k6HWcmm+p3WxeA+j8KnX5gDkBii/vJVhgjCxkTwThqqdUwHePdwwZTLK8RvbNePO2VzvUssSLVVrMvTQKvySZg0qPwKlmrW+8HpDGacLDkQKvrOH5xfBvg1i5Dn14uUyqOJ+H+XKRWnW8iYtVdrQqmAf4p1hWGHChQoFLVIF2ltfuAJSm5n7sBQ3ZdyjNV65MzTZMs7Jmm7AOqj0G0uyxMdO5JJ8vX358m/k39x0X3Hs1mCV+Ep1XMoV0HhHDH208lFGZISRhEYRip3TLZv2oAEsFsqf2jUlH0Q7RKCvW8PuZEYiKtyiVVleBD5XCqgBZX8hHN+qEb9rwpbk761hfRxMAaGG/PzybxbatZUrJ1w+7DGL0myWc/Ork54FkNt/di7On8uF/XM5id+v+/Vn8Xa+I6v1L7s8QOFf1u001q2R5kIZiVdmmjiy8US9jzmg4Nx/+C+rhbqMkt9Ky2iQfWItqYtkwdgw9cUSMvagv0xCjjrtL5Ok4Uf+heI/4Ny/TEomP/y/KzIPtQAuk8jv1Qy4NG4OsQKu80CIDiWaoHMdoL1hMXxuRfe+l5vpS77T/T5uQS/wMvGiL+Ge+irk8BPxqZEfesj9dfdQ5YmVUyZ/aLJizPWDHaJy/2D/k9x/KNLIBuav5j/j7yjsP4Pr2c4vtT9FxqiO6e345Uby7JR9wgaKUT53h+cIeAMh/Kj9DHm6m8sXTeiOCGnIAhMaNyx2xzjlvGR6a0wfo+8hSAGNZ3jhMeHmQUupYmHYSazI2BWyIqOzyEr4MuN814Nvq5iBkwPEWQ5EiBxc7MzwG7XcFAx96QDwOAzCqMMmHwR5x0T2zV1xseZUpGEHaoiMVH4kvOxJOfOSJgjVOkssZ/BTRLM/0A79x+2rQSv49AyyOAyIaXiUDzaQTa1R+9mGYtVI3N7LtAMYkzBufYJIiliXCe1WreCOHbSwTwbR7dleY/HUAMMYY2nPwfsXH3T9EO8CKdMpjZcmRovDGi6pkisFup9p1qOcoQQq+D0DbWYJqBXoeQpqriEKYg15vT1gm+kDqHr8lJrgnHhzTxx33S3yFhSQ3zPIICZG4gaNYcN6/S1PlhPb89KFc56asNp6nXWhSvRM6xb6Cp0HLNB5V2ZaSnBFPAF7TsAJyPiltAEKe7yFuelPhI/ZXoKo9XmmJYRuQNEVVP2spVQNKQuuiJHWKrZOVPUJUr94nXFVnIidclkcSedbl8ammWhh8h1PN6u5tZtOQwpaZFdMOPY+t8tkUQ/UAMMoQR1+YjpwDsJBrMz6JEScc5tPK0gupALNZ48TEuBncIRYYaqagM+RqPsXH6Zdj0Wmd9NR8xC+TYgzZQ3X7ZpF6zoJ3Yfi1YKKeMtisyaZYZz9Qe20yITyU89n5K37uKYmU+4jMooy60y5PL7qw9iIS41LX8+szFkCwiiZVtkxOsBVhtL8E832mOODVjQfdL5gZtJwZIHWDmyXrA238vz7ya+nSrwe57XlJjVsA7n0pFLyIozw08v/+Lm1ykvGofYalxwUySyHaeVTl3+aIq26IPpMcQ4MWuJNU4XfRhIqSCZSxTaMg/Uz8L4sP/FmQehuk85HBl1HBVbrD/u/vohh88L+9fZrEJGd9wRQ7BhNKPDN/BQGgZcA81SyjujjwVhwYKtpcewWb8JoUFpPGCaw4xMhY8Bggd2j+Jt2NL8CScGTSvt+qbbo5lNzrcIvBXAI05DvZ+KaW+MK7/ZzLNNw3mC2nXAkvKc/3Rqg9+VOFKKo7QFz1DnmRcqNVDnKKodYfjtHVysFK1pcz1HOncppPLgpv3r0i6JDL2h+q6sfj4YsZdb0jGvb54ht/Tmg9vSM/CYxSeK/mIjltkP+3NQBp27fLgivdJsRoHG9Si6QWEa6FR0IrALZr5H3sqYPfQtnyJsgnqmoERt7ognQbp4nA4g7twdgSD2fD6FTg1cINOWZRp4+b7tAXNL4GHViXT47Ru7THqkAnt0+G6uU7Z+YWM2XNDJS3VlXb5xifleBX7ibWHgpYSIzEN7Dz/5xSUj/4bF2KJxntxeF9jYAN4wb0ySfSiYCskBiVuRNDEt/bJPzVEsRFpgpKHoy6eqQqiNpwg+FKTrtw+aWdnblxY4y99wQrZCFL1w2QbjibG4Inmu+Gtz+FTyr+/HFHrGDYJ3Ry3X+Wpl9iBaVX/PCN6pWEYwlaEwOYyLiWVx8OJLCZaIsdrk5GdFo7coJtqZeZMslKE2uNBS+q2cNjUxG+axhhly8ezZoYR1th9nrbSSvcbSy4ifEmNtqOddnxe+1loM7gpzBIvUEVfhZkcF7QxR4ZahdpJ9ZIQIRAVmA2YJ/ne9FGrMcqrEbv0LBwg32p/lJEkMKIta55v3wycXNEqmAxGAo4/qapKgGSbSG6LHwmSsy/LVDJMjT+1Ce3eEtf2/wXoTyKOPo2C+oXZYKL+qpbE475DUQ3kNSXnhgSOBFqmT0IoGEiaW8bvPC/khVnRC/VgWH7kmpVAolwpb10V2lU1MuaNv3sj8fBPnw6b8JQ0Ip0VnSVIC5DDFBI7xKyEXoQ+G3X/vvw+/tje1XURZi4b8+VCw61BsZouJIr5ojA73E9l1La5f2JTFvaVOzdeu8VMGSfbsjz/4vkvX/muZVPbRiJQ9HKc0Wa6kwbVik3RVQeX9ocdTqI+fSHAqe9kc+nthvL4kZKkpPpdbR8BmH96k0YnlJOwquzMwsbT1oH4C5hinKjTAcCiGkVuVmph8BE6cDwET//ApoTNeYf3Y0DOR9MSCpDzgAAR4Ro2N++yDgiGRdvWP/3rR2NmwTFlf6dAVzdPoOs1bzIxuzVwqNPFLDpisdUTF/tLAPFKycm8EXNC3UXu4jKoTzZNzUfQBjpiA6UAMcA9DNy5tpOlV86AycDZidrQimtFIpWrwzQPkZV7eMrji0CiJOWTJ0pRHt+Za6G23vsrsPzGG5ZBEDETWL4tfRTquP3Nw5WlJiqOijGXlNuNyCquooJmIW4cPyUnisZa2NylYrfKxpZDFuU4k1WeCW82lY4OY+OwuCenydrSAkrGc3wC0QL8lPbXsP23/hg7W8Pq4Q5BMxUin5pdvi7yvBIiYI5VxGuEQlOVN4pj0EHGXb1FNJa3LVmbFLJvItphGdMtJ0sBApcBnKT0tIjoIsMuNCLgF5GkmZzlTKsxMfrn2EyQ2oSCYJG701YljSjJtQ0sZgGo7Y32/d9C7RdSnVOPD24JpV/c0m8NCB0UJWWfqQD1uht0PLk4YjEuIF6WNmC9YQRBUtQTlf0Ohxkqnf5I51hTWYo59kGp/Z65Qz+y9LrHa7pWkVXlGiAMxWqiqi8bd8fozKNZ//TbXYQq1nT/53LJCxbGSynK/OApj1yItfvFBtgh9SdEFm5qw5ic2X47rWxSsIkYknRaggAtb/PsaFxaJHmPRtQtUxwrEHMux0SFSBZCBjmJiBUlKdhi1uaF8SxiFiYjVgrc6FSYOI+xExMYuVtMr6JIiYiGSCKfF+7cpHU37aARw7JUCZmZXcD7DR3o/yLd21D8uX1td6S9XWGvwiJr98eksWENFMg7+9sqabglQqU4ZvusvrNM6juc6ShA7IPikOiwUYOuy8eu9PJPeYx/m/Ky4XlBeqHa/mmNkNPH9YOvu34HLJxb+g5dH0LNj9g4uZgwpXqjPRlLN9ftMzXRZPOd2Xt/3TzTkzMPGc75iB/ROzKJl0Fd+8D1BaGKC13qbkIKvLj1GxutKy9SmNqaHX1aaJ19VOro1WjmRaq4tyRpsaI6VmXdA9C3w1YSv3orJoEduesdmslRz/iGlk49YqmrSjQWQ/+e1vDqE+PWLCA2dcHT5j+6tDZoySmDMx8RovM86J9bypiG/s8C5UZaTr+1ptsnrtU9DwWAjk9FC1yhJMFtKQUkX92RbMxmcrIRXM6UJu4I68evnTP8MaT4M6YCu5iuaH7aNoe+iy2tORiZW/sqinhw6dHcRmuJp1v5wfKQEgNkxJYVeObKhidMF9bC8oBa7Jj1WhoWpatFLAkPyqAH759PbapS05JfvhE/nvsMqo91Mi08XM3zx8udEpRGzJomqwPC1rMY4Nh3dWxCWjbr2775ID5Slrfaj3lcptgnV1jdFoPRHaok+SBetuG1ynbJQery+6eN0EenlX+aN6gmdpjKflvak4CpoljFPlE6OC0/7NzlIwsjpBzHTK6a70FIxMc5WdlwhtV4MMM7ejuvV3xeFA1/Vy5Cm7rxdEl13Ya9UqmizeU46anFkvhMtUNwE7mTglXpcbvHd59/Az1Ny+ji5uG71j0A1vek/2Pf4gw/N03H3g2POo77zrO6+e6HKklIC8dHKgdf+a6mqCr8tubmSev8GrIfJmTdUKyJUJPKQoRqbOXMm9OSroCpSdhbgLJkx1xoC7d2FyJM+LLoE+6uoeMTHdL6lK6ye7YbZM/giaxXZrfQJDPrE/YNbQFgG+yyjKUuaupRNq/+E+c/Xx9fvnvSsSZUrZCb3RSzS4O7Drjhf+TW5d3hk0mkXdu21N1VNtN5w7DhGT6c4yGmGP54AXMr8yDsVnpPK2YB5RccezlRRkt4s0Ml1xGpaBIuruzYM9pb0/MbVylCmIo46/RtWQOg80jj/4zOMsYWam5XJ0rsdQAZFL42bJM4J6oBfWU3DImldYGTuigiyARGtrVsVNi44aQsUOz98+Vqxpy6mdihV26FOxojK2ZQWW818AUTTv0aKkNB2OcGjjHbwl84i+3UCIR5elKt1MWCYVC8LhuUr1o3ugkwCWqG+N6L9V1CJRUN5ltIwpe+66gfSapZgC1RpQSHFj2eFHRgZqqE2A/KsFF1AtjPXbW3E30hNBG8Bg4qXp/i0aGFaSJBZkcdRoQrWWEcNw2JaZtTtOLZvDPsw9en9YjE/8aAjNR71/64Iyvjx2PjqOhnTnj8GCo9LFnktbUsv/MOvTMcmOnj8P8nLUrBvnf62zhfOnftSutI2rpDWKZTjbOZjWjl2RcSk83RyL0qzkBdHRGuKMg0afimKle2enUv1YZH75fRQc87X7Tq6fpTBKcu4121YWsdtiKqWvyZtfP6EC+fg5PKj9uzZUxA5M3meB78iSMlUO5fVMqqTVF0wKygNp1cgdLBTgrf/cfcxfnebLWDyR3AJbrc2MfPxcgREcVwHl3hdtgNJgdKX3d9DTDtqjpOy1VF8AZLJ/p51X3qRkxTYgrO3J5L7syWHZWkGFRgbsV9KUwPu3edypKT17AXSoi4MghDeB/Xk4RG10jhZSJ3uJjJZ65hcsmChJRieo7SEV58G18O3fEhYpmbcfwBRDuSUKVhmnyp6KnUM5lvyocz1hJMqyAi0zFYEmei0zHqNdAkUm6Qie/J5JQ0/Pks8NV7+TMW4jUx5+GIyQcjVJq3tUZSLfn1KA35vkimoSw5I5s6+by1Xh6KqgEOIeumqn5t1rgbl4K1A+uoEBEh9+Aqvwio2EeKoKr3PQWjXS3GissXVWuRfIJ4u9duzmZJp5pjjzO0/WfEWs0LPVumqN7mWvMhe8X4t92c3fjv2KYZixG1WZmcoEulqXwAwM40uxAm3Q+mAik5n2e65zYCYaLkp9E6/pBrq4NpBNruCOg3FqNpV5717VYLrshnKNSqe2YeymqKuYbuVmtzayAjhN9z/OaJNu1koawyE+OxOsrOiuVV24MiMeG7lCIpm+7hw3fxaxdRfYVrfnyXdmDTvPoG9rmmE9Rmyyttyrlyrqzkp1bYVcPIApgmfhUPXf5Lg4+RFaRMzzMvCuQvsVE0RQIWul7f1OK9ajx8AIrdMwn4lGe6LAg/wm7wXl1ZYDuVv5z1/WdPHzxNa0v4k+j9VY7aNcEfRa+SyrAqr+c4+8jyIzfM9Bht51jKC3oLQmeFZb0cZNh9yAsjTn3xi3bt0ZBJdF1XXj/t/d+GfC+HsIpskyE1EehcDmfr4mZm6/uOtqai0YjY+FNyz2DWDHmRUuIW0aiS84UhXhQnwxISiR8dBFreCbelGL7IRcmbSTlq5DC1nPGQjONJjlpxDWaejqFs8xC+dTTs4nWlcus+T5eBnzUKdejc9dq1FNgRkjZyO4fgrpmoqeY+XLjh2MapP9San5Ty059fjTvBDNlsVayKoUBGi0xo82TvU9LhPT/cf63sQfMs5i9QUB/FWce3fwl9F6IqN1vHGaQDLDrIXOfB4yRK32ZXOMILxaXtcnVCx2nVcOZee70QQn9NvlEL2G4iamWm91aspdjsElUl2Gu51JV68XaqnN32IsWasjVfmDldSe+7S49h0hXq5XoiWZHnqoW+4tKePZ6WPY9fwaHy1q5Pm5VIurxpo+J9vWo43yRwEWrhtOsN5emm5YQ15HNU/IqykKrOCKRehc2wm/hzrHm3JvFcy6VL3Syo60h3GbWTWm7DEkjmXWxauiPHrvBa7JNFzsPfw5gQLS20tSQc3NhgvaOeJVa9VRWY1USo+Xaq94b+VkZsvj5dstTRb0mi+do47nzMUrE7ls8Gefgugc+CDF8XiZpktz3aa0XezYcxOlF6kqajrCHjKf3zyUteZbrwnGEHqpqqGqE5oUB3REt3V/hPZENn0PesIzq8mnlsLo49LBlkbBrctUGs2F7E4QGG9fuHin68Ywp0KGy2ANZsCEsvJaSLFLZKZLC9RVDZeC+O4RHKg2NwoiEIbvbnC3Xb37+KWbQZxpUytzkKRLTa70OoHkeehp03DmWS/9zMz7lXG4WdDosXwQVDLn3ccvBbkHUIW8PjM9D/aAwImnXqM1A0VVtGYR5XPHqvllqcZq2LjwxHLY3noqit1U9ITTfd3ZMpOwS28vk1ulRzaYb51D1vl5GN/yvjbfjyYtOvFU1UVt53U7uM0deRCnnkBtdnMqrFCDPDpAOhIslHpZFH9if1Qex944iMT/HzZW7lbF0+ocbJCBtXbPnphodQQtXrTVvVOj2GoFCmL7iX0BMIQ+Uh7+JdX8O6AbgfYQTp69t5965v5Tk7UVIVG+F/TBANfriu/w3aCR+9xf1yoMCxHhg8aYVV/UDZQoPe8Mu5wg2RfrENt/YsavrHTIc/lINK+KdwAdXfWVT02IzCpO2rGk7KujMIiUcxyL/urN7hBFhU4p3rsUjR+eXxMhu+O+0xquSuu5nfliuPZbo3KxXBJaMDLIr3FJRFuaXgytn4prjwNXLxOwYZHBlomXQtT7Sjg2okJI496H+WY4gyjNqVzwRxbS4SPSZX7hMqrWSv8rS2bqLJkDkmRcJvClSKyLIjdLpqOuWYJSLtrnGvXKBNPCFihUsd18nZOTPZc1o9jE5Hky3UsG3L/4kFeTlgKfVlluuww5S/7hhOPbFyjLmfj3HlhESXIW7dpFq/OqGIGi1cxwuCMP3r78NLCq9R6m+CFqfRUqxShAFyWfgs3dT95kva8aZ2MdS9gIl+kW3sorP0fYREgqHWfqNbIGYWFxKyHpeCB20FEoNAdIT8GSfOBxaMyUhesrYNy4o7D8IZMFm36F3LCjkMRAp2dJjE1MwyjIvflRkw2oHckEZ4/AvanDjKsEYt1SqrC/EhNEy8S/X6acaGYyr1KZIQndeSc2TFomHoXcNp3L46krCas81Vu7pp9Yxp3H4kdvsxnFYGP1vrIumUfUVtGK1qyj0Wq38f2T96jp4xUtnwu5o65rS1LTehF9xLx5Y4QbtxQDEHDYQPjwOLias10LN24dQJgDOxHNKT6Bmg7FG5+IaAcnbvBrwhyWj6/v3xKqFN25t+xxJmIqTLjfR8z0Y359NtE2qvaEczFbN8me+U95wOMMlUXCtwxMG+s278OEPvT0LMFh436WLCnjkx1llfnduP3z4/7SYxpSHF8pnSQUC6UpukUUTt+G22WgezGt5FTCKjh4VWjWkscYhie3L1/9dGPdnxzCPnh2f57AIPH4vIHtIbpQssJXuHbeHrSFfgLV0F2TNbqpugh5bS03mz7DoYUZHpVjqk1o5ZCQNJ4f1d3jM5bcoDGpHUz75jx6uvwsHDFltjieSp0tbsYROcfi4sE5A7WlWxPiTYmhSZpPiBXKvS2GtS9nvjpdDgWD4+7soSLO/atrZ6Pa/xlNsrRdGbPoEPENonkk46P49On+f7/5P+/eEjtOWQ7SI/xRu2K37UY8VeuWGXdTefyaVdfLjtt+0NKedQMilmqOJYmbyn7U7HHev3M4iqKyTHDe3hKdXtsU1S1b1SHDUttf3DJKM5/2GHwOPLxiatnFof7WsnNmf/mzP7l0+Py1hNBW9kBzckwBnOFt01GzVjLuMKlw+MJUzoRWx9A6jkEh77zRWi5nnS8rBsW9z51n4qKvHbeqFVTh7pCnw5U39etB1t1CczC04LR97W+7eqAeNqu/e6vM2batpAV2jNv/+c2DH0WXBp472o6LqboGSl1OaXcjJr9xZl3f72rAFASxpAlr1SYdisB+7pjJuYwon7FwEejWr4umbLf/8Wr2cvZqdkukIq9evry9e/n2l3/evf7lP9/e/fMff//57u52nFn/zuIg9w+ExrHyta1ZUTyWCnL/sPnJTnb/sPm5+NAQ2lKpwud2QMQL+l69OgS+naoHk4JEGrgAhn9EIBNz3FN3FpZ7AobzfC31GAOuAPbvP9+8ur29ub3995u//zwT25n/yyySSfOSuAfzw+ePREEkVRw89FW+JjNyj91b5cJQrAq6YZQo2IDS7eP5/oFwKR87LwsbbADD43nKMz2Xo1r8lf26DyUfe8AtlxD5S+L0xoUPY4lewBV8fvf2eW4Ze17YRXPZtVIASWT7iRanC+C1npHXOIAd7X/eotv9bCnlbEHVbCU5FauZVKvZM8vfZ9VftC78i/ZzdowYDKiEibzHmB2eRDIBX+WeCgLJAuIYYhLJdFcERalplbXDL6yNSe9evEizBWeRzpZL9g1xDJblOXZePtQlaQvnf9rh/IcWOZmunGGxJiiBXtyIf6TSg7i73WbfGTe+UedeAL6R2YEgAkGYw1BM3Vvz10pfTVIbei8O+HZo21j4BlGGqUTH8AOrZo0WifC3xk/cGVLrmXqZcT4fIQp1G7g7NeET/p0M7bc9IjNBLl1bmNx+ZmU+gg8QHGVBt0tgH9w/5DXKsRDOom4uQm9MYq9b7itT9znE4cwXCwx52I2u2ktGGwgkSEyIpZgCjZ/O/uRTrotrUH7o2vRUs+pmyAStqt7XH8VXXck84HNdtncoQzNF8Wufg4ypuS6glrq2o3/AjLyRSoFOsdCnkXmtLQ14p//CaswXeqdfCDAvWLr56YWJ0nkCyYx86Ggz053iGC42f3Tnj/7VJQMDQFKla7o/x717pQeiRcRur/tF8tNCbEU+X9pu/u6loEuHTE1Ark/6+T5Mr5wAn4W2T8804YG2FgHT69ZF3wkAlneAlWlHcTPiUsN8SzvLppwEbQOh1RHzEsk8eBlWx21YchmwCyBDUOudmOtwA8Wzgs5xDMWsIGo2SX8SzBbHEMxLJnBNmqGgs4MugIxB3Yz/PBnqV0NQc6rNnEahG5izgs5xDMFsdc1ZTpB+lcfEKoS4cNLiSc3XL2//JOarJeQJzdcsvkTzdf/qkoHm67mNvy7Ue/6l2B1po1756CjBVzfE13olB/+UQ6xyUXGf8rGEI6/afCOQWRLOZghcDeTbJ/9q489MpJmZ5x9KGOcsnD4wIJn1w6ecVuwkVA7VThXLNCjdy/sDEsXeydUK4puivDtozaRoBpD38bgjnHZwim/5AM2DCc6qoVVv/Ih5X4vq1QiXK2Y1V3OKPW/djqT57S+Z9lmcrqvnAA4ELmGPRGG/XuQIVaShYwFCuSLHrEEhfENTU+rXE0EkCyk5tOIDvUjs17AjRuQ0E81vhvZy5JhUsfCK5FVvG0l/ezBEcmqpqKyGU9BxYJYy5Z/GrcPq4HzyNWAfafIwTCe4NZqPvHLtPUJf164F/Z10WS62Aaj8l/8fAAD//81OxGk="
+	return "eJzsfWtvHDfS7nf9CsKLRaQX8thONsEefziAYyOAgHhl+IJd4OBgxOnmzHDVTXZItsaTX/+iqsm+sq9zUSv22sgm0kyx6qlisVgsFp+Te7Z/TfReGxZfEGK4idhr8uwT/uDZBSEh04HiieFSvCb/94IQQrJfEm2oSTWJmVE80Nck4veMvP3whVARkpjFUu1JqumGXROzpYZQxUggo4gFhoVkrWRMzJYRmTBFDRcby8XighC9lcosAynWfPOaGJWyC0IUixjV7DXZ0AtC1pxFoX6NDD0ngsbsNUmUDJjW+DNCzD6BDyuZJvYnHlng74fsa06Shf1FeYTyKCA3y3/qxrln+51UYennLaPB389b5phFGNmC/CYVYV9pnCD+KhWCi82zRWP0IEkXSWBK5LLxdUAjFi7XkaTlX66liql5TRKmAibMCPayL9ANI3KNajU8ZkQnTBiy2hNTFoGLgOFPIqoNYQ9MmIJz+PN5yzV5oFHKCNdEAFMR/5OFjpJI4xVTbqRAKqbRjLghiooNczq1QoHtvCRGkld+gLShyiyB4dL3MpzCqvJ6UAASZLdloiLvjqLalGFhc/zM8h9BR3bKlRmVQZAmnIWECxJT+Ef2mcuPb95fLSpzJ3cBo6bOXfa1OxJIYSgXmkQyoJGlNnRGgb4bYJVH78HCcvEc6JRYAVOyHJC1VISCoW4i8EIKEaMkTiPD8XuW5UKfdYdDiF+IsiC8PP8LUSIpNrVfdEgDf4H1t8BVNjEKriqf/Bv5kFuA9jKUaqZqpthrjt0mOYD5XvfBBTJGdEID1iJbRQLDg3vtlWE0tMAcjWUqzIGMWXuZI7j3TAkWjZHiiAD3IjyCO8EDNj/zlYJEcvc8UVwqbvbO2zI9RJqzIT2VSx5GM8Qcucq/1s74+Qx5AENyR7mZIZaCAGPkUgoScn1/NUyO80E7lj/1x/xA1kw98AC2NRDHbqkII/iPLVXhDnZCXBimVJqY3vmo/jifVR+Nay3X5inpBfidJuFj62YC54bRaH6a4YJw8SCjVBiq9pkLsNvDB65MSiP8xm7Lo2yzud0nAImWqjHYjuoKXtJsmXJLoFSLxhfePFAe0VXEiBTRnkhBvgj+dRCQZzOAWQPkMAmS9KCtXJCkjd0k4ABpEn3Y7gy2ecdUlC9pkSimbfSFJiq1WaDpCymeF3mPBr1iZmiy41FEtvSBEUpi+pXHaWxzJ3JN7l69fPl38j+4h9V3SLtBrJRfKdOlkWI03BND72ECFRkZYSShQYBml/n9h/J+PPvj4QVYKVRS+cZfY2tKbkUzRaCvG2T3MiUBFZnSCvq6SHxuFKOGKfiByHArZ/yuCV+TnxpkUceYN6WG/PLy78AaJFNtOsqmPRaQ8XJo3mXWs2Lk1T9blVPb/D3xLexfa5P4dLdff5Xdzl96N/ENxOXfo9vjRLdGmpkCCbEg0yQTG1fUmzBiaDg3t/8GL5STrdD/G/lXERkNik8gkpp7kJJ/3yuGXeNnK8jYhX6eghy02s9UN4OX/JnyP2Hdn6ckR1/8n5SYUyOAeQr5VMOAuaE5JAq4doUm2ldogptrj+z5v8Dfv5HPjezeUzmZPmdecuwqfjbeDlqYz4fg4LX2fCxNWD7PxtzRV8TH5nzqInc2vme9bjlM4DCby4OOH4BE6fwB/pPc3OZlZAPrV6efUcA/vfps1pfCn7xiVIf01Xh1o3gwZMG0lyvNFKfRMls8R7A3kIUf0B44jezyDKcaXJOY7omQhqywoPGBh9kyTqOoAL1B0+boewSCg5AFHnh4pZk2eTBSKkUYMIgmgYQMP5iMTgM4flynUbTv4W+nuGEnZxBHmcghCLdY7Q3TQxl0oaDvSxOYRzLIRpVtOLP5nYv0a3bExetDkVocqFlgpLKU8LAnibi1NEGo1mkMusNPEc3/xDj051c/DtLg4wMEOjZMHAcjR2wgTA2q/bCBFha1wu1O0CYAE/MI9gSBFKG2y5t1KzB638ILGLDHYxGH7+ORy1Mz6OcxlLCi37y4LTHYxaRM9Al5BD4gjk2U3Cim+0GDvPICbGCh2B8p02YRM7VhepkwtdQs8PLq2/X2MFsvH4AhiR0SLtSoTXZyD9NJihAOjQ3ZMcXIHylLWQg3H2CChuyBB2yYWGg3Z5YLxzy1YBV9nVVRBfdc6wb3JTlzul1yVBV0Xs0cVxLUiBWgI0Q4ghi/FjFAHo83eF4MWmZ7BaKwHT6uIPSBKUhslfZZcE+lamVejRgJUTFsospXkLpkyMzrjFrBAU+qFhzhjHqpTZojKcbSW9CHzRLiptOIApTJJRcZvFew6phtSZhuDzBMEvThJ5YDxyARExuzPYkQ55zmlu0jGRJ4Ax6w+rXHIwpgR8gEAWMqh4BXMIPJzYvb4+pjler98aQpDv0rOa4wVRC47rY82FZFaOWeXK6oCHc8NFuSGh7xPykMiyAUn7pakHfZxzU1KaQspMBbmUq7a6Xli7FBJDXEsbXKSgcJE0bJZH9IgqtIpdkrmk2a45NW1BFdrrg5ZkSfEyZAGFTWZLdg4/GPpwp+LZ/XUKZMDX9gznoSKaM8jfCPl//nl4u6GGsescpt3EmKvivINOqpi18do6w6F9oLvkfx/pV+MOSYtMTSlxLeUMEsSCoSxR94xGADhedlbsVbeFnPJulyZNJ1KI9Atnax/+5FyB5egASv7rwcgZ5PwAqQrbPCvpp/+JnA20HLRHJhjssLEgZPi7Qb2Pi5QWsdalsT0gRAnwgZMkwWgO/GnzSz+SWWFGNDOTqFtXdb9Voxtjw2aiW8FGNTQMP0zFCODkQNxypj141Yqtl5k9kw4Ej2Hn91qzFd8Nr8F8f5WsMCc1Hnecw6Zk0qo1RaykqLmDudo5uNYhuaH8/RKMpcTu3CTfHVA5e+6Qc0/6q6H8sNWcu0vjN2Y6FJHzCtP3vcnl6Qf0msXvw3F6HctdhfNrRnU9c1C/yabgLBNOqrQIGEsmgZ0qWFMosej9wJTR/3PVbp/mSgwuD1OVFnECbPozEIg/cx6HPP5+MQmSOXyGgSpRoxLdWcOC4jScOLPiPrGBW2fEDD7WkPdADPXj278MHV4ZThV1xslmsKZ2qvYat3MQq030vs59tNbLwUc5EatvBz+vOcOP3Z8qpbmH01K25fedj18w0Fh4vHsokKzxnDJOR53cSw8semOD/PQZxcA8eQ6NUsRHp1LJnwQ88uBrrtUbF+98XmizorWQuyQ/zzXUaikbKwjcuOkK442zYExrEN1wqOvSyddfvxBZbYQWx5YqrT7teK6kMY2jJZ7I3KXQRDyaCPIBTUB1Ea5h8OpMgKdlZ7F04GNNhm7QQbQ6/S9ZopTS41c9HnwkJDAyhqXNTCEC9Oc9qeDVJsJpuX3fpUHcDJG6TmFABgANYYwC3qEtfmZe3XNUh9ttRphH2GOECYkkAlPEs2eGOIYtYZwgkHJNjAiBi0wFwxs2P2dr41aSzfKOdurIa8jRvgb/2TJGQJgxIb63lvP2V5sxg6EoTMUB7pa5Jg1pYEWxbc53vmkg3fLfpBf6Q9lIXbP+VvDJ6L0ChII9zYryiopYRFtZQt8w6uB8J7FhcHHpgSeAFlxi9iFnOxltdNLOCPVOUB8Wtl5nB7UjiV3InwdZU6MA4eKldofTZkf24Fuf30H8JRUEp0GtcdoLMhLmiARwnOhG7zffu1/T77ozmxrRZlbhb260PNosW9DXJx/W5uoJE03R1tzNIWWZwcekeTwT4vUWzNv74mz/4feu7//+yig2Vcl5BKEbZApMK1gbQUHgEV54fAh1Mt9kd21mzVUxvJF8v0xTPnmLZ2314IM9SU2sY8NcMY+Izj97E8Yu6zxrErU7NI6GY8uhWeAheEISlkIQGXmxZTt5UDLk7HABf5F1vHh9IpusX6s4PZgHELgqQq1wAOcIkIj8kCUiTb8hl7Kx8z9drpsEnopADQl+BK9YVPht5o1bpZIFPyyCM9bLLRARXLe9DGRMNyaHpv0DS4tnYfUCEg4tmTbOg+BkOuWGDOz2A2brTv4A8c6fkYg9HyZEqjlKLOG96kO6N2i+wKDkYUCyLK46GaRm7Pp+p2bnvVnn1gydZrHnAmgnpT/FP6o2xsxy0peCj5owV5A5eDmSr9jHAR8gDbyxTGA5G5NirdbCAHCHGco1t3YnUIMqt6HAiysc8OwYUPh226YT5jPXsADoxYS37s2HvY/PMvrMXxcUkgW4iRSBm1qGM2sfj7UrKIC7jYJGHShSVxhorQNaF6BLA2P02EailpSQ0g1In3FscxnTwxMd2IIA8GdxAeVxDHBVmlBpPCPnsaKZlOFSR6Hlcw+cBUIOOYj54aIVvTNDK+oo1zzO932fBZoSsc5I1iHhauRXm/OWTBaHBWDOndw/Z7+TJLbZFWL5htsXMnR8W4axpFKxrcH2Xot25jXYIGa/TjVOM1e51EHP5lDbllAK3MnmNJMLOT6v6iTykdZnJnaZSO+exPys0WKm/2uN9ja491rZJl/CngyJLfvEiVme3Ig9/PWw/zQ5ouQDbHNwVbdd41b4cwmbsdJEB05RUvL4tcPCqHigWM99+PASATGtwzM5jRUcxY2gMBOx0nKudkIDBcLJhSUp0Gloy0bQmTccTFpocl0NW5eNJMhP0ccbEIlQRnfRKOuAhkDFsqp7vi0pQddgBip2RQpmYjuxmsPe9Hox3d1/VHyEs453hH1Q4CfhGSXz+9IysW0FQze3oFoZtiiVSmSN+0t9dxAFjnutRpHNMB1Sf5YrFihl4MQuW9XZFwI2n3v5tIrmiUu3Y8muNmP3D94cnif7zqkqv/ssCMU9jNhyxnzpT2DmaCY472+W3PcGl4zOG+vOsfbhnBTeDjjvk7XPbtHJgH8TEFvXn73iOpG8y2x+q17o5B7iyNUtRlfwL9rWhIDb0uP5p4XX7J1f5s4ZtIh0ddNOK0CjIhCTXbXO6F56sx32Q3KvMnYpsj1h9r7Qv0enQ04eHWMjcJDyeK3/zmEOmTAwacOOJm+oibSSMGMbTQY95BJ+sY2iRBX6yYivA5kMdcE2R+8d3X8muw17YEDZcFT00PVZs0xmIhzRKqqF3bvNX4fCOkYku6kg/sNfnx5T/+6RUZbjZOmErwtanzKNiFI0dzaoXVEYqBs4x8tTx06OhMPAx3s5nvXR5oAUw8cCUFaI48UMXhOF63WwG0rGMEXKivm1aRaZOC/KYY+/XTu+usbClzsrefyH/8LqP6nlK73x+dM3/74ctznbCAr3lQTpYnRS/Gunn6XPugjrid0egAhXS0pyzpoLtVbp1ZrJtZYNB6Im7zd5KA2ey0IXspG32I9RdtWNcZPd+5UbtASSXlnasg70/W8SZ4msDD21DPV9ooaB7ziCpbGOUd9u8wSg5keYCQ6ySi+2KnYGTiXLZrEWr3DL3gtnS3flIIe15dd3+q27PS62CWoq/ev/31dfe/0ivslW4VdYjtvm0OfsHfprrOcDbhTskvjtCt3g48fY/bdz5yP5a74Y/ed17+6F6sKsxk54Fj16O+9a5vvXqkw5HCAlzrZLvHKsO9pbpc4JtVN9cqz9/i0RB5u6Vqw8hlqeo8nw85ZWrwK/a/Yyrohimypdj+NYa2nqFNuNstjOPkynkOW5NtLzFx3aaVAl+ltTc/fC6QPzLNQ5han5ghn/ifbFHzFh7coTlPAv1fob0RhX9kn7n8+Ob9Va9GglQpGNAGvUSz7Azsuihp70RrfmvQaIha5dNbqh5ruuHYoU+YtPIWQJnfdTjNlzW5+A2yze4zUtlY0GVUsuwpWArOV/whrNPFpmHtaaKOmwfcO9j9xLGdo0yYuKj9rk9bFRyqaeR1FQMNLbzF4DUv4jE3C+iSfxBLHQYi1yYbxVUE9bCeR09ekk6gOm14JHTFSLCFsCqsiU+oIVTscf3tgwLeET8RFED6VFCUaAMU+NbyihFF3RstSspaEOvkDnwTb/KUdBl9mEAoqy5aVWYjgbhZQziAwFB9j5OSxAzAaOrHfstNYHiGIj/LaARTsO5mhPSWJ7A4UM8rvuI5wGEpI4A6dxv4LCziV0kuoFtYjJzqvN2U/PmTEdZ08w4DDJhUEuq8rDQaWobLgGM6bMcNoMw1wtyEFv7crAFAbMYnfjCEOqo377KkzGpfoY7UUG53GcxLla46Dm3LEEFO63QgAXV3PcjaUb1vnP2xTlfZfuoHnbW2yTppjYIMRzsHaM3cVfecHYFYkKQFFkTDTYsUEnSwp6L4iAGEDmhPeeWXnUdemm+y7zj/LKF9XxRZz7aTee42H0rpa/L2t0+4An/87FcA/F4bCpc3gRn3zkK0J2vKVUHK+plESfAXXAoaecqq4W/W/MBG/2776G6dOjXmVyR3jG+2ZkE+fi6x4aWrGI3sXrTGlIYz6eLtb+9Om5ouz1+U3lkbBpDtPW3XeZOSDX9gAoJXLtvKFrqdWa9DGzJfGxZ4887lnerW08lAi7uYxIJ/EsCfD1PcRis1nzvpFDJY64VVWKo7pW0JSMaIiuOgLuzzbzEPlHTPD8D02sodUWyTRlTBqthKKoPkB+38hJGYiVFMy1QFUJu3lWkUQj2cYnkl6QhM/kiloaeH5HNtq98KTOZdaOSrVLcsOTdJncHAHFWpcPNTCmbnJrmkmoRszbOwr5VkxTjaOij40MOt2qmxewPPWRkGKZAsu4FVNzb9xMDh5RMJ+Sk7vFaiRSBmJ18D1kXpXMANFlrv2EoWnvdHJjT2bMuLNX+Eiq8t32zL0WgnvMrMeL5aiDocVNt85XrCRFVmoaAZacxmAQb4ahiIaYPRBxepTLWdc62EuahtUaqTeEsfWJuXGwgTJGLdRD41TEXdu3U1MEXVA400Op3KhIFJUXUxrWRxaiMULKKJHmwhmehmq6QxEQvPDgLYim7T6goCvpw3yP1SKKG7bqXrrkXsskYE4Ntd8Z3Zsn1GlX3d0hT7McK2QK47/VLJ3cHKU9FQlg/giuBaeDURcXFqsItMvGsDj5UI+FCCoMLN0KvSMlroo5Vqu54G4pDF7SeW/aNb4LLRqmEumEjItVF8lZpiq3GNDzAOsAjudiPk4ceBQkMeXaeKdYrdtmscIfcHOw5siaKIQB8YONFBtX/4dHNll/pM1IpIrSQLUcmdljG7g0yRcWnnst+3oZghUDlnCHy4lWpuaSW1YNktMg5pE8rhCUQ0TuT4DsqW7jonbRtH+XSPq2PYYyPdrBoq/ufPV/bv1sraByQWr162HMKPOgipHx20H4iMtBz4+8mPH7BvXUO3iqw68cT+1Uv7FE7fYtwA6pdvC6hfJgP108tvC6mfXk6GCtfB9lh40NIzUvLPxdJbj4nHwjBCWnCST9jVQGR2Fk+DOP3yTeE0ydEgTj+9/KaAmuZnEKmZ+plhKHQI64QMkpQGgTns4MMeY7jnUuyZ3+Ki9p3v6fAZpcNtKam+mGjUA6X8XK0drO5U3blKfrRX3kS0khyly65y5FF+bqC8uaTVDSqFJG21VAm6JdCowGac3tpLgOcl1XWtgBd+jrfGbCERHOGmInDHiPAslmtq71x/Vm9KIQUJtoGHilCG+PbDFz0QtCxVA3X2x7L4HJGyCefmCyORWIZsPH/HVmpeXmx15rl1cO1TZLXo1zvSUJFOYqzHkavdPHMeBkhpa8bPZ1qXWWn41Xgbs6weWxuf27RRrmEfY2fDRTmJdR1LnkPtC2gnaado3ltl3ttlQw1wwDrQSDnntioFYTTYokZrq3orWazk6V3WOyv3R0astqOXraWDqpjvQevJgtbxwWnM4gWWHbcW5A9yq33l2CMEL7+PYSuiV/vWmqHi6erRAsf063yE3rK8lCoXnYVHlxyn4SylLupVIDhz+ncyksviMjUUO7SSxFbIV/Zei1vJS6hBpqN03JnqoYs6TJQ15VF6+iKUaoG8Pe6tXdRBRZLLmk6v4DZWK10Fy8Xgw8aYxXo3N98AR07ZQwjuRo3FA/kk0JkPW6hlYZCbQ630jjm39G7mfqWYYRYzWIybYFUcTivhw8GavSty5TfW4OqgocW10juJA9K7Obmg+mRDhbZSvGxoHZ3VSKd0P9d4xe5WTha23M8/bqlD0Bu+tFIdj8zsnYlc10yky0G0Ep7kOO7nGbrcnzB2AdpL6G42R1dhYUDWgDo2Tssfi7J+YZqgc3UNucgsbEjs8RGtNA/xnmgPT8FPWLDqODUcRh9KkyONHK15Oo26ItsrfMfHF1nqFns2siUV0t/HdjAAR7SVN0KKfQy133kEintduItrn3/D2sHn0FFVmGj/HFfgy98/fmkHKOLaVPqUxckaXr3cxiy+8vUmGA4e7NLPDB5cp38OTbGL9xkLcH7/+CUXd4JUiPWZ5fkACwQOfGwdbTlTVAVbHtBomc2w5bxcYzltnF+EcGzb6CnvVlnyE5nvay93PwpcejdPtIod2WDcWklW8ZyGGxdPzZNy4XEXlZnXSrYxI/NPjkHqEdxmO1J+h+rFaIJ1xPjSwbwkhmZARQz2PGOR2P8DTnW7K24lOgkdeFhiiY9lTMZl6s0iCL2oC8ptsOmCSqP4ZsMU3ATChyJaqSLrI+3hv1Itn4DcMf2vVD2Ck2fv4VPPsv+Etl0J9LXJG37YZED2WG0E16zgKl4rUXhuEr+HnUSxI0nIyy0xBuALyOolF2eDFQdEK4GreUbaWVXUI1HX1nqCHOUXSM4piExLm7RDRelqhHZu19e6LNqjN/AMigqdUOxklr/cdgWVF226IK3ectqaobRewsizQa0wEiQG/0JzIL14jZIX1DAbWT/lxx4TtZcK9sADA9fm5hY6o/OHd0slvHPkHjFk4WhJMaspd3OTzz5qWCkqTUWYt3PhRlufBt4sUdKwwDReDin+4BLknnrka5JIrfkqYp7rkaNvfAKEW77ZzhNDd2nX7rEqFUTTLofmH+lEBV3BvPLZn7oPj88JzFNI7JbOSo9w+uOBdDR02Bu6G7C2Ir9Ja2Qp0LbOJuPAhscsrKJx4LXy4bWFZUwiuWv9zAArGoGLH5vcFHa08qoy0wm8PLNivHNsYt06OPRI7shKpiKkrQ/x+hAA3/uIEBQupOJbs1toecuETpKlDhYoTY4ClhywrwFjIQtHQBLTr4+ICIjhW8BBGLqCHQm8OyXxSkgnYSAU069TjELK+KLlI4+IgD3dwd9315/AH9jY2H5AXAp7oy8HEE67xgGyvOdR9CiotM0RYChvs0Jub99nPxm3K4U81zLkyuznnIl0NzCNhIB9p6BVuSDwGduk5H7iLgXFB3oMiM0ZAlwLjil6lpVaajMnwW30nTfyAvXa9BkyqicKqyO6mpuQeYUEF8+tjJjx00algYGz+KnCyvlp1L3y7p5KxHRJzLWGnju27GGquJBkmo28sE+qTt3rRl4M1qZKN/rRks+pUZIT4Un1SnJMf2+XNOd2SU5LB/cx+QY6Jvmw+t40qaVpkg+sb7Nvkj3cysfqFfiv3zrpaF7nr9896WhO5xtooHQ8n/PEeyj1uhwn5yq65/JibHhbEejXSAb35Ob2+030U91E979B0ikLdnOYzY4ts+qiKB1JZvUPa6ag/MlIvAqI56Bg3ys0qhAOuFsHJx0nZKNg4nIySBMBuHlxC286KZs5TZgCtLMuFCD+dMGhtYdmxZtfNsMPq0kiIx4McQqHegQ/DoWHGHjW9t19HOQ+ijLl0d4D6uNm4zyKND0SgyuuJUdhZ8rhrgDInsETFNLAgEOcQEW0VrpTRIZUN5utnl0i3shWNKyiW0lOQOOcFgASssc1gZDrgKr5TnbLX5bZPosZOETOaQh2zIGm0EqwDsg4weeU8L65fWLJ7pvb74nueSe6QUMHp5umJbn/l72z+20bRwL4u/8Koi97H40SZ3vdPb+l9e6dgQAN2u2zlxZph6hECiTlxvvXH4YflixRX5biprcL9KGIpZkfhxQ5HJLD79pOfwW4GwLcqw9TBJq+9+D26sNfge1SYHuSXub/P6g9SSfzJwhoT9PHfOfB7HIX44vkXN1I5WmKT5KqaqYTukAP7tzhp/oDQUe2pVhOhI9eHc+BF+62zDmHpu0ucn8UqhRpgWw7kEsClOJZu2/mi2fCt7OetdNRI9XYY4FtcJmq8RYcrmATkRQTHm+wISyMJHRyEBA6iEIllGbPYRIveBiNFnCWenoYK3cQyx8i3bDpa8iKHURCKJ7eJCC0iQKt9A8K7SkkZeIJ+0ITdwSOaXvFO5zHwBJtcpOL2/hV5p5NnCDFdO7m/EyjFB/c6Y1w0XL+hYuvfPLSFQUr3cEIM2iNNSR0zxMCl9ubs3xaMrqHtQoJh9scUTSrokrMyJhut/L+8E6U12fNbYH4Llvh4hoJG4QK1xDshs3VdHpNdgimD1cguBdBQvc0mQ4ATl9BXVi5pwBB/erA4zVgCz4dxXuXoBaEIyv8NWKW5ePdaomwlPgADVJSAmd0uEZBOtjK7tMqTfQZlb4jl8vDKmnR/5wDvFFeqiTIUKOY0gqJbRuTOVs9EVPJJEYscTpa1MOBEUqm12/ldus335eK/jHrfVnBySUFZ7VqyIUCjBJ/NYC2v1VBSrMkPm3LKYxkhZcbzaNIiD0LML+5fXMF4XiP0IYH3yclz8UneBnRDLEwBYUx9cDjDlpPqqis9F3hsek44myoxrNe0OUpgt274bWpCwxa0FPz0jBVL2ihJxGYwMkUPUobSHE+Qg+do9X5sXCAynwzvpQq31z11wgPrhXjcVgnqcPUFMKUWWmcZl6hicQ6X+wR8x2N0OoEBQY+N/bAPho3v3pt51SQU0UrlGf1pQJPTZ9ovI4FGWWnT6v/vP/v/RKBHLtCXyL8QaEUM+49zSBFzpm2GazG11m5vkBu/aKDutY95QSSJkmqqB6jnVCTqGgIhaRK5LK+6SfcN9W0ut7Gd0BYKREzc77rK9OPja023BmVueIsd+lwg9dENXbtNUK4WMcIQqd38DRqdpsY25M09NfvTvkdEcISvXITk4pMFqJRWgu3zEgcUDEFizvRFVDV3DjqIO5YmG9njRn3mxpEmYhdOv+g0da0BlyiyuBYmL4cl9PXRSZyPRYtqLYpbVdZb1tZhml1OZlKOmdVhUpAIWdVDdUW2uJCQZJmK8XnkJNu4KdRyDnrHQ4gTNLmSSlOWC1fOlxNdfxwoqb3U7azmzYWSMu8+KKCEFucsuRwJgGQjlEOJ36TiFW7ChC7QLU/0yecZhBKn//7NrqJbqM5RHxub27mi5vlu58Xd+9+WS5+/tePbxeLeeXVluqFf/fAgVYPCBMCm05c3rcYczh7jjlaPezfgLLVw/7t8aGjmJayZULqYOkCTfxYvtvbc/BBVdEgg0ySpkLTF2DwjwZkYou70l3E5K4A/W0OywhBqrADdwT76e3V7Xx+NZ//dPXj24h/jdwvUSzSaBjzw28fIfGZkCQ46EtfJxFaaXDfxQbSCFOC9gwjSfdUqurXjqAKEyG+5Fk/M1CdkDXkMV4LTs+xx9nFh3kT3W6hxzU7k7MrGz4kwswC/kZ/u1/+3XvGzhZQafbSKcEpSkV9L1qCNzSJ0K9CekSY4lAE0v45B7cCvdoKEW2wjHYiwXwXCbmLXoF9X5X/UC2M9dohNwDIIFRTmTKzpufFo1hARiEzrcEc0XRDCaEExSI7+HJAcoGqYPPCo9bZ4vo6yzcJi1W+3bInw3F8uK0SwSxrKqWQA2qwo3H+AuJcFW58MW2Wn2OdmBbomhtylxcUdgsSu8ldlDESZG0e45rfHDTEeTGxSFPMz4UIBGHOo0hJwjidrtp+zZMEubKhE9GtHPSJnmkJiAvkJsXkGHvAHYTR4CYRfmu44saQWodq2DaxHtAUvFLrvTZvTfhkfkeB38fuTBBb2ELMj/6z2zoCHYgLR47yoHEtPVOYuEdDvjPtmHMYH0QtsBCCKIO0T8sZ13QXTN3VAeXBjA2b6QoOuAGDBjZITMhyVGGcHzULYeg4m7JeYAZ2ft103HLcbJCuuXcPg5Uz4WwOJ1NJH/B5jTYYfha8tGJWJEMyOYXNUUQXUIM/IMX+oBF6L6SkKjP7l7TwdzAratb0r6HHvFYHdc2pvmbZ/s21jjO4DSRCH053sguO7hnPnyLUaMT6l9Zeqz3t0127bTVcBhQye8TVmXDfmu5JC//uYO+BzfeJk8SphfOicearttm+rSVo6kOmLoDvT7rt3q9feQY+QGvrZ6p4VIFHwNRjbaHvGQCLNcCS2kHWjBOh6Bq2n16StkIIfcS6IFmj0ArHKTes1rwI7CNIH2p14GtF+TeH9hx9mSWN9y+BGTj6MG8ZN3VSDQVdHPoIMoS6Gv/5ZtS3fahh+XWN4y/fGtpz9GGGvuYiI0g7ssMIEXvSnGSzvo5OBxM4OJ+XJxSzfs7NC3RfPy+/qfuak5fovn5eTuG+Xtr5a6Ju+Y9Htbs2ZlW+qhlbiH63In4/uanOnzXmO99U7FMulhCNChSQHEQLHqWq79KA/3z8q5WfGc9yvfYPpSxJWHj7QEfNQJj3wydfVsZPREWzakEgDqQ6bX/GRrF7sdtRcsU4fO0SKWrTtFYCyG02ZmS6sCJYpch64mCCWhXFejq9d7y8NJKIHeOkrqIlwcrIMi/f5crt4jQxxz4WCCzCjqSA173mcmsIqg/vFRlBcOfV9d6a4lHsqk1FoCXZCJFQzIeSwGuIccIgpTzfIex0tFsk4AqNrJHYbzYvb99qZYjF1K2iVBu2gyYBLV5/QjGhsm9f20O7FEKjh359gq2j9cAl1w4IaA7lZUG3Jn08fFcFmiGEEEIIzf43AEkO230="
 }
@@ -28,10 +28,15 @@ var showExperimentalOnce = sync.Once{}
 var NotSyntheticsCapableError = fmt.Errorf("synthetic monitors cannot be created outside the official elastic docker image")
 
 func create(name string, cfg *common.Config) (p plugin.Plugin, err error) {
+	ss, err := NewSuite(cfg)
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+
 	// We don't want users running synthetics in environments that don't have the required GUI libraries etc, so we check
 	// this flag. When we're ready to support the many possible configurations of systems outside the docker environment
-	// we can remove this check.
-	if os.Getenv("ELASTIC_SYNTHETICS_CAPABLE") != "true" {
+	// we can remove this check. In pool mode the browser runs in the workers, so the check is up to them.
+	if !ss.suiteCfg.Executor.IsPool() && os.Getenv("ELASTIC_SYNTHETICS_CAPABLE") != "true" {
 		return plugin.Plugin{}, NotSyntheticsCapableError
 	}
 
@@ -47,25 +52,35 @@ func create(name string, cfg *common.Config) (p plugin.Plugin, err error) {
 		return plugin.Plugin{}, fmt.Errorf("script monitors cannot be run as root! Current UID is %s", curUser.Uid)
 	}
 
-	ss, err := NewSuite(cfg)
-	if err != nil {
-		return plugin.Plugin{}, err
-	}
-
 	extraArgs := []string{}
 	if ss.suiteCfg.Sandbox {
 		extraArgs = append(extraArgs, "--sandbox")
 	}
 
+	var pool *synthexec.WorkerPool
+	if ss.suiteCfg.Executor.IsPool() {
+		pool, err = synthexec.AcquirePool(ss.suiteCfg.Executor.Pool)
+		if err != nil {
+			return plugin.Plugin{}, fmt.Errorf("could not create worker pool for browser monitor: %w", err)
+		}
+	}
+
 	var j jobs.Job
 	if src, ok := ss.InlineSource(); ok {
-		j = synthexec.InlineJourneyJob(context.TODO(), src, ss.Params(), extraArgs...)
+		if pool != nil {
+			j = synthexec.PoolInlineJourneyJob(context.TODO(), pool, src, ss.Params(), extraArgs...)
+		} else {
+			j = synthexec.InlineJourneyJob(context.TODO(), src, ss.Params(), extraArgs...)
+		}
 	} else {
 		j = func(event *beat.Event) ([]jobs.Job, error) {
 			err := ss.Fetch()
 			if err != nil {
 				return nil, fmt.Errorf("could not fetch for suite job: %w", err)
 			}
+			if pool != nil {
+				return synthexec.PoolSuiteJob(context.TODO(), pool, ss.Workdir(), ss.Params(), extraArgs...)(event)
+			}
 			sj, err := synthexec.SuiteJob(context.TODO(), ss.Workdir(), ss.Params(), extraArgs...)
 			if err != nil {
 				return nil, err
@@ -75,8 +90,13 @@ func create(name string, cfg *common.Config) (p plugin.Plugin, err error) {
 	}
 
 	return plugin.Plugin{
-		Jobs:      []jobs.Job{j},
-		Close:     ss.Close,
+		Jobs: []jobs.Job{j},
+		Close: func() error {
+			if pool != nil {
+				pool.Release()
+			}
+			return ss.Close()
+		},
 		Endpoints: 1,
 	}, nil
 }
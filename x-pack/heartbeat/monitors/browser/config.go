@@ -9,11 +9,23 @@ import (
 
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/x-pack/heartbeat/monitors/browser/source"
+	"github.com/elastic/beats/v7/x-pack/heartbeat/monitors/browser/synthexec"
+)
+
+const (
+	// ExecutorModeLocal runs elastic-synthetics on this host for every check.
+	ExecutorModeLocal = "local"
+	// ExecutorModePool hands every check to a pool of worker processes.
+	ExecutorModePool = "pool"
 )
 
 func DefaultConfig() *Config {
 	return &Config{
 		Sandbox: false,
+		Executor: ExecutorConfig{
+			Mode: ExecutorModeLocal,
+			Pool: synthexec.DefaultPoolConfig(),
+		},
 	}
 }
 
@@ -25,12 +37,38 @@ type Config struct {
 	// Name is optional for lightweight checks but required for browsers
 	Name string `config:"name"`
 	// Id is optional for lightweight checks but required for browsers
-	Id      string `config:"id"`
-	Sandbox bool   `config:"sandbox"`
+	Id       string         `config:"id"`
+	Sandbox  bool           `config:"sandbox"`
+	Executor ExecutorConfig `config:"executor"`
+}
+
+type ExecutorConfig struct {
+	Mode string               `config:"mode"`
+	Pool synthexec.PoolConfig `config:"pool"`
+}
+
+// IsPool returns true if suites are run by a worker pool rather than locally.
+func (e ExecutorConfig) IsPool() bool {
+	return e.Mode == ExecutorModePool
+}
+
+func (e ExecutorConfig) Validate() error {
+	switch e.Mode {
+	case "", ExecutorModeLocal:
+		return nil
+	case ExecutorModePool:
+		if e.Pool.Command == "" {
+			return ErrPoolCommandRequired
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown executor mode '%s', expected one of '%s' or '%s'", e.Mode, ExecutorModeLocal, ExecutorModePool)
+	}
 }
 
 var ErrNameRequired = fmt.Errorf("config 'name' must be specified for this monitor")
 var ErrIdRequired = fmt.Errorf("config 'id' must be specified for this monitor")
+var ErrPoolCommandRequired = fmt.Errorf("config 'executor.pool.command' must be specified when 'executor.mode' is '%s'", ExecutorModePool)
 var ErrSourceRequired = fmt.Errorf("config 'source' must be specified for this monitor, if upgrading from a previous experimental version please see our new config docs")
 
 func (c *Config) Validate() error {
@@ -45,5 +83,5 @@ func (c *Config) Validate() error {
 		return ErrSourceRequired
 	}
 
-	return nil
+	return c.Executor.Validate()
 }
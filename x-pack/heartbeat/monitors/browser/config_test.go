@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/elastic/beats/v7/x-pack/heartbeat/monitors/browser/source"
+	"github.com/elastic/beats/v7/x-pack/heartbeat/monitors/browser/synthexec"
 )
 
 func TestConfig_Validate(t *testing.T) {
@@ -40,6 +41,16 @@ func TestConfig_Validate(t *testing.T) {
 			&Config{Id: "myid", Name: "myname"},
 			ErrSourceRequired,
 		},
+		{
+			"pool executor",
+			&Config{Id: "myid", Name: "myname", Source: &testSource, Executor: ExecutorConfig{Mode: ExecutorModePool, Pool: synthexec.PoolConfig{Command: "worker"}}},
+			nil,
+		},
+		{
+			"pool executor without command",
+			&Config{Id: "myid", Name: "myname", Source: &testSource, Executor: ExecutorConfig{Mode: ExecutorModePool}},
+			ErrPoolCommandRequired,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
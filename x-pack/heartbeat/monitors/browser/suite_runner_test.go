@@ -14,6 +14,7 @@ import (
 
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/x-pack/heartbeat/monitors/browser/source"
+	"github.com/elastic/beats/v7/x-pack/heartbeat/monitors/browser/synthexec"
 )
 
 func TestValidLocal(t *testing.T) {
@@ -114,3 +115,31 @@ func TestEmptySource(t *testing.T) {
 	require.Regexp(t, ErrBadConfig(source.ErrInvalidSource), e)
 	require.Nil(t, s)
 }
+
+func TestPoolExecutor(t *testing.T) {
+	cfg := common.MustNewConfigFrom(common.MapStr{
+		"name": "My Name",
+		"id":   "myId",
+		"source": common.MapStr{
+			"inline": common.MapStr{
+				"script": "a script",
+			},
+		},
+		"executor": common.MapStr{
+			"mode": "pool",
+			"pool": common.MapStr{
+				"command": "/usr/bin/synthetics-worker",
+				"workers": 3,
+			},
+		},
+	})
+	s, e := NewSuite(cfg)
+	require.NoError(t, e)
+	require.True(t, s.suiteCfg.Executor.IsPool())
+
+	pool := s.suiteCfg.Executor.Pool
+	require.Equal(t, "/usr/bin/synthetics-worker", pool.Command)
+	require.Equal(t, 3, pool.Workers)
+	require.Equal(t, synthexec.DefaultPoolConfig().MaxRunsPerWorker, pool.MaxRunsPerWorker)
+	require.Equal(t, synthexec.DefaultPoolConfig().Timeout, pool.Timeout)
+}
@@ -0,0 +1,583 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package synthexec
+
+// In pool mode suites are not run by spawning elastic-synthetics for every check, instead
+// each run is handed to one of a fixed number of long lived worker processes, started with
+// the configured command. Workers speak newline delimited JSON over stdio.
+//
+// Requests are written to the worker's stdin, one JSON object per line:
+//
+//	{"type":"run","id":"<run id>","suite":{"path":"/path/to/suite"},"params":{},"args":[],"timeout_ms":900000}
+//	{"type":"run","id":"<run id>","inline":{"script":"step(...)"},"params":{},"args":[],"timeout_ms":900000}
+//	{"type":"cancel","id":"<run id>"}
+//
+// Responses are written to the worker's stdout, one JSON object per line:
+//
+//	{"type":"event","id":"<run id>","event":{...}}
+//	{"type":"done","id":"<run id>","error":{"name":"...","message":"..."}}
+//
+// Where event is a synthetics event as written by `elastic-synthetics --json`, and error is
+// optional. Every run must be terminated by exactly one done message, a worker may handle
+// several runs concurrently, up to the configured limit. Lines written to the worker's stderr
+// are logged. Workers are expected to exit once their stdin is closed.
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+const (
+	poolMsgRun    = "run"
+	poolMsgCancel = "cancel"
+	poolMsgEvent  = "event"
+	poolMsgDone   = "done"
+)
+
+// poolWorkerStopGrace is how long a worker has to exit after its stdin is closed before it is killed.
+var poolWorkerStopGrace = 5 * time.Second
+
+// PoolConfig configures a pool of worker processes running synthetic suites.
+type PoolConfig struct {
+	// Command is the executable started for every worker.
+	Command string   `config:"command"`
+	Args    []string `config:"args"`
+	// Workers is the number of worker processes in the pool.
+	Workers int `config:"workers" validate:"min=1"`
+	// MaxRunsPerWorker limits the number of runs a single worker handles concurrently.
+	MaxRunsPerWorker int `config:"max_runs_per_worker" validate:"min=1"`
+	// Timeout bounds a run, including the time spent waiting for a free worker.
+	Timeout time.Duration `config:"timeout" validate:"positive,nonzero"`
+}
+
+func DefaultPoolConfig() PoolConfig {
+	return PoolConfig{
+		Workers:          1,
+		MaxRunsPerWorker: 1,
+		Timeout:          15 * time.Minute,
+	}
+}
+
+var ErrPoolCommandRequired = fmt.Errorf("a command is required to start pool workers")
+
+// poolRequest is sent to a worker as a single JSON line.
+type poolRequest struct {
+	Type      string        `json:"type"`
+	ID        string        `json:"id"`
+	Suite     *poolSuite    `json:"suite,omitempty"`
+	Inline    *poolInline   `json:"inline,omitempty"`
+	Params    common.MapStr `json:"params,omitempty"`
+	Args      []string      `json:"args,omitempty"`
+	TimeoutMs int64         `json:"timeout_ms,omitempty"`
+}
+
+type poolSuite struct {
+	Path string `json:"path"`
+}
+
+type poolInline struct {
+	Script string `json:"script"`
+}
+
+// poolResponse is read from a worker, one per line.
+type poolResponse struct {
+	Type  string      `json:"type"`
+	ID    string      `json:"id"`
+	Event *SynthEvent `json:"event"`
+	Error *SynthError `json:"error"`
+}
+
+// pools holds the running pools, keyed by their config, so monitors sharing
+// a config share workers.
+var pools = struct {
+	sync.Mutex
+	m map[string]*WorkerPool
+}{m: map[string]*WorkerPool{}}
+
+// WorkerPool runs synthetic suites on a set of worker processes.
+type WorkerPool struct {
+	key   string
+	cfg   PoolConfig
+	refs  int // guarded by pools
+	slots chan struct{}
+
+	mtx     sync.Mutex
+	workers []*poolWorker
+	closed  bool
+}
+
+// AcquirePool returns the pool for the given config, creating it if needed. Workers are
+// only started once they're handed a run. Every call must be matched by a call to Release.
+func AcquirePool(cfg PoolConfig) (*WorkerPool, error) {
+	if cfg.Command == "" {
+		return nil, ErrPoolCommandRequired
+	}
+	if cfg.Workers < 1 || cfg.MaxRunsPerWorker < 1 {
+		return nil, fmt.Errorf("pool needs at least one worker and one run per worker, got %d workers and %d runs", cfg.Workers, cfg.MaxRunsPerWorker)
+	}
+
+	keyBytes, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	key := string(keyBytes)
+
+	pools.Lock()
+	defer pools.Unlock()
+
+	p, ok := pools.m[key]
+	if !ok {
+		p = newWorkerPool(key, cfg)
+		pools.m[key] = p
+	}
+	p.refs++
+
+	return p, nil
+}
+
+func newWorkerPool(key string, cfg PoolConfig) *WorkerPool {
+	p := &WorkerPool{
+		key:   key,
+		cfg:   cfg,
+		slots: make(chan struct{}, cfg.Workers*cfg.MaxRunsPerWorker),
+	}
+	for i := 0; i < cfg.Workers; i++ {
+		p.workers = append(p.workers, &poolWorker{pool: p, idx: i, runs: map[string]*poolRun{}})
+	}
+	return p
+}
+
+// Release gives up a reference to the pool, stopping all workers once no monitor uses it anymore.
+func (p *WorkerPool) Release() {
+	pools.Lock()
+	p.refs--
+	last := p.refs <= 0
+	if last {
+		delete(pools.m, p.key)
+	}
+	pools.Unlock()
+
+	if last {
+		p.stop()
+	}
+}
+
+func (p *WorkerPool) stop() {
+	p.mtx.Lock()
+	p.closed = true
+	p.mtx.Unlock()
+
+	wg := sync.WaitGroup{}
+	for _, w := range p.workers {
+		wg.Add(1)
+		go func(w *poolWorker) {
+			defer wg.Done()
+			w.stop()
+		}(w)
+	}
+	wg.Wait()
+}
+
+// acquireWorker blocks until a run slot is free, then returns the least busy worker with capacity.
+func (p *WorkerPool) acquireWorker(ctx context.Context) (*poolWorker, error) {
+	select {
+	case p.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, fmt.Errorf("no pool worker available: %w", ctx.Err())
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.closed {
+		<-p.slots
+		return nil, fmt.Errorf("worker pool is closed")
+	}
+
+	// Since the number of slots matches the total capacity there is always a worker with room
+	var best *poolWorker
+	for _, w := range p.workers {
+		if w.active < p.cfg.MaxRunsPerWorker && (best == nil || w.active < best.active) {
+			best = w
+		}
+	}
+	best.active++
+
+	return best, nil
+}
+
+func (p *WorkerPool) releaseWorker(w *poolWorker) {
+	p.mtx.Lock()
+	w.active--
+	p.mtx.Unlock()
+	<-p.slots
+}
+
+// run hands the request to a worker, returning a multiplexer receiving the results.
+func (p *WorkerPool) run(ctx context.Context, req poolRequest) (*ExecMultiplexer, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.Timeout)
+
+	w, err := p.acquireWorker(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	req.Type = poolMsgRun
+	req.ID = makeUuid()
+	if deadline, ok := ctx.Deadline(); ok {
+		req.TimeoutMs = int64(time.Until(deadline) / time.Millisecond)
+	}
+
+	r := newPoolRun(req.ID)
+	err = w.start(r, req)
+	if err != nil {
+		p.releaseWorker(w)
+		cancel()
+		return nil, err
+	}
+
+	go func() {
+		defer cancel()
+		select {
+		case <-r.done:
+		case <-ctx.Done():
+			w.cancel(r.id)
+			name := "cancelled"
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				name = "timeout"
+			}
+			r.abort(&SynthError{Name: name, Message: fmt.Sprintf("run %s aborted: %s", r.id, ctx.Err())})
+		}
+		w.remove(r.id)
+		p.releaseWorker(w)
+	}()
+
+	return r.mpx, nil
+}
+
+// poolRun tracks a single run on a worker.
+// poolRun forwards the events of a run to its multiplexer. Events are queued,
+// so that a run whose events aren't consumed doesn't block the worker's other runs.
+type poolRun struct {
+	id  string
+	mpx *ExecMultiplexer
+
+	mtx      sync.Mutex
+	cond     *sync.Cond
+	queue    []*SynthEvent
+	finished bool
+	err      *SynthError
+
+	// done is closed when the run finished, queued events may not be forwarded yet.
+	done chan struct{}
+}
+
+func newPoolRun(id string) *poolRun {
+	r := &poolRun{
+		id:   id,
+		mpx:  NewExecMultiplexer(),
+		done: make(chan struct{}),
+	}
+	r.cond = sync.NewCond(&r.mtx)
+	go r.forward()
+	return r
+}
+
+func (r *poolRun) deliver(se *SynthEvent) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if !r.finished {
+		r.queue = append(r.queue, se)
+		r.cond.Signal()
+	}
+}
+
+// finish ends the run, serr is reported after the queued events.
+func (r *poolRun) finish(serr *SynthError) {
+	r.end(serr, false)
+}
+
+// abort ends the run, dropping the events that weren't forwarded yet.
+func (r *poolRun) abort(serr *SynthError) {
+	r.end(serr, true)
+}
+
+func (r *poolRun) end(serr *SynthError, drop bool) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.finished {
+		return
+	}
+	r.finished = true
+	r.err = serr
+	if drop {
+		r.queue = nil
+	}
+	close(r.done)
+	r.cond.Signal()
+}
+
+// forward writes the queued events to the multiplexer, closing it once the run finished.
+func (r *poolRun) forward() {
+	for {
+		r.mtx.Lock()
+		for len(r.queue) == 0 && !r.finished {
+			r.cond.Wait()
+		}
+		if len(r.queue) > 0 {
+			se := r.queue[0]
+			r.queue[0] = nil
+			r.queue = r.queue[1:]
+			r.mtx.Unlock()
+
+			r.mpx.writeSynthEvent(se)
+			continue
+		}
+		serr := r.err
+		r.mtx.Unlock()
+
+		if serr != nil {
+			r.mpx.writeSynthEvent(&SynthEvent{
+				Type:  "cmd/status",
+				Error: serr,
+			})
+		}
+		r.mpx.Close()
+		return
+	}
+}
+
+type poolWorker struct {
+	pool   *WorkerPool
+	idx    int
+	active int // guarded by pool.mtx
+
+	mtx    sync.Mutex
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	enc    *json.Encoder
+	exited chan struct{}
+	runs   map[string]*poolRun
+}
+
+func (w *poolWorker) start(r *poolRun, req poolRequest) error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if w.cmd == nil {
+		err := w.spawn()
+		if err != nil {
+			return err
+		}
+	}
+
+	w.runs[r.id] = r
+	err := w.enc.Encode(req)
+	if err != nil {
+		delete(w.runs, r.id)
+		return fmt.Errorf("could not send run to pool worker %d: %w", w.idx, err)
+	}
+	logp.Debug(debugSelector, "Sent run %s to pool worker %d", r.id, w.idx)
+
+	return nil
+}
+
+// spawn starts the worker process, must be called with w.mtx held.
+func (w *poolWorker) spawn() error {
+	cmd := exec.Command(w.pool.cfg.Command, w.pool.cfg.Args...)
+	cmd.Env = append(os.Environ(), "NODE_ENV=production")
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Start()
+	if err != nil {
+		return fmt.Errorf("could not start pool worker '%s': %w", cmd.String(), err)
+	}
+	logp.Info("Started synthetics pool worker %d: %s", w.idx, cmd.String())
+
+	w.cmd = cmd
+	w.stdin = stdin
+	w.enc = json.NewEncoder(stdin)
+	w.exited = make(chan struct{})
+
+	go w.readLoop(cmd, stdout, stderr, w.exited)
+
+	return nil
+}
+
+func (w *poolWorker) readLoop(cmd *exec.Cmd, stdout, stderr io.Reader, exited chan struct{}) {
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		scanner := bufio.NewScanner(stderr)
+		for scanner.Scan() {
+			logp.Info("pool worker %d stderr: %s", w.idx, scanner.Text())
+		}
+	}()
+
+	scanner := bufio.NewScanner(stdout)
+	buf := make([]byte, 1024*1024*2)  // 2MiB initial buffer (images can be big!)
+	scanner.Buffer(buf, 1024*1024*40) // Max 40MiB Buffer
+	for scanner.Scan() {
+		if emptyStringRegexp.Match(scanner.Bytes()) {
+			continue
+		}
+		resp := poolResponse{}
+		err := json.Unmarshal(scanner.Bytes(), &resp)
+		if err != nil {
+			logp.Warn("error parsing line from pool worker %d: %s for line: %s", w.idx, err, scanner.Text())
+			continue
+		}
+		w.handle(resp)
+	}
+	if scanner.Err() != nil {
+		logp.Warn("Error scanning results of pool worker %d: %s", w.idx, scanner.Err())
+	}
+
+	wg.Wait()
+	err := cmd.Wait()
+	msg := "pool worker exited before the run completed"
+	if err != nil {
+		msg = fmt.Sprintf("%s: %s", msg, err)
+		logp.Warn("Pool worker %d exited: %s", w.idx, err)
+	} else {
+		logp.Info("Pool worker %d exited", w.idx)
+	}
+
+	w.mtx.Lock()
+	runs := w.runs
+	if w.cmd == cmd {
+		w.cmd = nil
+		w.stdin = nil
+		w.enc = nil
+		w.runs = map[string]*poolRun{}
+	}
+	w.mtx.Unlock()
+	close(exited)
+
+	for _, r := range runs {
+		r.finish(&SynthError{Name: "cmdexit", Message: msg})
+	}
+}
+
+func (w *poolWorker) handle(resp poolResponse) {
+	w.mtx.Lock()
+	r, ok := w.runs[resp.ID]
+	w.mtx.Unlock()
+	if !ok {
+		logp.Debug(debugSelector, "dropping %s message from pool worker %d for unknown run %s", resp.Type, w.idx, resp.ID)
+		return
+	}
+
+	switch resp.Type {
+	case poolMsgEvent:
+		if resp.Event == nil || resp.Event.Type == "" {
+			logp.Warn("pool worker %d sent an event without type for run %s", w.idx, resp.ID)
+			return
+		}
+		r.deliver(resp.Event)
+	case poolMsgDone:
+		r.finish(resp.Error)
+	default:
+		logp.Warn("unknown message type '%s' from pool worker %d", resp.Type, w.idx)
+	}
+}
+
+// cancel asks the worker to abort the given run.
+func (w *poolWorker) cancel(id string) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if w.enc == nil {
+		return
+	}
+	err := w.enc.Encode(poolRequest{Type: poolMsgCancel, ID: id})
+	if err != nil {
+		logp.Warn("could not cancel run %s on pool worker %d: %s", id, w.idx, err)
+	}
+}
+
+func (w *poolWorker) remove(id string) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	delete(w.runs, id)
+}
+
+// stop closes the worker's stdin, killing it if it doesn't exit in time.
+func (w *poolWorker) stop() {
+	w.mtx.Lock()
+	cmd, stdin, exited := w.cmd, w.stdin, w.exited
+	w.mtx.Unlock()
+
+	if cmd == nil {
+		return
+	}
+
+	stdin.Close()
+	select {
+	case <-exited:
+	case <-time.After(poolWorkerStopGrace):
+		logp.Warn("Pool worker %d did not exit in time, killing it", w.idx)
+		cmd.Process.Kill()
+		<-exited
+	}
+}
+
+// PoolSuiteJob returns a job that runs the suite at suitePath on a worker of the given pool.
+func PoolSuiteJob(ctx context.Context, pool *WorkerPool, suitePath string, params common.MapStr, extraArgs ...string) jobs.Job {
+	return startPoolJob(ctx, pool, poolRequest{
+		Suite:  &poolSuite{Path: suitePath},
+		Params: params,
+		Args:   extraArgs,
+	})
+}
+
+// PoolInlineJourneyJob returns a job that runs the given source as a single journey on a worker of the given pool.
+func PoolInlineJourneyJob(ctx context.Context, pool *WorkerPool, script string, params common.MapStr, extraArgs ...string) jobs.Job {
+	return startPoolJob(ctx, pool, poolRequest{
+		Inline: &poolInline{Script: script},
+		Params: params,
+		Args:   extraArgs,
+	})
+}
+
+// startPoolJob is the pool counterpart of startCmdJob, streaming the worker's results through
+// the same enricher as locally run commands.
+func startPoolJob(ctx context.Context, pool *WorkerPool, req poolRequest) jobs.Job {
+	return func(event *beat.Event) ([]jobs.Job, error) {
+		mpx, err := pool.run(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		senr := streamEnricher{}
+		return []jobs.Job{readResultsJob(ctx, mpx.SynthEvents(), senr.enrich)}, nil
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package synthexec
+
+import (
+	"context"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// buildTestWorker compiles the fake worker in ./testworker, returning a pool config using it.
+func buildTestWorker(t *testing.T) PoolConfig {
+	_, filename, _, _ := runtime.Caller(0)
+	bin := filepath.Join(t.TempDir(), "testworker")
+	cmd := exec.Command("go", "build", "-o", bin, ".")
+	cmd.Dir = path.Join(filepath.Dir(filename), "testworker")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "could not build test worker: %s", out)
+
+	cfg := DefaultPoolConfig()
+	cfg.Command = bin
+	cfg.Timeout = 10 * time.Second
+	return cfg
+}
+
+func acquireTestPool(t *testing.T, cfg PoolConfig) *WorkerPool {
+	p, err := AcquirePool(cfg)
+	require.NoError(t, err)
+	t.Cleanup(p.Release)
+	return p
+}
+
+func collectPoolRun(t *testing.T, p *WorkerPool, req poolRequest) []*SynthEvent {
+	mpx, err := p.run(context.TODO(), req)
+	require.NoError(t, err)
+
+	var synthEvents []*SynthEvent
+	timeout := time.NewTimer(time.Minute)
+	defer timeout.Stop()
+	for {
+		select {
+		case se := <-mpx.SynthEvents():
+			if se == nil {
+				return synthEvents
+			}
+			synthEvents = append(synthEvents, se)
+		case <-timeout.C:
+			require.Fail(t, "timeout expired waiting for pool run!")
+		}
+	}
+}
+
+func eventTypes(synthEvents []*SynthEvent) (types []string) {
+	for _, se := range synthEvents {
+		types = append(types, se.Type)
+	}
+	return types
+}
+
+func TestPoolRun(t *testing.T) {
+	p := acquireTestPool(t, buildTestWorker(t))
+
+	t.Run("inline", func(t *testing.T) {
+		params := common.MapStr{"foo": "bar"}
+		synthEvents := collectPoolRun(t, p, poolRequest{Inline: &poolInline{Script: "ok"}, Params: params})
+
+		require.Equal(t, []string{"journey/start", "step/end", "journey/end"}, eventTypes(synthEvents))
+		require.Equal(t, map[string]interface{}{"foo": "bar"}, synthEvents[1].Payload["params"])
+		require.Equal(t, "fake", synthEvents[0].Journey.Id)
+	})
+
+	t.Run("suite", func(t *testing.T) {
+		synthEvents := collectPoolRun(t, p, poolRequest{Suite: &poolSuite{Path: "/my/suite"}})
+
+		require.Equal(t, []string{"journey/start", "step/end", "journey/end"}, eventTypes(synthEvents))
+		require.Equal(t, "/my/suite", synthEvents[1].Payload["suite"])
+	})
+}
+
+func TestPoolRunTimeout(t *testing.T) {
+	cfg := buildTestWorker(t)
+	cfg.Timeout = 300 * time.Millisecond
+	p := acquireTestPool(t, cfg)
+
+	synthEvents := collectPoolRun(t, p, poolRequest{Inline: &poolInline{Script: "hang"}})
+
+	require.Equal(t, []string{"journey/start", "cmd/status"}, eventTypes(synthEvents))
+	require.Equal(t, "timeout", synthEvents[1].Error.Name)
+
+	// The slot must be freed, so the worker can be used again
+	synthEvents = collectPoolRun(t, p, poolRequest{Inline: &poolInline{Script: "ok"}})
+	require.Equal(t, []string{"journey/start", "step/end", "journey/end"}, eventTypes(synthEvents))
+}
+
+func TestPoolWorkerCrash(t *testing.T) {
+	p := acquireTestPool(t, buildTestWorker(t))
+
+	synthEvents := collectPoolRun(t, p, poolRequest{Inline: &poolInline{Script: "crash"}})
+	require.Equal(t, []string{"journey/start", "cmd/status"}, eventTypes(synthEvents))
+	require.Equal(t, "cmdexit", synthEvents[1].Error.Name)
+
+	// The worker is restarted for the next run
+	synthEvents = collectPoolRun(t, p, poolRequest{Inline: &poolInline{Script: "ok"}})
+	require.Equal(t, []string{"journey/start", "step/end", "journey/end"}, eventTypes(synthEvents))
+}
+
+func TestPoolConcurrencyLimits(t *testing.T) {
+	cfg := buildTestWorker(t)
+	cfg.Workers = 2
+	cfg.MaxRunsPerWorker = 2
+	p := acquireTestPool(t, cfg)
+
+	var mtx sync.Mutex
+	pids := map[float64]int{}
+	wg := sync.WaitGroup{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			synthEvents := collectPoolRun(t, p, poolRequest{Inline: &poolInline{Script: "sleep"}})
+			require.Len(t, synthEvents, 3)
+			payload := synthEvents[1].Payload
+			require.LessOrEqual(t, payload["concurrent"], float64(2))
+
+			mtx.Lock()
+			pids[payload["pid"].(float64)]++
+			mtx.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, pids, 2)
+}
+
+func TestPoolRunNotConsumed(t *testing.T) {
+	cfg := buildTestWorker(t)
+	cfg.MaxRunsPerWorker = 2
+	cfg.Timeout = 2 * time.Second
+	p := acquireTestPool(t, cfg)
+
+	// Nothing reads the events of these runs for now
+	idle, err := p.run(context.TODO(), poolRequest{Inline: &poolInline{Script: "ok"}})
+	require.NoError(t, err)
+	hung, err := p.run(context.TODO(), poolRequest{Inline: &poolInline{Script: "hang"}})
+	require.NoError(t, err)
+
+	// They don't block the other runs of the shared worker
+	for i := 0; i < 3; i++ {
+		synthEvents := collectPoolRun(t, p, poolRequest{Inline: &poolInline{Script: "ok"}})
+		require.Equal(t, []string{"journey/start", "step/end", "journey/end"}, eventTypes(synthEvents))
+	}
+
+	readAll := func(mpx *ExecMultiplexer) (synthEvents []*SynthEvent) {
+		for se := range mpx.SynthEvents() {
+			synthEvents = append(synthEvents, se)
+		}
+		return synthEvents
+	}
+
+	// The timeout still aborts the hung run
+	synthEvents := readAll(hung)
+	require.Equal(t, []string{"journey/start", "cmd/status"}, eventTypes(synthEvents))
+	require.Equal(t, "timeout", synthEvents[1].Error.Name)
+
+	// The events of the idle run were kept until read
+	require.Equal(t, []string{"journey/start", "step/end", "journey/end"}, eventTypes(readAll(idle)))
+}
+
+func TestAcquirePool(t *testing.T) {
+	_, err := AcquirePool(DefaultPoolConfig())
+	require.Equal(t, ErrPoolCommandRequired, err)
+
+	cfg := DefaultPoolConfig()
+	cfg.Command = "/bin/true"
+	p1 := acquireTestPool(t, cfg)
+	p2 := acquireTestPool(t, cfg)
+	require.Same(t, p1, p2)
+
+	cfg.Workers = 2
+	p3 := acquireTestPool(t, cfg)
+	require.NotSame(t, p1, p3)
+}
@@ -0,0 +1,119 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// testworker is a fake synthetics pool worker. The inline script selects its behaviour:
+// "hang" waits until the run is cancelled, "crash" exits mid journey, "sleep" takes a while
+// to finish, anything else completes a journey with a single step right away.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+type request struct {
+	Type   string                   `json:"type"`
+	ID     string                   `json:"id"`
+	Inline *struct{ Script string } `json:"inline"`
+	Suite  *struct{ Path string }   `json:"suite"`
+	Params map[string]interface{}   `json:"params"`
+}
+
+var (
+	mtx       sync.Mutex
+	enc       = json.NewEncoder(os.Stdout)
+	active    int
+	cancelled = map[string]chan struct{}{}
+)
+
+func send(msg map[string]interface{}) {
+	mtx.Lock()
+	defer mtx.Unlock()
+	enc.Encode(msg)
+}
+
+func event(id string, typ string, payload map[string]interface{}) {
+	send(map[string]interface{}{
+		"type": "event",
+		"id":   id,
+		"event": map[string]interface{}{
+			"type":       typ,
+			"@timestamp": time.Now().UnixNano() / int64(time.Microsecond),
+			"journey":    map[string]interface{}{"name": "fake", "id": "fake"},
+			"payload":    payload,
+		},
+	})
+}
+
+func run(req request, cancel chan struct{}) {
+	mtx.Lock()
+	active++
+	concurrent := active
+	mtx.Unlock()
+	defer func() {
+		mtx.Lock()
+		active--
+		mtx.Unlock()
+	}()
+
+	script := ""
+	if req.Inline != nil {
+		script = req.Inline.Script
+	}
+	event(req.ID, "journey/start", nil)
+
+	switch script {
+	case "hang":
+		<-cancel
+		send(map[string]interface{}{"type": "done", "id": req.ID, "error": map[string]string{"name": "cancelled", "message": "cancelled"}})
+		return
+	case "crash":
+		os.Stderr.WriteString("crashing\n")
+		os.Exit(3)
+	case "sleep":
+		time.Sleep(200 * time.Millisecond)
+	}
+
+	suite := ""
+	if req.Suite != nil {
+		suite = req.Suite.Path
+	}
+	event(req.ID, "step/end", map[string]interface{}{
+		"params":     req.Params,
+		"suite":      suite,
+		"concurrent": concurrent,
+		"pid":        os.Getpid(),
+	})
+	event(req.ID, "journey/end", nil)
+	send(map[string]interface{}{"type": "done", "id": req.ID})
+}
+
+func main() {
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		req := request{}
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			os.Stderr.WriteString(err.Error() + "\n")
+			continue
+		}
+		switch req.Type {
+		case "run":
+			cancel := make(chan struct{})
+			mtx.Lock()
+			cancelled[req.ID] = cancel
+			mtx.Unlock()
+			go run(req, cancel)
+		case "cancel":
+			mtx.Lock()
+			if c, ok := cancelled[req.ID]; ok {
+				close(c)
+				delete(cancelled, req.ID)
+			}
+			mtx.Unlock()
+		}
+	}
+}
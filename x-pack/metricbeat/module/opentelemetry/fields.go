@@ -0,0 +1,23 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// Code generated by beats/dev-tools/cmd/asset/asset.go - DO NOT EDIT.
+
+package opentelemetry
+
+import (
+	"github.com/elastic/beats/v7/libbeat/asset"
+)
+
+func init() {
+	if err := asset.SetFields("metricbeat", "opentelemetry", asset.ModuleFieldsPri, AssetOpentelemetry); err != nil {
+		panic(err)
+	}
+}
+
+// AssetOpentelemetry returns asset data.
+// This is the base64 encoded gzipped contents of module/opentelemetry.
+func AssetOpentelemetry() string {
+	return "eJzMU83O0zAQvOcpRrl80ie1D5ADV+CAuHBDqHWdbbpg71r2OtC3R+kfKYRDpe9QZS+Z/fHMerzCDzp20ERiFCiS5WMDGFugDu3nRPLlircN0FPxmZOxSod3DQDc1SBqXwM1QKZArlCHHZlrgEJmLEPp8LUtJbTfGmDPFPrSncasIC7Sv1Smz46JOgxZa7ogCzym2N51b+FVzLEUTP/sCzJ54pF6/GQ7wA70F/2U1dRrWF9GzhnOWb68rkcXKr3cMleWuvtO3mbwGdics73WXaDl7Ca6lFiGS2n72s7q/iN4iveuDlTgpIeorKKKmgp7+BprcMYjodRYlkR4rWKUn0HGp0d4Z2dPsfuP4vNkc+j+tGMUFk8nY6VMI2udPJc025KKAxfTIbv4qJRb49uq+XAde3YT/UoqJMYu/Dlxfh33b/z3AAGLRfE="
+}
@@ -0,0 +1,51 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+	httpserver "github.com/elastic/beats/v7/metricbeat/helper/server/http"
+)
+
+// Default ports of OTLP receivers
+const (
+	defaultHTTPPort = 4318
+	defaultGRPCPort = 4317
+)
+
+type config struct {
+	// Host and Port of the HTTP receiver, SSL settings are used by both
+	// receivers
+	Host string                  `config:"host"`
+	Port int                     `config:"port"`
+	TLS  *tlscommon.ServerConfig `config:"ssl"`
+
+	GRPC grpcConfig `config:"grpc"`
+}
+
+type grpcConfig struct {
+	Enabled bool   `config:"enabled"`
+	Host    string `config:"host"`
+	Port    int    `config:"port"`
+}
+
+func defaultConfig() config {
+	return config{
+		Host: "localhost",
+		Port: defaultHTTPPort,
+		GRPC: grpcConfig{
+			Enabled: true,
+			Host:    "localhost",
+			Port:    defaultGRPCPort,
+		},
+	}
+}
+
+func defaultHTTPConfig() httpserver.HttpConfig {
+	return httpserver.HttpConfig{
+		Host: "localhost",
+		Port: defaultHTTPPort,
+	}
+}
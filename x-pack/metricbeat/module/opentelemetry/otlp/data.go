@@ -0,0 +1,208 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/x-pack/metricbeat/module/prometheus/collector"
+)
+
+// eventsGenerator converts OTLP metrics to events, data points with the same
+// labels and timestamp are grouped in the same event.
+// All methods are thread-unsafe and must not be called concurrently
+type eventsGenerator struct {
+	log          *logp.Logger
+	counterCache collector.CounterCache
+}
+
+func newEventsGenerator(log *logp.Logger, timeout time.Duration) *eventsGenerator {
+	return &eventsGenerator{
+		log:          log,
+		counterCache: collector.NewCounterCache(timeout),
+	}
+}
+
+// Start must be called before using the generator
+func (g *eventsGenerator) Start() {
+	g.counterCache.Start()
+}
+
+// Stop must be called when the generator won't be used anymore
+func (g *eventsGenerator) Stop() {
+	g.counterCache.Stop()
+}
+
+// GenerateEvents converts metrics to events, attributes of the resources and
+// the data points are stored as labels. Metrics are reported according to
+// their kind:
+//   - gauges and cumulative non-monotonic sums as `value`
+//   - cumulative monotonic sums as `counter`, with their `rate` since the
+//     previous request
+//   - delta sums as `rate`
+//   - histograms and exponential histograms as ES histograms in `histogram`,
+//     with the counts of the buckets since the previous request
+func (g *eventsGenerator) GenerateEvents(metrics []metric) map[string]mb.Event {
+	events := map[string]mb.Event{}
+	for _, m := range metrics {
+		name := common.DeDot(m.name)
+		for _, p := range m.points {
+			labels := common.MapStr{}
+			for k, v := range m.resource {
+				labels[common.DeDot(k)] = v
+			}
+			for k, v := range p.attributes {
+				labels[common.DeDot(k)] = v
+			}
+			key := name + labels.String()
+
+			var data common.MapStr
+			switch m.kind {
+			case gaugeKind:
+				data = g.gaugeToES(p)
+			case sumKind:
+				data = g.sumToES(key, m, p)
+			case histogramKind:
+				data = g.histogramToES(key, m, p)
+			case exponentialHistogramKind:
+				data = g.exponentialHistogramToES(key, m, p)
+			default:
+				g.log.Debugf("Ignoring metric '%s', summaries are not supported", m.name)
+			}
+			if data == nil {
+				continue
+			}
+
+			var timestamp time.Time
+			if p.time > 0 {
+				timestamp = time.Unix(0, int64(p.time)).UTC()
+			}
+			eventKey := labels.String() + timestamp.String()
+			e, found := events[eventKey]
+			if !found {
+				e = mb.Event{
+					Timestamp:       timestamp,
+					MetricSetFields: common.MapStr{},
+					Namespace:       "opentelemetry",
+				}
+				if len(labels) > 0 {
+					e.RootFields = common.MapStr{"labels": labels}
+				}
+				events[eventKey] = e
+			}
+			e.MetricSetFields[name] = data
+		}
+	}
+	return events
+}
+
+func (g *eventsGenerator) gaugeToES(p dataPoint) common.MapStr {
+	if math.IsNaN(p.value) || math.IsInf(p.value, 0) {
+		return nil
+	}
+	return common.MapStr{"value": p.value}
+}
+
+func (g *eventsGenerator) sumToES(key string, m metric, p dataPoint) common.MapStr {
+	if math.IsNaN(p.value) || math.IsInf(p.value, 0) {
+		return nil
+	}
+
+	if m.temporality == temporalityDelta {
+		return common.MapStr{"rate": p.value}
+	}
+	if !m.monotonic {
+		return common.MapStr{"value": p.value}
+	}
+	rate, _ := g.counterCache.RateFloat64(key, p.value)
+	return common.MapStr{
+		"counter": p.value,
+		"rate":    rate,
+	}
+}
+
+// histogramToES converts a histogram to an ES histogram, buckets are reported
+// by their centroids, and the bucket above the last bound is interpolated as
+// a point at the same distance as the previous one, as in PromHistogramToES.
+func (g *eventsGenerator) histogramToES(key string, m metric, p dataPoint) common.MapStr {
+	if len(p.bucketCounts) == 0 || len(p.bucketCounts) != len(p.bounds)+1 {
+		return nil
+	}
+
+	values := make([]float64, 0, len(p.bucketCounts))
+	var lastUpper, prevUpper float64
+	for i := range p.bucketCounts {
+		if i < len(p.bounds) {
+			values = append(values, lastUpper+(p.bounds[i]-lastUpper)/2.0)
+			prevUpper = lastUpper
+			lastUpper = p.bounds[i]
+		} else {
+			values = append(values, lastUpper+(lastUpper-prevUpper))
+		}
+	}
+
+	counts := make([]uint64, len(p.bucketCounts))
+	copy(counts, p.bucketCounts)
+	return g.bucketsToES(key, m.temporality, values, counts, p.count)
+}
+
+// exponentialHistogramToES converts an exponential histogram to an ES
+// histogram, buckets are reported by their centroids. Bucket with index i
+// contains the values in (base^i, base^(i+1)], where base is 2^(2^-scale),
+// negative buckets contain the same ranges of negative values.
+func (g *eventsGenerator) exponentialHistogramToES(key string, m metric, p dataPoint) common.MapStr {
+	base := math.Pow(2, math.Pow(2, -float64(p.scale)))
+	centroid := func(index int) float64 {
+		lower := math.Pow(base, float64(index))
+		return lower + (lower*base-lower)/2.0
+	}
+
+	values := make([]float64, 0, len(p.negative.counts)+len(p.positive.counts)+1)
+	counts := make([]uint64, 0, cap(values))
+
+	// Negative buckets are added from the highest index, to keep values in
+	// increasing order
+	for i := len(p.negative.counts) - 1; i >= 0; i-- {
+		values = append(values, -centroid(int(p.negative.offset)+i))
+		counts = append(counts, p.negative.counts[i])
+	}
+	values = append(values, 0)
+	counts = append(counts, p.zeroCount)
+	for i, count := range p.positive.counts {
+		values = append(values, centroid(int(p.positive.offset)+i))
+		counts = append(counts, count)
+	}
+
+	return g.bucketsToES(key, m.temporality, values, counts, p.count)
+}
+
+// bucketsToES builds an ES histogram, counts of cumulative histograms are
+// rated since the previous call, taking into account that the histogram can
+// be reset.
+func (g *eventsGenerator) bucketsToES(key string, t temporality, values []float64, counts []uint64, total uint64) common.MapStr {
+	if t != temporalityDelta {
+		totalRate, found := g.counterCache.RateUint64(key+"_histogram", total)
+		reset := found && totalRate == total
+
+		for i, value := range values {
+			countRate, _ := g.counterCache.RateUint64(key+strconv.FormatFloat(value, 'g', -1, 64), counts[i])
+			if !reset {
+				counts[i] = countRate
+			}
+		}
+	}
+
+	return common.MapStr{
+		"histogram": common.MapStr{
+			"values": values,
+			"counts": counts,
+		},
+	}
+}
@@ -0,0 +1,432 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// OTLP messages are decoded from the wire format, as only a few fields of
+// them are needed. Field numbers are the ones in the opentelemetry-proto
+// definitions for metrics.
+
+type metricKind int
+
+const (
+	gaugeKind metricKind = iota
+	sumKind
+	histogramKind
+	exponentialHistogramKind
+	summaryKind
+)
+
+type temporality int
+
+const (
+	temporalityUnspecified temporality = iota
+	temporalityDelta
+	temporalityCumulative
+)
+
+// metric is a metric with its data points, and the attributes of the
+// resource that reported it.
+type metric struct {
+	name        string
+	kind        metricKind
+	temporality temporality
+	monotonic   bool
+	resource    map[string]string
+	points      []dataPoint
+}
+
+// dataPoint contains the fields of any kind of data point, only the ones of
+// the kind of its metric are set.
+type dataPoint struct {
+	attributes map[string]string
+	time       uint64
+
+	// gauges and sums
+	value float64
+
+	// histograms and exponential histograms
+	count uint64
+	sum   float64
+
+	// histograms
+	bounds       []float64
+	bucketCounts []uint64
+
+	// exponential histograms
+	scale     int32
+	zeroCount uint64
+	positive  expBuckets
+	negative  expBuckets
+}
+
+type expBuckets struct {
+	offset int32
+	counts []uint64
+}
+
+// decodeExportRequest decodes an ExportMetricsServiceRequest
+func decodeExportRequest(buf []byte) ([]metric, error) {
+	var metrics []metric
+	err := forEachField(buf, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		if num != 1 || typ != protowire.BytesType {
+			return nil
+		}
+		rm, err := decodeResourceMetrics(value)
+		metrics = append(metrics, rm...)
+		return err
+	})
+	return metrics, errors.Wrap(err, "decoding OTLP metrics request")
+}
+
+func decodeResourceMetrics(buf []byte) ([]metric, error) {
+	resource := map[string]string{}
+	var scopes [][]byte
+	err := forEachField(buf, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		switch num {
+		case 1: // resource
+			return forEachField(value, func(num protowire.Number, typ protowire.Type, value []byte) error {
+				if num == 1 && typ == protowire.BytesType {
+					return decodeKeyValue(value, resource)
+				}
+				return nil
+			})
+		case 2, 1000: // scope_metrics, and deprecated instrumentation_library_metrics
+			scopes = append(scopes, value)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Resource can appear after the metrics in the message, so metrics are
+	// decoded once it is known
+	var metrics []metric
+	for _, scope := range scopes {
+		err := forEachField(scope, func(num protowire.Number, typ protowire.Type, value []byte) error {
+			if num != 2 || typ != protowire.BytesType {
+				return nil
+			}
+			m, err := decodeMetric(value)
+			if err != nil {
+				return err
+			}
+			if m != nil {
+				m.resource = resource
+				metrics = append(metrics, *m)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return metrics, nil
+}
+
+// decodeMetric decodes a metric, it returns nil for metrics without data
+func decodeMetric(buf []byte) (*metric, error) {
+	var m metric
+	var data []byte
+	found := false
+	err := forEachField(buf, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		switch num {
+		case 1:
+			m.name = string(value)
+		case 5:
+			m.kind, data, found = gaugeKind, value, true
+		case 7:
+			m.kind, data, found = sumKind, value, true
+		case 9:
+			m.kind, data, found = histogramKind, value, true
+		case 10:
+			m.kind, data, found = exponentialHistogramKind, value, true
+		case 11:
+			m.kind, data, found = summaryKind, value, true
+		}
+		return nil
+	})
+	if err != nil || !found {
+		return nil, err
+	}
+
+	err = forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			p, err := decodeDataPoint(m.kind, value)
+			if err != nil {
+				return errors.Wrapf(err, "decoding data point of metric '%s'", m.name)
+			}
+			m.points = append(m.points, p)
+		case num == 2 && typ == protowire.VarintType && m.kind != gaugeKind && m.kind != summaryKind:
+			v, _ := protowire.ConsumeVarint(value)
+			m.temporality = temporality(v)
+		case num == 3 && typ == protowire.VarintType && m.kind == sumKind:
+			v, _ := protowire.ConsumeVarint(value)
+			m.monotonic = v != 0
+		}
+		return nil
+	})
+	return &m, err
+}
+
+// attributesField returns the number of the attributes field in the data
+// points of each kind of metric
+func attributesField(kind metricKind) protowire.Number {
+	switch kind {
+	case histogramKind:
+		return 9
+	case exponentialHistogramKind:
+		return 1
+	default:
+		return 7
+	}
+}
+
+func decodeDataPoint(kind metricKind, buf []byte) (dataPoint, error) {
+	p := dataPoint{attributes: map[string]string{}}
+	attributes := attributesField(kind)
+	err := forEachField(buf, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		if num == attributes && typ == protowire.BytesType {
+			return decodeKeyValue(value, p.attributes)
+		}
+		if num == 3 && typ == protowire.Fixed64Type {
+			p.time = fixed64(value)
+			return nil
+		}
+
+		switch kind {
+		case gaugeKind, sumKind:
+			switch {
+			case num == 4 && typ == protowire.Fixed64Type:
+				p.value = math.Float64frombits(fixed64(value))
+			case num == 6 && typ == protowire.Fixed64Type:
+				p.value = float64(int64(fixed64(value)))
+			}
+
+		case histogramKind:
+			switch {
+			case num == 4 && typ == protowire.Fixed64Type:
+				p.count = fixed64(value)
+			case num == 5 && typ == protowire.Fixed64Type:
+				p.sum = math.Float64frombits(fixed64(value))
+			case num == 6:
+				return appendFixed64s(&p.bucketCounts, typ, value)
+			case num == 7:
+				var bounds []uint64
+				if err := appendFixed64s(&bounds, typ, value); err != nil {
+					return err
+				}
+				for _, b := range bounds {
+					p.bounds = append(p.bounds, math.Float64frombits(b))
+				}
+			}
+
+		case exponentialHistogramKind:
+			switch {
+			case num == 4 && typ == protowire.Fixed64Type:
+				p.count = fixed64(value)
+			case num == 5 && typ == protowire.Fixed64Type:
+				p.sum = math.Float64frombits(fixed64(value))
+			case num == 6 && typ == protowire.VarintType:
+				v, _ := protowire.ConsumeVarint(value)
+				p.scale = int32(protowire.DecodeZigZag(v))
+			case num == 7 && typ == protowire.Fixed64Type:
+				p.zeroCount = fixed64(value)
+			case num == 8 && typ == protowire.BytesType:
+				return decodeExpBuckets(value, &p.positive)
+			case num == 9 && typ == protowire.BytesType:
+				return decodeExpBuckets(value, &p.negative)
+			}
+		}
+		return nil
+	})
+	return p, err
+}
+
+func decodeExpBuckets(buf []byte, b *expBuckets) error {
+	return forEachField(buf, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			v, _ := protowire.ConsumeVarint(value)
+			b.offset = int32(protowire.DecodeZigZag(v))
+		case num == 2 && typ == protowire.BytesType:
+			for len(value) > 0 {
+				v, n := protowire.ConsumeVarint(value)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b.counts = append(b.counts, v)
+				value = value[n:]
+			}
+		case num == 2 && typ == protowire.VarintType:
+			v, _ := protowire.ConsumeVarint(value)
+			b.counts = append(b.counts, v)
+		}
+		return nil
+	})
+}
+
+// decodeKeyValue decodes an attribute, its value is converted to string
+func decodeKeyValue(buf []byte, attributes map[string]string) error {
+	var key string
+	var value interface{}
+	err := forEachField(buf, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		switch num {
+		case 1:
+			key = string(v)
+		case 2:
+			var err error
+			value, err = decodeAnyValue(v)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "decoding attribute")
+	}
+	if key == "" || value == nil {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		attributes[key] = v
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return errors.Wrapf(err, "encoding value of attribute '%s'", key)
+		}
+		attributes[key] = string(encoded)
+	}
+	return nil
+}
+
+// decodeAnyValue decodes an AnyValue into a value that can be encoded as JSON
+func decodeAnyValue(buf []byte) (interface{}, error) {
+	var value interface{}
+	err := forEachField(buf, func(num protowire.Number, typ protowire.Type, v []byte) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			value = string(v)
+		case num == 2 && typ == protowire.VarintType:
+			b, _ := protowire.ConsumeVarint(v)
+			value = strconv.FormatBool(b != 0)
+		case num == 3 && typ == protowire.VarintType:
+			i, _ := protowire.ConsumeVarint(v)
+			value = strconv.FormatInt(int64(i), 10)
+		case num == 4 && typ == protowire.Fixed64Type:
+			value = strconv.FormatFloat(math.Float64frombits(fixed64(v)), 'g', -1, 64)
+		case num == 5 && typ == protowire.BytesType:
+			values := []interface{}{}
+			err := forEachField(v, func(num protowire.Number, typ protowire.Type, v []byte) error {
+				if num != 1 || typ != protowire.BytesType {
+					return nil
+				}
+				item, err := decodeAnyValue(v)
+				values = append(values, item)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			value = values
+		case num == 6 && typ == protowire.BytesType:
+			kv := map[string]string{}
+			err := forEachField(v, func(num protowire.Number, typ protowire.Type, v []byte) error {
+				if num != 1 || typ != protowire.BytesType {
+					return nil
+				}
+				return decodeKeyValue(v, kv)
+			})
+			if err != nil {
+				return err
+			}
+			value = kv
+		case num == 7 && typ == protowire.BytesType:
+			value = base64.StdEncoding.EncodeToString(v)
+		}
+		return nil
+	})
+	return value, err
+}
+
+func fixed64(value []byte) uint64 {
+	v, _ := protowire.ConsumeFixed64(value)
+	return v
+}
+
+// appendFixed64s decodes a repeated fixed64 or double field, that can be
+// packed or not.
+func appendFixed64s(values *[]uint64, typ protowire.Type, value []byte) error {
+	switch typ {
+	case protowire.Fixed64Type:
+		*values = append(*values, fixed64(value))
+	case protowire.BytesType:
+		for len(value) > 0 {
+			v, n := protowire.ConsumeFixed64(value)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			*values = append(*values, v)
+			value = value[n:]
+		}
+	}
+	return nil
+}
+
+// forEachField calls fn for each field of a serialized message. The value
+// passed for length-delimited fields is their content, and for other types
+// the raw encoded value.
+func forEachField(buf []byte, fn func(protowire.Number, protowire.Type, []byte) error) error {
+	for len(buf) > 0 {
+		num, typ, n := protowire.ConsumeTag(buf)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		buf = buf[n:]
+
+		var value []byte
+		if typ == protowire.BytesType {
+			v, n := protowire.ConsumeBytes(buf)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			value = v
+			buf = buf[n:]
+		} else {
+			n := protowire.ConsumeFieldValue(num, typ, buf)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			value = buf[:n]
+			buf = buf[n:]
+		}
+
+		if err := fn(num, typ, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
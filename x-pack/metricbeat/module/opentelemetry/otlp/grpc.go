@@ -0,0 +1,134 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	_ "google.golang.org/grpc/encoding/gzip" // register gzip compressor
+	"google.golang.org/grpc/status"
+
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// exportFunc processes a serialized export request
+type exportFunc func(ctx context.Context, request []byte) error
+
+// grpcServer receives export requests of the OTLP metrics service
+type grpcServer struct {
+	log    *logp.Logger
+	addr   string
+	server *grpc.Server
+	export exportFunc
+}
+
+func newGRPCServer(log *logp.Logger, config config, export exportFunc) (*grpcServer, error) {
+	tlsConfig, err := tlscommon.LoadTLSServerConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	// Messages are decoded by the metricset, so they are passed raw
+	options := []grpc.ServerOption{
+		grpc.CustomCodec(rawCodec{}),
+		grpc.MaxRecvMsgSize(maxRequestLength),
+	}
+	if tlsConfig != nil {
+		options = append(options, grpc.Creds(credentials.NewTLS(tlsConfig.BuildServerConfig(config.GRPC.Host))))
+	}
+
+	s := &grpcServer{
+		log:    log,
+		addr:   net.JoinHostPort(config.GRPC.Host, strconv.Itoa(config.GRPC.Port)),
+		server: grpc.NewServer(options...),
+		export: export,
+	}
+	s.server.RegisterService(&metricsServiceDesc, s)
+	return s, nil
+}
+
+// Start listens for requests in the background
+func (s *grpcServer) Start() error {
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return errors.Wrapf(err, "starting gRPC server on %s", s.addr)
+	}
+
+	s.log.Infof("Starting gRPC server on %s", s.addr)
+	go func() {
+		if err := s.server.Serve(listener); err != nil {
+			s.log.Errorf("gRPC server stopped with error: %v", err)
+		}
+	}()
+	return nil
+}
+
+// Stop stops the server, waiting for running requests to finish
+func (s *grpcServer) Stop() {
+	s.server.GracefulStop()
+}
+
+var metricsServiceDesc = grpc.ServiceDesc{
+	ServiceName: "opentelemetry.proto.collector.metrics.v1.MetricsService",
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Export",
+			Handler:    exportHandler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "opentelemetry/proto/collector/metrics/v1/metrics_service.proto",
+}
+
+func exportHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	var request rawMessage
+	if err := dec(&request); err != nil {
+		return nil, err
+	}
+	if err := srv.(*grpcServer).export(ctx, request); err != nil {
+		if ctx.Err() != nil {
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Empty ExportMetricsServiceResponse
+	return &rawMessage{}, nil
+}
+
+// rawMessage is a serialized protobuf message
+type rawMessage []byte
+
+// rawCodec passes messages without serializing or deserializing them
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	msg, ok := v.(*rawMessage)
+	if !ok {
+		return nil, fmt.Errorf("unexpected message type %T", v)
+	}
+	return *msg, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	msg, ok := v.(*rawMessage)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", v)
+	}
+	*msg = append((*msg)[:0], data...)
+	return nil
+}
+
+func (rawCodec) String() string {
+	return "proto"
+}
@@ -0,0 +1,189 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package otlp
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	serverhelper "github.com/elastic/beats/v7/metricbeat/helper/server"
+	httpserver "github.com/elastic/beats/v7/metricbeat/helper/server/http"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/mb/parse"
+)
+
+const (
+	metricsPath      = "/v1/metrics"
+	protobufType     = "application/x-protobuf"
+	maxRequestLength = 64 * 1024 * 1024
+)
+
+// init registers the MetricSet with the central registry.
+func init() {
+	mb.Registry.MustAddMetricSet("opentelemetry", "otlp", New,
+		mb.WithHostParser(parse.EmptyHostParser),
+	)
+}
+
+// MetricSet receives metrics sent with the OpenTelemetry protocol (OTLP),
+// over HTTP and gRPC.
+type MetricSet struct {
+	mb.BaseMetricSet
+	httpServer serverhelper.Server
+	grpcServer *grpcServer
+	events     chan mb.Event
+
+	// generator is shared by all requests
+	mutex     sync.Mutex
+	generator *eventsGenerator
+}
+
+// New creates a new instance of the MetricSet
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The opentelemetry otlp metricset is beta.")
+
+	config := defaultConfig()
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	m := &MetricSet{
+		BaseMetricSet: base,
+		events:        make(chan mb.Event),
+		// use a counter cache with a timeout of 5x the period, as a safe value
+		// to make sure that all counters are available between requests
+		generator: newEventsGenerator(base.Logger(), base.Module().Config().Period*5),
+	}
+
+	svc, err := httpserver.NewHttpServerWithDefaults(base, defaultHTTPConfig(), http.HandlerFunc(m.handleFunc))
+	if err != nil {
+		return nil, err
+	}
+	m.httpServer = svc
+
+	if config.GRPC.Enabled {
+		m.grpcServer, err = newGRPCServer(base.Logger(), config, m.export)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// Run starts the receivers and reports the events generated from the
+// received metrics.
+func (m *MetricSet) Run(reporter mb.PushReporterV2) {
+	m.generator.Start()
+	defer m.generator.Stop()
+
+	m.httpServer.Start()
+	defer m.httpServer.Stop()
+
+	if m.grpcServer != nil {
+		if err := m.grpcServer.Start(); err != nil {
+			reporter.Error(err)
+			return
+		}
+		defer m.grpcServer.Stop()
+	}
+
+	for {
+		select {
+		case <-reporter.Done():
+			return
+		case e := <-m.events:
+			reporter.Event(e)
+		}
+	}
+}
+
+// export decodes an export request and sends its events to be reported
+func (m *MetricSet) export(ctx context.Context, request []byte) error {
+	metrics, err := decodeExportRequest(request)
+	if err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	events := m.generator.GenerateEvents(metrics)
+	m.mutex.Unlock()
+
+	for _, e := range events {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case m.events <- e:
+		}
+	}
+	return nil
+}
+
+// handleFunc receives export requests sent through HTTP, encoded in protobuf
+func (m *MetricSet) handleFunc(writer http.ResponseWriter, req *http.Request) {
+	if req.URL.Path != metricsPath {
+		http.NotFound(writer, req)
+		return
+	}
+	if req.Method != http.MethodPost {
+		writer.Header().Set("Allow", http.MethodPost)
+		http.Error(writer, "only POST requests are accepted", http.StatusMethodNotAllowed)
+		return
+	}
+	if mediaType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type")); err != nil || mediaType != protobufType {
+		http.Error(writer, "only "+protobufType+" requests are supported", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	body, err := readBody(req)
+	if err != nil {
+		m.Logger().Errorf("Read error %v", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := m.export(req.Context(), body); err != nil {
+		m.Logger().Errorf("Export error %v", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Empty ExportMetricsServiceResponse
+	writer.Header().Set("Content-Type", protobufType)
+	writer.WriteHeader(http.StatusOK)
+}
+
+func readBody(req *http.Request) ([]byte, error) {
+	var body io.Reader = req.Body
+	switch req.Header.Get("Content-Encoding") {
+	case "":
+	case "gzip":
+		r, err := gzip.NewReader(req.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "decompressing request")
+		}
+		defer r.Close()
+		body = r
+	default:
+		return nil, errors.Errorf("unsupported content encoding '%s'", req.Header.Get("Content-Encoding"))
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(body, maxRequestLength+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxRequestLength {
+		return nil, errors.New("request too large")
+	}
+	return data, nil
+}
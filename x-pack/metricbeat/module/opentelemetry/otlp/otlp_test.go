@@ -0,0 +1,522 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+//go:build !integration
+// +build !integration
+
+package otlp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"math"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/transport/transptest"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+var testTime = uint64(time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC).UnixNano())
+
+func TestDecodeExportRequest(t *testing.T) {
+	request := exportRequest(
+		resourceMetrics(
+			[][]byte{keyValue("service.name", "checkout")},
+			gauge("memory.usage", numberPoint(testTime, 42, keyValue("state", "used"))),
+			sum("requests", true, temporalityCumulative, numberPoint(testTime, 10)),
+			histogram("latency", temporalityDelta, histogramPoint(testTime, []float64{1, 5}, []uint64{2, 3, 1})),
+			exponentialHistogram("size", temporalityDelta, expHistogramPoint(testTime, 1, 4, -1, []uint64{1, 2}, 0, []uint64{5})),
+		),
+	)
+
+	metrics, err := decodeExportRequest(request)
+	require.NoError(t, err)
+	require.Len(t, metrics, 4)
+
+	for _, m := range metrics {
+		assert.Equal(t, map[string]string{"service.name": "checkout"}, m.resource)
+		require.Len(t, m.points, 1)
+		assert.Equal(t, testTime, m.points[0].time)
+	}
+
+	assert.Equal(t, "memory.usage", metrics[0].name)
+	assert.Equal(t, gaugeKind, metrics[0].kind)
+	assert.Equal(t, float64(42), metrics[0].points[0].value)
+	assert.Equal(t, map[string]string{"state": "used"}, metrics[0].points[0].attributes)
+
+	assert.Equal(t, sumKind, metrics[1].kind)
+	assert.True(t, metrics[1].monotonic)
+	assert.Equal(t, temporalityCumulative, metrics[1].temporality)
+	assert.Equal(t, float64(10), metrics[1].points[0].value)
+
+	assert.Equal(t, histogramKind, metrics[2].kind)
+	assert.Equal(t, temporalityDelta, metrics[2].temporality)
+	assert.Equal(t, []float64{1, 5}, metrics[2].points[0].bounds)
+	assert.Equal(t, []uint64{2, 3, 1}, metrics[2].points[0].bucketCounts)
+	assert.Equal(t, uint64(6), metrics[2].points[0].count)
+
+	assert.Equal(t, exponentialHistogramKind, metrics[3].kind)
+	p := metrics[3].points[0]
+	assert.Equal(t, int32(1), p.scale)
+	assert.Equal(t, uint64(4), p.zeroCount)
+	assert.Equal(t, expBuckets{offset: -1, counts: []uint64{1, 2}}, p.positive)
+	assert.Equal(t, expBuckets{offset: 0, counts: []uint64{5}}, p.negative)
+}
+
+func TestDecodeAttributes(t *testing.T) {
+	attributes := map[string]string{}
+	anyValue := func(num protowire.Number, typ protowire.Type, value []byte) []byte {
+		b := protowire.AppendTag(nil, num, typ)
+		return append(b, value...)
+	}
+	cases := map[string][]byte{
+		"bool":   anyValue(2, protowire.VarintType, protowire.AppendVarint(nil, 1)),
+		"int":    anyValue(3, protowire.VarintType, protowire.AppendVarint(nil, uint64(7))),
+		"double": anyValue(4, protowire.Fixed64Type, protowire.AppendFixed64(nil, math.Float64bits(1.5))),
+		"array": bytesField(nil, 5,
+			bytesField(bytesField(nil, 1, stringField(nil, 1, "a")), 1, stringField(nil, 1, "b"))),
+	}
+	for key, value := range cases {
+		kv := stringField(nil, 1, key)
+		kv = bytesField(kv, 2, value)
+		require.NoError(t, decodeKeyValue(kv, attributes))
+	}
+
+	assert.Equal(t, map[string]string{
+		"bool":   "true",
+		"int":    "7",
+		"double": "1.5",
+		"array":  `["a","b"]`,
+	}, attributes)
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	_, err := decodeExportRequest([]byte{0x0a, 0xff})
+	assert.Error(t, err)
+}
+
+func TestGenerateEvents(t *testing.T) {
+	g := newEventsGenerator(logp.NewLogger("test"), time.Minute)
+	g.Start()
+	defer g.Stop()
+
+	request := func(requests, latency0, latency1 uint64) []metric {
+		metrics, err := decodeExportRequest(exportRequest(
+			resourceMetrics(
+				[][]byte{keyValue("service.name", "checkout")},
+				gauge("memory.usage", numberPoint(testTime, 42)),
+				sum("requests", true, temporalityCumulative, numberPoint(testTime, float64(requests))),
+				sum("errors", true, temporalityDelta, numberPoint(testTime, 2)),
+				sum("connections", false, temporalityCumulative, numberPoint(testTime, 5)),
+				histogram("latency", temporalityCumulative, histogramPoint(testTime, []float64{1}, []uint64{latency0, latency1})),
+				gauge("memory.usage", numberPoint(testTime, 10, keyValue("state", "free"))),
+			),
+		))
+		require.NoError(t, err)
+		return metrics
+	}
+
+	events := g.GenerateEvents(request(10, 2, 1))
+	require.Len(t, events, 2)
+
+	timestamp := time.Unix(0, int64(testTime)).UTC()
+	labels := common.MapStr{"service_name": "checkout"}
+	e := events[labels.String()+timestamp.String()]
+	assert.Equal(t, timestamp, e.Timestamp)
+	assert.Equal(t, "opentelemetry", e.Namespace)
+	assert.Equal(t, common.MapStr{"labels": labels}, e.RootFields)
+	assert.Equal(t, common.MapStr{
+		"memory_usage": common.MapStr{"value": float64(42)},
+		"requests":     common.MapStr{"counter": float64(10), "rate": float64(0)},
+		"errors":       common.MapStr{"rate": float64(2)},
+		"connections":  common.MapStr{"value": float64(5)},
+		"latency": common.MapStr{
+			"histogram": common.MapStr{
+				"values": []float64{0.5, 2},
+				"counts": []uint64{0, 0},
+			},
+		},
+	}, e.MetricSetFields)
+
+	freeLabels := common.MapStr{"service_name": "checkout", "state": "free"}
+	e = events[freeLabels.String()+timestamp.String()]
+	assert.Equal(t, common.MapStr{"labels": freeLabels}, e.RootFields)
+	assert.Equal(t, common.MapStr{
+		"memory_usage": common.MapStr{"value": float64(10)},
+	}, e.MetricSetFields)
+
+	// Cumulative metrics are rated
+	events = g.GenerateEvents(request(15, 4, 4))
+	e = events[labels.String()+timestamp.String()]
+	assert.Equal(t, common.MapStr{"counter": float64(15), "rate": float64(5)}, e.MetricSetFields["requests"])
+	assert.Equal(t, common.MapStr{
+		"histogram": common.MapStr{
+			"values": []float64{0.5, 2},
+			"counts": []uint64{2, 3},
+		},
+	}, e.MetricSetFields["latency"])
+
+	// Reset
+	events = g.GenerateEvents(request(3, 1, 1))
+	e = events[labels.String()+timestamp.String()]
+	assert.Equal(t, common.MapStr{"counter": float64(3), "rate": float64(3)}, e.MetricSetFields["requests"])
+	assert.Equal(t, common.MapStr{
+		"histogram": common.MapStr{
+			"values": []float64{0.5, 2},
+			"counts": []uint64{1, 1},
+		},
+	}, e.MetricSetFields["latency"])
+}
+
+func TestExponentialHistogramToES(t *testing.T) {
+	g := newEventsGenerator(logp.NewLogger("test"), time.Minute)
+	m := metric{kind: exponentialHistogramKind, temporality: temporalityDelta}
+
+	// Scale 0, buckets are (1, 2], (2, 4], (4, 8]...
+	p := dataPoint{
+		scale:     0,
+		zeroCount: 1,
+		positive:  expBuckets{offset: 0, counts: []uint64{2, 3}},
+		negative:  expBuckets{offset: 1, counts: []uint64{4}},
+	}
+	assert.Equal(t, common.MapStr{
+		"histogram": common.MapStr{
+			"values": []float64{-3, 0, 1.5, 3},
+			"counts": []uint64{4, 1, 2, 3},
+		},
+	}, g.exponentialHistogramToES("test", m, p))
+}
+
+func TestHTTPReceiver(t *testing.T) {
+	port := freePort(t)
+	ms := mbtest.NewPushMetricSetV2(t, map[string]interface{}{
+		"module":       "opentelemetry",
+		"metricsets":   []string{"otlp"},
+		"host":         "127.0.0.1",
+		"port":         port,
+		"grpc.enabled": false,
+	})
+
+	request := exportRequest(resourceMetrics(nil, gauge("up", numberPoint(testTime, 1))))
+
+	var body bytes.Buffer
+	w := gzip.NewWriter(&body)
+	w.Write(request)
+	w.Close()
+
+	go func() {
+		url := "http://" + net.JoinHostPort("127.0.0.1", strconv.Itoa(port)) + metricsPath
+		for i := 0; i < 100; i++ {
+			req, _ := http.NewRequest("POST", url, bytes.NewReader(body.Bytes()))
+			req.Header.Set("Content-Type", protobufType)
+			req.Header.Set("Content-Encoding", "gzip")
+			resp, err := http.DefaultClient.Do(req)
+			if err == nil {
+				resp.Body.Close()
+				assert.Equal(t, http.StatusOK, resp.StatusCode)
+				return
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+	}()
+
+	events := mbtest.RunPushMetricSetV2(10*time.Second, 1, ms)
+	require.Len(t, events, 1)
+	assertUpEvent(t, events[0])
+}
+
+func TestGRPCReceiver(t *testing.T) {
+	port := freePort(t)
+	ms := mbtest.NewPushMetricSetV2(t, map[string]interface{}{
+		"module":     "opentelemetry",
+		"metricsets": []string{"otlp"},
+		"host":       "127.0.0.1",
+		"port":       freePort(t),
+		"grpc.host":  "127.0.0.1",
+		"grpc.port":  port,
+	})
+
+	request := rawMessage(exportRequest(resourceMetrics(nil, gauge("up", numberPoint(testTime, 1)))))
+
+	go func() {
+		conn, err := grpc.Dial(net.JoinHostPort("127.0.0.1", strconv.Itoa(port)), grpc.WithInsecure())
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer conn.Close()
+
+		for i := 0; i < 100; i++ {
+			var response rawMessage
+			err = conn.Invoke(context.Background(),
+				"/opentelemetry.proto.collector.metrics.v1.MetricsService/Export",
+				&request, &response, grpc.CallCustomCodec(rawCodec{}))
+			if err == nil {
+				return
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+		t.Errorf("failed to export metrics: %v", err)
+	}()
+
+	events := mbtest.RunPushMetricSetV2(10*time.Second, 1, ms)
+	require.Len(t, events, 1)
+	assertUpEvent(t, events[0])
+}
+
+func TestGRPCReceiverClientAuthentication(t *testing.T) {
+	certFile := filepath.Join(t.TempDir(), "cert")
+	require.NoError(t, transptest.GenCertForTestingPurpose(t, certFile, "", "127.0.0.1", "localhost"))
+
+	port := freePort(t)
+	ms := mbtest.NewPushMetricSetV2(t, map[string]interface{}{
+		"module":                      "opentelemetry",
+		"metricsets":                  []string{"otlp"},
+		"host":                        "127.0.0.1",
+		"port":                        freePort(t),
+		"grpc.host":                   "127.0.0.1",
+		"grpc.port":                   port,
+		"ssl.certificate":             certFile + ".pem",
+		"ssl.key":                     certFile + ".key",
+		"ssl.certificate_authorities": []string{certFile + ".pem"},
+		"ssl.client_authentication":   "required",
+	})
+
+	caPEM, err := ioutil.ReadFile(certFile + ".pem")
+	require.NoError(t, err)
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(caPEM))
+	clientCert, err := tls.LoadX509KeyPair(certFile+".pem", certFile+".key")
+	require.NoError(t, err)
+
+	request := rawMessage(exportRequest(resourceMetrics(nil, gauge("up", numberPoint(testTime, 1)))))
+	export := func(tlsConfig *tls.Config) error {
+		conn, err := grpc.Dial(net.JoinHostPort("127.0.0.1", strconv.Itoa(port)),
+			grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		var response rawMessage
+		return conn.Invoke(context.Background(),
+			"/opentelemetry.proto.collector.metrics.v1.MetricsService/Export",
+			&request, &response, grpc.CallCustomCodec(rawCodec{}))
+	}
+
+	go func() {
+		// A client without certificate is rejected
+		var err error
+		for i := 0; i < 100; i++ {
+			err = export(&tls.Config{RootCAs: roots, ServerName: "localhost"})
+			// Retry until the server is listening
+			if err == nil || !strings.Contains(err.Error(), "connection refused") {
+				break
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+		if !assert.Error(t, err) {
+			return
+		}
+
+		err = export(&tls.Config{RootCAs: roots, ServerName: "localhost", Certificates: []tls.Certificate{clientCert}})
+		assert.NoError(t, err)
+	}()
+
+	events := mbtest.RunPushMetricSetV2(10*time.Second, 1, ms)
+	require.Len(t, events, 1)
+	assertUpEvent(t, events[0])
+}
+
+func TestHTTPReceiverUnsupportedContentType(t *testing.T) {
+	m := &MetricSet{}
+	req, _ := http.NewRequest("POST", metricsPath, bytes.NewReader([]byte("{}")))
+	req.Header.Set("Content-Type", "application/json")
+	w := &responseRecorder{header: http.Header{}}
+	m.handleFunc(w, req)
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.status)
+}
+
+func assertUpEvent(t *testing.T, e mb.Event) {
+	t.Helper()
+	assert.Equal(t, "opentelemetry", e.Namespace)
+	assert.Equal(t, common.MapStr{"up": common.MapStr{"value": float64(1)}}, e.MetricSetFields)
+}
+
+type responseRecorder struct {
+	header http.Header
+	status int
+}
+
+func (r *responseRecorder) Header() http.Header         { return r.header }
+func (r *responseRecorder) Write(b []byte) (int, error) { return len(b), nil }
+func (r *responseRecorder) WriteHeader(status int)      { r.status = status }
+
+func freePort(t *testing.T) int {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+// Helpers to encode OTLP messages
+
+func bytesField(b []byte, num protowire.Number, value []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, value)
+}
+
+func stringField(b []byte, num protowire.Number, value string) []byte {
+	return bytesField(b, num, []byte(value))
+}
+
+func fixed64Field(b []byte, num protowire.Number, value uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.Fixed64Type)
+	return protowire.AppendFixed64(b, value)
+}
+
+func varintField(b []byte, num protowire.Number, value uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, value)
+}
+
+func keyValue(key, value string) []byte {
+	b := stringField(nil, 1, key)
+	return bytesField(b, 2, stringField(nil, 1, value))
+}
+
+func exportRequest(resources ...[]byte) []byte {
+	var b []byte
+	for _, r := range resources {
+		b = bytesField(b, 1, r)
+	}
+	return b
+}
+
+func resourceMetrics(attributes [][]byte, metrics ...[]byte) []byte {
+	var scope []byte
+	for _, m := range metrics {
+		scope = bytesField(scope, 2, m)
+	}
+	var resource []byte
+	for _, a := range attributes {
+		resource = bytesField(resource, 1, a)
+	}
+
+	// Resource is sent after the metrics to check that it is applied to them
+	b := bytesField(nil, 2, scope)
+	return bytesField(b, 1, resource)
+}
+
+func metricMessage(name string, num protowire.Number, data []byte) []byte {
+	b := stringField(nil, 1, name)
+	return bytesField(b, num, data)
+}
+
+func gauge(name string, points ...[]byte) []byte {
+	var data []byte
+	for _, p := range points {
+		data = bytesField(data, 1, p)
+	}
+	return metricMessage(name, 5, data)
+}
+
+func sum(name string, monotonic bool, t temporality, points ...[]byte) []byte {
+	var data []byte
+	for _, p := range points {
+		data = bytesField(data, 1, p)
+	}
+	data = varintField(data, 2, uint64(t))
+	if monotonic {
+		data = varintField(data, 3, 1)
+	}
+	return metricMessage(name, 7, data)
+}
+
+func histogram(name string, t temporality, points ...[]byte) []byte {
+	var data []byte
+	for _, p := range points {
+		data = bytesField(data, 1, p)
+	}
+	data = varintField(data, 2, uint64(t))
+	return metricMessage(name, 9, data)
+}
+
+func exponentialHistogram(name string, t temporality, points ...[]byte) []byte {
+	var data []byte
+	for _, p := range points {
+		data = bytesField(data, 1, p)
+	}
+	data = varintField(data, 2, uint64(t))
+	return metricMessage(name, 10, data)
+}
+
+func numberPoint(time uint64, value float64, attributes ...[]byte) []byte {
+	var b []byte
+	for _, a := range attributes {
+		b = bytesField(b, 7, a)
+	}
+	b = fixed64Field(b, 3, time)
+	if value == math.Trunc(value) {
+		return fixed64Field(b, 6, uint64(int64(value)))
+	}
+	return fixed64Field(b, 4, math.Float64bits(value))
+}
+
+func histogramPoint(time uint64, bounds []float64, counts []uint64, attributes ...[]byte) []byte {
+	var b []byte
+	for _, a := range attributes {
+		b = bytesField(b, 9, a)
+	}
+	b = fixed64Field(b, 3, time)
+
+	var total uint64
+	var packedCounts, packedBounds []byte
+	for _, c := range counts {
+		total += c
+		packedCounts = protowire.AppendFixed64(packedCounts, c)
+	}
+	for _, bound := range bounds {
+		packedBounds = protowire.AppendFixed64(packedBounds, math.Float64bits(bound))
+	}
+	b = fixed64Field(b, 4, total)
+	b = bytesField(b, 6, packedCounts)
+	return bytesField(b, 7, packedBounds)
+}
+
+func expHistogramPoint(time uint64, scale int32, zeroCount uint64, positiveOffset int32, positive []uint64, negativeOffset int32, negative []uint64) []byte {
+	buckets := func(offset int32, counts []uint64) []byte {
+		b := varintField(nil, 1, protowire.EncodeZigZag(int64(offset)))
+		var packed []byte
+		for _, c := range counts {
+			packed = protowire.AppendVarint(packed, c)
+		}
+		return bytesField(b, 2, packed)
+	}
+
+	b := fixed64Field(nil, 3, time)
+	b = varintField(b, 6, protowire.EncodeZigZag(int64(scale)))
+	b = fixed64Field(b, 7, zeroCount)
+	b = bytesField(b, 8, buckets(positiveOffset, positive))
+	return bytesField(b, 9, buckets(negativeOffset, negative))
+}
@@ -8,7 +8,13 @@ package collector
 
 import (
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/libbeat/common"
 	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
 
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/prometheus"
@@ -20,3 +26,59 @@ import (
 func TestData(t *testing.T) {
 	mbtest.TestDataFiles(t, "prometheus", "collector")
 }
+
+func TestGeneratePromEventsSummaryHistograms(t *testing.T) {
+	family := &dto.MetricFamily{
+		Name: proto.String("go_gc_duration_seconds"),
+		Type: dto.MetricType_SUMMARY.Enum(),
+		Metric: []*dto.Metric{
+			{
+				Summary: &dto.Summary{
+					SampleCount: proto.Uint64(10),
+					SampleSum:   proto.Float64(1.5),
+					Quantile: []*dto.Quantile{
+						{Quantile: proto.Float64(0.5), Value: proto.Float64(0.1)},
+						{Quantile: proto.Float64(0.99), Value: proto.Float64(0.4)},
+					},
+				},
+			},
+		},
+	}
+
+	for title, c := range map[string]struct {
+		summaryHistograms bool
+		expected          common.MapStr
+	}{
+		"disabled": {
+			expected: common.MapStr{
+				"go_gc_duration_seconds_sum":   common.MapStr{"counter": 1.5},
+				"go_gc_duration_seconds_count": common.MapStr{"counter": uint64(10)},
+			},
+		},
+		"enabled": {
+			summaryHistograms: true,
+			expected: common.MapStr{
+				"go_gc_duration_seconds_sum":   common.MapStr{"counter": 1.5},
+				"go_gc_duration_seconds_count": common.MapStr{"counter": uint64(10)},
+				"go_gc_duration_seconds": common.MapStr{
+					"histogram": common.MapStr{
+						"values": []float64{0.1, 0.4},
+						"counts": []uint64{0, 0},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(title, func(t *testing.T) {
+			g := typedGenerator{
+				counterCache:      NewCounterCache(1 * time.Second),
+				summaryHistograms: c.summaryHistograms,
+			}
+
+			events := g.GeneratePromEvents(family)
+			if assert.Len(t, events, 3) {
+				assert.Equal(t, c.expected, events[0].Data)
+			}
+		})
+	}
+}
@@ -9,6 +9,9 @@ import "errors"
 type config struct {
 	UseTypes     bool `config:"use_types"`
 	RateCounters bool `config:"rate_counters"`
+	// SummaryHistograms also stores summaries as histograms approximated from
+	// their quantiles
+	SummaryHistograms bool `config:"summary_histograms"`
 }
 
 func (c *config) Validate() error {
@@ -16,5 +19,9 @@ func (c *config) Validate() error {
 		return errors.New("'rate_counters' can only be enabled when `use_types` is also enabled")
 	}
 
+	if c.SummaryHistograms && !c.UseTypes {
+		return errors.New("'summary_histograms' can only be enabled when `use_types` is also enabled")
+	}
+
 	return nil
 }
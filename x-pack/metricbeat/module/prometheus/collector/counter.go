@@ -23,12 +23,14 @@ type CounterCache interface {
 
 	// RateUint64 returns, for a given counter name, the difference between the given value
 	// and the value that was given in a previous call, and true if a previous value existed.
-	// It will return 0 and false on the first call.
+	// It will return 0 and false on the first call. If the counter was reset since
+	// the previous call, the given value is returned as rate.
 	RateUint64(counterName string, value uint64) (uint64, bool)
 
 	// RateFloat64 returns, for a given counter name, the difference between the given value
 	// and the value that was given in a previous call, and true if a previous value existed.
-	// It will return 0 and false on the first call.
+	// It will return 0 and false on the first call. If the counter was reset since
+	// the previous call, the given value is returned as rate.
 	RateFloat64(counterName string, value float64) (float64, bool)
 }
 
@@ -50,13 +52,15 @@ func NewCounterCache(timeout time.Duration) CounterCache {
 
 // RateUint64 returns, for a given counter name, the difference between the given value
 // and the value that was given in a previous call, and true if a previous value existed.
-// It will return 0 and false on the first call.
+// It will return 0 and false on the first call. If the counter was reset since
+// the previous call, the given value is returned as rate.
 func (c *counterCache) RateUint64(counterName string, value uint64) (uint64, bool) {
 	prev := c.ints.PutWithTimeout(counterName, value, c.timeout)
 	if prev != nil {
 		if prev.(uint64) > value {
-			// counter reset
-			return 0, true
+			// counter reset, everything counted since the reset happened
+			// in this period
+			return value, true
 		}
 		return value - prev.(uint64), true
 	}
@@ -67,13 +71,15 @@ func (c *counterCache) RateUint64(counterName string, value uint64) (uint64, boo
 
 // RateFloat64 returns, for a given counter name, the difference between the given value
 // and the value that was given in a previous call, and true if a previous value existed.
-// It will return 0 and false on the first call.
+// It will return 0 and false on the first call. If the counter was reset since
+// the previous call, the given value is returned as rate.
 func (c *counterCache) RateFloat64(counterName string, value float64) (float64, bool) {
 	prev := c.floats.PutWithTimeout(counterName, value, c.timeout)
 	if prev != nil {
 		if prev.(float64) > value {
-			// counter reset
-			return 0, true
+			// counter reset, everything counted since the reset happened
+			// in this period
+			return value, true
 		}
 		return value - prev.(float64), true
 	}
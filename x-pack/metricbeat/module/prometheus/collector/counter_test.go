@@ -41,9 +41,9 @@ func Test_CounterCache(t *testing.T) {
 			counterCache:    NewCounterCache(1 * time.Second),
 			counterName:     "test_counter",
 			valuesUint64:    []uint64{10, 14, 17, 1, 3},
-			expectedUin64:   []uint64{0, 4, 3, 0, 2},
+			expectedUin64:   []uint64{0, 4, 3, 1, 2},
 			valuesFloat64:   []float64{1.0, 101.0, 2.0, 13.0},
-			expectedFloat64: []float64{0.0, 100.0, 0.0, 11.0},
+			expectedFloat64: []float64{0.0, 100.0, 2.0, 11.0},
 		},
 	}
 	for _, tt := range tests {
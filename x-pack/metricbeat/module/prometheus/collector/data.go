@@ -29,8 +29,9 @@ func promEventsGeneratorFactory(base mb.BaseMetricSet) (collector.PromEventsGene
 		counters := NewCounterCache(base.Module().Config().Period * 5)
 
 		g := typedGenerator{
-			counterCache: counters,
-			rateCounters: config.RateCounters,
+			counterCache:      counters,
+			rateCounters:      config.RateCounters,
+			summaryHistograms: config.SummaryHistograms,
 		}
 
 		return &g, nil
@@ -40,8 +41,9 @@ func promEventsGeneratorFactory(base mb.BaseMetricSet) (collector.PromEventsGene
 }
 
 type typedGenerator struct {
-	counterCache CounterCache
-	rateCounters bool
+	counterCache      CounterCache
+	rateCounters      bool
+	summaryHistograms bool
 }
 
 func (g *typedGenerator) Start() {
@@ -51,6 +53,10 @@ func (g *typedGenerator) Start() {
 		cfgwarn.Experimental("Prometheus 'rate_counters' setting is experimental")
 	}
 
+	if g.summaryHistograms {
+		cfgwarn.Experimental("Prometheus 'summary_histograms' setting is experimental")
+	}
+
 	g.counterCache.Start()
 }
 
@@ -106,11 +112,19 @@ func (g *typedGenerator) GeneratePromEvents(mf *dto.MetricFamily) []collector.Pr
 		summary := metric.GetSummary()
 		if summary != nil {
 			if !math.IsNaN(summary.GetSampleSum()) && !math.IsInf(summary.GetSampleSum(), 0) {
+				data := common.MapStr{
+					name + "_sum":   g.rateCounterFloat64(name, labels, summary.GetSampleSum()),
+					name + "_count": g.rateCounterUint64(name, labels, summary.GetSampleCount()),
+				}
+				if g.summaryHistograms {
+					if histogram := PromSummaryToES(g.counterCache, name, labels, summary); histogram != nil {
+						data[name] = common.MapStr{
+							"histogram": histogram,
+						}
+					}
+				}
 				events = append(events, collector.PromEvent{
-					Data: common.MapStr{
-						name + "_sum":   g.rateCounterFloat64(name, labels, summary.GetSampleSum()),
-						name + "_count": g.rateCounterUint64(name, labels, summary.GetSampleCount()),
-					},
+					Data:   data,
 					Labels: labels,
 				})
 			}
@@ -7,6 +7,7 @@ package collector
 import (
 	"fmt"
 	"math"
+	"sort"
 
 	"github.com/elastic/beats/v7/libbeat/common"
 
@@ -25,21 +26,30 @@ import (
 // This code takes a Prometheus histogram and tries to accomodate it into an ES histogram by:
 //  - calculating centroids for each bucket (values)
 //  - undoing counters accumulation for each bucket (counts)
+//  - rating the count of each bucket since the previous call, taking into account
+//    that the histogram can be reset
 //
 // https://www.elastic.co/guide/en/elasticsearch/reference/master/histogram.html
 func PromHistogramToES(cc CounterCache, name string, labels common.MapStr, histogram *dto.Histogram) common.MapStr {
-	var values []float64
-	var counts []uint64
-
-	// calculate centroids and rated counts
-	var lastUpper, prevUpper float64
-	var sumCount, prevCount uint64
+	// buckets can come in any order when received through remote write
+	buckets := make([]*dto.Bucket, 0, len(histogram.GetBucket()))
 	for _, bucket := range histogram.GetBucket() {
 		// Ignore non-numbers
 		if bucket.GetCumulativeCount() == uint64(math.NaN()) || bucket.GetCumulativeCount() == uint64(math.Inf(0)) {
 			continue
 		}
+		buckets = append(buckets, bucket)
+	}
+	sort.SliceStable(buckets, func(i, j int) bool {
+		return buckets[i].GetUpperBound() < buckets[j].GetUpperBound()
+	})
 
+	// calculate centroids and deaccumulate counts
+	var lastUpper, prevUpper float64
+	var prevCount uint64
+	values := make([]float64, 0, len(buckets))
+	counts := make([]uint64, 0, len(buckets))
+	for _, bucket := range buckets {
 		if bucket.GetUpperBound() == math.Inf(0) {
 			// Report +Inf bucket as a point, interpolating its value
 			values = append(values, lastUpper+(lastUpper-prevUpper))
@@ -50,25 +60,29 @@ func PromHistogramToES(cc CounterCache, name string, labels common.MapStr, histo
 			lastUpper = bucket.GetUpperBound()
 		}
 
-		// Take count for this period (rate)
-		countRate, found := cc.RateUint64(name+labels.String()+fmt.Sprintf("%f", bucket.GetUpperBound()), bucket.GetCumulativeCount())
-
-		switch {
-		case !found:
-			// This is a new bucket, consider it zero by now, but still increase the
-			// sum to don't deviate following buckets that are not new.
-			counts = append(counts, 0)
-			sumCount += bucket.GetCumulativeCount() - prevCount
-		case countRate < sumCount:
-			// This should never happen, this means something is wrong in the
-			// prometheus response. Handle it to avoid overflowing when deaccumulating.
-			counts = append(counts, 0)
-		default:
-			// Store the deaccumulated count.
-			counts = append(counts, countRate-sumCount)
-			sumCount = countRate
+		// A cumulative count lower than the previous one should never happen,
+		// this means something is wrong in the prometheus response. Handle it
+		// to avoid overflowing when deaccumulating.
+		var count uint64
+		if bucket.GetCumulativeCount() > prevCount {
+			count = bucket.GetCumulativeCount() - prevCount
+			prevCount = bucket.GetCumulativeCount()
+		}
+		counts = append(counts, count)
+	}
+
+	// The histogram was reset if the total count is lower than in the previous
+	// call, all the observations since then happened in this period.
+	totalRate, found := cc.RateUint64(name+labels.String()+"_histogram", prevCount)
+	reset := found && totalRate == prevCount
+
+	// Take count for each bucket for this period (rate), new buckets are
+	// considered zero by now
+	for i, bucket := range buckets {
+		countRate, _ := cc.RateUint64(name+labels.String()+fmt.Sprintf("%f", bucket.GetUpperBound()), counts[i])
+		if !reset {
+			counts[i] = countRate
 		}
-		prevCount = bucket.GetCumulativeCount()
 	}
 
 	res := common.MapStr{
@@ -78,3 +92,57 @@ func PromHistogramToES(cc CounterCache, name string, labels common.MapStr, histo
 
 	return res
 }
+
+// PromSummaryToES takes a Prometheus summary and approximates it with an ES histogram.
+//
+// Summaries don't expose how observations are distributed, only some of their
+// quantiles, so the result is an approximation. Quantiles are used as values, and
+// the number of observations since the previous call is distributed between them
+// according to their ranks, so the observations between the quantiles 0.5 and 0.9
+// are counted in the value of the 0.9 quantile. Observations above the last
+// quantile are counted in it too, as if its rank was 1, whatever their actual
+// values. It returns nil if the summary has no valid quantiles.
+func PromSummaryToES(cc CounterCache, name string, labels common.MapStr, summary *dto.Summary) common.MapStr {
+	quantiles := make([]*dto.Quantile, 0, len(summary.GetQuantile()))
+	for _, quantile := range summary.GetQuantile() {
+		if math.IsNaN(quantile.GetValue()) || math.IsInf(quantile.GetValue(), 0) {
+			continue
+		}
+		quantiles = append(quantiles, quantile)
+	}
+	if len(quantiles) == 0 {
+		return nil
+	}
+	sort.SliceStable(quantiles, func(i, j int) bool {
+		return quantiles[i].GetQuantile() < quantiles[j].GetQuantile()
+	})
+
+	countRate, _ := cc.RateUint64(name+labels.String()+"_summary", summary.GetSampleCount())
+
+	values := make([]float64, 0, len(quantiles))
+	counts := make([]uint64, 0, len(quantiles))
+	var prevCount uint64
+	for i, quantile := range quantiles {
+		rank := quantile.GetQuantile()
+		if i == len(quantiles)-1 {
+			rank = 1
+		}
+
+		// Round the accumulated count so the counts add up to the rate
+		count := uint64(math.Round(float64(countRate) * rank))
+
+		// ES histograms require values in increasing order
+		if len(values) > 0 && quantile.GetValue() <= values[len(values)-1] {
+			counts[len(counts)-1] += count - prevCount
+		} else {
+			values = append(values, quantile.GetValue())
+			counts = append(counts, count-prevCount)
+		}
+		prevCount = count
+	}
+
+	return common.MapStr{
+		"values": values,
+		"counts": counts,
+	}
+}
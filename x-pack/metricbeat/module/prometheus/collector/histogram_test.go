@@ -8,6 +8,7 @@
 package collector
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -388,6 +389,122 @@ func TestPromHistogramToES(t *testing.T) {
 				},
 			},
 		},
+		"counter reset": {
+			samples: []sample{
+				{
+					histogram: dto.Histogram{
+						SampleCount: proto.Uint64(12),
+						SampleSum:   proto.Float64(10),
+						Bucket: []*dto.Bucket{
+							{
+								UpperBound:      proto.Float64(0.09),
+								CumulativeCount: proto.Uint64(10),
+							},
+							{
+								UpperBound:      proto.Float64(0.99),
+								CumulativeCount: proto.Uint64(12),
+							},
+						},
+					},
+					expected: common.MapStr{
+						"counts": []uint64{0, 0},
+						"values": []float64{0.045, 0.54},
+					},
+				},
+				{
+					histogram: dto.Histogram{
+						SampleCount: proto.Uint64(4),
+						SampleSum:   proto.Float64(1.2),
+						Bucket: []*dto.Bucket{
+							{
+								UpperBound:      proto.Float64(0.09),
+								CumulativeCount: proto.Uint64(1),
+							},
+							{
+								UpperBound:      proto.Float64(0.99),
+								CumulativeCount: proto.Uint64(4),
+							},
+						},
+					},
+					expected: common.MapStr{
+						"counts": []uint64{1, 3},
+						"values": []float64{0.045, 0.54},
+					},
+				},
+				{
+					histogram: dto.Histogram{
+						SampleCount: proto.Uint64(7),
+						SampleSum:   proto.Float64(2.5),
+						Bucket: []*dto.Bucket{
+							{
+								UpperBound:      proto.Float64(0.09),
+								CumulativeCount: proto.Uint64(2),
+							},
+							{
+								UpperBound:      proto.Float64(0.99),
+								CumulativeCount: proto.Uint64(7),
+							},
+						},
+					},
+					expected: common.MapStr{
+						"counts": []uint64{1, 2},
+						"values": []float64{0.045, 0.54},
+					},
+				},
+			},
+		},
+		"unsorted buckets": {
+			samples: []sample{
+				{
+					histogram: dto.Histogram{
+						SampleCount: proto.Uint64(12),
+						SampleSum:   proto.Float64(10),
+						Bucket: []*dto.Bucket{
+							{
+								UpperBound:      proto.Float64(math.Inf(1)),
+								CumulativeCount: proto.Uint64(12),
+							},
+							{
+								UpperBound:      proto.Float64(10),
+								CumulativeCount: proto.Uint64(10),
+							},
+							{
+								UpperBound:      proto.Float64(1),
+								CumulativeCount: proto.Uint64(4),
+							},
+						},
+					},
+					expected: common.MapStr{
+						"counts": []uint64{0, 0, 0},
+						"values": []float64{0.5, 5.5, 19},
+					},
+				},
+				{
+					histogram: dto.Histogram{
+						SampleCount: proto.Uint64(15),
+						SampleSum:   proto.Float64(12),
+						Bucket: []*dto.Bucket{
+							{
+								UpperBound:      proto.Float64(10),
+								CumulativeCount: proto.Uint64(12),
+							},
+							{
+								UpperBound:      proto.Float64(math.Inf(1)),
+								CumulativeCount: proto.Uint64(15),
+							},
+							{
+								UpperBound:      proto.Float64(1),
+								CumulativeCount: proto.Uint64(5),
+							},
+						},
+					},
+					expected: common.MapStr{
+						"counts": []uint64{1, 1, 1},
+						"values": []float64{0.5, 5.5, 19},
+					},
+				},
+			},
+		},
 	}
 
 	metricName := "somemetric"
@@ -405,3 +522,40 @@ func TestPromHistogramToES(t *testing.T) {
 		})
 	}
 }
+
+// TestPromSummaryToES tests that calling PromSummaryToES multiple
+// times with the same cache produces each time the expected results.
+func TestPromSummaryToES(t *testing.T) {
+	summary := func(count uint64, quantiles ...float64) *dto.Summary {
+		s := &dto.Summary{SampleCount: proto.Uint64(count)}
+		for i := 0; i < len(quantiles); i += 2 {
+			s.Quantile = append(s.Quantile, &dto.Quantile{
+				Quantile: proto.Float64(quantiles[i]),
+				Value:    proto.Float64(quantiles[i+1]),
+			})
+		}
+		return s
+	}
+
+	cache := NewCounterCache(120 * time.Minute)
+	labels := common.MapStr{}
+
+	assert.EqualValues(t, common.MapStr{
+		"counts": []uint64{0, 0, 0},
+		"values": []float64{0.1, 0.4, 2},
+	}, PromSummaryToES(cache, "somemetric", labels, summary(100, 0.5, 0.1, 0.9, 0.4, 0.99, 2)))
+
+	assert.EqualValues(t, common.MapStr{
+		"counts": []uint64{50, 40, 10},
+		"values": []float64{0.1, 0.4, 2},
+	}, PromSummaryToES(cache, "somemetric", labels, summary(200, 0.99, 2, 0.5, 0.1, 0.9, 0.4)))
+
+	// counter reset, quantiles with the same value are merged
+	assert.EqualValues(t, common.MapStr{
+		"counts": []uint64{18, 2},
+		"values": []float64{0.1, 0.3},
+	}, PromSummaryToES(cache, "somemetric", labels, summary(20, 0.5, 0.1, 0.9, 0.1, 0.99, 0.3)))
+
+	// no valid quantiles
+	assert.Nil(t, PromSummaryToES(cache, "somemetric", labels, summary(20, 0.5, math.NaN())))
+}
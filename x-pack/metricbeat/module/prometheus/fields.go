@@ -19,5 +19,5 @@ func init() {
 // AssetPrometheus returns asset data.
 // This is the base64 encoded gzipped contents of module/prometheus.
 func AssetPrometheus() string {
-	return "eJzEkktqAzEMhvdzih8vQ5MDeNEzFLosJSi24rjxC0tTmtuXZIYw9EGzKMQ7+/+QPgmvceSTRes1sx54lPVHI3ccAI2a2MI8XSPoqbFHZu3RiRkAz+J6bBprsXgcAOBZSQXiOp3Zfa8ZhEUNLr7VWHQzAJ0Tk7BFoAEQVo0liMWLEUnmAeag2szrAOwjJy/20mGNQpmXzpvV5p3SyJcYF02Luntjp/PTdNlOia/jLvH3ZJuptVjCjJmVmZkfxjyfxVSBxsDzZn6XdHUsyv1+mrPAn6Kd9I7LPHf3N7seomgNnfKNwl/5/3G+Vl36Ts7LX/4ZAAD//xMCFRw="
+	return "eJzElMFq8zAQhO9+ikHH8DsP4MN/6qW3QksvpYSNtLFV25KQ1m3y9kWJG9wmJS4tBHSRZ9j5xiwq0fKuQoi+Z2l4SOU2kG4LQKx0XEHdHSXILrBBzxKtTqoADCcdbRDrXYX/BQDcC0lC0pGydxN9D8JkBjsTvHWyLIDIHVPiCjUVQGIR6+pU4Uml1Kl/UI1IUM8FsLHcmVTtE0o46nnKvFwsX6kbeC9jj1nBr19Yy/jpcFkdFOOHdcenyqqnEKyrR5taqNFzpmY+k1Y1DTWPf+Z7SO0HJxyvhzkCXASNJHw9ypxuZrM2NomvI/Uzgb/6/4b5OPUiL2+5Dx3FdJWdfcyZ8BtIw/hAyXc6tyc+ToqtB92yzCkmkTSvrJnZreXdm4/mVPppuYeci9ubX/fLp/z0PL0PAEEso9c="
 }
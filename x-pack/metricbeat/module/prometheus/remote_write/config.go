@@ -10,6 +10,9 @@ type config struct {
 	UseTypes      bool          `config:"use_types"`
 	RateCounters  bool          `config:"rate_counters"`
 	TypesPatterns TypesPatterns `config:"types_patterns" yaml:"types_patterns,omitempty"`
+	// SummaryHistograms also stores summaries as histograms approximated from
+	// their quantiles
+	SummaryHistograms bool `config:"summary_histograms"`
 }
 
 type TypesPatterns struct {
@@ -28,5 +31,9 @@ func (c *config) Validate() error {
 		return errors.New("'rate_counters' can only be enabled when `use_types` is also enabled")
 	}
 
+	if c.SummaryHistograms && !c.UseTypes {
+		return errors.New("'summary_histograms' can only be enabled when `use_types` is also enabled")
+	}
+
 	return nil
 }
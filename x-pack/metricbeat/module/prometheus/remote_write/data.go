@@ -12,7 +12,6 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
-
 	dto "github.com/prometheus/client_model/go"
 	"github.com/prometheus/common/model"
 
@@ -36,6 +35,14 @@ type histogram struct {
 	buckets    []*dto.Bucket
 	labels     common.MapStr
 	metricName string
+	exemplars  []common.MapStr
+}
+
+type summary struct {
+	timestamp  time.Time
+	quantiles  []*dto.Quantile
+	labels     common.MapStr
+	metricName string
 }
 
 func remoteWriteEventsGeneratorFactory(base mb.BaseMetricSet) (remote_write.RemoteWriteEventsGenerator, error) {
@@ -51,8 +58,9 @@ func remoteWriteEventsGeneratorFactory(base mb.BaseMetricSet) (remote_write.Remo
 		counters := collector.NewCounterCache(base.Module().Config().Period * 5)
 
 		g := remoteWriteTypedGenerator{
-			counterCache: counters,
-			rateCounters: config.RateCounters,
+			counterCache:      counters,
+			rateCounters:      config.RateCounters,
+			summaryHistograms: config.SummaryHistograms,
 		}
 
 		g.counterPatterns, err = p.CompilePatternList(config.TypesPatterns.CounterPatterns)
@@ -73,6 +81,7 @@ func remoteWriteEventsGeneratorFactory(base mb.BaseMetricSet) (remote_write.Remo
 type remoteWriteTypedGenerator struct {
 	counterCache      collector.CounterCache
 	rateCounters      bool
+	summaryHistograms bool
 	counterPatterns   []*regexp.Regexp
 	histogramPatterns []*regexp.Regexp
 }
@@ -84,6 +93,10 @@ func (g *remoteWriteTypedGenerator) Start() {
 		cfgwarn.Experimental("Prometheus 'rate_counters' setting is experimental")
 	}
 
+	if g.summaryHistograms {
+		cfgwarn.Experimental("Prometheus 'summary_histograms' setting is experimental")
+	}
+
 	g.counterCache.Start()
 }
 
@@ -98,8 +111,18 @@ func (g *remoteWriteTypedGenerator) Stop() {
 // 3. if metrics of histogram type then it is converted to ES histogram
 // 4. metrics with the same set of labels are grouped into same events
 func (g remoteWriteTypedGenerator) GenerateEvents(metrics model.Samples) map[string]mb.Event {
+	return g.GenerateEventsWithExemplars(metrics, nil)
+}
+
+// GenerateEventsWithExemplars generates events like GenerateEvents, adding the
+// exemplars of counters and histogram buckets to the metrics they belong to.
+// If summary_histograms is enabled, summaries, whose quantiles and count are
+// received as different series, are also converted to ES histograms.
+func (g remoteWriteTypedGenerator) GenerateEventsWithExemplars(metrics model.Samples, exemplars remote_write.Exemplars) map[string]mb.Event {
 	var data common.MapStr
 	histograms := map[string]histogram{}
+	summaries := map[string]summary{}
+	summaryCounts := map[string]uint64{}
 	eventList := map[string]mb.Event{}
 
 	for _, metric := range metrics {
@@ -113,11 +136,23 @@ func (g remoteWriteTypedGenerator) GenerateEvents(metrics model.Samples) map[str
 			continue
 		}
 
-		name := string(metric.Metric["__name__"])
-		delete(metric.Metric, "__name__")
+		// Exemplars are only added once, in case that there are multiple
+		// samples of the same series
+		var seriesExemplars []common.MapStr
+		if len(exemplars) > 0 {
+			fingerprint := metric.Metric.Fingerprint()
+			for _, e := range exemplars[fingerprint] {
+				seriesExemplars = append(seriesExemplars, exemplarToES(e))
+			}
+			delete(exemplars, fingerprint)
+		}
 
+		// samples of the same series share the metric, so it is not modified
+		name := string(metric.Metric["__name__"])
 		for k, v := range metric.Metric {
-			labels[string(k)] = v
+			if k != "__name__" {
+				labels[string(k)] = v
+			}
 		}
 
 		promType := g.findMetricType(name, labels)
@@ -148,8 +183,15 @@ func (g remoteWriteTypedGenerator) GenerateEvents(metrics model.Samples) map[str
 		e := eventList[labelsHash]
 		switch promType {
 		case counterType:
+			counter := g.rateCounterFloat64(name, labels, val)
+			if len(seriesExemplars) > 0 {
+				counter["exemplars"] = seriesExemplars
+			}
 			data = common.MapStr{
-				name: g.rateCounterFloat64(name, labels, val),
+				name: counter,
+			}
+			if strings.HasSuffix(name, "_count") {
+				summaryCounts[strings.TrimSuffix(name, "_count")+labels.String()+metric.Timestamp.Time().String()] = uint64(val)
 			}
 		case otherType:
 			data = common.MapStr{
@@ -157,6 +199,23 @@ func (g remoteWriteTypedGenerator) GenerateEvents(metrics model.Samples) map[str
 					"value": val,
 				},
 			}
+			if q, ok := labels["quantile"]; ok && g.summaryHistograms {
+				quantile, err := strconv.ParseFloat(string(q.(model.LabelValue)), 64)
+				if err == nil {
+					summaryLabels := labels.Clone()
+					summaryLabels.Delete("quantile")
+					key := name + summaryLabels.String() + metric.Timestamp.Time().String()
+					sum := summaries[key]
+					sum.quantiles = append(sum.quantiles, &dto.Quantile{
+						Quantile: &quantile,
+						Value:    &val,
+					})
+					sum.timestamp = metric.Timestamp.Time()
+					sum.labels = summaryLabels
+					sum.metricName = name
+					summaries[key] = sum
+				}
+			}
 		case histogramType:
 			histKey := name + labelsClone.String()
 
@@ -177,6 +236,7 @@ func (g remoteWriteTypedGenerator) GenerateEvents(metrics model.Samples) map[str
 				hist = histogram{}
 			}
 			hist.buckets = append(hist.buckets, b)
+			hist.exemplars = append(hist.exemplars, seriesExemplars...)
 			hist.timestamp = metric.Timestamp.Time()
 			hist.labels = labelsClone
 			hist.metricName = name
@@ -189,6 +249,7 @@ func (g remoteWriteTypedGenerator) GenerateEvents(metrics model.Samples) map[str
 
 	// process histograms together
 	g.processPromHistograms(eventList, histograms)
+	g.processPromSummaries(eventList, summaries, summaryCounts)
 	return eventList
 }
 
@@ -240,12 +301,78 @@ func (g *remoteWriteTypedGenerator) processPromHistograms(eventList map[string]m
 		}
 		name := strings.TrimSuffix(histogram.metricName, "_bucket")
 		data := common.MapStr{
-			name: common.MapStr{
-				"histogram": collector.PromHistogramToES(g.counterCache, histogram.metricName, histogram.labels, &hist),
+			"histogram": collector.PromHistogramToES(g.counterCache, histogram.metricName, histogram.labels, &hist),
+		}
+		if len(histogram.exemplars) > 0 {
+			data["exemplars"] = histogram.exemplars
+		}
+		e.ModuleFields.Update(common.MapStr{
+			name: data,
+		})
+	}
+}
+
+// processPromSummaries receives a group of Summaries and converts each one to ES histogram,
+// summaries whose count was not received are ignored
+func (g *remoteWriteTypedGenerator) processPromSummaries(eventList map[string]mb.Event, summaries map[string]summary, counts map[string]uint64) {
+	for _, summary := range summaries {
+		count, found := counts[summary.metricName+summary.labels.String()+summary.timestamp.String()]
+		if !found {
+			continue
+		}
+
+		sum := dto.Summary{
+			SampleCount: &count,
+			Quantile:    summary.quantiles,
+		}
+		histogram := collector.PromSummaryToES(g.counterCache, summary.metricName, summary.labels, &sum)
+		if histogram == nil {
+			continue
+		}
+
+		// the count of the summary is always in the event with the same labels
+		e := eventList[summary.labels.String()+summary.timestamp.String()]
+		e.ModuleFields.Update(common.MapStr{
+			summary.metricName: common.MapStr{
+				"histogram": histogram,
 			},
+		})
+	}
+}
+
+// traceIDLabels are the exemplar labels used by instrumentation libraries to
+// store trace IDs.
+var traceIDLabels = []string{"trace_id", "traceID", "traceId"}
+
+// exemplarToES converts an exemplar received with a series to an object that can
+// be stored with the metric it belongs to. The trace ID is stored apart from other
+// labels so it can be used to correlate the metric with traces.
+func exemplarToES(e remote_write.Exemplar) common.MapStr {
+	exemplar := common.MapStr{
+		"value": float64(e.Value),
+	}
+	if e.Timestamp != 0 {
+		exemplar["timestamp"] = e.Timestamp.Time().UTC()
+	}
+
+	labels := common.MapStr{}
+	for k, v := range e.Labels {
+		if k != "" && v != "" {
+			labels[string(k)] = string(v)
+		}
+	}
+	for _, name := range traceIDLabels {
+		if traceID, found := labels[name]; found {
+			exemplar["trace_id"] = traceID
+			delete(labels, name)
+			break
 		}
-		e.ModuleFields.Update(data)
 	}
+	if len(labels) > 0 {
+		exemplar["labels"] = labels
+	}
+
+	return exemplar
 }
 
 // findMetricType evaluates the type of the metric by check the metricname format in order to handle it properly
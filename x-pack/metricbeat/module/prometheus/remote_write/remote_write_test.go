@@ -15,6 +15,7 @@ import (
 
 	"github.com/elastic/beats/v7/libbeat/common"
 	p "github.com/elastic/beats/v7/metricbeat/helper/prometheus"
+	"github.com/elastic/beats/v7/metricbeat/module/prometheus/remote_write"
 	xcollector "github.com/elastic/beats/v7/x-pack/metricbeat/module/prometheus/collector"
 )
 
@@ -1207,3 +1208,172 @@ func TestGenerateEventsHistogramWithDefinedPattern(t *testing.T) {
 	assert.EqualValues(t, e.ModuleFields, expected)
 
 }
+
+// TestGenerateEventsSummaryHistograms tests that summaries are also stored as
+// histograms when summary_histograms is enabled
+func TestGenerateEventsSummaryHistograms(t *testing.T) {
+	counters := xcollector.NewCounterCache(1 * time.Second)
+
+	g := remoteWriteTypedGenerator{
+		counterCache:      counters,
+		summaryHistograms: true,
+	}
+	g.counterCache.Start()
+	defer g.counterCache.Stop()
+
+	timestamp := model.Time(424242)
+	labels := common.MapStr{
+		"runtime": model.LabelValue("linux"),
+	}
+	samples := func(count, q50, q99 float64) model.Samples {
+		return model.Samples{
+			&model.Sample{
+				Metric: model.Metric{
+					"__name__": "go_gc_duration_seconds",
+					"runtime":  "linux",
+					"quantile": "0.5",
+				},
+				Value:     model.SampleValue(q50),
+				Timestamp: timestamp,
+			},
+			&model.Sample{
+				Metric: model.Metric{
+					"__name__": "go_gc_duration_seconds",
+					"runtime":  "linux",
+					"quantile": "0.99",
+				},
+				Value:     model.SampleValue(q99),
+				Timestamp: timestamp,
+			},
+			&model.Sample{
+				Metric: model.Metric{
+					"__name__": "go_gc_duration_seconds_count",
+					"runtime":  "linux",
+				},
+				Value:     model.SampleValue(count),
+				Timestamp: timestamp,
+			},
+		}
+	}
+
+	events := g.GenerateEvents(samples(10, 0.1, 0.4))
+	e := events[labels.String()+timestamp.Time().String()]
+	assert.EqualValues(t, common.MapStr{
+		"go_gc_duration_seconds": common.MapStr{
+			"histogram": common.MapStr{
+				"values": []float64{0.1, 0.4},
+				"counts": []uint64{0, 0},
+			},
+		},
+		"go_gc_duration_seconds_count": common.MapStr{
+			"counter": float64(10),
+		},
+		"labels": labels,
+	}, e.ModuleFields)
+
+	events = g.GenerateEvents(samples(20, 0.2, 0.5))
+	e = events[labels.String()+timestamp.Time().String()]
+	assert.EqualValues(t, common.MapStr{
+		"histogram": common.MapStr{
+			"values": []float64{0.2, 0.5},
+			"counts": []uint64{5, 5},
+		},
+	}, e.ModuleFields["go_gc_duration_seconds"])
+}
+
+// TestGenerateEventsWithExemplars tests that exemplars are added to counters and histograms
+func TestGenerateEventsWithExemplars(t *testing.T) {
+	counters := xcollector.NewCounterCache(1 * time.Second)
+
+	g := remoteWriteTypedGenerator{
+		counterCache: counters,
+		rateCounters: false,
+	}
+	g.counterCache.Start()
+	defer g.counterCache.Stop()
+
+	timestamp := model.Time(424242)
+	labels := common.MapStr{
+		"runtime": model.LabelValue("linux"),
+	}
+
+	requests := model.Metric{
+		"__name__": "http_requests_total",
+		"runtime":  "linux",
+	}
+	bucket := model.Metric{
+		"__name__": "http_request_duration_seconds_bucket",
+		"runtime":  "linux",
+		"le":       "1",
+	}
+	metrics := model.Samples{
+		&model.Sample{
+			Metric:    requests,
+			Value:     model.SampleValue(42),
+			Timestamp: timestamp,
+		},
+		&model.Sample{
+			Metric: model.Metric{
+				"__name__": "http_request_duration_seconds_bucket",
+				"runtime":  "linux",
+				"le":       "0.1",
+			},
+			Value:     model.SampleValue(3),
+			Timestamp: timestamp,
+		},
+		&model.Sample{
+			Metric:    bucket,
+			Value:     model.SampleValue(10),
+			Timestamp: timestamp,
+		},
+	}
+	exemplars := remote_write.Exemplars{
+		requests.Fingerprint(): {
+			{
+				Labels:    model.LabelSet{"trace_id": "4bf92f3577b34da6a3ce929d0e0e4736"},
+				Value:     1,
+				Timestamp: model.Time(424000),
+			},
+		},
+		bucket.Fingerprint(): {
+			{
+				Labels: model.LabelSet{"trace_id": "00f067aa0ba902b7", "span_id": "b7ad6b7169203331"},
+				Value:  0.67,
+			},
+		},
+	}
+	events := g.GenerateEventsWithExemplars(metrics, exemplars)
+
+	expected := common.MapStr{
+		"http_requests_total": common.MapStr{
+			"counter": float64(42),
+			"exemplars": []common.MapStr{
+				{
+					"value":     float64(1),
+					"timestamp": model.Time(424000).Time().UTC(),
+					"trace_id":  "4bf92f3577b34da6a3ce929d0e0e4736",
+				},
+			},
+		},
+		"http_request_duration_seconds": common.MapStr{
+			"histogram": common.MapStr{
+				"values": []float64{0.05, 0.55},
+				"counts": []uint64{0, 0},
+			},
+			"exemplars": []common.MapStr{
+				{
+					"value":    0.67,
+					"trace_id": "00f067aa0ba902b7",
+					"labels": common.MapStr{
+						"span_id": "b7ad6b7169203331",
+					},
+				},
+			},
+		},
+		"labels": labels,
+	}
+
+	assert.Equal(t, 1, len(events))
+	e := events[labels.String()+timestamp.Time().String()]
+	assert.EqualValues(t, expected, e.ModuleFields)
+}
@@ -0,0 +1,109 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package query
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type config struct {
+	Driver string `config:"driver" validate:"nonzero,required"`
+
+	// Query and ResponseFormat configure a single unnamed query. They are
+	// kept for compatibility, new configurations should use Queries.
+	Query          string `config:"sql_query"`
+	ResponseFormat string `config:"sql_response_format"`
+
+	// Queries is the list of named queries executed on every fetch over the
+	// same connection pool.
+	Queries []query `config:"sql_queries"`
+
+	// MergeResults merges the results of queries returning a single row into
+	// one event.
+	MergeResults bool `config:"merge_results"`
+}
+
+type query struct {
+	// Name identifies the query in events and in the persisted cursor state.
+	Name  string `config:"name" validate:"required"`
+	Query string `config:"query" validate:"required"`
+	// ResponseFormat has 2 possible values: table and variables. Explained in
+	// the SQL helper on Metricbeat.
+	ResponseFormat string `config:"response_format"`
+	// Timeout for the execution of the query, it is only bound by the fetch
+	// context when not set.
+	Timeout time.Duration `config:"timeout" validate:"min=0"`
+	// Cursor, when set, passes a value from the rows returned by the previous
+	// execution as parameter to the query.
+	Cursor *cursorConfig `config:"cursor"`
+}
+
+type cursorConfig struct {
+	// Column of the last row returned whose value is kept for the next
+	// execution.
+	Column string `config:"column" validate:"required"`
+	// Default is the value used when there is no persisted state.
+	Default interface{} `config:"default" validate:"required"`
+}
+
+func defaultConfig() config {
+	return config{
+		ResponseFormat: tableResponseFormat,
+	}
+}
+
+func (c *config) Validate() error {
+	if c.Query != "" && len(c.Queries) > 0 {
+		return errors.New("sql_query and sql_queries cannot be used together")
+	}
+	if c.Query == "" && len(c.Queries) == 0 {
+		return errors.New("one of sql_query or sql_queries is required")
+	}
+	if err := validateResponseFormat(c.ResponseFormat); err != nil {
+		return errors.Wrap(err, "invalid sql_response_format")
+	}
+
+	names := make(map[string]struct{}, len(c.Queries))
+	for _, q := range c.Queries {
+		if _, found := names[q.Name]; found {
+			return fmt.Errorf("duplicated query name '%s'", q.Name)
+		}
+		names[q.Name] = struct{}{}
+	}
+	return nil
+}
+
+func (q *query) Validate() error {
+	if q.ResponseFormat == "" {
+		return nil
+	}
+	return errors.Wrapf(validateResponseFormat(q.ResponseFormat), "invalid response_format in query '%s'", q.Name)
+}
+
+// queries returns the list of queries to execute, including the one configured
+// with sql_query if any.
+func (c *config) queries() []query {
+	if c.Query != "" {
+		return []query{{Query: c.Query, ResponseFormat: c.ResponseFormat}}
+	}
+	queries := make([]query, len(c.Queries))
+	for i, q := range c.Queries {
+		if q.ResponseFormat == "" {
+			q.ResponseFormat = tableResponseFormat
+		}
+		queries[i] = q
+	}
+	return queries
+}
+
+func validateResponseFormat(format string) error {
+	if format != variableResponseFormat && format != tableResponseFormat {
+		return fmt.Errorf("unknown response format '%s'", format)
+	}
+	return nil
+}
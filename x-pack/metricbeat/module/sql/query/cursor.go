@@ -0,0 +1,132 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package query
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/paths"
+	"github.com/elastic/beats/v7/libbeat/statestore"
+	"github.com/elastic/beats/v7/libbeat/statestore/backend/memlog"
+)
+
+const (
+	cursorRegistryPath = "sql"
+	cursorStoreName    = "cursors"
+)
+
+// cursorRegistry is shared by all the metricsets persisting cursors, so they
+// don't write concurrently to the same files. It is opened by the first user,
+// and closed when the last one releases it.
+var cursorRegistry struct {
+	sync.Mutex
+	registry *statestore.Registry
+	refs     int
+}
+
+// cursorState is the persisted state of a query cursor.
+type cursorState struct {
+	Value interface{} `struct:"value"`
+}
+
+// cursorStore keeps the last value of the cursors of the queries executed
+// against a host.
+type cursorStore struct {
+	store  *statestore.Store
+	prefix string
+}
+
+// openCursorStore opens the store for the cursors of the queries executed with
+// the given driver and URI. The URI is only used hashed to build the keys.
+func openCursorStore(logger *logp.Logger, driver, uri string) (*cursorStore, error) {
+	cursorRegistry.Lock()
+	defer cursorRegistry.Unlock()
+
+	if cursorRegistry.registry == nil {
+		backend, err := memlog.New(logger, memlog.Settings{
+			Root: paths.Resolve(paths.Data, cursorRegistryPath),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "creating cursor registry")
+		}
+		cursorRegistry.registry = statestore.NewRegistry(backend)
+	}
+
+	store, err := cursorRegistry.registry.Get(cursorStoreName)
+	if err != nil {
+		if cursorRegistry.refs == 0 {
+			cursorRegistry.registry.Close()
+			cursorRegistry.registry = nil
+		}
+		return nil, errors.Wrap(err, "opening cursor store")
+	}
+	cursorRegistry.refs++
+
+	return newCursorStore(store, driver, uri), nil
+}
+
+func newCursorStore(store *statestore.Store, driver, uri string) *cursorStore {
+	hash := sha256.Sum256([]byte(uri))
+	return &cursorStore{
+		store:  store,
+		prefix: "sql.query::" + driver + "::" + hex.EncodeToString(hash[:8]) + "::",
+	}
+}
+
+// Load returns the persisted value of the cursor of a query, or def if there
+// is none.
+func (s *cursorStore) Load(name string, def interface{}) (interface{}, error) {
+	key := s.prefix + name
+	found, err := s.store.Has(key)
+	if err != nil || !found {
+		return def, err
+	}
+
+	var st cursorState
+	if err := s.store.Get(key, &st); err != nil {
+		return def, err
+	}
+	return st.Value, nil
+}
+
+// Save persists the value of the cursor of a query.
+func (s *cursorStore) Save(name string, value interface{}) error {
+	return s.store.Set(s.prefix+name, cursorState{Value: value})
+}
+
+// Close closes the store, and the shared registry if it is not used anymore.
+func (s *cursorStore) Close() error {
+	err := s.store.Close()
+
+	cursorRegistry.Lock()
+	defer cursorRegistry.Unlock()
+	cursorRegistry.refs--
+	if cursorRegistry.refs == 0 && cursorRegistry.registry != nil {
+		if cerr := cursorRegistry.registry.Close(); err == nil {
+			err = cerr
+		}
+		cursorRegistry.registry = nil
+	}
+	return err
+}
+
+// cursorValue returns the value of the cursor column in the last row of the
+// results.
+func cursorValue(rows []common.MapStr, column string) (interface{}, bool) {
+	if len(rows) == 0 {
+		return nil, false
+	}
+	v, err := rows[len(rows)-1].GetValue(column)
+	if err != nil || v == nil {
+		return nil, false
+	}
+	return v, true
+}
@@ -6,9 +6,9 @@ package query
 
 import (
 	"context"
-	"fmt"
+	"strings"
 
-	"github.com/jmoiron/sqlx"
+	"github.com/joeshaw/multierror"
 	"github.com/pkg/errors"
 
 	"github.com/elastic/beats/v7/libbeat/common"
@@ -39,11 +39,12 @@ func init() {
 // interface methods except for Fetch.
 type MetricSet struct {
 	mb.BaseMetricSet
-	Driver         string
-	Query          string
-	ResponseFormat string
+	Driver       string
+	Queries      []query
+	MergeResults bool
 
-	db *sqlx.DB
+	db      *sql.DbClient
+	cursors *cursorStore
 }
 
 // New creates a new instance of the MetricSet. New is responsible for unpacking
@@ -51,70 +52,166 @@ type MetricSet struct {
 func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 	cfgwarn.Beta("The sql query metricset is beta.")
 
-	config := struct {
-		Driver         string `config:"driver" validate:"nonzero,required"`
-		Query          string `config:"sql_query" validate:"nonzero,required"`
-		ResponseFormat string `config:"sql_response_format"`
-	}{ResponseFormat: tableResponseFormat}
-
+	config := defaultConfig()
 	if err := base.Module().UnpackConfig(&config); err != nil {
 		return nil, err
 	}
 
-	if config.ResponseFormat != variableResponseFormat && config.ResponseFormat != tableResponseFormat {
-		return nil, fmt.Errorf("invalid sql_response_format value: %s", config.ResponseFormat)
+	m := &MetricSet{
+		BaseMetricSet: base,
+		Driver:        config.Driver,
+		Queries:       config.queries(),
+		MergeResults:  config.MergeResults,
+	}
+
+	for _, q := range m.Queries {
+		if q.Cursor == nil {
+			continue
+		}
+		cursors, err := openCursorStore(m.Logger(), m.Driver, m.HostData().URI)
+		if err != nil {
+			return nil, err
+		}
+		m.cursors = cursors
+		break
 	}
 
-	return &MetricSet{
-		BaseMetricSet:  base,
-		Driver:         config.Driver,
-		Query:          config.Query,
-		ResponseFormat: config.ResponseFormat,
-	}, nil
+	return m, nil
 }
 
 // Fetch methods implements the data gathering and data conversion to the right
 // format. It publishes the event which is then forwarded to the output. In case
 // of an error set the Error field of mb.Event or simply call report.Error().
-// It calls m.fetchTableMode() or m.fetchVariableMode() depending on the response
-// format of the query.
+// All the queries are executed over the same connection pool, which is kept
+// open between fetches. A failing query doesn't prevent the execution of the
+// rest.
 func (m *MetricSet) Fetch(ctx context.Context, report mb.ReporterV2) error {
-	db, err := sql.NewDBClient(m.Driver, m.HostData().URI, m.Logger())
-	if err != nil {
-		return errors.Wrap(err, "error opening connection")
+	if m.db == nil {
+		db, err := sql.NewDBClient(m.Driver, m.HostData().URI, m.Logger())
+		if err != nil {
+			return errors.Wrap(err, "error opening connection")
+		}
+		m.db = db
 	}
-	defer db.Close()
 
-	if m.ResponseFormat == tableResponseFormat {
-		mss, err := db.FetchTableMode(ctx, m.Query)
+	var errs multierror.Errors
+	merged := common.MapStr{}
+	mergedResults := map[string][]common.MapStr{}
+	for _, q := range m.Queries {
+		mss, err := m.fetchQuery(ctx, q)
 		if err != nil {
-			return err
+			errs = append(errs, queryError(q, err))
+			continue
 		}
 
+		if m.MergeResults && len(mss) == 1 {
+			merged.DeepUpdate(getMetrics(mss[0]))
+			mergedResults[q.Name] = mss
+			continue
+		}
+
+		reported := true
 		for _, ms := range mss {
-			report.Event(m.getEvent(ms))
+			reported = report.Event(m.getEvent(q, ms)) && reported
+		}
+		if reported {
+			if err := m.saveCursor(q, mss); err != nil {
+				errs = append(errs, queryError(q, err))
+			}
 		}
+	}
 
-		return nil
+	if len(merged) > 0 {
+		reported := report.Event(mb.Event{
+			RootFields: common.MapStr{
+				"sql": common.MapStr{
+					"driver":  m.Driver,
+					"metrics": merged,
+				},
+			},
+		})
+		for _, q := range m.Queries {
+			mss, found := mergedResults[q.Name]
+			if !reported || !found {
+				continue
+			}
+			if err := m.saveCursor(q, mss); err != nil {
+				errs = append(errs, queryError(q, err))
+			}
+		}
 	}
 
-	ms, err := db.FetchVariableMode(ctx, m.Query)
-	if err != nil {
-		return err
+	return errs.Err()
+}
+
+func queryError(q query, err error) error {
+	if q.Name != "" {
+		return errors.Wrapf(err, "query '%s' failed", q.Name)
+	}
+	return err
+}
+
+// fetchQuery executes a query and returns its results as a list of rows. In
+// variables mode there is a single row containing all the key/values.
+func (m *MetricSet) fetchQuery(ctx context.Context, q query) ([]common.MapStr, error) {
+	if q.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, q.Timeout)
+		defer cancel()
 	}
-	report.Event(m.getEvent(ms))
 
-	return nil
+	var args []interface{}
+	if q.Cursor != nil {
+		value, err := m.cursors.Load(q.Name, q.Cursor.Default)
+		if err != nil {
+			return nil, errors.Wrap(err, "error loading cursor")
+		}
+		args = append(args, value)
+	}
+
+	var mss []common.MapStr
+	if q.ResponseFormat == tableResponseFormat {
+		var err error
+		mss, err = m.db.FetchTableMode(ctx, q.Query, args...)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		ms, err := m.db.FetchVariableMode(ctx, q.Query, args...)
+		if err != nil {
+			return nil, err
+		}
+		mss = []common.MapStr{ms}
+	}
+
+	return mss, nil
+}
+
+// saveCursor persists the cursor of the query from the rows it returned. It is
+// called once the rows are reported, so they are fetched again otherwise.
+func (m *MetricSet) saveCursor(q query, mss []common.MapStr) error {
+	if q.Cursor == nil {
+		return nil
+	}
+	value, found := cursorValue(mss, strings.ToLower(q.Cursor.Column))
+	if !found {
+		return nil
+	}
+	return errors.Wrap(m.cursors.Save(q.Name, value), "error saving cursor")
 }
 
-func (m *MetricSet) getEvent(ms common.MapStr) mb.Event {
+func (m *MetricSet) getEvent(q query, ms common.MapStr) mb.Event {
+	fields := common.MapStr{
+		"driver":  m.Driver,
+		"query":   q.Query,
+		"metrics": getMetrics(ms),
+	}
+	if q.Name != "" {
+		fields["query_name"] = q.Name
+	}
 	return mb.Event{
 		RootFields: common.MapStr{
-			"sql": common.MapStr{
-				"driver":  m.Driver,
-				"query":   m.Query,
-				"metrics": getMetrics(ms),
-			},
+			"sql": fields,
 		},
 	}
 }
@@ -156,10 +253,19 @@ func getMetrics(ms common.MapStr) (ret common.MapStr) {
 	return
 }
 
-// Close closes the connection pool releasing its resources
+// Close closes the connection pool and the cursor store releasing their
+// resources
 func (m *MetricSet) Close() error {
-	if m.db == nil {
-		return nil
+	var errs multierror.Errors
+	if m.cursors != nil {
+		if err := m.cursors.Close(); err != nil {
+			errs = append(errs, errors.Wrap(err, "closing cursor store"))
+		}
+	}
+	if m.db != nil {
+		if err := m.db.Close(); err != nil {
+			errs = append(errs, errors.Wrap(err, "closing connection"))
+		}
 	}
-	return errors.Wrap(m.db.Close(), "closing connection")
+	return errs.Err()
 }
@@ -0,0 +1,377 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+//go:build !integration
+// +build !integration
+
+package query
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/statestore"
+	"github.com/elastic/beats/v7/libbeat/statestore/backend/memlog"
+	"github.com/elastic/beats/v7/metricbeat/helper/sql"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+func TestConfig(t *testing.T) {
+	cases := map[string]struct {
+		config  map[string]interface{}
+		err     bool
+		queries []query
+	}{
+		"single query": {
+			config: map[string]interface{}{
+				"driver":    "postgres",
+				"sql_query": "select now()",
+			},
+			queries: []query{{Query: "select now()", ResponseFormat: tableResponseFormat}},
+		},
+		"named queries": {
+			config: map[string]interface{}{
+				"driver": "postgres",
+				"sql_queries": []map[string]interface{}{
+					{"name": "activity", "query": "select * from pg_stat_activity"},
+					{"name": "settings", "query": "select name, setting from pg_settings", "response_format": "variables", "timeout": "5s"},
+					{"name": "events", "query": "select * from events where id > $1 order by id", "cursor": map[string]interface{}{"column": "id", "default": 0}},
+				},
+			},
+			queries: []query{
+				{Name: "activity", Query: "select * from pg_stat_activity", ResponseFormat: tableResponseFormat},
+				{Name: "settings", Query: "select name, setting from pg_settings", ResponseFormat: variableResponseFormat, Timeout: 5 * time.Second},
+				{Name: "events", Query: "select * from events where id > $1 order by id", ResponseFormat: tableResponseFormat, Cursor: &cursorConfig{Column: "id", Default: int64(0)}},
+			},
+		},
+		"no query": {
+			config: map[string]interface{}{"driver": "postgres"},
+			err:    true,
+		},
+		"both single and named queries": {
+			config: map[string]interface{}{
+				"driver":      "postgres",
+				"sql_query":   "select now()",
+				"sql_queries": []map[string]interface{}{{"name": "now", "query": "select now()"}},
+			},
+			err: true,
+		},
+		"unnamed query": {
+			config: map[string]interface{}{
+				"driver":      "postgres",
+				"sql_queries": []map[string]interface{}{{"query": "select now()"}},
+			},
+			err: true,
+		},
+		"duplicated names": {
+			config: map[string]interface{}{
+				"driver": "postgres",
+				"sql_queries": []map[string]interface{}{
+					{"name": "now", "query": "select now()"},
+					{"name": "now", "query": "select current_timestamp"},
+				},
+			},
+			err: true,
+		},
+		"invalid response format": {
+			config: map[string]interface{}{
+				"driver":      "postgres",
+				"sql_queries": []map[string]interface{}{{"name": "now", "query": "select now()", "response_format": "rows"}},
+			},
+			err: true,
+		},
+		"cursor without column": {
+			config: map[string]interface{}{
+				"driver":      "postgres",
+				"sql_queries": []map[string]interface{}{{"name": "events", "query": "select * from events", "cursor": map[string]interface{}{"default": 0}}},
+			},
+			err: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			config := defaultConfig()
+			err := common.MustNewConfigFrom(c.config).Unpack(&config)
+			if c.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.queries, config.queries())
+		})
+	}
+}
+
+func TestCursorStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sql-cursors")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	open := func() (*statestore.Registry, *statestore.Store) {
+		backend, err := memlog.New(logp.NewLogger("test"), memlog.Settings{Root: dir})
+		require.NoError(t, err)
+		registry := statestore.NewRegistry(backend)
+		store, err := registry.Get(cursorStoreName)
+		require.NoError(t, err)
+		return registry, store
+	}
+
+	registry, store := open()
+	cursors := newCursorStore(store, "postgres", "postgres://localhost:5432")
+	other := newCursorStore(store, "postgres", "postgres://otherhost:5432")
+
+	v, err := cursors.Load("events", 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, v)
+
+	require.NoError(t, cursors.Save("events", 42.0))
+	require.NoError(t, cursors.Save("logins", "2020-11-05T10:00:00Z"))
+
+	v, err = other.Load("events", 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, v, "cursors must not be shared between hosts")
+
+	store.Close()
+	registry.Close()
+
+	// Values are kept after reopening the store.
+	registry, store = open()
+	defer registry.Close()
+	defer store.Close()
+	cursors = newCursorStore(store, "postgres", "postgres://localhost:5432")
+
+	v, err = cursors.Load("events", 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 42, v)
+
+	v, err = cursors.Load("logins", "")
+	require.NoError(t, err)
+	assert.Equal(t, "2020-11-05T10:00:00Z", v)
+}
+
+func TestCursorValue(t *testing.T) {
+	rows := []common.MapStr{
+		{"id": 1.0, "name": "first"},
+		{"id": 2.0, "name": "second"},
+		{"id": nil, "name": "third"},
+	}
+
+	_, found := cursorValue(nil, "id")
+	assert.False(t, found)
+
+	v, found := cursorValue(rows[:2], "id")
+	assert.True(t, found)
+	assert.Equal(t, 2.0, v)
+
+	_, found = cursorValue(rows, "id")
+	assert.False(t, found, "null values are not used as cursor")
+
+	_, found = cursorValue(rows, "missing")
+	assert.False(t, found)
+}
+
+func TestGetMetrics(t *testing.T) {
+	merged := common.MapStr{}
+	merged.DeepUpdate(getMetrics(common.MapStr{"connections": 10.0, "version": "13.1"}))
+	merged.DeepUpdate(getMetrics(common.MapStr{"replicating": true, "lag": 0.5}))
+
+	assert.Equal(t, common.MapStr{
+		"numeric": common.MapStr{"connections": 10.0, "lag": 0.5},
+		"string":  common.MapStr{"version": "13.1"},
+		"bool":    common.MapStr{"replicating": true},
+	}, merged)
+}
+
+func newTestMetricSet(t *testing.T, config map[string]interface{}) (*MetricSet, sqlmock.Sqlmock) {
+	c := defaultConfig()
+	require.NoError(t, common.MustNewConfigFrom(config).Unpack(&c))
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+
+	backend, err := memlog.New(logp.NewLogger("test"), memlog.Settings{Root: t.TempDir()})
+	require.NoError(t, err)
+	registry := statestore.NewRegistry(backend)
+	store, err := registry.Get(cursorStoreName)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		assert.NoError(t, mock.ExpectationsWereMet())
+		db.Close()
+		store.Close()
+		registry.Close()
+	})
+
+	return &MetricSet{
+		Driver:       c.Driver,
+		Queries:      c.queries(),
+		MergeResults: c.MergeResults,
+		db:           &sql.DbClient{DB: db},
+		cursors:      newCursorStore(store, c.Driver, "postgres://localhost:5432"),
+	}, mock
+}
+
+func fetchEvents(t *testing.T, m *MetricSet) ([]mb.Event, error) {
+	reporter := &mbtest.CapturingReporterV2{}
+	err := m.Fetch(context.Background(), reporter)
+	return reporter.GetEvents(), err
+}
+
+func eventField(t *testing.T, event mb.Event, field string) interface{} {
+	v, err := event.RootFields.GetValue(field)
+	require.NoError(t, err)
+	return v
+}
+
+// rejectingReporter doesn't accept any event, as when the metricset is being stopped.
+type rejectingReporter struct{}
+
+func (rejectingReporter) Event(mb.Event) bool { return false }
+func (rejectingReporter) Error(error) bool    { return false }
+
+func TestFetchNamedQueries(t *testing.T) {
+	m, mock := newTestMetricSet(t, map[string]interface{}{
+		"driver": "postgres",
+		"sql_queries": []map[string]interface{}{
+			{"name": "activity", "query": "select datname, numbackends from pg_stat_database"},
+			{"name": "settings", "query": "select name, setting from pg_settings", "response_format": "variables"},
+		},
+	})
+
+	mock.ExpectQuery("select datname, numbackends from pg_stat_database").WillReturnRows(
+		sqlmock.NewRows([]string{"datname", "numbackends"}).AddRow("postgres", 3).AddRow("app", 7))
+	mock.ExpectQuery("select name, setting from pg_settings").WillReturnRows(
+		sqlmock.NewRows([]string{"name", "setting"}).AddRow("max_connections", "100"))
+
+	events, err := fetchEvents(t, m)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+
+	assert.Equal(t, "activity", eventField(t, events[0], "sql.query_name"))
+	assert.Equal(t, "postgres", eventField(t, events[0], "sql.metrics.string.datname"))
+	assert.Equal(t, 3.0, eventField(t, events[0], "sql.metrics.numeric.numbackends"))
+	assert.Equal(t, "app", eventField(t, events[1], "sql.metrics.string.datname"))
+
+	assert.Equal(t, "settings", eventField(t, events[2], "sql.query_name"))
+	assert.Equal(t, "select name, setting from pg_settings", eventField(t, events[2], "sql.query"))
+	assert.Equal(t, 100.0, eventField(t, events[2], "sql.metrics.numeric.max_connections"))
+}
+
+func TestFetchMergeResults(t *testing.T) {
+	m, mock := newTestMetricSet(t, map[string]interface{}{
+		"driver":        "postgres",
+		"merge_results": true,
+		"sql_queries": []map[string]interface{}{
+			{"name": "connections", "query": "select count(*) as connections from pg_stat_activity"},
+			{"name": "settings", "query": "select name, setting from pg_settings", "response_format": "variables"},
+			{"name": "activity", "query": "select datname from pg_stat_database"},
+		},
+	})
+
+	mock.ExpectQuery("select count(*) as connections from pg_stat_activity").WillReturnRows(
+		sqlmock.NewRows([]string{"connections"}).AddRow(10))
+	mock.ExpectQuery("select name, setting from pg_settings").WillReturnRows(
+		sqlmock.NewRows([]string{"name", "setting"}).AddRow("max_connections", "100").AddRow("server_encoding", "UTF8"))
+	mock.ExpectQuery("select datname from pg_stat_database").WillReturnRows(
+		sqlmock.NewRows([]string{"datname"}).AddRow("postgres").AddRow("app"))
+
+	events, err := fetchEvents(t, m)
+	require.NoError(t, err)
+
+	// Queries returning more than one row are not merged
+	require.Len(t, events, 3)
+	assert.Equal(t, "activity", eventField(t, events[0], "sql.query_name"))
+	assert.Equal(t, "activity", eventField(t, events[1], "sql.query_name"))
+
+	assert.Equal(t, common.MapStr{
+		"driver": "postgres",
+		"metrics": common.MapStr{
+			"numeric": common.MapStr{"connections": 10.0, "max_connections": 100.0},
+			"string":  common.MapStr{"server_encoding": "UTF8"},
+		},
+	}, events[2].RootFields["sql"])
+}
+
+func TestFetchQueryTimeout(t *testing.T) {
+	m, mock := newTestMetricSet(t, map[string]interface{}{
+		"driver": "postgres",
+		"sql_queries": []map[string]interface{}{
+			{"name": "slow", "query": "select pg_sleep(10)", "timeout": "50ms"},
+			{"name": "fast", "query": "select 1 as one"},
+		},
+	})
+
+	mock.ExpectQuery("select pg_sleep(10)").WillDelayFor(time.Second).WillReturnRows(
+		sqlmock.NewRows([]string{"pg_sleep"}).AddRow(""))
+	mock.ExpectQuery("select 1 as one").WillReturnRows(
+		sqlmock.NewRows([]string{"one"}).AddRow(1))
+
+	start := time.Now()
+	events, err := fetchEvents(t, m)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "query 'slow' failed")
+	}
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+
+	// The other queries still run
+	require.Len(t, events, 1)
+	assert.Equal(t, "fast", eventField(t, events[0], "sql.query_name"))
+}
+
+func TestFetchCursor(t *testing.T) {
+	const q = "select id, message from events where id > $1 order by id"
+	m, mock := newTestMetricSet(t, map[string]interface{}{
+		"driver": "postgres",
+		"sql_queries": []map[string]interface{}{
+			{"name": "events", "query": q, "cursor": map[string]interface{}{"column": "id", "default": 0}},
+		},
+	})
+
+	rows := func(ids ...int) *sqlmock.Rows {
+		r := sqlmock.NewRows([]string{"id", "message"})
+		for _, id := range ids {
+			r.AddRow(id, "message")
+		}
+		return r
+	}
+
+	// The first fetch uses the default value
+	mock.ExpectQuery(q).WithArgs(int64(0)).WillReturnRows(rows(1, 2))
+	events, err := fetchEvents(t, m)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	// The next one continues from the last row reported
+	mock.ExpectQuery(q).WithArgs(2.0).WillReturnRows(rows(3))
+	events, err = fetchEvents(t, m)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, 3.0, eventField(t, events[0], "sql.metrics.numeric.id"))
+
+	// Rows that are not reported are fetched again
+	mock.ExpectQuery(q).WithArgs(3.0).WillReturnRows(rows(4))
+	require.NoError(t, m.Fetch(context.Background(), rejectingReporter{}))
+
+	// The cursor is kept when no rows are returned
+	mock.ExpectQuery(q).WithArgs(3.0).WillReturnRows(rows())
+	events, err = fetchEvents(t, m)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	mock.ExpectQuery(q).WithArgs(3.0).WillReturnRows(rows(4))
+	events, err = fetchEvents(t, m)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+}
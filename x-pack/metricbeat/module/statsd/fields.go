@@ -19,5 +19,5 @@ func init() {
 // AssetStatsd returns asset data.
 // This is the base64 encoded gzipped contents of module/statsd.
 func AssetStatsd() string {
-	return "eJyUkTtqxTAQRXut4qLGYHAWoCKbyAKMIo2NEv2QxoV3H/wL4uFXeMp7z4gzaMAvrQqVNVcrAHbsSUF+7YEUgKVqisvsUlT4FABwlAjJLp4EUMiTrqQwawFMjrytaicHRB2oeX8bXvPGlrTkM2lX2rWu/zBpidz9N9d2+v4hw018BOPR+hTn+24MOmcX5xOUGykb9Obaa86rdyEq9U62fyo6+aTflK+m/TPNQFycaS3bX/oLAAD//wYwi2Y="
+	return "eJy0kztqxDAQhnuf4sfNgmFzABe5RA6waOVZrWK90IwTfPvgxxoVKmJCUPc/hu8vdMVIcw8WJTw0gFhx1KP9WIW2AQZinW0SG0OP9wYANhM+DpOjBsjkSDH1MKoBHpbcwP2avCIoT8X9RZQ5Ldkcp7QrZaWsXbo3Hacgl8N5teP9k7QU8ibcNtfFYOrezauUbDB7sF2SbRGtrH29ffUKRJlrsE/LEk1W/izwUfwddXcO+biO+6RHkiq7J2Zl6Cz5SPN3zEPd/iM3U/6ymqCfpEfsgFX47iz2w0Ul/wLtSbLVJWX5PX4GAMam9eY="
 }
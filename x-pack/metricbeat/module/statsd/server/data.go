@@ -6,7 +6,9 @@ package server
 
 import (
 	"bytes"
+	"math"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -17,9 +19,16 @@ import (
 
 var errInvalidPacket = errors.New("invalid statsd packet")
 
+const serviceCheckType = "sc"
+
+var (
+	serviceCheckPrefix = []byte("_sc|")
+	eventPrefix        = []byte("_e{")
+)
+
 type metricProcessor struct {
-	registry      *registry
-	reservoirSize int
+	registry *registry
+	mapper   *mapper
 }
 
 type statsdMetric struct {
@@ -28,14 +37,22 @@ type statsdMetric struct {
 	sampleRate string
 	value      string
 	tags       map[string]string
+	message    string
 }
 
-func splitTags(rawTags []byte, kvSep []byte) map[string]string {
-	tags := map[string]string{}
+// splitTags parses a list of tags, tags without value are kept with an empty
+// value.
+func splitTags(tags map[string]string, rawTags []byte, kvSep []byte) map[string]string {
+	if tags == nil {
+		tags = map[string]string{}
+	}
 	for _, kv := range bytes.Split(rawTags, []byte(",")) {
+		if len(kv) == 0 {
+			continue
+		}
 		kvSplit := bytes.SplitN(kv, kvSep, 2)
 		if len(kvSplit) != 2 {
-			logger.Warnf("could not parse tags")
+			tags[string(kv)] = ""
 			continue
 		}
 		tags[string(kvSplit[0])] = string(kvSplit[1])
@@ -46,25 +63,25 @@ func splitTags(rawTags []byte, kvSep []byte) map[string]string {
 func parseSingle(b []byte) (statsdMetric, error) {
 	// format: <metric name>:<value>|<type>[|@samplerate][|#<k>:<v>,<k>:<v>]
 	// alternative: <metric name>[,<k>=<v>,<k>=<v>]:<value>|<type>[|@samplerate]
+	// DogStatsD extensions like container IDs (|c:<id>) and timestamps (|T<ts>)
+	// are ignored.
 	s := statsdMetric{}
 
-	parts := bytes.SplitN(b, []byte("|"), 4)
+	parts := bytes.Split(b, []byte("|"))
 	if len(parts) < 2 {
 		return s, errInvalidPacket
 	}
 
-	if len(parts) > 2 && len(parts[2]) > 0 && parts[2][0] == '@' {
-		s.sampleRate = string(parts[2][1:])
-
-		if len(parts) > 3 {
-			parts = [][]byte{parts[0], parts[1], parts[3]}
-		} else {
-			parts = [][]byte{parts[0], parts[1]}
+	for _, section := range parts[2:] {
+		if len(section) == 0 {
+			continue
+		}
+		switch section[0] {
+		case '@':
+			s.sampleRate = string(section[1:])
+		case '#':
+			s.tags = splitTags(s.tags, section[1:], []byte(":"))
 		}
-	}
-
-	if len(parts) > 2 && len(parts[2]) > 0 && parts[2][0] == '#' {
-		s.tags = splitTags(parts[2][1:], []byte(":"))
 	}
 
 	nameSplit := bytes.SplitN(parts[0], []byte{':'}, 2)
@@ -75,7 +92,7 @@ func parseSingle(b []byte) (statsdMetric, error) {
 	nameTagsSplit := bytes.SplitN(nameSplit[0], []byte(","), 2)
 	s.name = string(nameTagsSplit[0])
 	if len(nameTagsSplit) > 1 {
-		s.tags = splitTags(nameTagsSplit[1], []byte("="))
+		s.tags = splitTags(s.tags, nameTagsSplit[1], []byte("="))
 	}
 
 	s.value = string(nameSplit[1])
@@ -84,24 +101,76 @@ func parseSingle(b []byte) (statsdMetric, error) {
 	return s, nil
 }
 
+// parseServiceCheck parses a DogStatsD service check.
+func parseServiceCheck(b []byte) (statsdMetric, error) {
+	// format: _sc|<name>|<status>[|d:<timestamp>][|h:<hostname>][|#<k>:<v>,<k>:<v>][|m:<message>]
+	s := statsdMetric{metricType: serviceCheckType}
+
+	// the message is the last field, and can contain any character
+	if idx := bytes.Index(b, []byte("|m:")); idx >= 0 {
+		s.message = string(b[idx+3:])
+		b = b[:idx]
+	}
+
+	parts := bytes.Split(b[len(serviceCheckPrefix):], []byte("|"))
+	if len(parts) < 2 || len(parts[0]) == 0 {
+		return s, errInvalidPacket
+	}
+	s.name = string(parts[0])
+	s.value = string(parts[1])
+
+	for _, section := range parts[2:] {
+		if len(section) > 0 && section[0] == '#' {
+			s.tags = splitTags(s.tags, section[1:], []byte(":"))
+		}
+	}
+
+	return s, nil
+}
+
 // parse will parse a statsd metric into its components
 func parse(b []byte) ([]statsdMetric, error) {
 	metrics := []statsdMetric{}
 	for _, rawMetric := range bytes.Split(b, []byte("\n")) {
-		if len(rawMetric) > 0 {
-			metric, err := parseSingle(rawMetric)
+		if len(rawMetric) == 0 {
+			continue
+		}
+
+		switch {
+		case bytes.HasPrefix(rawMetric, eventPrefix):
+			logger.Debug("DogStatsD events are not supported")
+		case bytes.HasPrefix(rawMetric, serviceCheckPrefix):
+			metric, err := parseServiceCheck(rawMetric)
 			if err != nil {
 				return metrics, err
 			}
 			metrics = append(metrics, metric)
+		default:
+			metric, err := parseSingle(rawMetric)
+			if err != nil {
+				return metrics, err
+			}
+
+			// DogStatsD allows packing multiple values in the same metric,
+			// like <metric name>:<value1>:<value2>|<type>, values of sets
+			// are kept as they are as they can contain any character.
+			values := []string{metric.value}
+			if metric.metricType != "s" {
+				values = strings.Split(metric.value, ":")
+			}
+			for _, value := range values {
+				metric.value = value
+				metrics = append(metrics, metric)
+			}
 		}
 	}
 	return metrics, nil
 }
 
-func newMetricProcessor(ttl time.Duration) *metricProcessor {
+func newMetricProcessor(ttl time.Duration, m *mapper) *metricProcessor {
 	return &metricProcessor{
-		registry: &registry{metrics: map[string]map[string]*metric{}, ttl: ttl},
+		registry: &registry{metrics: map[string]map[string]*metric{}, ttl: ttl, mapper: m},
+		mapper:   m,
 	}
 }
 
@@ -110,6 +179,8 @@ func (p *metricProcessor) processSingle(m statsdMetric) error {
 		return nil
 	}
 
+	m.name, m.tags = p.mapper.Map(m.name, m.tags)
+
 	// parse sample rate. Only applicable for timers and counters
 	var sampleRate float64
 	if m.sampleRate == "" {
@@ -133,7 +204,7 @@ func (p *metricProcessor) processSingle(m statsdMetric) error {
 			return errors.Wrapf(err, "failed to process counter `%s` with value `%s`", m.name, m.value)
 		}
 		// apply sample rate
-		v = int64(float64(v) * (1.0 / sampleRate))
+		v = int64(math.Round(float64(v) * (1.0 / sampleRate)))
 		c.Inc(v)
 	case "g":
 		c := p.registry.GetOrNewGauge64(m.name, m.tags)
@@ -155,16 +226,32 @@ func (p *metricProcessor) processSingle(m statsdMetric) error {
 			return errors.Wrapf(err, "failed to process timer `%s` with value `%s`", m.name, m.value)
 		}
 		c.SampledUpdate(time.Duration(v), sampleRate)
-	case "h": // TODO: can these be floats?
+	case "h":
 		c := p.registry.GetOrNewHistogram(m.name, m.tags)
-		v, err := strconv.ParseInt(m.value, 10, 64)
+		v, err := strconv.ParseFloat(m.value, 64)
 		if err != nil {
 			return errors.Wrapf(err, "failed to process histogram `%s` with value `%s`", m.name, m.value)
 		}
-		c.Update(v)
+		// histograms keep integer values, use distributions for decimals
+		c.SampledUpdate(int64(math.Round(v)), sampleRate)
+	case "d":
+		c := p.registry.GetOrNewDistribution(m.name, m.tags)
+		v, err := strconv.ParseFloat(m.value, 64)
+		if err != nil {
+			return errors.Wrapf(err, "failed to process distribution `%s` with value `%s`", m.name, m.value)
+		}
+		c.SampledUpdate(v, sampleRate)
 	case "s":
 		c := p.registry.GetOrNewSet(m.name, m.tags)
 		c.Add(m.value)
+	case serviceCheckType:
+		c := p.registry.GetOrNewServiceCheck(m.name, m.tags)
+		v, err := strconv.ParseInt(m.value, 10, 64)
+		if err != nil || v < 0 || v > 3 {
+			return errors.Errorf("failed to process service check `%s` with status `%s`", m.name, m.value)
+		}
+		c.status = v
+		c.message = m.message
 	default:
 		logp.NewLogger("statsd").Debugf("metric type `%s` is not supported", m.metricType)
 	}
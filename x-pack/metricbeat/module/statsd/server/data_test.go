@@ -156,6 +156,76 @@ func TestParseMetrics(t *testing.T) {
 				},
 			},
 		},
+		/// DogStatsD extensions
+		{
+			input: "tags3:1|c|#k1:v1,canary",
+			expected: []statsdMetric{
+				{
+					name:       "tags3",
+					metricType: "c",
+					value:      "1",
+					tags: map[string]string{
+						"k1":     "v1",
+						"canary": "",
+					},
+				},
+			},
+		},
+		{
+			input: "distribution1:0.5|d|@0.5|#k1:v1|c:container1|T1604566800",
+			expected: []statsdMetric{
+				{
+					name:       "distribution1",
+					metricType: "d",
+					value:      "0.5",
+					sampleRate: "0.5",
+					tags:       map[string]string{"k1": "v1"},
+				},
+			},
+		},
+		{
+			input: "multi1:1:2.5|d",
+			expected: []statsdMetric{
+				{
+					name:       "multi1",
+					metricType: "d",
+					value:      "1",
+				},
+				{
+					name:       "multi1",
+					metricType: "d",
+					value:      "2.5",
+				},
+			},
+		},
+		{
+			input: "set2:user:1|s",
+			expected: []statsdMetric{{
+				name:       "set2",
+				metricType: "s",
+				value:      "user:1",
+			}},
+		},
+		{
+			input: "_sc|check1|2|d:1604566800|h:host1|#k1:v1|m:disk is full|almost",
+			expected: []statsdMetric{
+				{
+					name:       "check1",
+					metricType: "sc",
+					value:      "2",
+					tags:       map[string]string{"k1": "v1"},
+					message:    "disk is full|almost",
+				},
+			},
+		},
+		{
+			input: "_e{5,4}:title|text|#k1:v1\ncounter3:1|c",
+			expected: []statsdMetric{{
+				name:       "counter3",
+				metricType: "c",
+				value:      "1",
+			}},
+		},
 		/// errors
 		{
 			input:    "meter1-1.4|m",
@@ -172,7 +242,9 @@ func TestParseMetrics(t *testing.T) {
 		assert.Equal(t, test.err, err, test.input)
 		assert.Equal(t, test.expected, actual, test.input)
 
-		processor := newMetricProcessor(time.Second)
+		mapper, err := newMapper(defaultConfig())
+		require.NoError(t, err)
+		processor := newMetricProcessor(time.Second, mapper)
 		for _, e := range actual {
 			err := processor.processSingle(e)
 
@@ -449,6 +521,180 @@ func TestChangeType(t *testing.T) {
 	}, events[0].MetricSetFields)
 }
 
+func TestHistogramSampled(t *testing.T) {
+	ms := mbtest.NewMetricSet(t, map[string]interface{}{"module": "statsd"}).(*MetricSet)
+	testData := []string{
+		"metric01:2|h|@0.1",
+		"metric01:4.4|h|@0.5",
+	}
+	err := process(testData, ms)
+	require.NoError(t, err)
+
+	events := ms.getEvents()
+	require.Len(t, events, 1)
+
+	actualMetric01 := events[0].MetricSetFields["metric01"].(map[string]interface{})
+	assert.Equal(t, int64(12), actualMetric01["count"])
+	assert.Equal(t, int64(2), actualMetric01["min"])
+	assert.Equal(t, int64(4), actualMetric01["max"])
+}
+
+func TestDistribution(t *testing.T) {
+	ms := mbtest.NewMetricSet(t, map[string]interface{}{
+		"module":      "statsd",
+		"percentiles": []float64{50, 90},
+		"buckets":     []float64{1, 2},
+	}).(*MetricSet)
+	testData := []string{
+		"metric01:0.5:1.5:1.5:2.5|d",
+		"metric01:3|d|@0.5",
+	}
+	err := process(testData, ms)
+	require.NoError(t, err)
+
+	events := ms.getEvents()
+	require.Len(t, events, 1)
+
+	assert.Equal(t, common.MapStr{
+		"metric01": map[string]interface{}{
+			"count":  int64(6),
+			"sum":    12.0,
+			"min":    0.5,
+			"max":    3.0,
+			"mean":   2.0,
+			"median": 1.5,
+			"p90":    3.0,
+			"histogram": common.MapStr{
+				"values": []float64{0.5, 1.5, 3},
+				"counts": []uint64{1, 2, 3},
+			},
+		},
+	}, events[0].MetricSetFields)
+
+	// distributions are reset on each report
+	events = ms.getEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, int64(0), events[0].MetricSetFields["metric01"].(map[string]interface{})["count"])
+}
+
+func TestHistogramsSettings(t *testing.T) {
+	ms := mbtest.NewMetricSet(t, map[string]interface{}{
+		"module": "statsd",
+		"histograms": []map[string]interface{}{
+			{"match": "api.*.latency", "percentiles": []float64{99.99}, "buckets": []float64{10, 100}},
+		},
+	}).(*MetricSet)
+	testData := []string{
+		"api.users.latency:5|ms",
+		"api.users.latency:50|ms",
+		"db.latency:5|ms",
+	}
+	err := process(testData, ms)
+	require.NoError(t, err)
+
+	events := ms.getEvents()
+	require.Len(t, events, 1)
+
+	api := events[0].MetricSetFields["api_users_latency"].(map[string]interface{})
+	assert.Contains(t, api, "p99_99")
+	assert.NotContains(t, api, "median")
+	assert.Equal(t, common.MapStr{
+		"values": []float64{5, 55, 190},
+		"counts": []uint64{1, 1, 0},
+	}, api["histogram"])
+
+	db := events[0].MetricSetFields["db_latency"].(map[string]interface{})
+	for _, key := range []string{"median", "p75", "p95", "p99", "p99_9"} {
+		assert.Contains(t, db, key)
+	}
+	assert.NotContains(t, db, "histogram")
+}
+
+func TestInvalidHistogramsSettings(t *testing.T) {
+	for _, c := range []map[string]interface{}{
+		{"percentiles": []float64{0}},
+		{"percentiles": []float64{101}},
+		{"buckets": []float64{10, 1}},
+		{"histograms": []map[string]interface{}{{"match": "api..latency"}}},
+		{"mappings": []map[string]interface{}{{"metric": "api.<>.latency", "name": "latency"}}},
+	} {
+		config := defaultConfig()
+		require.NoError(t, common.MustNewConfigFrom(c).Unpack(&config))
+		_, err := newMapper(config)
+		assert.Error(t, err, "%v", c)
+	}
+}
+
+func TestServiceCheck(t *testing.T) {
+	ms := mbtest.NewMetricSet(t, map[string]interface{}{"module": "statsd"}).(*MetricSet)
+	testData := []string{
+		"_sc|app.health|0",
+		"_sc|app.health|2|m:database unreachable",
+	}
+	err := process(testData, ms)
+	require.NoError(t, err)
+
+	events := ms.getEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, common.MapStr{
+		"app_health": map[string]interface{}{"status": int64(2), "message": "database unreachable"},
+	}, events[0].MetricSetFields)
+
+	err = process([]string{"_sc|app.health|5"}, ms)
+	assert.Error(t, err)
+}
+
+func TestMappings(t *testing.T) {
+	ms := mbtest.NewMetricSet(t, map[string]interface{}{
+		"module": "statsd",
+		"mappings": []map[string]interface{}{
+			{"metric": "airflow.dag.<dag_id>.*.duration", "name": "dag_duration", "labels": map[string]string{"source": "airflow"}},
+			{"metric": "airflow.<job_name>.start", "name": "job_start"},
+		},
+	}).(*MetricSet)
+	testData := []string{
+		"airflow.dag.backup.task1.duration:10|ms",
+		"airflow.dag.backup.task2.duration:20|ms|#source:custom",
+		"airflow.dag.cleanup.task1.duration:30|ms",
+		"airflow.scheduler.start:1|c",
+		"airflow.scheduler.start.delay:1|ms",
+	}
+	err := process(testData, ms)
+	require.NoError(t, err)
+
+	events := ms.getEvents()
+	require.Len(t, events, 5)
+
+	groups := map[string]common.MapStr{}
+	for _, e := range events {
+		labels := e.RootFields["labels"].(common.MapStr)
+		groups[labels.String()] = e.MetricSetFields
+	}
+
+	expected := []common.MapStr{
+		{"dag_id": "backup", "source": "airflow"},
+		{"dag_id": "backup", "source": "custom"},
+		{"dag_id": "cleanup", "source": "airflow"},
+	}
+	for _, labels := range expected {
+		fields, found := groups[labels.String()]
+		if assert.True(t, found, "%v", labels) {
+			assert.Contains(t, fields, "dag_duration")
+		}
+	}
+
+	fields, found := groups[common.MapStr{"job_name": "scheduler"}.String()]
+	if assert.True(t, found) {
+		assert.Contains(t, fields, "job_start")
+	}
+
+	// patterns must match all the segments of the name
+	fields, found = groups[common.MapStr{}.String()]
+	if assert.True(t, found) {
+		assert.Contains(t, fields, "airflow_scheduler_start_delay")
+	}
+}
+
 func BenchmarkIngest(b *testing.B) {
 	tests := []string{
 		"metric01:1.0|g|#k1:v1,k2:v2",
@@ -0,0 +1,238 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package server
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rcrowley/go-metrics"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+const reservoirSize = 1028
+
+var defaultPercentiles = []float64{50, 75, 95, 99, 99.9}
+
+// histogramSettings are the percentiles and buckets reported for timers,
+// histograms and distributions.
+type histogramSettings struct {
+	// percentiles as quantiles in the (0, 1] range
+	percentiles []float64
+	// names of the percentiles in the events
+	names   []string
+	buckets []float64
+}
+
+func newHistogramSettings(percentiles, buckets []float64) (*histogramSettings, error) {
+	if percentiles == nil {
+		percentiles = defaultPercentiles
+	}
+
+	s := &histogramSettings{
+		percentiles: make([]float64, len(percentiles)),
+		names:       make([]string, len(percentiles)),
+	}
+	for i, p := range percentiles {
+		if p <= 0 || p > 100 {
+			return nil, fmt.Errorf("percentile %v out of range (0, 100]", p)
+		}
+		s.percentiles[i] = p / 100
+		s.names[i] = percentileName(p)
+	}
+
+	if len(buckets) > 0 {
+		if !sort.Float64sAreSorted(buckets) {
+			return nil, fmt.Errorf("buckets must be sorted in increasing order")
+		}
+		for i := 1; i < len(buckets); i++ {
+			if buckets[i] == buckets[i-1] {
+				return nil, fmt.Errorf("duplicated bucket %v", buckets[i])
+			}
+		}
+		s.buckets = buckets
+	}
+
+	return s, nil
+}
+
+// percentileName returns the key for a percentile, the median is kept as
+// `median`, and other percentiles are named like `p99` or `p99_9`.
+func percentileName(p float64) string {
+	if p == 50 {
+		return "median"
+	}
+	return "p" + strings.Replace(strconv.FormatFloat(p, 'f', -1, 64), ".", "_", -1)
+}
+
+func (s *histogramSettings) putPercentiles(values map[string]interface{}, ps []float64) {
+	for i, name := range s.names {
+		values[name] = ps[i]
+	}
+}
+
+// bucketCounts counts the values observed in each bucket since the last
+// report.
+type bucketCounts struct {
+	bounds []float64
+	// counts has an additional bucket for the values above the last bound
+	counts []float64
+}
+
+func newBucketCounts(bounds []float64) *bucketCounts {
+	if len(bounds) == 0 {
+		return nil
+	}
+	return &bucketCounts{
+		bounds: bounds,
+		counts: make([]float64, len(bounds)+1),
+	}
+}
+
+// Observe adds a value, weighted according to its sample rate.
+func (b *bucketCounts) Observe(v float64, sampleRate float64) {
+	if b == nil {
+		return
+	}
+	i := sort.SearchFloat64s(b.bounds, v)
+	b.counts[i] += 1 / sampleRate
+}
+
+// Histogram returns the counts in a format that can be stored as an
+// Elasticsearch histogram, and resets them. Buckets are reported by their
+// centroids, and the bucket for values above the last bound is interpolated
+// as a point at the same distance as the previous one.
+func (b *bucketCounts) Histogram() common.MapStr {
+	values := make([]float64, 0, len(b.counts))
+	counts := make([]uint64, 0, len(b.counts))
+
+	var lastUpper, prevUpper float64
+	for i, count := range b.counts {
+		if i < len(b.bounds) {
+			values = append(values, lastUpper+(b.bounds[i]-lastUpper)/2.0)
+			prevUpper = lastUpper
+			lastUpper = b.bounds[i]
+		} else {
+			values = append(values, lastUpper+(lastUpper-prevUpper))
+		}
+		counts = append(counts, uint64(math.Round(count)))
+		b.counts[i] = 0
+	}
+
+	return common.MapStr{
+		"values": values,
+		"counts": counts,
+	}
+}
+
+// samplingHistogram is a histogram that supports sampling.
+type samplingHistogram struct {
+	metrics.Histogram
+	count    float64
+	settings *histogramSettings
+	buckets  *bucketCounts
+}
+
+func newSamplingHistogram(settings *histogramSettings) *samplingHistogram {
+	return &samplingHistogram{
+		Histogram: metrics.NewHistogram(metrics.NewExpDecaySample(reservoirSize, 0.015)),
+		settings:  settings,
+		buckets:   newBucketCounts(settings.buckets),
+	}
+}
+
+// SampledUpdate will update the histogram with a sampled measurement.
+func (h *samplingHistogram) SampledUpdate(v int64, sampleRate float64) {
+	h.Histogram.Update(v)
+	h.count += 1 / sampleRate
+	h.buckets.Observe(float64(v), sampleRate)
+}
+
+// Count returns the number of values recorded, extrapolated according to the
+// sample rates.
+func (h *samplingHistogram) Count() int64 {
+	return int64(math.Round(h.count))
+}
+
+// distribution keeps the values reported since the last report, so their
+// percentiles can be calculated. Values are kept in a reservoir of limited size
+// randomly sampled.
+type distribution struct {
+	values   []float64
+	seen     int
+	count    float64
+	sum      float64
+	min, max float64
+	settings *histogramSettings
+	buckets  *bucketCounts
+}
+
+func newDistribution(settings *histogramSettings) *distribution {
+	return &distribution{
+		settings: settings,
+		buckets:  newBucketCounts(settings.buckets),
+	}
+}
+
+// SampledUpdate adds a sampled value to the distribution.
+func (d *distribution) SampledUpdate(v float64, sampleRate float64) {
+	if d.seen == 0 || v < d.min {
+		d.min = v
+	}
+	if d.seen == 0 || v > d.max {
+		d.max = v
+	}
+	d.seen++
+	d.count += 1 / sampleRate
+	d.sum += v / sampleRate
+	d.buckets.Observe(v, sampleRate)
+
+	if len(d.values) < reservoirSize {
+		d.values = append(d.values, v)
+	} else if r := rand.Intn(d.seen); r < reservoirSize {
+		d.values[r] = v
+	}
+}
+
+// Percentiles returns the requested quantiles of the values in the reservoir.
+func (d *distribution) Percentiles(ps []float64) []float64 {
+	scores := make([]float64, len(ps))
+	if len(d.values) == 0 {
+		return scores
+	}
+
+	sorted := make([]float64, len(d.values))
+	copy(sorted, d.values)
+	sort.Float64s(sorted)
+	for i, p := range ps {
+		idx := int(math.Ceil(p*float64(len(sorted)))) - 1
+		if idx < 0 {
+			idx = 0
+		}
+		scores[i] = sorted[idx]
+	}
+	return scores
+}
+
+// Reset clears the distribution.
+func (d *distribution) Reset() {
+	d.values = d.values[:0]
+	d.seen = 0
+	d.count = 0
+	d.sum = 0
+	d.min = 0
+	d.max = 0
+}
+
+// serviceCheck is the last status reported by a DogStatsD service check.
+type serviceCheck struct {
+	status  int64
+	message string
+}
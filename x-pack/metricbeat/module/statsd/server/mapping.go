@@ -0,0 +1,175 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package server
+
+import (
+	"fmt"
+	"strings"
+)
+
+// metricPattern matches dotted metric names segment by segment. A `*` segment
+// matches any single segment, and a `<label>` segment matches any single
+// segment capturing its value as label.
+type metricPattern struct {
+	segments []string
+	labels   []string
+}
+
+func compileMetricPattern(pattern string) (*metricPattern, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("empty metric pattern")
+	}
+
+	segments := strings.Split(pattern, ".")
+	labels := make([]string, len(segments))
+	for i, s := range segments {
+		if s == "" {
+			return nil, fmt.Errorf("empty segment in metric pattern '%s'", pattern)
+		}
+		if strings.HasPrefix(s, "<") && strings.HasSuffix(s, ">") {
+			label := s[1 : len(s)-1]
+			if label == "" {
+				return nil, fmt.Errorf("empty label name in metric pattern '%s'", pattern)
+			}
+			labels[i] = label
+		}
+	}
+	return &metricPattern{segments: segments, labels: labels}, nil
+}
+
+// Match checks if the name matches the pattern, and returns the captured
+// labels if it does.
+func (p *metricPattern) Match(name string) (map[string]string, bool) {
+	segments := strings.Split(name, ".")
+	if len(segments) != len(p.segments) {
+		return nil, false
+	}
+
+	var labels map[string]string
+	for i, s := range segments {
+		switch {
+		case p.labels[i] != "":
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[p.labels[i]] = s
+		case p.segments[i] == "*":
+		case p.segments[i] != s:
+			return nil, false
+		}
+	}
+	return labels, true
+}
+
+// MappingConfig renames the metrics matching a pattern, turning parts of their
+// names into labels.
+type MappingConfig struct {
+	Metric string            `config:"metric" validate:"required"`
+	Name   string            `config:"name" validate:"required"`
+	Labels map[string]string `config:"labels"`
+}
+
+// HistogramConfig overrides the percentiles and buckets reported for the timers,
+// histograms and distributions matching a pattern.
+type HistogramConfig struct {
+	Match       string    `config:"match" validate:"required"`
+	Percentiles []float64 `config:"percentiles"`
+	Buckets     []float64 `config:"buckets"`
+}
+
+type mapping struct {
+	pattern *metricPattern
+	name    string
+	labels  map[string]string
+}
+
+// mapper applies the configured mappings and resolves the histogram settings of
+// the metrics.
+type mapper struct {
+	mappings   []mapping
+	histograms []histogramMatcher
+	defaults   *histogramSettings
+}
+
+type histogramMatcher struct {
+	pattern  *metricPattern
+	settings *histogramSettings
+}
+
+func newMapper(config Config) (*mapper, error) {
+	defaults, err := newHistogramSettings(config.Percentiles, config.Buckets)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &mapper{defaults: defaults}
+
+	for _, c := range config.Mappings {
+		pattern, err := compileMetricPattern(c.Metric)
+		if err != nil {
+			return nil, err
+		}
+		m.mappings = append(m.mappings, mapping{pattern: pattern, name: c.Name, labels: c.Labels})
+	}
+
+	for _, c := range config.Histograms {
+		pattern, err := compileMetricPattern(c.Match)
+		if err != nil {
+			return nil, err
+		}
+
+		percentiles := c.Percentiles
+		if percentiles == nil {
+			percentiles = config.Percentiles
+		}
+		buckets := c.Buckets
+		if buckets == nil {
+			buckets = config.Buckets
+		}
+		settings, err := newHistogramSettings(percentiles, buckets)
+		if err != nil {
+			return nil, fmt.Errorf("invalid histogram settings for '%s': %v", c.Match, err)
+		}
+		m.histograms = append(m.histograms, histogramMatcher{pattern: pattern, settings: settings})
+	}
+
+	return m, nil
+}
+
+// Map returns the name and tags of a metric after applying the first matching
+// mapping. Tags present in the metric take precedence over labels captured by
+// the mapping.
+func (m *mapper) Map(name string, tags map[string]string) (string, map[string]string) {
+	for _, mp := range m.mappings {
+		captured, ok := mp.pattern.Match(name)
+		if !ok {
+			continue
+		}
+
+		mapped := make(map[string]string, len(captured)+len(mp.labels)+len(tags))
+		for k, v := range mp.labels {
+			mapped[k] = v
+		}
+		for k, v := range captured {
+			mapped[k] = v
+		}
+		for k, v := range tags {
+			mapped[k] = v
+		}
+		return mp.name, mapped
+	}
+	return name, tags
+}
+
+// HistogramSettings returns the settings for the histogram-like metric with the
+// given name. It is only called when the metric is created in the registry.
+func (m *mapper) HistogramSettings(name string) *histogramSettings {
+	for _, h := range m.histograms {
+		if _, ok := h.pattern.Match(name); ok {
+			return h.settings
+		}
+	}
+	return m.defaults
+}
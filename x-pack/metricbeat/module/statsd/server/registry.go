@@ -5,6 +5,7 @@
 package server
 
 import (
+	"math"
 	"time"
 
 	"github.com/rcrowley/go-metrics"
@@ -28,6 +29,7 @@ type registry struct {
 	metrics    map[string]map[string]*metric
 	ttl        time.Duration
 	lastReport time.Time
+	mapper     *mapper
 }
 
 type setMetric struct {
@@ -73,24 +75,29 @@ type samplingTimer struct {
 	metrics.Timer
 	meter     metrics.Meter
 	histogram metrics.Histogram
+	settings  *histogramSettings
+	buckets   *bucketCounts
 }
 
 // NewSamplingTimer returns a new SamplingTimer
-func newSamplingTimer() *samplingTimer {
+func newSamplingTimer(settings *histogramSettings) *samplingTimer {
 	m := metrics.NewMeter()
-	h := metrics.NewHistogram(metrics.NewExpDecaySample(1028, 0.015))
+	h := metrics.NewHistogram(metrics.NewExpDecaySample(reservoirSize, 0.015))
 
 	return &samplingTimer{
 		Timer:     metrics.NewCustomTimer(h, m),
 		meter:     m,
 		histogram: h,
+		settings:  settings,
+		buckets:   newBucketCounts(settings.buckets),
 	}
 }
 
 // SampledUpdate will update the timer a sampled measurement
 func (s *samplingTimer) SampledUpdate(d time.Duration, sampleRate float64) {
 	s.histogram.Update(int64(d))
-	s.meter.Mark(int64(1 / sampleRate))
+	s.meter.Mark(int64(math.Round(1 / sampleRate)))
+	s.buckets.Observe(float64(d), sampleRate)
 }
 
 // Snapshot gets a snapshot of the SamplingTimer
@@ -193,39 +200,55 @@ func (r *registry) getMetric(metric interface{}) map[string]interface{} {
 		m.Clear()
 	case *deltaGaugeMetric:
 		values["value"] = m.Value()
-	case metrics.Histogram:
+	case *samplingHistogram:
 		h := m.Snapshot()
-		ps := h.Percentiles([]float64{0.5, 0.75, 0.95, 0.99, 0.999})
-		values["count"] = h.Count()
+		values["count"] = m.Count()
 		values["min"] = h.Min()
 		values["max"] = h.Max()
 		values["mean"] = h.Mean()
 		values["stddev"] = h.StdDev()
-		values["median"] = ps[0]
-		values["p75"] = ps[1]
-		values["p95"] = ps[2]
-		values["p99"] = ps[3]
-		values["p99_9"] = ps[4]
+		m.settings.putPercentiles(values, h.Percentiles(m.settings.percentiles))
+		if m.buckets != nil {
+			values["histogram"] = m.buckets.Histogram()
+		}
 	case *samplingTimer:
 		t := m.Snapshot()
-		ps := t.Percentiles([]float64{0.5, 0.75, 0.95, 0.99, 0.999})
 		values["count"] = t.Count()
 		values["min"] = t.Min()
 		values["max"] = t.Max()
 		values["mean"] = t.Mean()
 		values["stddev"] = t.StdDev()
-		values["median"] = ps[0]
-		values["p75"] = ps[1]
-		values["p95"] = ps[2]
-		values["p99"] = ps[3]
-		values["p99_9"] = ps[4]
+		m.settings.putPercentiles(values, t.Percentiles(m.settings.percentiles))
 		values["1m_rate"] = t.Rate1()
 		values["5m_rate"] = t.Rate5()
 		values["15m_rate"] = t.Rate15()
 		values["mean_rate"] = t.RateMean()
+		if m.buckets != nil {
+			values["histogram"] = m.buckets.Histogram()
+		}
+	case *distribution:
+		values["count"] = int64(math.Round(m.count))
+		values["sum"] = m.sum
+		values["min"] = m.min
+		values["max"] = m.max
+		mean := 0.0
+		if m.count > 0 {
+			mean = m.sum / m.count
+		}
+		values["mean"] = mean
+		m.settings.putPercentiles(values, m.Percentiles(m.settings.percentiles))
+		if m.buckets != nil {
+			values["histogram"] = m.buckets.Histogram()
+		}
+		m.Reset()
 	case *setMetric:
 		values["count"] = m.Count()
 		m.Reset()
+	case *serviceCheck:
+		values["status"] = m.status
+		if m.message != "" {
+			values["message"] = m.message
+		}
 	}
 	return values
 }
@@ -334,7 +357,9 @@ func (r *registry) GetOrNewCounter(name string, tags map[string]string) metrics.
 }
 
 func (r *registry) GetOrNewTimer(name string, tags map[string]string) *samplingTimer {
-	timer, ok := r.getOrNew(name, tags, func() interface{} { return newSamplingTimer() }).(*samplingTimer)
+	timer, ok := r.getOrNew(name, tags, func() interface{} {
+		return newSamplingTimer(r.mapper.HistogramSettings(name))
+	}).(*samplingTimer)
 	if ok {
 		return timer
 	}
@@ -353,8 +378,10 @@ func (r *registry) GetOrNewGauge64(name string, tags map[string]string) *deltaGa
 	return r.GetOrNewGauge64(name, tags)
 }
 
-func (r *registry) GetOrNewHistogram(name string, tags map[string]string) metrics.Histogram {
-	histogram, ok := r.getOrNew(name, tags, func() interface{} { return metrics.NewHistogram(metrics.NewExpDecaySample(1028, 0.015)) }).(metrics.Histogram)
+func (r *registry) GetOrNewHistogram(name string, tags map[string]string) *samplingHistogram {
+	histogram, ok := r.getOrNew(name, tags, func() interface{} {
+		return newSamplingHistogram(r.mapper.HistogramSettings(name))
+	}).(*samplingHistogram)
 	if ok {
 		return histogram
 	}
@@ -363,6 +390,28 @@ func (r *registry) GetOrNewHistogram(name string, tags map[string]string) metric
 	return r.GetOrNewHistogram(name, tags)
 }
 
+func (r *registry) GetOrNewDistribution(name string, tags map[string]string) *distribution {
+	dist, ok := r.getOrNew(name, tags, func() interface{} {
+		return newDistribution(r.mapper.HistogramSettings(name))
+	}).(*distribution)
+	if ok {
+		return dist
+	}
+
+	r.clearTypeChanged(name, tags)
+	return r.GetOrNewDistribution(name, tags)
+}
+
+func (r *registry) GetOrNewServiceCheck(name string, tags map[string]string) *serviceCheck {
+	check, ok := r.getOrNew(name, tags, func() interface{} { return &serviceCheck{} }).(*serviceCheck)
+	if ok {
+		return check
+	}
+
+	r.clearTypeChanged(name, tags)
+	return r.GetOrNewServiceCheck(name, tags)
+}
+
 func (r *registry) GetOrNewSet(name string, tags map[string]string) *setMetric {
 	setmetric, ok := r.getOrNew(name, tags, func() interface{} { return newSetMetric() }).(*setMetric)
 	if ok {
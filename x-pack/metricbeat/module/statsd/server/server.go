@@ -22,6 +22,17 @@ func init() {
 // Config for the statsd server metricset.
 type Config struct {
 	TTL time.Duration `config:"ttl"`
+
+	// Percentiles and Buckets reported by default for timers, histograms and
+	// distributions.
+	Percentiles []float64 `config:"percentiles"`
+	Buckets     []float64 `config:"buckets"`
+
+	// Histograms overrides the percentiles and buckets of some metrics.
+	Histograms []HistogramConfig `config:"histograms"`
+
+	// Mappings renames metrics, extracting labels from their names.
+	Mappings []MappingConfig `config:"mappings"`
 }
 
 func defaultConfig() Config {
@@ -49,12 +60,17 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 		return nil, err
 	}
 
+	mapper, err := newMapper(config)
+	if err != nil {
+		return nil, err
+	}
+
 	svc, err := udp.NewUdpServer(base)
 	if err != nil {
 		return nil, err
 	}
 
-	processor := newMetricProcessor(config.TTL)
+	processor := newMetricProcessor(config.TTL, mapper)
 	return &MetricSet{
 		BaseMetricSet: base,
 		server:        svc,